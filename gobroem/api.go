@@ -1,38 +1,132 @@
 package gobroem
 
 import (
+	"compress/gzip"
+	"context"
 	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"html/template"
+	"io"
+	"io/ioutil"
+	"mime"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 // API ...
 type API struct {
-	dbClient *sqlClient
-	dbFile   string
+	databases []*database
+	options   Options
+	queries   *runningQueries
+	saved     *savedQueries
+	jobs      *maintenanceJobs
+}
+
+// Options configures the API controller.
+type Options struct {
+	// ReadOnly rejects the statements writing to the database. A DB file is
+	// also opened in read-only mode; a DB handle should be opened read-only
+	// by the caller, e.g. with the "mode=ro" URI parameter.
+	ReadOnly bool
+	// Authenticator, if set, must accept every API request. The index page
+	// and static files are always served.
+	Authenticator Authenticator
+	// MaxRows caps the number of rows returned by a query, 0 for no limit.
+	MaxRows int
+	// QueryTimeout bounds the duration of every query, 0 for no limit.
+	QueryTimeout time.Duration
+	// AttachAllowlist holds the glob patterns, matched against absolute
+	// paths, of the files which may be attached through the API, with
+	// POST api/schemas. Attaching is disabled when empty.
+	// A database given as a *sql.DB can't attach files.
+	AttachAllowlist []string
+	// History records the queries run through the API and serves them to the
+	// UI. The queries are not recorded when nil.
+	History HistoryStore
+	// SavedQueries are saved queries defined by the application, served with
+	// the ones saved through the API but not editable.
+	SavedQueries []SavedQuery
+	// SavedQueryStore keeps the queries saved through the API, in memory if
+	// nil.
+	SavedQueryStore SavedQueryStore
+	// VacuumDir is the directory of the files written by VACUUM INTO, which
+	// is disabled when empty.
+	VacuumDir string
 }
 
 // NewAPI initializes the API controller with a DB file.
 func NewAPI(dbFile string) (*API, error) {
-	client, err := newClient(dbFile)
-	if err != nil {
+	return NewAPIWithOptions(dbFile, Options{})
+}
+
+// NewAPIWithOptions initializes the API controller with a DB file and the
+// given options.
+func NewAPIWithOptions(dbFile string, opts Options) (*API, error) {
+	registry := NewRegistry()
+	if err := registry.AddFile(fileDBName(dbFile), dbFile); err != nil {
 		return nil, err
 	}
-	return &API{client, dbFile}, nil
+	return NewAPIWithRegistry(registry, opts)
 }
 
 // NewAPIFromDB initializes the API controller with a DB.
 func NewAPIFromDB(db *sql.DB) (*API, error) {
-	client, err := newClientFromDB(db)
+	return NewAPIFromDBWithOptions(db, Options{})
+}
+
+// NewAPIFromDBWithOptions initializes the API controller with a DB and the
+// given options.
+func NewAPIFromDBWithOptions(db *sql.DB, opts Options) (*API, error) {
+	registry := NewRegistry()
+	if err := registry.AddDB("main", db); err != nil {
+		return nil, err
+	}
+	return NewAPIWithRegistry(registry, opts)
+}
+
+// NewAPIWithRegistry initializes the API controller with the databases of the
+// registry and the given options. The requests select a database with the db
+// parameter.
+func NewAPIWithRegistry(registry *Registry, opts Options) (*API, error) {
+	if len(registry.entries) == 0 {
+		return nil, errors.New("no database in the registry")
+	}
+
+	saved, err := newSavedQueries(opts.SavedQueries, opts.SavedQueryStore)
 	if err != nil {
 		return nil, err
 	}
-	return &API{client, ""}, nil
+
+	a := &API{
+		options: opts,
+		queries: newRunningQueries(),
+		saved:   saved,
+		jobs:    newMaintenanceJobs(),
+	}
+	for _, e := range registry.entries {
+		var client *sqlClient
+		var err error
+		if e.db != nil {
+			client, err = newClientFromDB(e.db, opts.ReadOnly)
+		} else {
+			client, err = newClient(e.file, opts.ReadOnly, opts.AttachAllowlist)
+		}
+		if err != nil {
+			return nil, err
+		}
+		a.databases = append(a.databases, &database{name: e.name, file: e.file, client: client})
+	}
+	return a, nil
 }
 
 // Handler ...
@@ -44,9 +138,30 @@ func (a *API) Handler(browserRoot string, staticRoot string) http.Handler {
 	staticHandler := http.StripPrefix(staticRoot, fileServer)
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, browserRoot+"api/") || strings.HasPrefix(r.URL.Path, browserRoot+"q/") {
+			if r = a.authenticate(w, r); r == nil {
+				return
+			}
+			if !sameSiteRequest(r) {
+				renderError(w, http.StatusForbidden, errCrossSiteRequest)
+				return
+			}
+		}
+
 		switch r.URL.Path {
 		case browserRoot + "api/info":
 			a.Info(w, r)
+		case browserRoot + "api/databases":
+			a.Databases(w, r)
+		case browserRoot + "api/schemas":
+			switch r.Method {
+			case http.MethodPost:
+				a.AttachDatabase(w, r)
+			case http.MethodDelete:
+				a.DetachDatabase(w, r)
+			default:
+				a.Schemas(w, r)
+			}
 		case browserRoot + "api/tables":
 			a.Tables(w, r)
 		case browserRoot + "api/table":
@@ -57,8 +172,58 @@ func (a *API) Handler(browserRoot string, staticRoot string) http.Handler {
 			a.TableSQL(w, r)
 		case browserRoot + "api/table/indexes":
 			a.TableIndexes(w, r)
+		case browserRoot + "api/table/rows":
+			switch r.Method {
+			case http.MethodPost:
+				a.InsertRow(w, r)
+			case http.MethodPatch:
+				a.UpdateRow(w, r)
+			case http.MethodDelete:
+				a.DeleteRow(w, r)
+			default:
+				a.TableRows(w, r)
+			}
+		case browserRoot + "api/table/blob":
+			a.TableBlob(w, r)
+		case browserRoot + "api/schema/graph":
+			a.SchemaGraph(w, r)
 		case browserRoot + "api/query":
 			a.Query(w, r)
+		case browserRoot + "api/query/cancel":
+			a.CancelQuery(w, r)
+		case browserRoot + "api/history":
+			a.History(w, r)
+		case browserRoot + "api/query/plan":
+			a.QueryPlan(w, r)
+		case browserRoot + "api/saved":
+			switch r.Method {
+			case http.MethodPost:
+				a.CreateSavedQuery(w, r)
+			case http.MethodPut:
+				a.UpdateSavedQuery(w, r)
+			case http.MethodDelete:
+				a.DeleteSavedQuery(w, r)
+			default:
+				a.SavedQueries(w, r)
+			}
+		case browserRoot + "api/backup":
+			a.Backup(w, r)
+		case browserRoot + "api/export/sql":
+			a.ExportSQL(w, r)
+		case browserRoot + "api/import":
+			a.Import(w, r)
+		case browserRoot + "api/maintenance":
+			a.MaintenanceJobs(w, r)
+		case browserRoot + "api/maintenance/job":
+			if r.Method == http.MethodDelete {
+				a.CancelMaintenanceJob(w, r)
+			} else {
+				a.MaintenanceJob(w, r)
+			}
+		case browserRoot + "api/maintenance/" + path.Base(r.URL.Path):
+			a.StartMaintenance(w, r)
+		case browserRoot + "q/" + path.Base(r.URL.Path):
+			a.RunSavedQuery(w, r)
 		case browserRoot:
 			indexTmpl.Execute(w, map[string]string{"root": browserRoot, "static": staticRoot})
 		default:
@@ -74,48 +239,100 @@ func (a *API) Handler(browserRoot string, staticRoot string) http.Handler {
 
 // Info ...
 func (a *API) Info(w http.ResponseWriter, req *http.Request) {
-	info, err := a.dbClient.Info()
-	if err != nil {
-		renderError(w, http.StatusInternalServerError, err)
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	db, ok := a.requestDatabase(w, req)
+	if !ok {
+		return
+	}
+	client, ok := a.schemaClient(ctx, w, req)
+	if !ok {
+		return
 	}
 
-	filePath, err := filepath.Abs(a.dbFile)
+	info, err := client.Info(ctx)
 	if err != nil {
-		filePath = ""
+		renderError(w, http.StatusInternalServerError, err)
+		return
 	}
 
-	dbName := filepath.Base(a.dbFile)
-	size, _ := fileSize(filePath)
+	// A DB handle has no file
+	var filePath, dbName string
+	var size int64
+	if db.file != "" {
+		filePath, _ = filepath.Abs(db.file)
+		dbName = filepath.Base(db.file)
+		size, _ = fileSize(filePath)
+	}
 
 	result := map[string]interface{}{
-		"number_of_tables":  info.Rows[0][0],
-		"number_of_indexes": info.Rows[0][1],
-		"filename":          dbName,
-		"fullname":          filePath,
-		"size":              size,
+		"number_of_tables":         info.Rows[0][0],
+		"number_of_indexes":        info.Rows[0][1],
+		"number_of_views":          info.Rows[0][2],
+		"number_of_triggers":       info.Rows[0][3],
+		"number_of_virtual_tables": info.Rows[0][4],
+		"filename":                 dbName,
+		"fullname":                 filePath,
+		"size":                     size,
 	}
 	renderJSON(w, http.StatusOK, result)
 }
 
 // Tables ...
 func (a *API) Tables(w http.ResponseWriter, req *http.Request) {
-	tables, err := a.dbClient.Tables()
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	client, ok := a.schemaClient(ctx, w, req)
+	if !ok {
+		return
+	}
+
+	objects, err := client.SchemaObjects(ctx)
 	if err != nil {
 		renderError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// tables keeps listing the names of all the tables, virtual ones included
+	tables := make([]string, 0, len(objects.Tables)+len(objects.VirtualTables))
+	for _, group := range [][]schemaObject{objects.Tables, objects.VirtualTables} {
+		for _, t := range group {
+			tables = append(tables, t.Name)
+		}
 	}
 
 	result := map[string]interface{}{
-		"tables": tables,
+		"tables":  tables,
+		"objects": objects,
 	}
 	renderJSON(w, http.StatusOK, result)
 }
 
 // Table ...
 func (a *API) Table(w http.ResponseWriter, req *http.Request) {
-	name := req.URL.Query().Get("table")
-	result, err := a.dbClient.Table(name)
+	name, ok := tableParam(w, req)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	client, ok := a.schemaClient(ctx, w, req)
+	if !ok {
+		return
+	}
+
+	result, err := client.Table(ctx, name)
 	if err != nil {
-		renderError(w, http.StatusInternalServerError, err)
+		renderClientError(w, err)
+		return
+	}
+	if len(result.Rows) == 0 {
+		renderClientError(w, errNoSuchTable(name))
+		return
 	}
 
 	renderJSON(w, http.StatusOK, result.Format())
@@ -123,15 +340,33 @@ func (a *API) Table(w http.ResponseWriter, req *http.Request) {
 
 // TableInfo ...
 func (a *API) TableInfo(w http.ResponseWriter, req *http.Request) {
-	name := req.URL.Query().Get("table")
-	result, err := a.dbClient.TableInfo(name)
+	name, ok := tableParam(w, req)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	client, ok := a.schemaClient(ctx, w, req)
+	if !ok {
+		return
+	}
+
+	result, err := client.TableInfo(ctx, name)
 	if err != nil {
-		renderError(w, http.StatusInternalServerError, err)
+		renderClientError(w, err)
+		return
+	}
+	indexes, err := client.TableIndexes(ctx, name)
+	if err != nil {
+		renderClientError(w, err)
+		return
 	}
 
 	data := map[string]interface{}{
 		"row_count":     result.Rows[0][0],
-		"indexes_count": 0,
+		"indexes_count": len(indexes),
 	}
 
 	renderJSON(w, http.StatusOK, data)
@@ -139,14 +374,32 @@ func (a *API) TableInfo(w http.ResponseWriter, req *http.Request) {
 
 // TableSQL ...
 func (a *API) TableSQL(w http.ResponseWriter, req *http.Request) {
-	name := req.URL.Query().Get("table")
-	result, err := a.dbClient.TableSQL(name)
+	name, ok := tableParam(w, req)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	client, ok := a.schemaClient(ctx, w, req)
+	if !ok {
+		return
+	}
+
+	result, err := client.TableSQL(ctx, name)
 	if err != nil {
-		renderError(w, http.StatusInternalServerError, err)
+		renderClientError(w, err)
+		return
+	}
+	if len(result.Rows) == 0 {
+		renderClientError(w, errNoSuchTable(name))
+		return
 	}
 
 	data := map[string]interface{}{
-		"sql": result[0],
+		"type": result.Rows[0][0],
+		"sql":  result.Rows[0][1],
 	}
 
 	renderJSON(w, http.StatusOK, data)
@@ -154,52 +407,1344 @@ func (a *API) TableSQL(w http.ResponseWriter, req *http.Request) {
 
 // TableIndexes ...
 func (a *API) TableIndexes(w http.ResponseWriter, req *http.Request) {
-	name := req.URL.Query().Get("table")
-	result, err := a.dbClient.TableIndexes(name)
+	name, ok := tableParam(w, req)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	client, ok := a.schemaClient(ctx, w, req)
+	if !ok {
+		return
+	}
+
+	indexes, err := client.TableIndexes(ctx, name)
 	if err != nil {
-		renderError(w, http.StatusInternalServerError, err)
+		renderClientError(w, err)
+		return
 	}
 
-	renderJSON(w, http.StatusOK, result.Format())
+	renderJSON(w, http.StatusOK, indexes)
 }
 
-// Query ...
-func (a *API) Query(w http.ResponseWriter, req *http.Request) {
-	query := strings.TrimSpace(req.FormValue("query"))
+// SchemaGraph ...
+func (a *API) SchemaGraph(w http.ResponseWriter, req *http.Request) {
+	format := req.URL.Query().Get("format")
+	switch format {
+	case "", "json", "dot", "mermaid":
+	default:
+		renderError(w, http.StatusBadRequest, errors.New("Invalid format"))
+		return
+	}
 
-	if query == "" {
-		renderError(w, http.StatusBadRequest, errors.New("Query missing"))
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	client, ok := a.schemaClient(ctx, w, req)
+	if !ok {
 		return
 	}
 
-	result, err := a.dbClient.QuerySQL(req.FormValue("query"))
+	graph, err := client.SchemaGraph(ctx)
 	if err != nil {
-		renderError(w, http.StatusInternalServerError, err)
+		renderClientError(w, err)
 		return
 	}
 
+	switch format {
+	case "dot":
+		renderText(w, "text/vnd.graphviz", graph.DOT())
+	case "mermaid":
+		renderText(w, "text/plain; charset=UTF-8", graph.Mermaid())
+	default:
+		renderJSON(w, http.StatusOK, graph)
+	}
+}
+
+// TableRows ...
+func (a *API) TableRows(w http.ResponseWriter, req *http.Request) {
 	q := req.URL.Query()
-	if len(q["format"]) > 0 {
-		if q["format"][0] == "csv" {
-			renderCSV(w, http.StatusOK, result.CSV())
+	name, ok := tableParam(w, req)
+	if !ok {
+		return
+	}
+
+	opts := pageOptions{
+		Order:  q.Get("order"),
+		Cursor: q.Get("cursor"),
+	}
+	_, opts.Keyset = q["cursor"]
+
+	var err error
+	if v := q.Get("limit"); v != "" {
+		if opts.Limit, err = strconv.Atoi(v); err != nil {
+			renderError(w, http.StatusBadRequest, errors.New("Invalid limit"))
+			return
+		}
+	}
+	if v := q.Get("offset"); v != "" {
+		if opts.Offset, err = strconv.Atoi(v); err != nil {
+			renderError(w, http.StatusBadRequest, errors.New("Invalid offset"))
+			return
+		}
+	}
+	if v := q.Get("filters"); v != "" {
+		if opts.Filters, err = parseFilters(v); err != nil {
+			renderClientError(w, err)
 			return
-		} else if q["format"][0] == "json" {
-			// Format the returned JSON instead of returning in the Result format
-			renderJSON(w, http.StatusOK, result.Format())
+		}
+	}
+	switch strings.ToLower(q.Get("dir")) {
+	case "", "asc":
+	case "desc":
+		opts.Desc = true
+	default:
+		renderError(w, http.StatusBadRequest, errors.New("Invalid dir"))
+		return
+	}
+	if opts.Encoding, err = encodingParam(req); err != nil {
+		renderError(w, http.StatusBadRequest, err)
+		return
+	}
+	// Counting reads all the matching rows, which total=0 avoids
+	opts.Count = true
+	if _, found := q["total"]; found {
+		if opts.Count, err = boolParam(req, "total"); err != nil {
+			renderError(w, http.StatusBadRequest, err)
 			return
 		}
 	}
 
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	client, ok := a.schemaClient(ctx, w, req)
+	if !ok {
+		return
+	}
+
+	result, err := client.TableRows(ctx, name, opts)
+	if err != nil {
+		renderClientError(w, err)
+		return
+	}
+
 	renderJSON(w, http.StatusOK, result)
 }
 
-// renderError renders a JSON response with the given error message.
-func renderError(w http.ResponseWriter, status int, err error) {
-	result := map[string]interface{}{
-		"code":    "error",
-		"message": err.Error(),
+// InsertRow ...
+func (a *API) InsertRow(w http.ResponseWriter, req *http.Request) {
+	name, ok := tableParam(w, req)
+	if !ok {
+		return
 	}
-	renderJSON(w, status, result)
+
+	values, err := parseObject(req.Body)
+	if err != nil {
+		renderError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	client, ok := a.schemaClient(ctx, w, req)
+	if !ok {
+		return
+	}
+
+	result, err := client.InsertRow(ctx, name, values)
+	if err != nil {
+		renderClientError(w, err)
+		return
+	}
+
+	renderJSON(w, http.StatusCreated, result.Format()[0])
+}
+
+// UpdateRow ...
+func (a *API) UpdateRow(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	name, ok := tableParam(w, req)
+	if !ok {
+		return
+	}
+
+	key, err := parseObject(strings.NewReader(q.Get("key")))
+	if err != nil {
+		renderError(w, http.StatusBadRequest, errors.New("Invalid key"))
+		return
+	}
+	values, err := parseObject(req.Body)
+	if err != nil {
+		renderError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	client, ok := a.schemaClient(ctx, w, req)
+	if !ok {
+		return
+	}
+
+	result, err := client.UpdateRow(ctx, name, key, values)
+	if err != nil {
+		renderClientError(w, err)
+		return
+	}
+
+	renderJSON(w, http.StatusOK, result.Format()[0])
+}
+
+// DeleteRow ...
+func (a *API) DeleteRow(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	name, ok := tableParam(w, req)
+	if !ok {
+		return
+	}
+
+	key, err := parseObject(strings.NewReader(q.Get("key")))
+	if err != nil {
+		renderError(w, http.StatusBadRequest, errors.New("Invalid key"))
+		return
+	}
+
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	client, ok := a.schemaClient(ctx, w, req)
+	if !ok {
+		return
+	}
+
+	result, err := client.DeleteRow(ctx, name, key)
+	if err != nil {
+		renderClientError(w, err)
+		return
+	}
+
+	renderJSON(w, http.StatusOK, result.Format()[0])
+}
+
+// TableBlob ...
+func (a *API) TableBlob(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	name, ok := tableParam(w, req)
+	if !ok {
+		return
+	}
+	column := q.Get("column")
+	if column == "" {
+		renderError(w, http.StatusBadRequest, errors.New("Column missing"))
+		return
+	}
+	rowid, err := strconv.ParseInt(q.Get("rowid"), 10, 64)
+	if err != nil {
+		renderError(w, http.StatusBadRequest, errors.New("Invalid rowid"))
+		return
+	}
+	format := q.Get("format")
+	switch format {
+	case "", "raw", "hex", "base64":
+	default:
+		renderError(w, http.StatusBadRequest, errors.New("Invalid format"))
+		return
+	}
+	download, err := boolParam(req, "download")
+	if err != nil {
+		renderError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// The query timeout is not meant for sending a large value
+	ctx := req.Context()
+	client, ok := a.schemaClient(ctx, w, req)
+	if !ok {
+		return
+	}
+
+	blob, err := client.OpenBlob(ctx, name, column, rowid)
+	if err != nil {
+		renderClientError(w, err)
+		return
+	}
+	head := make([]byte, blobSniffLen)
+	n, err := io.ReadFull(blob, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		renderClientError(w, err)
+		return
+	}
+	contentType := sniffContentType(head[:n], int64(n) == blob.Size)
+	blob.Seek(0, io.SeekStart)
+
+	// The detected type is also reported for the hex and base64 views
+	w.Header().Set("X-Blob-Content-Type", contentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	fileName := fmt.Sprintf("%s-%s-%d", name, column, rowid)
+
+	switch format {
+	case "hex":
+		if download {
+			w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName + ".hex"}))
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		dumper := hex.Dumper(w)
+		io.Copy(dumper, blob)
+		dumper.Close()
+	case "base64":
+		if download {
+			w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName + ".b64"}))
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		encoder := base64.NewEncoder(base64.StdEncoding, w)
+		io.Copy(encoder, blob)
+		encoder.Close()
+	default:
+		if download {
+			ext, ok := blobExtensions[contentType]
+			if !ok {
+				ext = ".bin"
+			}
+			w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName + ext}))
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Security-Policy", "sandbox")
+		// ServeContent answers the Range requests
+		http.ServeContent(w, req, "", time.Time{}, blob)
+	}
+}
+
+// Query ...
+func (a *API) Query(w http.ResponseWriter, req *http.Request) {
+	query, args, err := queryParam(req)
+	if err != nil {
+		renderClientError(w, err)
+		return
+	}
+	if query == "" {
+		renderError(w, http.StatusBadRequest, errors.New("Query missing"))
+		return
+	}
+
+	script, err := boolParam(req, "script")
+	if err != nil {
+		renderError(w, http.StatusBadRequest, err)
+		return
+	}
+	transaction, err := boolParam(req, "transaction")
+	if err != nil {
+		renderError(w, http.StatusBadRequest, err)
+		return
+	}
+	if transaction && !script {
+		renderError(w, http.StatusBadRequest, errors.New("Transaction requires script mode"))
+		return
+	}
+	if script && len(args) > 0 {
+		renderError(w, http.StatusBadRequest, errors.New("Parameters are not supported in script mode"))
+		return
+	}
+	enc, err := encodingParam(req)
+	if err != nil {
+		renderError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var writer rowWriter
+	switch req.FormValue("format") {
+	case "csv":
+		writer = newCSVWriter(w)
+	case "json":
+		// Format the returned JSON instead of returning in the Result format
+		writer = newObjectsWriter(w, false)
+	case "ndjson":
+		writer = newObjectsWriter(w, true)
+	default:
+		writer = newResultWriter(w)
+	}
+
+	db, ok := a.requestDatabase(w, req)
+	if !ok {
+		return
+	}
+
+	id := req.FormValue("query_id")
+	if id == "" {
+		if id, err = newQueryID(); err != nil {
+			renderError(w, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	// Any page can send a GET request, e.g. with an image. The other errors
+	// are reported when the query runs.
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		if err := db.client.checkReadOnly(ctx, query); err == errReadOnly {
+			renderError(w, http.StatusMethodNotAllowed, errors.New("Statements writing the database require POST"))
+			return
+		}
+	}
+
+	if !a.queries.start(id, Principal(req), cancel) {
+		renderError(w, http.StatusConflict, errors.New("Query id already in use"))
+		return
+	}
+	defer a.queries.done(id)
+
+	start := time.Now()
+	w.Header().Set("X-Query-Id", id)
+	if script {
+		result, err := db.client.RunScript(ctx, query, transaction, a.options.MaxRows, enc)
+		if err != nil {
+			a.recordQuery(req, db, query, start, 0, err)
+			renderClientError(w, err)
+			return
+		}
+
+		var rows int64
+		for _, res := range result.Results {
+			rows += int64(len(res.Rows))
+		}
+		if result.Error != "" {
+			err = errors.New(result.Error)
+		}
+		a.recordQuery(req, db, query, start, rows, err)
+		renderJSON(w, http.StatusOK, result)
+		return
+	}
+
+	counter := &countingWriter{rowWriter: writer}
+	err = db.client.StreamSQL(ctx, counter, a.options.MaxRows, enc, query, args...)
+	if err != nil {
+		renderClientError(w, err)
+	} else {
+		err = counter.err
+	}
+	a.recordQuery(req, db, query, start, counter.rows, err)
+}
+
+// CancelQuery ...
+func (a *API) CancelQuery(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		renderError(w, http.StatusMethodNotAllowed, errors.New("Queries are canceled with POST"))
+		return
+	}
+	id := req.FormValue("query_id")
+	if id == "" {
+		renderError(w, http.StatusBadRequest, errors.New("Query id missing"))
+		return
+	}
+
+	if !a.queries.cancel(id, Principal(req)) {
+		renderError(w, http.StatusNotFound, errors.New("No such running query"))
+		return
+	}
+
+	renderJSON(w, http.StatusOK, map[string]interface{}{"query_id": id, "canceled": true})
+}
+
+// QueryPlan ...
+func (a *API) QueryPlan(w http.ResponseWriter, req *http.Request) {
+	query, args, err := queryParam(req)
+	if err != nil {
+		renderClientError(w, err)
+		return
+	}
+	if query == "" {
+		renderError(w, http.StatusBadRequest, errors.New("Query missing"))
+		return
+	}
+
+	bytecode, err := boolParam(req, "bytecode")
+	if err != nil {
+		renderError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	db, ok := a.requestDatabase(w, req)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	plan, err := db.client.QueryPlan(ctx, query, bytecode, args...)
+	if err != nil {
+		renderClientError(w, err)
+		return
+	}
+
+	renderJSON(w, http.StatusOK, plan)
+}
+
+// History ...
+func (a *API) History(w http.ResponseWriter, req *http.Request) {
+	if a.options.History == nil {
+		renderError(w, http.StatusNotFound, errors.New("Query history disabled"))
+		return
+	}
+
+	q := req.URL.Query()
+	filter := HistoryFilter{
+		Search:    q.Get("search"),
+		Principal: q.Get("user"),
+		Limit:     defaultPageSize,
+	}
+	var err error
+	if v := q.Get("limit"); v != "" {
+		if filter.Limit, err = strconv.Atoi(v); err != nil || filter.Limit <= 0 {
+			renderError(w, http.StatusBadRequest, errors.New("Invalid limit"))
+			return
+		}
+	}
+	if filter.Limit > maxPageSize {
+		filter.Limit = maxPageSize
+	}
+	if v := q.Get("offset"); v != "" {
+		if filter.Offset, err = strconv.Atoi(v); err != nil || filter.Offset < 0 {
+			renderError(w, http.StatusBadRequest, errors.New("Invalid offset"))
+			return
+		}
+	}
+
+	db, ok := a.requestDatabase(w, req)
+	if !ok {
+		return
+	}
+	filter.Database = db.name
+
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	entries, total, err := a.options.History.List(ctx, filter)
+	if err != nil {
+		renderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if entries == nil {
+		entries = []HistoryEntry{}
+	}
+
+	result := map[string]interface{}{
+		"entries": entries,
+		"total":   total,
+		"limit":   filter.Limit,
+		"offset":  filter.Offset,
+	}
+	renderJSON(w, http.StatusOK, result)
+}
+
+// SavedQueries ...
+func (a *API) SavedQueries(w http.ResponseWriter, req *http.Request) {
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	if slug := req.URL.Query().Get("slug"); slug != "" {
+		q, err := a.saved.get(ctx, slug)
+		if err != nil {
+			renderClientError(w, err)
+			return
+		}
+		renderJSON(w, http.StatusOK, q)
+		return
+	}
+
+	queries, err := a.saved.list(ctx)
+	if err != nil {
+		renderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	renderJSON(w, http.StatusOK, map[string]interface{}{"queries": queries})
+}
+
+// CreateSavedQuery ...
+func (a *API) CreateSavedQuery(w http.ResponseWriter, req *http.Request) {
+	var q SavedQuery
+	if err := decodeJSON(req.Body, &q); err != nil {
+		renderError(w, http.StatusBadRequest, errors.New("Invalid saved query"))
+		return
+	}
+
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	if err := a.checkSavedQuery(ctx, req, &q); err != nil {
+		renderClientError(w, err)
+		return
+	}
+	if err := a.saved.create(ctx, q); err != nil {
+		renderClientError(w, err)
+		return
+	}
+	renderJSON(w, http.StatusCreated, q)
+}
+
+// UpdateSavedQuery ...
+func (a *API) UpdateSavedQuery(w http.ResponseWriter, req *http.Request) {
+	slug := req.URL.Query().Get("slug")
+	if slug == "" {
+		renderError(w, http.StatusBadRequest, errors.New("Slug missing"))
+		return
+	}
+	var q SavedQuery
+	if err := decodeJSON(req.Body, &q); err != nil {
+		renderError(w, http.StatusBadRequest, errors.New("Invalid saved query"))
+		return
+	}
+	// The slug is kept so that the links to the query keep working
+	if q.Slug != "" && q.Slug != slug {
+		renderError(w, http.StatusBadRequest, errors.New("The slug can't be changed"))
+		return
+	}
+	q.Slug = slug
+
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	if err := a.checkSavedQuery(ctx, req, &q); err != nil {
+		renderClientError(w, err)
+		return
+	}
+	if err := a.saved.update(ctx, q); err != nil {
+		renderClientError(w, err)
+		return
+	}
+	renderJSON(w, http.StatusOK, q)
+}
+
+// DeleteSavedQuery ...
+func (a *API) DeleteSavedQuery(w http.ResponseWriter, req *http.Request) {
+	slug := req.URL.Query().Get("slug")
+	if slug == "" {
+		renderError(w, http.StatusBadRequest, errors.New("Slug missing"))
+		return
+	}
+
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	q, err := a.saved.delete(ctx, slug)
+	if err != nil {
+		renderClientError(w, err)
+		return
+	}
+	renderJSON(w, http.StatusOK, q)
+}
+
+// RunSavedQuery ...
+func (a *API) RunSavedQuery(w http.ResponseWriter, req *http.Request) {
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	saved, err := a.saved.get(ctx, path.Base(req.URL.Path))
+	if err != nil {
+		renderClientError(w, err)
+		return
+	}
+
+	values := req.URL.Query()
+	format := values.Get("format")
+	if format == "" {
+		format = saved.Format
+	}
+	page := newSavedPage(saved, values)
+	fail := func(err error) {
+		if format == "csv" || format == "json" {
+			renderClientError(w, err)
+			return
+		}
+		status, err := clientError(err)
+		page.Error = err.Error()
+		renderSavedPage(w, status, page)
+	}
+
+	var writer rowWriter
+	switch format {
+	case "", "html":
+		writer = newHTMLWriter(w, page)
+	case "csv":
+		writer = &attachmentWriter{rowWriter: newCSVWriter(w), w: w, fileName: saved.Slug + ".csv"}
+	case "json":
+		writer = newObjectsWriter(w, false)
+	default:
+		fail(inputError("invalid format: " + format))
+		return
+	}
+
+	name := saved.Database
+	if name == "" {
+		name = values.Get("db")
+	}
+	db := a.database(name)
+	if db == nil {
+		fail(notFoundError("No such database: " + name))
+		return
+	}
+
+	args, err := saved.args(values)
+	if err != nil {
+		fail(err)
+		return
+	}
+	// A page is opened by following a link, so it must not write
+	if err := db.client.checkReadOnly(ctx, saved.SQL); err != nil {
+		if err == errReadOnly {
+			err = errSavedQueryWrites
+		}
+		fail(err)
+		return
+	}
+
+	start := time.Now()
+	counter := &countingWriter{rowWriter: writer}
+	err = db.client.StreamSQL(ctx, counter, a.options.MaxRows, valueEncoding{}, saved.SQL, args...)
+	if err != nil {
+		fail(err)
+	} else {
+		err = counter.err
+	}
+	a.recordQuery(req, db, saved.SQL, start, counter.rows, err)
+}
+
+// checkSavedQuery normalizes a saved query and checks that its database
+// exists and that it only reads it. The query is checked against the
+// database of the request when it has none.
+func (a *API) checkSavedQuery(ctx context.Context, req *http.Request, q *SavedQuery) error {
+	if err := q.normalize(); err != nil {
+		return err
+	}
+
+	name := q.Database
+	if name == "" {
+		name = req.URL.Query().Get("db")
+	}
+	db := a.database(name)
+	if db == nil {
+		return inputError("no such database: " + name)
+	}
+
+	err := db.client.checkReadOnly(ctx, q.SQL)
+	switch {
+	case err == errReadOnly:
+		return errSavedQueryWrites
+	case err == context.DeadlineExceeded || err == context.Canceled:
+		return err
+	case err != nil:
+		// The statements failed to compile
+		return inputError(err.Error())
+	}
+	return nil
+}
+
+// Backup ...
+func (a *API) Backup(w http.ResponseWriter, req *http.Request) {
+	compress, err := boolParam(req, "gzip")
+	if err != nil {
+		renderError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	db, ok := a.requestDatabase(w, req)
+	if !ok {
+		return
+	}
+
+	// The query timeout is not meant for copying a whole database
+	ctx := req.Context()
+	client, ok := a.schemaClient(ctx, w, req)
+	if !ok {
+		return
+	}
+
+	tmp, err := ioutil.TempFile("", "gobroem-backup-*.db")
+	if err != nil {
+		renderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	tmp.Close()
+	defer os.Remove(tmp.Name())
+
+	if err := client.Backup(ctx, tmp.Name()); err != nil {
+		renderClientError(w, err)
+		return
+	}
+
+	file, err := os.Open(tmp.Name())
+	if err != nil {
+		renderError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer file.Close()
+
+	name := db.name
+	if client.schema != "" {
+		name += "-" + client.schema
+	}
+	name += ".db"
+
+	if compress {
+		w.Header().Set("Content-Type", "application/gzip")
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name + ".gz"}))
+		gz := gzip.NewWriter(w)
+		io.Copy(gz, file)
+		gz.Close()
+		return
+	}
+
+	if fi, err := file.Stat(); err == nil {
+		w.Header().Set("Content-Length", strconv.FormatInt(fi.Size(), 10))
+	}
+	w.Header().Set("Content-Type", "application/vnd.sqlite3")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name}))
+	io.Copy(w, file)
+}
+
+// ExportSQL ...
+func (a *API) ExportSQL(w http.ResponseWriter, req *http.Request) {
+	db, ok := a.requestDatabase(w, req)
+	if !ok {
+		return
+	}
+
+	// The query timeout is not meant for reading a whole database
+	ctx := req.Context()
+	client, ok := a.schemaClient(ctx, w, req)
+	if !ok {
+		return
+	}
+
+	name := db.name
+	if client.schema != "" {
+		name += "-" + client.schema
+	}
+	out := &exportWriter{w: w, contentType: "application/sql", fileName: name + ".sql"}
+	if err := client.Dump(ctx, out, req.URL.Query()["table"]); err != nil {
+		if !out.started {
+			renderClientError(w, err)
+			return
+		}
+		w.Header().Set(trailerError, err.Error())
+	}
+}
+
+// Import ...
+func (a *API) Import(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		renderError(w, http.StatusMethodNotAllowed, errors.New("Files are imported with POST"))
+		return
+	}
+	name, ok := tableParam(w, req)
+	if !ok {
+		return
+	}
+
+	if err := req.ParseMultipartForm(maxImportMemory); err != nil {
+		renderError(w, http.StatusBadRequest, errors.New("Invalid multipart form"))
+		return
+	}
+	file, header, err := req.FormFile("file")
+	if err != nil {
+		renderError(w, http.StatusBadRequest, errors.New("File missing"))
+		return
+	}
+	defer file.Close()
+
+	opts := importOptions{
+		Format:     req.FormValue("format"),
+		OnConflict: req.FormValue("on_conflict"),
+		BatchSize:  importBatchRows,
+	}
+	if opts.Format == "" {
+		switch strings.ToLower(filepath.Ext(header.Filename)) {
+		case ".json":
+			opts.Format = "json"
+		case ".ndjson", ".jsonl":
+			opts.Format = "ndjson"
+		default:
+			opts.Format = "csv"
+		}
+	}
+	if opts.Format != "csv" && opts.Format != "json" && opts.Format != "ndjson" {
+		renderError(w, http.StatusBadRequest, errors.New("Invalid format"))
+		return
+	}
+	switch req.FormValue("mode") {
+	case "", "append":
+	case "create":
+		opts.Create = true
+	default:
+		renderError(w, http.StatusBadRequest, errors.New("Invalid mode"))
+		return
+	}
+	if req.FormValue("header") != "" {
+		header, err := boolParam(req, "header")
+		if err != nil {
+			renderError(w, http.StatusBadRequest, err)
+			return
+		}
+		opts.Header = &header
+	}
+	switch d := req.FormValue("delimiter"); {
+	case d == "tab" || d == "\\t":
+		opts.Delimiter = '\t'
+	case utf8.RuneCountInString(d) == 1:
+		opts.Delimiter, _ = utf8.DecodeRuneInString(d)
+	case d != "":
+		renderError(w, http.StatusBadRequest, errors.New("Invalid delimiter"))
+		return
+	}
+	if opts.InferTypes, err = boolParam(req, "infer_types"); err != nil {
+		renderError(w, http.StatusBadRequest, err)
+		return
+	}
+	if v := req.FormValue("columns"); v != "" {
+		if err := json.Unmarshal([]byte(v), &opts.Columns); err != nil {
+			renderError(w, http.StatusBadRequest, errors.New("Invalid columns"))
+			return
+		}
+	}
+	switch opts.OnConflict {
+	case "":
+		opts.OnConflict = "abort"
+	case "abort", "ignore", "replace":
+	default:
+		renderError(w, http.StatusBadRequest, errors.New("Invalid on_conflict"))
+		return
+	}
+	if v := req.FormValue("batch_size"); v != "" {
+		if opts.BatchSize, err = strconv.Atoi(v); err != nil || opts.BatchSize <= 0 {
+			renderError(w, http.StatusBadRequest, errors.New("Invalid batch_size"))
+			return
+		}
+	}
+
+	// The query timeout is not meant for loading a whole file
+	ctx := req.Context()
+	client, ok := a.schemaClient(ctx, w, req)
+	if !ok {
+		return
+	}
+
+	result, err := client.Import(ctx, name, file, opts)
+	if err != nil {
+		renderClientError(w, err)
+		return
+	}
+
+	renderJSON(w, http.StatusOK, result)
+}
+
+// StartMaintenance ...
+func (a *API) StartMaintenance(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		renderError(w, http.StatusMethodNotAllowed, errors.New("Maintenance jobs are started with POST"))
+		return
+	}
+	action := path.Base(req.URL.Path)
+
+	db, ok := a.requestDatabase(w, req)
+	if !ok {
+		return
+	}
+	client, ok := a.schemaClient(req.Context(), w, req)
+	if !ok {
+		return
+	}
+
+	run, err := a.maintenanceAction(client, action, req.FormValue("into"))
+	if err != nil {
+		renderClientError(w, err)
+		return
+	}
+
+	id, err := newQueryID()
+	if err != nil {
+		renderError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// The job outlives the request, without the query timeout
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &maintenanceJob{
+		ID:        id,
+		Action:    action,
+		Database:  db.name,
+		Schema:    client.schema,
+		Progress:  jobProgress{Total: 1},
+		Started:   time.Now(),
+		principal: Principal(req),
+		cancel:    cancel,
+	}
+	if !a.jobs.start(job) {
+		cancel()
+		renderClientError(w, errJobRunning)
+		return
+	}
+
+	go func() {
+		defer cancel()
+		result, err := run(ctx, func(done, total int64, step string) {
+			a.jobs.progress(job, done, total, step)
+		})
+		a.jobs.finish(job, result, err)
+	}()
+
+	started, _ := a.jobs.get(job.ID, job.principal)
+	renderJSON(w, http.StatusAccepted, started)
+}
+
+// maintenanceRun runs a maintenance action in the background.
+type maintenanceRun func(ctx context.Context, progress progressFunc) (interface{}, error)
+
+// maintenanceAction returns the run of an action, once checked that it can
+// start.
+func (a *API) maintenanceAction(client *sqlClient, action, into string) (maintenanceRun, error) {
+	switch action {
+	case "integrity_check", "quick_check":
+		return func(ctx context.Context, progress progressFunc) (interface{}, error) {
+			return client.IntegrityCheck(ctx, action == "quick_check")
+		}, nil
+	case "foreign_key_check":
+		return func(ctx context.Context, progress progressFunc) (interface{}, error) {
+			return client.ForeignKeyCheck(ctx, progress)
+		}, nil
+	case "analyze":
+		if client.readOnly {
+			return nil, errReadOnly
+		}
+		return func(ctx context.Context, progress progressFunc) (interface{}, error) {
+			return client.Analyze(ctx, progress)
+		}, nil
+	case "optimize":
+		if client.readOnly {
+			return nil, errReadOnly
+		}
+		return func(ctx context.Context, progress progressFunc) (interface{}, error) {
+			return client.Optimize(ctx)
+		}, nil
+	case "vacuum":
+		// SQLite opens the file written by VACUUM INTO read-only too
+		if client.readOnly {
+			return nil, errReadOnly
+		}
+		if into == "" {
+			return func(ctx context.Context, progress progressFunc) (interface{}, error) {
+				return client.Vacuum(ctx)
+			}, nil
+		}
+
+		if a.options.VacuumDir == "" {
+			return nil, errVacuumIntoDisabled
+		}
+		if into != filepath.Base(into) || into == "." || into == ".." {
+			return nil, inputError("invalid file name: " + into)
+		}
+		file := filepath.Join(a.options.VacuumDir, into)
+		if _, err := os.Stat(file); err == nil {
+			return nil, inputError("the file already exists")
+		}
+		return func(ctx context.Context, progress progressFunc) (interface{}, error) {
+			result, err := client.VacuumInto(ctx, file, progress)
+			if err != nil {
+				return nil, err
+			}
+			// The directory is not disclosed
+			result.File = into
+			return result, nil
+		}, nil
+	}
+	return nil, notFoundError("no such maintenance action: " + action)
+}
+
+// MaintenanceJobs ...
+func (a *API) MaintenanceJobs(w http.ResponseWriter, req *http.Request) {
+	db, ok := a.requestDatabase(w, req)
+	if !ok {
+		return
+	}
+
+	renderJSON(w, http.StatusOK, map[string]interface{}{"jobs": a.jobs.list(db.name, Principal(req))})
+}
+
+// MaintenanceJob ...
+func (a *API) MaintenanceJob(w http.ResponseWriter, req *http.Request) {
+	job, found := a.jobs.get(req.FormValue("id"), Principal(req))
+	if !found {
+		renderError(w, http.StatusNotFound, errors.New("No such maintenance job"))
+		return
+	}
+
+	renderJSON(w, http.StatusOK, job)
+}
+
+// CancelMaintenanceJob ...
+func (a *API) CancelMaintenanceJob(w http.ResponseWriter, req *http.Request) {
+	id := req.FormValue("id")
+	if !a.jobs.cancel(id, Principal(req)) {
+		renderError(w, http.StatusNotFound, errors.New("No such running maintenance job"))
+		return
+	}
+
+	renderJSON(w, http.StatusOK, map[string]interface{}{"id": id, "canceled": true})
+}
+
+// Databases ...
+func (a *API) Databases(w http.ResponseWriter, req *http.Request) {
+	databases := make([]databaseInfo, len(a.databases))
+	for i, db := range a.databases {
+		databases[i] = databaseInfo{Name: db.name}
+		if db.file != "" {
+			databases[i].File, _ = filepath.Abs(db.file)
+		}
+	}
+
+	renderJSON(w, http.StatusOK, map[string]interface{}{"databases": databases})
+}
+
+// Schemas ...
+func (a *API) Schemas(w http.ResponseWriter, req *http.Request) {
+	db, ok := a.requestDatabase(w, req)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	schemas, err := db.client.Databases(ctx)
+	if err != nil {
+		renderClientError(w, err)
+		return
+	}
+
+	renderJSON(w, http.StatusOK, map[string]interface{}{"schemas": schemas})
+}
+
+// AttachDatabase ...
+func (a *API) AttachDatabase(w http.ResponseWriter, req *http.Request) {
+	name := req.FormValue("name")
+	file := req.FormValue("file")
+	if name == "" || file == "" {
+		renderError(w, http.StatusBadRequest, errors.New("Name or file missing"))
+		return
+	}
+
+	db, ok := a.requestDatabase(w, req)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := a.requestContext(req)
+	defer cancel()
+
+	if err := db.client.Attach(ctx, name, file); err != nil {
+		renderClientError(w, err)
+		return
+	}
+
+	a.Schemas(w, req)
+}
+
+// DetachDatabase ...
+func (a *API) DetachDatabase(w http.ResponseWriter, req *http.Request) {
+	name := req.URL.Query().Get("name")
+	if name == "" {
+		renderError(w, http.StatusBadRequest, errors.New("Name missing"))
+		return
+	}
+
+	db, ok := a.requestDatabase(w, req)
+	if !ok {
+		return
+	}
+
+	if err := db.client.Detach(name); err != nil {
+		renderClientError(w, err)
+		return
+	}
+
+	a.Schemas(w, req)
+}
+
+// requestDatabase returns the database of the db parameter, the first one of
+// the registry if missing. It renders an error and returns false if there is
+// no such database.
+func (a *API) requestDatabase(w http.ResponseWriter, req *http.Request) (*database, bool) {
+	name := req.URL.Query().Get("db")
+	db := a.database(name)
+	if db == nil {
+		renderError(w, http.StatusNotFound, errors.New("No such database: "+name))
+		return nil, false
+	}
+	return db, true
+}
+
+// database returns the database with the given name, the first one if the
+// name is empty, nil if there is no such database.
+func (a *API) database(name string) *database {
+	if name == "" {
+		return a.databases[0]
+	}
+	for _, db := range a.databases {
+		if db.name == name {
+			return db
+		}
+	}
+	return nil
+}
+
+// schemaClient returns the client reading the schema parameter of the
+// requested database, the main one if missing. It renders an error and
+// returns false if there is no such database or schema.
+func (a *API) schemaClient(ctx context.Context, w http.ResponseWriter, req *http.Request) (*sqlClient, bool) {
+	db, ok := a.requestDatabase(w, req)
+	if !ok {
+		return nil, false
+	}
+	client, err := db.client.InSchema(ctx, req.URL.Query().Get("schema"))
+	if err != nil {
+		renderClientError(w, err)
+		return nil, false
+	}
+	return client, true
+}
+
+// requestContext returns the context of the queries run for a request,
+// bounded by the query timeout.
+func (a *API) requestContext(req *http.Request) (context.Context, context.CancelFunc) {
+	if a.options.QueryTimeout > 0 {
+		return context.WithTimeout(req.Context(), a.options.QueryTimeout)
+	}
+	return context.WithCancel(req.Context())
+}
+
+// tableParam returns the table query parameter. It renders an error and
+// returns false if the parameter is missing or not a valid identifier.
+func tableParam(w http.ResponseWriter, req *http.Request) (string, bool) {
+	name := req.URL.Query().Get("table")
+	if name == "" {
+		renderError(w, http.StatusBadRequest, errors.New("Table missing"))
+		return "", false
+	}
+	if !validIdent(name) {
+		renderError(w, http.StatusBadRequest, errors.New("Invalid table name"))
+		return "", false
+	}
+	return name, true
+}
+
+// queryParam returns the query to run and its arguments, read from the JSON
+// body of a parameterized query or else from the query parameter.
+func queryParam(req *http.Request) (string, []interface{}, error) {
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if mediaType == "application/json" {
+		return parseQueryBody(req.Body)
+	}
+	return strings.TrimSpace(req.FormValue("query")), nil, nil
+}
+
+// boolParam returns an optional boolean parameter, false when missing.
+func boolParam(req *http.Request, name string) (bool, error) {
+	v := req.FormValue(name)
+	if v == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("Invalid %s", name)
+	}
+	return b, nil
+}
+
+// encodingParam returns the value encoding of the typed and blob parameters.
+func encodingParam(req *http.Request) (valueEncoding, error) {
+	var enc valueEncoding
+	var err error
+	if enc.Typed, err = boolParam(req, "typed"); err != nil {
+		return enc, err
+	}
+	switch req.FormValue("blob") {
+	case "", "base64":
+	case "hex":
+		enc.Hex = true
+	default:
+		return enc, errors.New("Invalid blob")
+	}
+	return enc, nil
+}
+
+// parseObject decodes a JSON object, keeping integers exact.
+func parseObject(r io.Reader) (map[string]interface{}, error) {
+	var object map[string]interface{}
+	if err := decodeJSON(r, &object); err != nil {
+		return nil, fmt.Errorf("Invalid JSON object: %v", err)
+	}
+	if object == nil {
+		return nil, errors.New("Invalid JSON object: null")
+	}
+	if _, err := normalizeJSON(object); err != nil {
+		return nil, fmt.Errorf("Invalid JSON object: %v", err)
+	}
+	return object, nil
+}
+
+// renderError renders a JSON response with the given error message.
+func renderError(w http.ResponseWriter, status int, err error) {
+	result := map[string]interface{}{
+		"code":    "error",
+		"message": err.Error(),
+	}
+	renderJSON(w, status, result)
+}
+
+// renderClientError renders an error returned by the sqlClient with the
+// matching status code.
+func renderClientError(w http.ResponseWriter, err error) {
+	status, err := clientError(err)
+	renderError(w, status, err)
+}
+
+// clientError returns the status code of an error returned by the sqlClient,
+// and the error reported to the client.
+func clientError(err error) (int, error) {
+	status := http.StatusInternalServerError
+	switch err.(type) {
+	case inputError:
+		status = http.StatusBadRequest
+	case notFoundError:
+		status = http.StatusNotFound
+	}
+	switch {
+	case err == errAttachDenied, err == errSavedQueryBuiltin, err == errVacuumIntoDisabled:
+		status = http.StatusForbidden
+	case err == errSavedQueryExists, err == errJobRunning:
+		status = http.StatusConflict
+	case isReadOnlyError(err):
+		status = http.StatusForbidden
+		err = errReadOnly
+	case err == context.DeadlineExceeded:
+		status = http.StatusRequestTimeout
+		err = errors.New("query timed out")
+	case err == context.Canceled:
+		status = http.StatusConflict
+		err = errors.New("query canceled")
+	}
+	return status, err
+}
+
+func renderText(w http.ResponseWriter, contentType string, data []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
 }
 
 func renderCSV(w http.ResponseWriter, status int, data []byte) {
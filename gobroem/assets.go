@@ -81,7 +81,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _staticCssAppCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xfd\x79\xb3\xe4\xb8\x71\x28\x8a\xff\xaf\x4f\x41\xf7\x44\xff\xa6\x5b\x5d\xac\x66\xad\xe7\x54\x9d\x50\x5f\xf9\xea\x5e\x5f\x2b\xc2\xf2\x2f\xe2\x59\x2f\xe2\x46\x8c\xe6\xdd\x40\x91\xa8\x2a\x4e\x93\x04\x87\xcb\x59\xa6\x6e\xdd\x98\xde\x4e\x8f\x64\x49\xe1\x7d\xb7\x43\x5e\x24\x79\x7f\xb2\xad\xc5\xfb\x1f\xef\x0b\xe9\x2b\xbc\x20\x36\x62\x49\x90\xac\xd3\x3d\xb2\xef\x0b\xbb\xc3\x9a\x3a\xc8\x44\x02\x48\x24\x32\x93\x09\x20\xf1\xf9\x70\x8f\x8a\x12\x57\xde\xbd\xff\xf3\xcb\x3f\xe3\x9f\xdf\xbb\x78\x27\x43\x97\x1b\x54\x1c\x72\x52\xc6\x55\x4c\xb2\xf5\x36\xbe\xc6\xd1\x45\x45\xf2\x75\x70\x91\xe0\x6d\xb5\x9e\x2e\x82\xfc\xfa\xa2\x88\x77\xfb\x6a\x1d\x5c\xec\x31\xfd\x41\xcb\x36\x28\x7c\xba\x2b\x48\x9d\x45\xeb\x77\xf0\x79\xf3\xef\x62\x43\xae\xfd\x72\x8f\x22\x72\xb5\x8e\xb3\xa6\x9d\xc0\xf3\x27\xf9\xb5\x17\x78\x81\xf7\xce\x26\x0a\x67\xe1\xf9\x91\x37\xe9\xd5\xc9\x21\x8a\xcb\x3c\x41\x37\xeb\x4d\x42\xc2\xa7\x17\x29\x2a\x76\x71\xb6\x0e\x2e\x72\x14\x45\x71\xb6\xd3\x9b\x53\xea\x79\x49\x7c\x50\x3b\x12\xd6\x45\x49\x8a\x75\x4e\xe2\xac\xc2\xc5\x45\x12\x97\x95\x5f\x56\x37\x09\x5e\x67\x24\xc3\xca\xdf\x7e\x75\x93\xb3\x42\x85\xda\x38\x43\x97\x7e\x8a\xe2\xac\x21\xbb\x4d\x08\xaa\xd6\xcd\xc0\x79\x77\x7c\x36\xf2\x49\x7e\x2d\x0a\x28\x57\x9a\xbf\x79\x37\x79\xc1\x5c\x29\xe1\x75\x9a\xa2\x24\xce\xb0\xcf\x3b\x3b\x5f\xe5\xd7\x17\x5b\x92\x55\x7e\x19\x7f\x84\x19\x9c\xfe\x79\x25\x06\x13\x5c\x84\x24\x21\xc5\xfa\x9d\xe5\x62\x19\x9d\x9d\x3b\x7a\xb9\xde\x93\x4b\x5c\x1c\x54\xfe\x6f\xb7\x5b\x51\x35\x08\x02\x57\xbd\x6d\x5c\x94\x95\x1f\xee\xe3\x24\x3a\xa8\xa3\x71\x55\x18\x97\x38\xc1\x61\x85\x05\xf6\x3a\xb8\x70\x37\x7a\xb1\x21\x45\x84\x8b\x96\x61\x5e\x49\x92\x38\x12\xf3\x2e\xc0\x82\x7d\x30\xb4\x11\xbc\x79\x0b\x9c\xac\x10\x3a\x8f\x04\x70\x43\xaa\x8a\xa4\x6c\x52\x55\xbe\x9d\x39\x47\x2c\x07\xa0\x0d\x5d\xed\x09\x13\x86\x32\x8e\xb0\xb6\x0c\xd0\xa6\x24\x49\x5d\x61\x75\x25\x04\x17\x57\x71\x54\xed\xf9\x8a\xe0\x73\x3a\x09\x82\xfb\x1a\x53\x66\x8b\xd9\x62\x3e\x93\x24\xbd\x71\x85\x36\x38\x29\xfd\x46\x0a\x9d\xf4\xf9\xc8\xa6\xd3\x86\xb2\xd6\x18\xa5\xdf\x4c\xf7\x36\x21\x57\xfe\xcd\x1a\xd5\x15\x51\x88\xc7\xd9\x96\x14\x29\x6a\x68\x7a\xe3\xab\x02\xe5\x23\xb0\x61\x06\x3b\xa8\x7d\x76\xd3\xb4\xea\x79\xe3\x2a\xae\x12\xec\xec\xbd\xd2\x53\x75\x59\xaa\xa2\x4f\x0b\xf4\x05\x33\x6b\x56\x2e\x93\x9e\x46\x90\x54\x1e\x4e\x17\xd3\xc5\x74\xdb\xd9\xa5\x3a\x39\xd8\xfa\xa2\xa7\xc6\x3b\x15\xda\x24\xb8\x3c\x08\xfc\xa6\x53\x8d\x6a\x52\xd7\xe4\xac\xd1\x34\x9d\x54\x1a\x35\xd1\x6a\x28\xd9\x09\x3e\xd0\x99\x39\x72\x5e\xd0\xaf\x92\x4c\x35\xc6\x79\x13\x86\xd1\x24\x5a\xf5\xf7\xa9\x5d\xab\xc6\xd2\xd0\x16\x9e\xbd\xb6\x3a\xa7\xe0\xc2\xbf\xc2\x9b\xa7\x71\xe5\x33\x0e\xa5\x84\x54\xfb\x66\xdc\x28\xab\x62\x94\xc4\xa8\xc4\x51\x7f\xcf\x00\x75\x35\x68\x82\xbd\x24\xf6\xca\x1c\x65\x2d\xb7\xbd\x05\x9d\x30\x2a\x3a\xc2\x76\xc4\x19\xe5\x36\x33\x21\x4c\x12\x1b\x79\xbe\x48\xe3\xcc\x6f\x05\x13\x5e\x32\x80\x44\xf3\x95\x18\x00\xab\x90\x4f\x28\x5b\xa4\x9d\xa3\xb1\x56\xa5\xc7\x44\xef\x7f\xa8\x6d\x8b\x01\x68\x1a\x08\xaa\xcb\x57\x5f\xa7\x80\x75\x2d\xad\x4e\xe2\x75\xd2\x2e\x07\x7b\x35\x4c\xb5\xd5\x00\xd5\x56\x6c\xf1\xd4\xb4\x77\x53\xc5\x26\xae\x03\xef\xbc\x9d\x3c\x97\x6c\x3b\x5a\xd0\xc4\x80\x9b\x2c\x65\x82\x03\x75\xb0\xd5\x1e\xa3\x48\x13\x36\xbc\xc4\x2b\x1c\x1d\xab\x0d\x89\x6e\xbc\x6a\xaf\xad\x8f\x79\x63\x3a\x42\x92\x55\x38\x73\x69\xe7\x85\xd4\xc9\xba\x2b\x24\x25\x45\x68\x52\xa1\x47\xab\xa2\x0e\xab\xba\xc0\x9a\x89\x9d\x2c\x5a\x0f\x82\x5b\xc8\x85\x22\xc5\x4c\x08\xe2\x2c\xaf\x2b\xc9\x4e\xda\x9a\x24\xbe\x8f\xa3\x08\x67\x50\x05\xc6\xa9\x1c\x2b\xe6\xab\xc0\x09\xaa\xe2\x4b\x0b\xc3\x7b\x07\x47\x71\x45\x0a\xd9\x46\x40\x3d\x2b\x8e\x84\xc2\xa6\x72\x29\xfa\xdd\x0c\x7e\x12\x98\x8e\x8f\x5a\x61\x53\x65\x87\x76\x1a\x56\x74\x5d\x50\x65\xb3\x6e\xbd\x01\x14\xc5\x75\xd9\xa8\x66\x52\x57\xcd\xe8\x60\x26\x4f\x17\x93\xd6\xf2\x59\x1c\x06\x3c\x43\x8d\xe9\x06\x57\x58\x4b\xe3\x6d\x9d\x24\x07\x6a\xa1\x44\x51\x17\xa7\x3e\xf3\xf8\xb3\x3f\xf5\x19\xef\xb3\xde\x7f\x26\xa4\x2a\xab\x46\xf4\x2e\x67\xe3\xd9\xf8\xcc\x7b\xb0\xaf\xaa\x7c\xfd\xf8\xf1\x0e\x57\x1b\x01\x1b\x87\x24\x7d\xd8\x60\x7f\x81\xe4\x37\xb4\xbb\xde\x34\x98\x4c\xfc\x69\x30\x59\x7a\x5f\xbe\x8a\xab\x0a\x17\x23\xef\x8b\x59\x38\x6e\x90\x7e\x2e\x0e\x71\x56\xe2\xc8\xab\xb3\x08\x17\xde\x97\xbe\xf8\x65\x46\xb4\x6c\xa8\xc6\xd5\xbe\xde\x34\xf4\x1e\x57\x57\x9b\xf2\xb1\x6c\xe2\xf1\x26\x21\x9b\xc7\x29\x2a\x2b\x5c\x3c\xfe\xb9\x2f\x7e\xe1\xbf\xfe\xfc\x2f\xfc\xd7\xa6\xc9\xc7\x4d\x47\xbd\xac\x59\x26\x49\xfc\x11\x1e\x87\x65\xd9\xf4\x34\x18\xcf\xbc\xff\x49\x49\xf3\xd6\xbc\xff\xe9\x29\xb4\x33\x1c\x92\x04\x95\x8f\xf5\x7a\x9f\x7d\xfc\x99\x7d\x95\x26\x6c\x45\x6c\x51\x1a\x27\x37\xeb\x12\x65\xa5\x5f\xe2\x22\xde\x5e\xf8\x69\xe9\x57\xf8\x9a\x29\x03\x1f\x45\x1f\xd4\x25\x77\x1e\x84\x59\x00\xa1\xc7\x66\xa1\x49\xeb\x7c\x44\x45\x15\x87\x09\x1e\xa1\x66\x95\x8f\x22\x5c\xa1\x38\x29\x47\xdb\x78\x17\xa2\xbc\x99\x82\xe6\x67\x5d\xe0\xd1\x96\x90\x86\x6b\xcd\xf2\x6d\xfe\xd3\xac\xde\x7c\xd4\xf8\x71\xa3\x14\x67\xf5\x28\x43\x97\xa3\x12\x53\x09\x1d\x95\x75\x9a\xa2\xe2\x46\xff\x7e\x38\xa2\x3a\x8a\xc9\x28\x44\xd9\x25\x2a\x47\x79\x41\x76\x05\x2e\xcb\xd1\x65\x1c\x61\x72\x00\xad\xc5\x25\x6e\xba\x86\x12\x1f\x25\xf1\x2e\x5b\x6f\x50\x89\x1b\x28\x23\xb4\xce\x48\xf5\xe0\xbd\x46\x35\x14\x24\x29\xdf\x7f\xa8\xe9\x6b\x61\x0b\x82\xe3\x7b\x6c\x5d\xbe\x3f\xaa\x70\x9a\x27\xa8\xc2\xba\x5e\x47\x8a\x26\xf2\x99\x96\xaa\x0a\x94\x95\x39\x2a\x70\x56\x1d\xd1\xba\x59\x73\x97\x78\x84\xb8\x91\x24\x75\xd5\x74\xa1\x61\xdb\x66\x53\xbc\x47\x35\xff\xfb\x07\xdd\xff\x6d\x9c\xe7\x88\x54\x15\x8e\x8e\x9b\x51\x59\x15\x24\xdb\x99\x36\xff\x18\x6d\x33\x56\xc6\x9c\x8e\xb8\x42\x49\x1c\x1e\xf7\x93\x43\xab\xdc\xa7\x38\x15\x0b\x6b\xbc\x3c\xc3\xa9\x17\x1c\x53\x54\x3c\x35\x3e\x2b\x02\xf5\xb3\xa2\x4c\x51\x92\x28\x24\xce\x83\xfb\xc7\xb2\xde\x8c\xca\x3a\x57\x4a\xcf\x16\xf7\x35\x33\x10\x5c\x58\xab\xcd\xc9\xfa\x86\x52\xb3\x64\xfd\xf1\x02\xa7\x0d\xed\x03\x1f\xb4\x3f\x9e\x36\x25\x71\xba\x3b\x08\x15\x73\x2c\x2f\x77\x74\x96\xd6\x05\x21\xd5\xc3\x83\xa1\x2a\x8f\x4c\xae\x84\x20\x4e\x70\xea\xcd\x1b\xe5\xb5\x2f\x0e\xf4\x33\x35\xfe\xa8\xd1\x24\x5c\xf7\xfb\x1b\x72\xdd\x4e\x6a\x5e\xe0\x83\xae\xd5\x43\x12\xe1\xd1\xd3\x4d\x34\xca\x0b\x3c\x2a\x51\x9a\x6b\x6b\x26\x25\x19\x29\x73\x14\xe2\x91\xfc\xa5\x5a\x51\x9c\x1e\x37\x75\x55\x91\x6c\x44\xf5\xe6\x88\xe4\x15\x93\x6e\xe6\xb2\x8d\x9a\x55\x84\x0a\x8c\x0e\x8c\xd5\x71\xb6\xc7\x45\x5c\x51\x0a\xf2\x0f\xb9\x9c\x18\xa5\xb6\x7b\x97\x71\x19\x6f\x12\x2c\x5a\x60\x24\x0f\x74\x61\x52\x49\x6b\x0c\x2a\x93\x45\x8e\xd1\xac\x78\x8f\x76\xe4\xbd\xc6\xf7\xfc\x1c\x2b\x7e\x7f\xa4\x14\x15\xb8\xc4\x95\x56\x52\xd6\x9b\x34\xae\xde\x3f\x88\x85\xdf\x68\x51\x54\xa0\x2c\xc4\x6b\x56\xdf\xf0\x5e\x79\x63\xef\x45\x71\xd9\x38\x3e\xd1\xfb\x6a\xb3\xb2\xf0\xc0\x2b\x45\x78\x8b\xea\xa4\xe2\x95\xd6\x6b\x3f\x25\x1f\xf9\x5b\x12\xd6\xa5\x1f\x67\x19\x2e\x58\x4f\xec\x72\x29\x0a\xca\x27\x00\xb3\xa1\xaa\xfc\x31\x9d\x77\x54\x46\x13\xee\x71\xf8\x74\x43\xae\xf5\x41\xa3\x28\x26\xef\xab\xb2\x21\x97\xdd\xb5\x49\x9f\xd5\xc8\xea\x74\x83\x8b\xf7\xd7\x6b\xc1\x15\xda\x29\xbf\xcc\xe3\xcc\x57\x27\xdc\x81\x4d\xea\x4a\xc7\x16\x56\x99\x4a\x9c\xca\x7c\x8c\x8a\x70\x0f\x32\xbf\x99\xe7\x6d\x8c\x93\xe8\x02\x96\x69\x80\x4c\xdb\x01\x56\xe0\x87\x0d\xa5\x04\xe8\xb1\xab\x42\x84\x43\x52\x30\x27\x16\xe8\x12\x95\x35\xda\xa7\x12\x57\x62\x86\xda\x0f\xfe\x32\x4e\x2e\x71\x21\xe5\xd9\x9b\x2a\x3e\xe2\x78\xb6\xc0\xa9\x37\x5e\x4e\xe9\x7f\xce\x9a\x05\x9f\xe0\x1d\xce\xa2\x76\xcd\xcb\xb5\xa2\xaf\x4f\xb1\xa4\x2c\x45\x48\xfd\x6e\xa1\x40\x43\x92\x24\x28\x2f\xf1\x5a\xfc\x10\x5e\x4a\xb3\x64\xd9\xf4\xb2\xf6\x46\x55\x34\xaa\xf6\xed\x87\x07\xf5\x0e\xbc\x5f\x20\x75\x11\xe2\xb5\x07\xd8\xee\xfd\x62\x93\x3f\x6e\x44\x7c\xe1\x6f\x48\x9c\xe0\x82\xda\x02\xcd\x86\x97\x45\xf8\x38\x2c\xcb\xc7\x8d\x49\x13\xd6\xf7\xf3\x82\x7f\x4f\xf1\xcd\xb6\x40\x29\x2e\x3d\x61\xb8\xfc\x0d\x2a\xfc\xb2\x2a\xe2\x1c\x97\x87\xe0\xbe\x6a\x47\xa4\x3a\x9d\xd3\x0f\xd8\x63\x45\x40\x68\xe0\x05\xc7\xe3\xe7\x3f\x3d\xca\x29\x8e\x62\xe4\xe5\x45\x9c\x55\x87\xcf\x8e\xd6\x68\xdb\x98\xef\xf5\x06\x6f\x49\x81\x55\x2b\x12\x78\xc1\x4f\xc5\x69\x4e\x8a\x0a\x65\x95\x62\x4e\x94\x42\x25\x6e\xd8\x88\x8f\x02\x61\x1e\x06\x04\x3a\xa2\x11\xa2\xda\xaf\xf9\xe6\xa5\x68\xad\x5c\xae\xa9\xa7\xc5\x8c\xf9\x7b\xfb\x02\x6f\xdf\x67\xdd\x3b\xf0\xa5\xb1\xbe\xe7\x3d\xb8\xe7\xa1\xaa\x2a\x1e\x34\xd0\x87\xde\xbd\x87\xf7\x54\x8b\xeb\xc4\xa6\x60\x8e\x4e\x09\xff\x5f\x9f\xbb\xf7\xce\x3d\x8e\x3f\x92\x45\x1f\xa0\x4b\x54\x86\x45\x9c\x57\xeb\x7b\x16\xb1\x7b\x47\xea\x7e\x7c\x58\x93\x0a\x37\xf6\xc4\x5e\x23\xef\xac\x56\x2b\xfe\x21\x23\xbc\x09\x2a\xc7\x3e\x73\x8e\x7c\x2a\xea\x2a\x95\x38\xdd\x51\xcb\x54\x15\x87\x1c\xed\xb0\xbf\x29\x30\x7a\xea\xc7\x59\xe3\x72\xad\xd1\x25\x89\x23\x6a\x39\x53\x74\xad\x7c\x13\x2b\xac\xdc\x4f\x47\xfb\xd9\x28\x3f\x90\x22\xdf\xa3\xac\x5c\xcf\x9a\xef\x5e\x72\x55\xae\x67\x0c\xa4\x52\xa5\xa3\xe1\x44\xc7\x3c\x7c\xac\xf9\x3c\xcd\x67\xc1\x93\x71\x88\x0a\x5c\x8d\xc6\x51\x41\xf2\x3a\x7f\xa2\x94\x1d\xda\x50\x9f\x0f\x09\xc3\x71\x9c\xa0\x0d\x4e\x00\xae\x34\x2e\xc8\xb8\x7b\x41\xab\x64\x28\xa6\x57\x45\x23\xf1\x6b\x6f\x3b\x63\xcd\x27\xa3\x55\xc7\x67\xd4\x71\xd4\x56\x56\x8a\xf6\x40\xcf\xa2\x28\x52\xa8\x1c\x3f\xcf\x9d\x83\x10\x6b\x6e\xc2\xbb\xff\x2d\xb9\xc9\xf7\x71\x48\xb2\xd2\xfb\x59\x94\x6c\x93\x38\xdb\x95\xef\x5e\x94\x45\xb8\xae\x8b\xe4\xc1\x78\xfc\xb8\xc1\x56\xbf\x01\x76\xb2\x82\xbf\x17\x15\xfc\x02\xef\xea\x04\x15\x63\x4c\xaa\x87\x6f\x52\xf9\x3f\xbd\x13\xe3\x6d\x7c\xfd\xd0\x63\xdf\xdc\x0f\xee\xe1\x74\x83\xa3\x08\x47\x3e\xc9\x71\xd6\x58\x80\x7b\x0f\x47\x77\xa1\x7d\x45\xb6\xdb\x69\x4b\x96\xfe\xf9\x06\xa4\x74\x4a\x77\x24\x54\x55\x0a\x9d\xaa\xa8\xf1\x1b\x8c\xaf\xbc\xdc\xbd\xd3\x22\xfc\x0f\x89\xc0\xe1\x6d\x3b\xe5\xe5\xee\xde\xc3\xe3\x58\xe2\xda\x9f\x9d\xf4\xe3\x77\xe2\x0a\x67\x0d\x10\x1e\xc5\xc9\x67\x7e\xce\x85\x11\xde\xd0\x5c\xf1\xc9\x80\x80\xde\x05\xf5\xb2\x48\x79\x6d\xe2\xec\x0a\x74\x53\x86\x28\xc1\xca\x88\x7c\x6a\xdb\xe2\xf2\xa9\xd0\xfb\x52\xcb\x7d\x25\x08\xa6\xe8\x9e\x8a\x9a\x27\x75\x09\xa2\x6d\x34\x34\x5c\x17\x1c\x6b\xa4\x97\x12\xbb\xf2\x34\x40\xa1\x56\x39\x8d\x33\xa8\x91\xe9\x74\x32\xd5\xf0\xc2\x84\xd4\x11\x80\xb7\x0c\x26\x7a\x67\xb2\x4b\x9c\x90\x1c\x03\xa8\x67\xc1\x4a\x1f\x1e\xce\xc2\x38\x01\x11\xb7\x1a\xe2\x2e\x41\x25\xd0\x47\x1c\x18\x6d\xa7\x75\x19\x87\x20\x9e\x3e\x16\xe6\x95\x81\x88\x33\x0d\x71\x8f\x51\x51\x81\x78\x0b\x9d\x60\x85\x0a\x10\x6d\x69\xa1\xf9\x38\xcd\xab\x1b\x10\xf9\x4c\x43\xae\x4b\x0c\xd3\x3c\xd7\xd0\xb6\x71\x92\x82\x68\x3a\xaf\xab\xbd\x9f\xa0\x62\x07\x4c\x0b\x0e\x26\x81\x81\x0a\x22\x4d\x2c\x7a\x71\x09\xf2\xc6\x10\x1c\x02\x48\x3a\x0e\x26\x3a\xa3\x0b\x9c\x92\x4b\xb8\x73\x73\x0d\xf1\x23\x42\x52\x3f\xce\x40\xcc\x85\x8d\x49\x6a\xb8\x8b\xfa\xbc\x90\xed\x16\xc4\xd2\x27\xa4\x8c\x77\x19\x02\xc4\x15\x07\x13\x7d\x4a\x42\xb2\x03\xb1\x8c\x19\x29\x50\x09\x72\x7a\xaa\x4f\xc7\x9e\xa4\x20\x63\xa6\x13\x53\x0e\x60\x34\x7d\x36\xaa\xd8\x41\xcd\x98\x0f\x82\x80\xc5\x8e\x83\xa9\x3e\x1b\x11\xb9\xca\x12\x82\x22\x1f\x25\x20\x9f\xa7\x0b\x10\x1d\x44\xd5\xa7\xa4\xce\x9d\x88\xfa\xac\xc4\xd9\x86\x5c\x83\x78\xe7\x86\x2e\x45\x37\x7e\x18\x17\xa1\x83\x4d\x2b\x43\x1e\x73\x8c\xc0\x21\xcd\x02\x03\x71\x5b\x60\x78\x1e\x67\xfa\x04\xd1\x1d\x2c\x07\x9f\x66\xfa\x24\x35\xa6\x0c\x44\xd3\x27\x69\x9b\x20\x50\xd0\x66\x73\x53\x89\x45\xf9\x9e\x64\x18\x54\xa1\x33\x7d\x8a\x2e\x49\x52\xa7\xd8\xb5\x22\x66\x4b\x08\xb9\x99\x56\x10\xfb\x0c\xc2\xae\x73\x10\x57\x9f\xad\x0f\x8b\x90\x44\xe0\x44\xcd\xf4\x89\xda\x20\x27\xe6\xdc\x50\x6b\x30\xb3\xe6\x13\x13\x0b\x64\xd3\x5c\x9f\xa1\x0d\x81\xd5\xda\x7c\x66\xa1\xa5\xa8\x80\x51\xf5\x59\xa2\x5f\x85\x20\x9e\x3e\x41\x21\x4a\x71\x81\x40\x44\x7d\x72\x68\x30\x0c\x42\x3b\x33\xba\x98\x80\xcb\x6c\xae\x4f\x08\x8b\x87\x82\x88\x86\x5a\x6b\xbe\x2b\xb9\xf3\x04\x60\x2f\x02\x1b\x9b\x7d\x60\x41\xc8\xfa\xdc\xd0\xc8\x27\xdb\x54\x81\x90\xa7\x00\x72\x88\xb3\x0a\x36\xa3\x8b\x19\x80\x5e\x38\xbb\x3d\x07\xb0\x3f\xa8\xcb\x2a\xde\x82\xb6\x7c\xb1\xb0\xd6\x3e\x88\xb6\x34\x74\x59\x84\xb3\xca\x3d\x42\x53\xf3\x51\x6c\x77\x9f\x0d\x47\x01\x85\xb8\xd1\xfe\x3e\x8d\xef\x83\x15\x0c\xf7\x2c\xa6\x9b\x72\x10\xe6\x52\x9f\xc5\x14\xe5\x7e\x23\xe6\x30\xa7\x97\xc6\xc4\xb0\x7d\x0f\x08\x71\x66\x98\x2a\x58\x80\x97\xfa\x5c\xe0\x28\x86\xd1\x0c\x17\x6d\x8f\x1c\x63\xd1\xe7\x80\x46\x3a\x41\x3c\x9d\xfb\x2e\x7f\x65\x79\x6e\xb8\x7c\x38\xf7\x9b\x8f\xe8\x2b\x54\x80\xeb\x6c\xb9\x32\x66\xa9\xac\x3a\xf1\xcf\x02\x43\xff\x75\xa0\x4e\x2c\x0b\x08\xa2\xe9\xf3\x93\xa3\xba\x04\x47\x76\x36\x33\x46\x46\x40\x4d\x7e\x36\x37\xd4\x50\xe1\xec\xdf\xc2\x1e\x7a\x17\xba\xe9\x4c\xe3\xbc\x13\x5d\x9f\x2f\xfc\x01\x0e\x41\x39\x39\x3b\x37\xe7\xff\xb2\x20\x6e\x35\x73\xb6\x02\xd1\x9d\xab\xf0\x3c\xb0\x3e\xe9\xa8\x27\x09\xe2\x4e\xec\x4f\x33\x37\xf2\x14\xf0\xa0\xdd\xd8\x33\xc3\x29\x77\x63\xea\xf3\xf7\x61\x8d\xcb\xe6\x03\xdc\x8d\xbf\x30\xb4\xd2\x96\xb8\x71\x8d\x29\x0c\x0b\x8c\xb3\x72\x4f\x60\xce\x9d\x41\x03\x74\xbb\x70\xe7\xe7\xe6\x10\x3b\x70\x4d\x2f\x22\xeb\x40\x5e\xe9\x53\x88\x8a\x82\x5c\x39\xe5\x63\x35\x01\x90\x9d\xd2\xb1\x9a\x02\xd8\xb0\x87\xb4\x9a\x01\xa8\x2e\xd7\x6b\x35\xb7\x95\x9f\xcb\xf9\x5c\x2d\x0c\x3e\xd3\xed\xe9\x6d\x9d\x80\xdf\x3a\xab\x25\x84\x4d\xf7\x39\x41\x74\x63\x15\x5e\x87\x09\x62\xc7\x55\x5c\x42\x32\x31\x3e\xea\x77\x31\xc8\xe8\x89\xf1\x4d\x9f\x60\x04\xb9\xac\x13\xe3\x8b\x7e\x1b\x83\x56\x60\x12\x18\x46\xe5\x06\xd3\x98\x1e\x88\xba\xb0\x50\xc3\x84\x80\x3a\x73\x62\x04\x00\xae\x50\x91\xc5\xd9\xce\x3d\xf4\x33\x53\x63\x67\x30\x59\x43\x67\xa1\x04\x67\x11\x18\x82\x98\x18\x71\x80\x02\x65\x11\x81\x02\x06\x13\x23\x0a\x10\x92\x34\xc5\xa0\x01\x9e\x18\xa1\x80\x14\xed\x32\x0c\x23\x4e\x41\x5d\x09\xca\xf7\xc4\x88\x08\x08\x64\x87\x84\x4f\x8c\xb8\x40\x81\xab\x2b\xec\xe8\x85\xe9\x08\x90\x3c\x6f\x26\x21\x84\x63\x3b\x93\x89\xe9\x47\x27\x34\x70\xee\x9a\x62\x23\x4a\xc0\xd1\x5d\xc2\x63\x84\x0a\xf8\xf2\x11\x9b\xfb\x60\x0d\xf3\xcb\x94\xd6\xd8\x93\x22\xfe\x88\x64\x15\x5c\xc7\x0c\x21\x44\x90\x85\x9c\x18\x11\x84\x4d\x9d\x24\x7b\x52\x80\xdd\x36\xa2\x08\x1b\x0c\xae\xf6\x89\x11\x45\x08\x9b\x61\x6d\xe3\x10\x55\x20\xe7\x8c\x60\x42\xb5\xaf\xd3\x4d\xe9\x90\x0e\x23\x92\xc0\x71\x5d\xc2\x61\x04\x13\xf6\x28\x8b\x9c\x3a\x78\x62\x04\x14\x28\xb2\x43\xbb\x4f\x8c\xa0\x02\xc5\x75\x74\x78\x65\x63\xba\xba\x6b\xc4\x14\x98\x25\xea\x31\x1d\x13\x23\xbc\xa0\x55\x72\x75\xdf\x88\x33\x68\x75\xe0\x61\x18\x21\x07\xad\x86\x73\x38\xfa\xbc\xee\x12\xb2\x01\xe7\xdf\x08\x3d\x5c\x15\x38\x03\xa3\xb2\x13\x23\xec\x50\xa1\xf2\x29\xf4\x91\x3e\x31\x02\x0e\xdb\x38\x81\x3f\xfe\x26\x46\xb4\x61\x53\xc4\x78\x1b\x22\x78\x7d\x1b\x01\x87\xc6\x2e\x32\xbf\x05\x42\x36\x62\x0e\x11\x2a\xf7\x1b\x02\x3b\xa8\x13\x23\xf2\x90\xa3\x1c\x17\x61\x12\x83\xd3\x60\x84\x1f\x68\x5c\xda\x19\x49\x9e\x18\x51\x88\x24\xce\xa0\x2f\x9a\x89\x19\x81\xd8\x13\xd8\xda\x18\x11\x88\xbc\x2e\xf7\x39\x18\x82\x9d\x18\x21\x88\xba\x84\x07\xae\x73\x7f\xb7\x81\x87\xac\xf3\xbd\x24\xb0\xb6\x36\x02\x0a\x0d\x9a\xbf\xb9\xf1\x51\x92\xef\xd1\x06\x36\x08\x46\x58\xc1\xac\xe2\xf0\x93\x26\x46\x80\x41\x54\x63\x5b\x9b\x10\xfe\xcc\x8d\xef\x6c\x63\x0e\x77\xad\xaa\x8a\x78\x53\x57\x60\x08\x6f\x62\x04\x1b\xec\x4a\xce\xd6\x8c\xe9\xca\xe8\xc7\x2f\x06\x27\x6d\x61\x3a\x72\x39\xca\x60\x44\x33\x18\xce\xf6\x99\x9d\xda\xc2\x88\x3a\x48\x7c\x58\x1f\x19\x91\x87\x84\xec\xe0\xdd\x80\xc9\x72\x62\xc6\x4a\xc1\x28\xed\x64\x69\x86\x5e\x77\x8e\x4d\x83\x89\x11\x9e\xc8\xf0\x95\x7f\x15\x67\x11\xb9\x02\x91\x4d\xf7\x24\x24\xb0\x16\x30\xc3\x14\x08\x0c\x2b\x4c\x8c\x28\x85\xcb\xbd\x30\x82\x14\x0d\x35\xb8\x55\x23\xba\x47\x77\xe2\x41\xc4\x95\x39\xed\x0e\x44\x23\x2e\x51\x62\x58\x3a\xce\xcc\x69\x21\x79\x7e\xe3\x47\xe0\x7e\x28\x9e\x18\xa1\x09\x8e\xed\x1c\xd5\x99\x19\x1f\xa7\xe8\xce\xbd\xa5\x89\x19\xaa\x68\xc9\x83\xd8\x0b\x08\xdb\x35\x13\x46\xb4\x22\x2c\x70\x14\x57\x8d\xcf\x09\xf7\x5c\x9f\x37\x76\x0e\x11\x56\x2b\x66\xbc\xa2\xae\x12\x5c\x80\x66\xc0\x08\x55\xb0\xa3\x30\x10\xe2\xb9\xe5\xfa\xe7\x05\x2e\x4b\x98\xc9\x46\x90\x02\xa3\xc2\x69\x38\x8c\x10\x05\xc5\x73\xe9\x22\x23\x40\x51\x91\x2b\x47\x5f\x0d\x0d\x59\xa1\x0a\x54\x8a\x46\x58\xa2\x8c\x9c\x71\xcf\x89\x11\x95\xd8\x77\xa1\x1a\xeb\xab\xde\xd0\xf3\x4d\x70\x0f\x8c\x48\x20\x3d\x44\x53\x56\xb8\x70\x90\x36\xed\x5d\x4d\x3d\xc6\x64\x03\xce\xed\xca\x34\x7b\x0d\xf6\xc2\x9f\x80\xb8\xa6\xbd\x6b\x70\x97\x0e\x5c\xd3\xc8\x35\xb8\x67\x0e\x5c\xc3\x37\x14\x27\xfb\x7d\xc7\x96\xc7\x64\x65\x2a\xc5\x5d\x5c\x56\xec\xfc\x99\xbb\x8e\xb1\xfd\x91\x90\x3a\xea\xda\x48\x9c\x18\x11\x07\x56\xc1\xb9\x9d\x38\x59\x9d\x1b\x2b\x0f\x63\x3f\x24\x59\xec\x58\x7d\x2b\x73\x13\x17\x63\x3f\xc2\x61\x1c\xd5\x04\x3a\x46\x81\xa7\x81\xb1\xb6\xa0\x4e\x4c\x8d\x90\x47\xa3\x81\x5c\x1b\xba\x53\x23\xee\xd1\xe8\x1f\x37\xae\xe1\x08\xe2\x4b\x9c\xc0\x86\x75\x6a\x04\x40\x9a\xc9\x04\xd1\x0c\x5f\x10\x95\xe0\xb7\xdd\xd4\x08\x7c\xa0\x04\x83\x66\x63\x6a\x84\x27\xf0\x87\x35\xbd\x6c\x01\xf1\x7e\x6a\x44\x28\x9e\xd2\x13\xc7\x00\xda\xc4\x0c\x60\x82\x1a\xda\x3c\xe0\x92\x23\xd0\x3f\x99\x1a\x71\x89\x4d\x5c\xee\xc1\xc0\xf7\xd4\x88\x48\x3c\xcd\x1c\x5f\x6e\x53\x23\x20\xb1\x41\x9b\x1b\x7f\x4b\x8a\xb4\x4e\xa0\x7d\xbd\xa9\x11\x8f\xa8\xc0\xa8\xcc\x74\xb9\xd5\xcf\x0e\x6d\x12\x14\x3e\x75\x7d\x7b\x4c\x8d\x30\xc4\x06\x54\xf5\x53\x23\xf4\x80\xf2\x1c\x12\xb3\xed\xf9\x56\x3f\xae\x83\x0b\xf8\x53\x6a\x6a\x04\x1c\xf6\xa4\x2e\x1c\x47\x7b\xa6\xb3\x89\x7e\xc6\x29\x41\x29\xc8\x74\x23\xe2\x10\xd5\x79\xe2\x8a\x37\x4c\x8d\x78\x43\x1e\xef\x76\x37\xfe\x06\x81\x1f\x47\x53\x23\xe0\x50\x86\x71\x59\x92\x02\x5c\xe2\x46\xb4\x61\x13\x57\x21\x91\x4e\xa9\x7a\x24\x6b\x53\x85\x40\xe9\xf5\x06\x94\x12\x23\x2c\xf1\x81\x5c\x8f\x6a\xdd\x1b\x48\xb4\x83\x00\x19\x21\xdd\x7a\x03\xd4\x2d\xea\x0d\x34\x9d\xd3\x60\x13\x19\x43\x47\xb0\x76\x31\xc2\x16\x71\x88\xfd\x84\x24\x09\xa8\x37\x8c\x68\x85\xc4\x6d\x3e\xe6\x2b\x58\xfa\x8c\x60\x05\x8e\xea\x90\x1d\x55\x86\x70\x8d\xed\x0d\x7a\x4f\xaa\x3b\x48\x36\x35\xc2\x14\xbc\x4e\x47\x28\x6e\x6a\x04\x2c\x52\x9c\xd5\xfe\x1e\xa5\x9b\xba\xd8\xc1\x1a\xcb\x08\x5c\xa4\x24\x42\x89\xfb\xa3\x61\x6a\xc4\x2f\x08\x74\x38\x0e\x4f\x8d\xe0\xc5\xae\x40\xe0\x17\xd0\xd4\x08\x5c\x94\x75\x46\x17\x1b\xe8\xac\x4c\xcd\xc3\x12\xe2\x9a\x1a\x88\x3b\xb1\x71\xd9\xe1\x60\x08\x79\x6a\x23\x2b\x47\xdd\xa1\x1a\xc6\x5c\x6e\x3e\xc0\x61\xc5\xb7\xdc\xe1\x3d\xc7\xa9\x11\xc9\xd0\xaa\xf0\xeb\x50\x50\xad\x85\xbb\x56\xb7\xe8\x18\x71\x0e\xad\xa6\x23\xf6\x36\x35\xce\x5d\x68\x75\xba\x84\xce\x88\x96\x68\xf5\x5c\xc1\xc1\xa9\x79\x28\xa3\x88\x51\xb6\x4b\xb0\xbb\x82\x79\x2e\x43\x54\x70\x8d\xc6\x88\xa1\x48\x7c\x37\xb7\x8d\xf0\x89\xac\xe1\x98\xd2\x85\xe9\x5c\x66\x25\x81\xd5\x90\x19\x33\xa9\x73\x5c\xf0\xab\x04\x10\xf6\xc2\xf4\xe0\x3b\x70\x97\xf6\x7a\x77\x32\xe4\xcc\xc6\x75\x73\xfb\xdc\x46\x76\xc4\x47\xa6\x46\x7c\x84\xe2\xc2\x2e\xdc\x32\xb8\x77\x34\xaf\x95\x00\xb7\x93\xe4\x15\x24\xf8\xe2\x16\x3d\xcc\x0c\x83\xc0\xd2\x63\x7b\x21\x96\x5d\xde\x0b\xf2\xeb\xf6\xba\x2b\xca\xfd\x7d\xbc\xdb\x27\xf4\xbb\xc0\xbe\xce\x49\x2f\xbe\xaa\x87\xb6\xef\xfd\x2c\x4e\x2e\x71\xb3\x18\xbc\x9f\xc7\x35\xbe\x37\x92\x7f\x8f\x7e\xba\x88\x51\x32\x52\x6e\xdb\x1a\x89\x61\xb4\x63\xdb\xe3\xf9\xf4\x7c\x71\x26\xee\xce\xcc\x66\xb3\x0b\xf0\x26\x83\x7e\xcd\xd0\xbc\x5d\xa8\x76\x4c\xbd\x63\xc8\x1a\x15\x25\x6a\xbb\xbc\xec\x28\x6e\x26\xbe\x83\x82\x68\xbe\x3c\xbf\x30\x6f\xdb\xb0\xab\xae\x6b\x7a\x33\x4f\x5e\x65\xe5\x55\xce\xd0\x06\xcd\x16\x56\x15\xe5\x82\x0e\xaf\x07\xdd\xcf\xb3\x41\xcd\xb7\x01\x50\xcc\x2e\xef\xb1\x72\x79\x89\x76\x91\x5f\x7b\xa8\xae\x88\xd7\x1e\x7a\x0f\xeb\xd2\x2f\xe8\x76\x59\xd3\xbb\x0b\x8e\xe9\x93\xed\xb6\xc4\xd5\xda\x9f\xe6\xd7\xc6\xb5\xd1\x80\x5e\x9c\x31\xae\xab\xa6\x71\x14\x25\xf8\x38\x8e\xd3\x9d\x5f\xe0\x32\x27\x59\x19\x5f\x62\x2b\x9d\x91\x7a\xd9\xe6\x42\xbd\xdb\xc7\x2a\x36\x93\x87\x65\x36\x1a\x7e\x51\x7e\x99\x5f\x33\x30\xdd\x13\xca\x50\xdc\xe6\x67\x70\x49\x05\x28\x0a\x17\xe0\x25\x15\xe3\x56\xfe\x5c\x95\xed\x46\x8e\xf9\xcd\xfc\x24\xf1\xc6\xd3\xd2\xc3\xa8\xc4\x7e\x9c\xf9\xa4\xae\x2e\x7c\xd2\x87\xd1\x03\x06\x2f\x38\xf4\xf1\x88\x6d\xd0\x18\x2c\x5a\x04\xf7\x8f\xfb\x42\xcd\x52\x30\x55\xb2\x14\x88\x54\x3a\x50\x32\x02\x7e\xd9\x42\x30\x04\x63\x7c\x1c\x97\x85\x4f\xb2\xe4\x06\xc8\x4b\xc0\x3b\xa6\xe4\xfa\x91\xad\xac\xfd\x89\x9a\xda\xc2\xca\xd2\x40\x37\x3e\x0a\x1c\x56\x0f\x82\x11\xfd\xf7\x50\xf6\x45\xb6\xc8\xa4\x11\x35\x5e\x2b\xbf\x04\x0e\x40\x98\x40\xcb\xbe\x95\x15\xaa\xe2\x50\x4d\x73\xa2\xb0\xac\x4d\x93\x60\x5e\x0e\x66\xfd\xa1\x6c\x7d\xaf\x20\x89\xbc\xf3\x7b\x30\x2e\xed\x8e\xf7\x93\xd1\x78\x3f\x1d\x8d\xf7\xb3\xd1\x78\x3f\x1f\x8d\xf7\x8b\xd1\x78\xbf\x1c\xed\x27\x23\x76\x43\x6c\x3f\x1f\xed\x17\xa3\xfd\xd2\xad\x4b\x94\x54\x56\xba\xac\x4e\x2e\xb4\xdb\xcd\x4d\x5b\xde\x98\x1e\xb4\x18\x35\x3f\xc5\xaf\x69\x5b\x38\x95\x85\xb3\xb6\x70\x26\x0b\xe7\x6d\xe1\x5c\x16\x2e\xda\xc2\x85\x2c\x5c\xb6\x85\x4b\x5e\xd8\x36\x2e\xdb\x6e\x9b\x96\x2d\xb7\x0d\xcb\x76\xdb\x66\x65\xab\x6d\xa3\xb2\xcd\xb6\x49\xd1\xa2\x99\x75\xc4\xb8\x96\x23\x94\xe5\xd9\x99\x36\x09\x82\xf1\x3d\xc2\x4e\x93\x72\xbc\x21\x43\xef\xca\x11\xc5\x56\x2e\x17\xf7\x8f\x9a\xd8\x08\x69\x71\x24\x14\xd1\x7b\xff\x66\xf3\xf9\x46\xd3\x22\xd2\x16\x50\xde\x6b\x49\x12\x66\x54\x1f\x37\x73\x30\x55\x4b\x59\x8f\x67\xcd\xcc\x28\x09\x15\xe6\x6c\x1c\xa3\xfd\x5c\x75\x20\xce\x69\xe9\x62\xb4\x5f\x1c\x74\x13\x7f\xa4\x3c\x5a\x1e\x8c\x7c\x3b\xb9\x34\x3d\x5e\xe0\x31\xde\x24\x18\xc9\x84\x6d\xaa\x7e\x53\x6a\x2e\x8d\x5c\x72\x33\x6b\x01\xce\xc5\x8d\xdd\x07\x6d\xca\x96\xb3\xe5\x79\x7e\xfd\xf0\xc0\x1a\x50\x46\x32\xc9\xaf\x8f\x47\xce\x2a\x2b\xe9\x43\xc3\xa7\x14\x15\x4f\x47\x46\xba\x08\x69\x7d\xc2\xed\x39\x9e\xb5\x97\xba\xa7\x38\x3d\x8e\xa9\xfd\x6f\xbc\xcd\x51\xb5\x67\xb7\x75\x99\x29\x6d\x8a\x38\x94\xfa\x97\x2a\x8c\x16\x70\x20\x3b\xfa\xac\x42\x59\x09\x07\xf3\xc3\xcb\x2a\x9c\x17\x71\x84\x8c\xd0\x0c\x68\x57\xfb\xb8\xc2\xf4\xba\x37\x5e\xb3\x22\xd1\x35\x72\x85\x8b\x10\x95\xd8\x4c\x9d\x20\x01\xc7\x71\x9c\xc5\xf4\x96\x5c\x99\x8e\x58\xa5\x3a\xcf\xe1\x4a\x12\x20\x7a\x8f\x72\x7a\xe4\xfc\x23\x0b\xb3\x85\x70\xd4\xb4\xae\x70\x74\x50\xf5\x01\x2d\xce\x8b\x98\xa6\x3c\xd1\x1c\xb1\x23\xd2\x80\xc2\x01\xd3\x0b\x35\x6f\xec\xfc\x2c\x5c\xcd\x03\x4e\xb3\xac\xc3\x10\x97\xa5\x80\xcd\xc2\xb3\xe5\x2c\x12\x34\x39\x50\xa7\x29\x0a\x35\x9a\xd3\xcd\x62\x3e\x0d\x39\xcd\x38\xdb\x12\x49\x70\x72\x16\x9c\x6f\x05\xc1\x06\xa2\x53\xa3\x25\x3a\xa9\xf9\x62\xba\x5c\x71\x52\xfc\x78\x99\xec\x3a\x5a\x46\xb3\x8d\xa0\xc6\x81\x3a\x41\x51\xa8\xd1\x5c\x2e\x17\x13\xd9\xbd\x08\x65\xbb\x16\x84\x56\xf3\xf9\x7c\x2a\x48\x32\x98\x4e\x91\x97\xe9\x3c\x9c\xcf\x16\xb3\xf9\x71\xbc\xd9\x99\xb3\xa2\x27\x51\xf3\xcd\xb9\x6a\x2b\xc8\x46\x94\x22\x33\x4b\x9a\x6f\x4c\xd9\x66\x27\x27\xcc\x46\x8a\xb6\xdb\x20\xe2\x6d\x98\x33\xa7\x14\xb9\xda\x08\x27\x78\xba\x99\xd1\x36\xe8\x04\x02\x0d\xac\x70\xb4\x3d\x63\x0d\x68\x33\x29\xfe\x76\x91\x46\xdb\x68\xd5\xf8\x59\x9b\x9d\x9c\x50\x97\xca\x60\xd4\xcd\x99\x55\x8a\x5c\x6d\x6c\xcf\x70\xb8\x59\xd0\x36\xf8\x04\x03\x38\xd3\x08\x47\x98\x35\x61\xcc\x74\x5b\xe2\x6a\x00\xcf\x37\xab\xcd\xea\x38\xa6\xd7\xe0\xd9\xce\xa3\x4c\x47\xc6\x15\xf2\xaa\x75\x0e\x59\xf2\x04\x4f\x71\x41\xd5\xd4\x3d\x8a\xf3\x19\x25\x23\x92\x8c\x64\x2a\x43\x9f\xa5\x53\x04\xac\x23\x49\x3c\xd2\xe0\x7a\x75\x83\xee\xd1\x4a\x6d\x0a\x44\x81\x1a\x1c\xc7\xf4\x5a\x16\x73\xb1\x47\xec\x8f\x3a\xa3\xb7\x83\x23\x33\x7d\x9a\x91\x92\x50\xab\xaa\x65\x2e\xf3\x17\xd4\x04\xb5\xd0\x27\x49\x0c\xe7\x53\xd2\x5a\x58\x58\xf9\x50\x1b\x3a\x91\xd9\xe7\x86\x49\xc7\x28\x1a\x45\x7a\xce\x16\xfe\x6d\x73\x8c\x2a\x3b\xb5\x91\x99\xbb\x74\x1c\x25\x4a\x80\xcb\x8b\x22\x1e\x33\xb0\xcb\xcd\x28\x83\x77\xef\x42\xcb\xaf\xe0\x22\x75\x08\x13\x8c\x8a\xf5\x86\x54\xfb\x0e\x23\x6a\xd4\xad\xd4\x64\xb2\xfc\x5b\x62\x49\xd3\xd5\x52\x62\xb4\xd8\x34\x76\xd6\x87\x04\x45\x90\x85\x38\x49\xe2\xbc\x8c\xcb\x0b\xc8\x8c\x99\x5d\xd7\xd3\xcf\x9d\x37\x7c\x66\x29\x2e\x22\x54\x21\x9f\x14\xf1\x2e\xce\x50\xe2\xb3\x84\x17\x23\x35\xdd\x14\xff\x2a\xd8\xe3\x24\x07\xe4\x97\xa5\x9e\xf2\x98\x6d\x6a\x4d\xa2\xe2\x21\xac\x82\xfb\x4a\x72\x0a\xf9\xfd\xda\x48\xb2\xa7\x78\xaf\xd4\xc1\x31\xfd\x98\xb3\xf1\xa2\x5d\x35\x42\x90\xd4\x35\xd3\x12\xf6\x48\xb2\x4e\x90\xc8\xea\x3a\x52\x00\xb9\xa3\xbc\x56\x2b\x58\x8b\x47\x41\xe4\xae\x8f\x52\xc2\xb3\x93\x29\x25\xcc\x2f\xd2\xbf\xf9\xb5\xd4\x58\x5d\x21\x9c\x86\x79\x56\x7b\x22\xc8\x65\x35\x0b\x00\xc0\x23\xea\xef\x7e\x65\x1a\x4c\xe6\xde\x57\x82\xe0\xa7\x83\x77\x8f\xe3\x16\xdd\x2f\xf0\x25\x2e\x4a\x95\xc2\x38\xaf\x93\x84\xfb\x5c\x46\xde\xe2\x85\x99\x50\xd2\xc8\xed\xab\xcf\x88\x36\x59\x81\x25\xd2\x50\x37\x8c\xf1\x42\x18\xfa\xc0\x21\x0c\x07\xcb\x94\x71\x39\xd9\xaa\xe2\xb8\x38\xac\xe2\xc0\xcc\x06\x39\x2c\xda\xe4\x2a\xc8\x3d\x32\x37\x82\x4a\xa0\x73\x5c\x5d\x28\x5a\x2b\x5d\xa3\xd2\x93\xd3\xbc\x4b\x65\xc7\xa3\x72\xf4\xee\x11\x45\x51\xd1\xf8\x1b\xce\x4f\x0f\x35\xef\x04\xa4\xbe\xbb\xd3\xa6\x7d\x09\x67\x09\x19\x7d\x89\x64\x28\x24\xa3\x2f\xd0\x90\x38\x2a\x47\xf7\xbe\x40\xea\x22\xc6\x85\xf7\xf3\xf8\xea\x5e\x9b\x50\x4d\xa3\x25\x75\xca\x34\xbf\xf6\xe6\x9a\x06\x59\x05\xf7\x65\x7a\xd1\xb3\xe9\x62\x8e\xa1\xf8\xd8\x6a\x3b\xdd\xce\xed\x60\xd8\xf1\xe9\x26\xea\xf6\xe5\x68\xe8\x55\xab\x36\xcb\xaf\x3b\xd2\x9e\x17\xbb\x0d\xe2\x31\xa0\xf1\x74\xf1\xb0\x69\xc0\x6b\x1a\x69\x43\x47\x6a\xa8\x39\xb8\x6f\xe6\xb5\x36\x33\x23\xd1\xd4\x74\xba\xce\x11\xa4\x56\xe3\x85\xae\x5a\x27\x86\x6a\x9d\x39\xe2\x87\x57\xa4\x88\x58\x6e\x9f\x35\xcf\xf0\x93\x24\xac\xb0\x31\x2c\xbc\xac\xf9\xbb\x27\xfe\xbc\x68\xfe\x01\x71\xc7\x30\x0c\x9b\x6e\x7b\xcd\x04\x82\x03\x17\x01\x24\x3d\xfd\x9d\x6a\xe3\xf2\x02\xd3\xce\x58\x59\x45\xc7\x0d\xa4\x0c\x0b\x92\x24\x34\x2f\x50\x8a\xae\x65\x5e\xdc\xb9\x9a\x3f\xd5\xbf\x59\x33\xb4\xe3\xb8\x91\x75\x14\x67\x58\x86\x10\x0b\x33\x84\xc6\x74\x19\x2d\xd0\x13\xeb\xda\x4e\xcd\x84\x7a\x47\x92\xa4\x58\xd6\x6d\x41\xbf\xcf\x61\x54\x1e\xe6\x6c\xb4\x83\xe0\xe5\x34\x47\x3e\x50\x61\xb5\x9a\x82\x15\x56\x67\x8e\x0a\x13\x9a\x14\x16\xa8\x31\x99\xb0\x2a\x2d\xc0\xdf\x26\x75\x1c\x7d\x0a\x6c\x64\x84\x2d\x66\xf2\xe2\x13\x58\xaa\x12\x52\x19\x3b\x2e\xc8\x95\xee\xe5\xda\x09\x7a\x7d\xd6\xa9\x82\x5c\x89\x8e\x14\xc0\x46\xb7\xdd\xb8\xac\xa0\x35\x18\x92\xc4\x4f\x76\xfe\x64\x24\x7f\x05\xed\x4f\xa5\x74\x2a\x7f\xb6\xbf\x66\xf2\xd7\x5c\xfe\x5a\xc8\x5f\x4b\xf9\xeb\x4c\xfe\x3a\x97\xbf\x56\xec\x57\x1a\x89\xa6\x9b\x5f\x41\xfb\x53\x29\x9d\xca\x9f\xed\xaf\x99\xfc\x35\x97\xbf\x16\xf2\xd7\x52\xfe\x3a\x93\xbf\xce\xe5\x2f\xde\x74\x99\x8a\xa6\x9b\x5f\x41\xfb\x53\x29\x9d\xca\x9f\xed\xaf\x99\xfc\x35\x97\xbf\x16\xf2\xd7\x52\xfe\x3a\x93\xbf\xce\xe5\x2f\xde\xf4\x75\x29\x9a\x6e\x7e\x05\xed\x4f\xa5\x74\x2a\x7f\xb6\xbf\x66\xf2\xd7\x5c\xfe\x5a\xc8\x5f\x4b\xf9\xeb\x4c\xfe\x3a\x97\xbf\x56\x40\x96\xa7\x66\x75\x29\x9b\x08\x43\x57\xc3\xbf\xd5\x00\xda\x0f\x97\xb6\x17\x5c\x09\x9c\x8f\x67\xcd\xff\xdd\x97\x80\xa9\xd0\x0e\xcb\xf1\x72\xb9\x5c\x9e\xb5\x90\xd9\x41\xbc\x97\xd0\x96\xcd\x79\xd9\x6c\x66\xd2\x59\x70\xc8\x7c\x62\xd2\x59\x72\xc8\x22\x68\xcb\xce\x44\x99\xd5\x9f\x73\x0e\x59\x5a\xfd\x59\x49\x55\xd9\x96\x4d\x02\x31\x2e\xab\x43\x13\x31\xe4\x95\xd5\xa3\x89\x1c\x74\xa0\xf4\x89\xba\x56\xc1\xa1\x50\xf6\xb0\x54\xc8\x84\x43\xac\x1e\x53\xe8\x94\x43\x6d\x36\x52\xf0\x8c\x83\x55\x5e\x52\xc0\x9c\x03\x6c\x86\x52\xf0\x82\x83\x6d\xae\x52\xf0\x92\x83\x17\xe6\x30\xce\x04\x00\xee\xed\x39\x07\xdb\x4c\xa6\xe0\x15\x07\x9f\x99\xbd\x9d\x08\xf6\xd8\xec\x66\x70\xc1\x24\x9b\xe7\x0c\x2e\xd9\xa4\x33\xbe\xdc\xfb\xc1\x41\x5a\x1b\x1d\x30\x61\x00\x60\x20\xe5\xde\x9f\x32\x20\xc4\xf5\x72\xef\xcf\x0e\x3c\xef\xbb\x51\x3e\x67\xe5\x10\xcf\xcb\xbd\xbf\x60\x50\x88\xe5\xe5\xde\x5f\x32\xe8\xc2\xec\xff\x19\x2f\x87\xfb\x79\xce\xa0\x10\xbf\xcb\xbd\xbf\x62\xd0\x33\xb3\x9f\x13\xce\x14\x88\xdb\x0d\x98\xb3\x06\x62\x76\x03\x16\xcc\x51\x79\xcd\x76\xca\xfd\xc0\x8c\xc1\xe8\xe0\x89\x06\xb6\x86\xc4\xb1\xa6\x7a\x88\xc2\x1a\x1b\x47\x9b\x69\x68\xea\x64\x70\x84\xb9\x86\x60\xcf\x0a\x47\x5b\x68\x68\xf6\xf4\x70\xb4\xa5\x86\xb6\xb0\xc7\x7e\xa6\x23\xb8\x46\x77\xae\xa1\xd9\x33\xc7\xd1\x56\x1a\xda\x99\x3d\xba\x89\xce\x6b\x7b\x2e\x05\x9e\xce\x74\x7b\x52\x05\x9e\xc1\xf6\x66\x76\xbb\xfc\xcc\x7f\x23\x03\x6e\x19\xa1\xa6\x15\xd0\x08\x35\x6d\xc2\x46\xa8\xe9\x83\x69\x84\x9a\xde\xc0\x46\xa8\xe9\x1d\x6c\x84\x9a\xde\x9a\x46\xa8\xe9\x37\x6c\x84\x9a\x71\xc0\x46\x88\x8e\xcb\x30\x42\x94\xa5\xb0\x11\xa2\x2c\x86\x8d\x10\x65\xb9\x65\x84\xca\xd4\x65\x84\x04\x04\x36\x42\x02\xea\x30\x42\x02\x6c\x19\x21\x01\x70\x18\x21\x01\x76\x18\x21\x01\xb6\x8c\x90\x00\x38\x8c\x90\x00\x3b\x8c\x90\x00\x5b\x46\x48\xf2\xc0\x61\x84\x24\xdc\x61\x84\x24\xdc\x36\x42\x14\x04\x19\x21\x01\x00\x8d\x90\x00\xc2\x46\x48\x40\x4d\x23\x24\xca\x61\x23\x24\xa0\xb0\x11\x12\x50\xd3\x08\x89\x72\xd8\x08\x09\x28\x6c\x84\x04\xd4\x34\x42\x72\xe8\xb0\x11\x92\x60\xd8\x08\x49\xb0\x65\x84\xca\xb4\xd3\x08\xb5\xe0\x2e\x23\xd4\x62\x75\x1a\xa1\x16\xcd\x61\x84\x5a\x84\x4e\x23\xd4\xa2\x75\x1a\xa1\x16\xcd\x61\x84\x5a\x84\x4e\x23\xd4\xa2\x75\x1a\xa1\x16\xcd\x61\x84\x14\x5e\x76\x1a\x21\x05\xaf\xd3\x08\x29\x78\x80\x11\xea\x0c\x5e\xfc\x1b\x7d\xc1\x5a\x56\xa8\x69\x05\xb4\x42\x4d\x9b\xb0\x15\x6a\xfa\x60\x5a\xa1\xa6\x37\xb0\x15\x6a\x7a\x07\x5b\xa1\xa6\xb7\xa6\x15\x6a\xfa\x0d\x5b\xa1\x66\x1c\xb0\x15\xa2\xe3\x32\xac\x10\x65\x29\x6c\x85\x28\x8b\x61\x2b\x44\x59\x6e\x59\xa1\x34\x72\x59\x21\x01\x81\xad\x90\x80\x3a\xac\x90\x00\x5b\x56\x48\x00\x1c\x56\x48\x80\x1d\x56\x48\x80\x2d\x2b\x24\x00\x0e\x2b\x24\xc0\x0e\x2b\x24\xc0\x96\x15\x92\x3c\x70\x58\x21\x09\x77\x58\x21\x09\xb7\xad\x10\x05\x41\x56\x48\x00\x40\x2b\x24\x80\xb0\x15\x12\x50\xd3\x0a\x89\x72\xd8\x0a\x09\x28\x6c\x85\x04\xd4\xb4\x42\xa2\x1c\xb6\x42\x02\x0a\x5b\x21\x01\x35\xad\x90\x1c\x3a\x6c\x85\x24\x18\xb6\x42\x12\x6c\x59\xa1\x34\xea\xb4\x42\x2d\xb8\xcb\x0a\xb5\x58\x9d\x56\xa8\x45\x73\x58\xa1\x16\xa1\xd3\x0a\xb5\x68\x9d\x56\xa8\x45\x73\x58\xa1\x16\xa1\xd3\x0a\xb5\x68\x9d\x56\xa8\x45\x73\x58\x21\x85\x97\x9d\x56\x48\xc1\xeb\xb4\x42\x0a\xde\x30\x2b\xa4\x44\xc4\xff\x8d\x62\xb8\x96\x19\x6a\x5a\x01\xcd\x50\xd3\x26\x6c\x86\x9a\x3e\x98\x66\xa8\xe9\x0d\x6c\x86\x9a\xde\xc1\x66\xa8\xe9\xad\x69\x86\x9a\x7e\xc3\x66\xa8\x19\x07\x6c\x86\xe8\xb8\x0c\x33\x44\x59\x0a\x9b\x21\xca\x62\xd8\x0c\x51\x96\x5b\x66\x28\xd9\xb9\xcc\x90\x80\xc0\x66\x48\x40\x1d\x66\x48\x80\x2d\x33\x24\x00\x0e\x33\x24\xc0\x0e\x33\x24\xc0\x96\x19\x12\x00\x87\x19\x12\x60\x87\x19\x12\x60\xcb\x0c\x49\x1e\x38\xcc\x90\x84\x3b\xcc\x90\x84\xdb\x66\x88\x82\x20\x33\x24\x00\xa0\x19\x12\x40\xd8\x0c\x09\xa8\x69\x86\x44\x39\x6c\x86\x04\x14\x36\x43\x02\x6a\x9a\x21\x51\x0e\x9b\x21\x01\x85\xcd\x90\x80\x9a\x66\x48\x0e\x1d\x36\x43\x12\x0c\x9b\x21\x09\xb6\xcc\x50\xb2\xeb\x34\x43\x2d\xb8\xcb\x0c\xb5\x58\x9d\x66\xa8\x45\x73\x98\xa1\x16\xa1\xd3\x0c\xb5\x68\x9d\x66\xa8\x45\x73\x98\xa1\x16\xa1\xd3\x0c\xb5\x68\x9d\x66\xa8\x45\x73\x98\x21\x85\x97\x9d\x66\x48\xc1\xeb\x34\x43\x0a\x1e\x64\x86\xc4\x8e\xf9\x88\x3f\x78\xd3\xf5\x8c\x20\x7f\x49\x51\x9e\xe3\xa9\x48\xbe\x3e\x57\x76\x95\xf8\xc1\x8d\xf3\xf6\xe5\xd5\xb3\xb3\xb3\x0b\xfb\x14\x36\x6d\x49\xb9\x8f\x63\x5c\xcf\x01\x4e\xee\xb1\x3a\x4f\xe8\x5b\x45\x4f\xaa\xe2\x89\xf2\x68\xd6\xb9\xeb\xba\x12\x78\x17\x27\x8a\x22\x49\x6c\x43\xa2\x1b\x4a\x4c\xbc\xbe\xa3\x14\xed\x65\xd1\x96\x90\xca\xc0\x92\x45\x2d\x96\xec\x58\xd4\xdb\x31\xe3\x92\x57\x45\xf2\x01\x7d\x55\x06\x6e\xbe\x69\x48\xd9\x64\x1c\xa0\x9b\x1a\x54\x72\x94\xe1\xe4\x89\x3a\x4a\xf5\xa5\xf2\x27\x55\xa1\xfe\x49\x9f\x23\x3a\xb1\x86\xe4\x04\x97\x91\x47\xa2\xc7\x7a\xad\x68\x18\x5a\x4b\x8d\x24\xf4\x11\xaa\x5e\x72\xdd\x78\xfa\x3c\x75\x0c\x44\x9d\xe5\x5e\xd4\xbd\xf2\xc4\xd4\x3a\xd0\xc4\xea\x11\xfd\x5f\x15\x6e\xce\x08\x7b\x2b\x6a\xec\x58\x75\xf4\x96\x25\x7f\x12\x2a\x24\x59\x44\xdf\x75\x05\x24\x16\x04\xee\x01\xa0\x25\xc5\x20\x10\xaa\xd9\x4a\x76\x17\x70\xaf\x3e\xef\x6c\xbe\x6f\x65\x3e\x6e\x05\x0d\x04\x80\xed\x6d\x98\x3d\x0c\x00\x06\xd4\xb3\x07\x01\xc0\x1c\x2f\x6e\x99\xa3\x39\x91\x1a\x5f\x92\x5c\xc1\x4d\x5b\xee\xb0\xa7\xf1\xda\x01\xaf\xb3\x6a\xef\x93\x2d\x7d\xa9\xfd\x01\x89\xa2\x87\x90\x58\xac\x9a\x7f\x82\x02\x3d\x46\xde\xd6\xa7\x7f\x9a\xaa\x6c\xcc\x2e\xfd\x01\x4a\x4e\x42\xf6\xb6\xfa\x8b\xc6\xe2\xae\xa0\xa5\x18\x4d\x08\x67\x3b\xd0\x8e\x09\x81\x74\xaa\x83\x1a\xd0\x0e\x67\x2c\xd0\x8e\x09\x81\xb4\xb2\x83\x5a\xdb\x0e\xc4\x6c\x7a\xd2\x8c\x3d\xed\xe8\x85\x24\x79\x2f\x4c\x50\x59\x7e\xf6\x73\x8d\x55\x7d\xdf\xba\x2c\xc9\x3e\x9d\xe8\xab\xbd\xfa\x63\x7a\x21\x49\xea\x34\x3b\x8a\x67\xe2\x34\x2a\x23\xf1\x64\xdc\x5d\x69\xe3\x24\x81\xa5\x81\x0f\x6b\xcd\x4b\xa5\xa4\x76\x62\xed\x61\x2c\x0e\xd6\x79\x68\xe0\xb4\x3c\xd6\xe4\xd0\xc2\xda\x6b\x58\xd0\x1d\x88\xf3\xe6\x9f\x69\xa4\xc7\xfc\x6a\x09\x24\xc7\x12\x04\x0a\x32\x87\x42\x92\x6c\x82\x84\xc0\x02\x6d\x59\x20\x50\x98\x1d\x04\xa1\xb6\x84\xd0\x02\x6d\x59\x20\x50\xa0\x1d\x04\x95\xb6\xdc\xf7\x77\x60\x59\xd0\x6e\xef\xb8\x45\xc6\x40\xeb\x91\x19\xbd\x9b\x80\xd0\x68\xe4\xdc\x52\xd3\x77\xb5\x28\x0a\xf0\x2a\x5c\x5a\x62\x13\x67\x5b\x02\xc9\x0c\x2b\x07\x05\xa6\x01\x41\xd2\xa2\x95\x0b\x79\x30\xe9\xeb\xe5\xa0\x90\x40\x74\x2c\xfa\x42\x06\x4c\xfa\x7a\x39\x28\x18\x10\x1d\x41\xdf\x7d\xe3\x0a\x9e\xeb\xf6\xca\x95\x5b\x1e\x54\x9c\x1e\x61\x50\xba\x06\x48\x42\x4b\xc8\x2d\x06\x9d\x77\xc0\xc2\x39\x9e\x6d\x67\x96\x0c\xf0\x6b\x5d\x90\x18\x48\x10\x28\x09\x1c\x0a\x09\x83\x09\x12\xf3\x0e\xb4\x65\x81\x40\xa9\x70\x10\x84\xda\x12\x32\x00\xb4\x65\x81\x40\x09\x71\x10\x54\xda\x72\xdf\x9d\x83\x65\x40\xbb\x39\xe7\x16\x15\x03\xad\x47\x5a\xf4\x6e\x02\x02\xa3\x91\x73\xcb\x4c\xef\xb5\x3e\xb4\x9d\x86\xa1\x25\x36\xec\xae\x1e\x24\x35\x02\x02\x0a\x0d\x03\x42\x32\x63\x40\x84\x5c\xd8\xed\x98\x10\x50\x60\x60\x6a\x40\x3b\x42\x26\xec\x76\x4c\x08\x28\x2c\x30\xb5\xb6\x1d\xf7\x1d\x48\x58\x06\xd4\x2b\x90\x6e\x49\xd1\xb1\x7a\x04\x45\xeb\x22\x20\x27\x2a\x31\xb7\x98\xf4\xdd\xcd\xdc\x84\xa1\x94\x12\x35\x1d\x8b\x3c\xfa\x7f\xcd\x0f\xa5\xb7\x87\x70\xc7\xc1\x44\x1e\x7a\x62\xe9\xcf\x3d\x94\x45\xde\x83\x36\xe0\x71\xb6\x3c\xa3\x21\x7f\x8b\xaa\x33\x1e\x42\xcf\xf1\x2a\xd7\x0d\xf8\x0d\x3e\x3f\x2d\xe5\x05\x3e\x7e\x61\xa6\x29\x6a\x7a\xb4\x8f\x69\x74\x86\xdd\x4b\xd8\xa0\x02\xce\xd9\x62\x8f\x8c\x7f\xfd\xdb\xb7\x3f\x1d\x88\xd0\x67\x5d\x17\xd2\xbe\x03\xc9\xfe\xd0\xeb\x42\xea\xa2\x64\x7f\xac\x75\x21\xed\xe1\xab\xfb\x70\x3d\xf9\xd9\xd7\xbe\x1b\xdf\x8b\xaa\x32\x49\x0d\x27\x38\x3b\x07\x7e\x19\x9f\x5a\xb3\x65\xe7\x9d\x6b\x9e\xdc\x66\xcb\xf8\x3b\xd7\xd4\xda\x3c\x68\x17\x00\x4f\xe4\xb4\x72\x51\xf3\x34\x46\x9f\x56\x51\xe1\xf3\x1d\x2b\x9e\xda\xa2\xc2\xe5\x3b\x56\x54\x5b\x3c\x68\x17\x30\x4f\x61\xb2\x42\xa4\x6b\xa9\xf5\x54\x74\x2f\x64\x9b\x57\xa7\xb7\x08\x55\x34\xc2\x34\xeb\xe0\x78\xdc\xc6\x38\x89\x4a\x5c\x8d\x12\xbc\xc3\x99\x7a\x89\x4e\x2e\x73\x81\x22\x73\xa8\x5c\xb4\xdb\xb8\xc1\x91\xd7\xeb\x0c\x69\x5f\xe8\x69\x50\xa0\x7c\x68\x17\xf6\x65\x40\xe0\x7e\xff\xa2\xf9\x77\x64\x6f\xd9\x0f\x49\x7e\xa5\x77\x65\x61\xe4\x73\x39\x0b\x82\x23\x94\x21\xcd\x4e\x82\x26\x86\x3e\xa7\x17\x10\x65\x3a\x01\x11\xc3\xfe\xca\x4a\x1b\x12\xbb\xbc\x79\x34\x33\xac\x09\x16\x6b\x57\x0d\x8f\x5a\x5b\xd9\x0e\xbf\x6f\x5c\x45\x54\xb6\x60\x59\xf2\xb9\xf7\xd2\x3a\xa9\xe2\xbc\x21\xc8\x0b\x1a\xc6\xbe\x2f\x73\xd2\x8d\xb7\xa4\x48\xfd\x90\x64\x55\x41\x92\x83\x96\xfe\x4b\x85\x8c\x48\x5d\xe5\x75\xe5\xbc\x6c\xdd\x97\x30\x6f\xb1\x58\x1c\x39\x09\x75\x8b\xe4\x2c\xbf\xd6\xdb\x39\xd8\xb9\xc8\x66\x73\x25\xd1\xd7\x32\xbf\xf6\x26\xd3\xfc\xda\x95\x72\xad\x2d\x8d\x53\xb4\x63\x89\x15\xe0\x0b\x91\x1d\x89\xd8\x80\xcb\xa4\x4d\xdd\xe6\xff\xd5\xdb\xa4\xc1\xd9\xe2\x21\x74\xf1\xd4\x89\x0b\x24\x7a\xe3\x9d\xa0\x43\x50\x93\xb5\x79\xe3\xc9\xa2\x1c\xb5\xc4\x2d\x98\x91\x0e\xee\xee\x74\xde\x06\x11\x7d\x0e\x79\xca\x34\x95\xda\xfa\x9d\xe5\x12\x6d\xf1\x4a\xa4\xfb\x5b\x07\x77\x61\xf6\x28\xf0\x02\xef\x5c\x00\x26\xc1\x74\x34\x39\x5b\x8c\xa6\xb3\xd9\x68\xbc\x3c\x69\x26\x3a\x09\x19\x83\x59\xd3\xfc\x95\x79\x82\x42\xbc\xa7\x4f\x71\x89\xeb\xc9\xab\xd5\xea\x82\xe4\x28\x8c\xab\x9b\xf5\xc4\xa8\xd4\xf8\x97\x74\xa9\x3a\x2a\x5a\x6d\x70\x66\x9c\x54\x27\x2d\xf9\xab\x29\x87\x36\xcb\x5e\xd7\x1e\xa5\x56\xff\xbd\x28\xa6\xf9\xed\xa2\xf7\x47\x7a\x79\x81\x51\x44\xb2\xe4\xe6\xfd\x91\xd0\xe4\x2d\xaa\xa7\xaf\x55\xe0\x4b\x00\x63\x17\x4f\x94\x06\x7b\x09\xf3\xf4\x17\x19\xa9\x7c\x94\x24\xe4\x0a\x47\xc7\x8e\xb4\xa3\x28\xcf\x31\x2a\x50\x16\xf2\x3c\x2a\xc0\x57\x85\x40\x6d\x4c\x51\x84\x2f\xe3\x10\xfb\x79\x7c\x8d\x13\x9f\xe6\xc2\x5c\x07\x0f\x0f\x0a\xfd\x08\x55\xf8\x7d\x5d\xfb\x19\x50\xfa\xec\x6d\x42\x42\x94\xb8\xf1\x52\x92\x55\x7b\x37\xb8\x21\xa1\x43\xb5\xec\x2b\x33\x9a\x9d\x8c\x89\x03\xdd\xbe\xf3\xcb\xd4\x33\xfb\x38\xea\x41\x50\xba\xd9\x85\xca\x7a\xda\x85\x41\x3b\x6b\x22\x3c\xd1\xfe\xde\x54\xd9\x13\xfa\xf7\x78\x53\x65\x1d\x7d\x1c\x54\xab\xa3\xe3\xbd\xf5\xe1\xd1\xf4\x56\x83\x87\xc8\x90\x34\x31\xee\x18\x5a\x17\x76\xd7\x90\x5c\xf5\x1c\x43\x71\xa1\x77\x0d\x41\x2d\x44\x51\x44\x3a\xa7\xa8\xb7\x4a\xff\x60\x1c\x95\x3b\x47\xe4\xa8\xc3\x86\x65\xad\x4f\x86\x5d\xa6\x5d\x6b\x13\xc2\xe1\xeb\x12\x02\xb1\x35\x29\x20\xfa\x7a\x0c\xcc\xf5\x98\xec\x7a\xd6\xa3\x8d\xe0\x64\x9b\x8e\x0a\x31\x49\xc7\x00\x66\x3a\xd9\xdd\x65\x3d\x0e\xac\xd5\xd1\xf1\x3b\xad\xc7\x01\xd5\xe0\x21\x9e\xb0\x1e\x7b\xb0\xbb\x86\x74\xca\x7a\xec\x40\xef\x1a\xc2\xf0\xf5\x38\xa4\x4a\xff\x60\x4e\x58\x8f\x7d\x75\x3a\xd7\x63\xb2\xeb\x5f\x8f\x3a\x8e\xb6\x1e\x75\x90\xba\x1e\x93\x9d\xb6\x1e\xe7\x4b\x9a\x07\x83\x32\x9d\xf6\xf0\x60\x87\x02\x8f\x63\xf1\x8d\x36\x1a\xd3\x0f\x33\x20\x2d\x80\x99\x30\xba\x3f\x4b\xaa\xa0\xe9\xd1\xef\xca\x91\xfc\x5b\xe6\x7c\xa3\x4d\x09\x28\xfd\x43\xe4\x74\xd3\x92\x0e\x00\x49\x64\x83\x0b\x33\x45\xad\x99\x1d\xd8\x6c\x5c\x69\xeb\xa0\x04\x57\xa7\x7a\x4f\xc1\xaf\x56\xb3\xe3\x0e\x2c\xd6\x82\xf5\x89\xab\x8f\xcc\x86\x03\xc9\x9c\xb5\x5c\x1f\xea\xe8\x1b\x76\xcf\xe9\x77\x71\xdb\xe7\x47\xe6\xdc\x3d\xe2\x53\xa8\xd4\xf1\xb5\x39\xd6\xf9\x2f\x59\xee\x9c\x71\x2d\x10\xe0\xc8\x2e\x6e\xd0\x7e\xd4\xdd\xd8\x23\xbd\x69\x28\xa3\x20\x3f\x5d\x08\xf4\x7b\x2c\x7c\xe1\x76\x62\x64\x11\x24\x4c\x0a\x3e\x2d\x36\x91\x21\x1f\xdb\x90\x9e\x2e\x14\x31\x40\x08\x47\x15\x70\x27\xbc\x83\x00\x28\x68\xdd\x78\x5c\xe8\xa0\x9a\x2d\x23\x20\xa8\xf2\xd5\x61\x91\x03\x6b\x32\x50\x5b\x0d\xfa\x16\xd1\x74\xbd\xcf\x0e\xa2\x98\x61\x0d\xf3\xe4\xe7\x19\xb0\xda\x95\x15\xcb\xfc\x7d\x80\x6e\xab\x18\x3b\xa0\x65\x6a\xdb\x57\x08\x5b\xfb\x18\x19\x54\xc3\xb2\x01\xfd\x86\x1c\xa4\xb3\xa9\x32\xdb\x25\x3f\xad\x8b\xae\x1a\x3d\x5d\x84\x7c\x7f\x57\x17\xcd\x0c\x9d\x7a\x4e\x98\x40\xb5\x37\xcd\x17\xd2\x09\x9d\x1d\xb5\x6e\xa5\xe2\x51\x5e\x28\x27\x03\xad\x64\x61\x53\x2b\xa2\xb6\xb0\x13\x9f\x99\xdf\x88\x4f\x6c\x76\x68\x0d\x1a\x04\xbb\xab\x53\x56\x35\x6c\xf9\x09\x76\xb9\x69\x93\x05\x2a\x41\x99\xe1\xa0\x4e\xc6\x73\x1c\x5b\x2e\x34\x80\x31\x13\xb6\xb3\xaf\xcf\xb5\x19\x4d\x35\xba\xa0\x23\x83\xc1\xd5\x7e\xb1\x6c\xc7\xad\x44\x6d\x7b\x6b\xc9\xc6\x06\xf2\xab\x83\xb6\x8b\x73\x1d\x55\x86\x0d\x55\x62\xb9\x26\x45\x61\xac\x54\x69\x46\xa5\x76\xc2\x94\xf0\xb4\x31\x3f\x60\x34\x7b\xd0\xfc\x82\x3a\x5d\x25\xa0\x2a\xeb\xa9\x19\x91\xee\x5f\x07\x5a\x7b\xc9\xae\x4b\x77\x98\xba\x78\xd4\xba\xc0\x8a\xf7\x7b\xa1\x25\x75\x6d\xb3\xcf\xcb\x94\xf7\x46\x0f\xe8\x05\x89\x0b\xe8\xa9\x93\x0e\x7d\xae\x29\x10\xda\x2a\x40\xb4\x9b\x84\xa9\x44\x7e\x62\x7d\xef\xd2\x24\xc9\xae\x5f\x93\xb4\x38\x3d\x9a\xc4\x98\x17\xeb\x33\xc5\x98\xf9\xe1\x9a\x24\xd9\x0d\xd1\x24\xdd\xe3\x76\x2d\x5d\xa8\x96\x5b\x93\xc0\xfc\xea\xa0\x7d\xaa\x26\x49\x76\x43\x34\x89\x8a\x35\x58\x93\x24\x3b\x53\x93\xb4\x13\x66\x69\x12\x39\x3f\xa0\x26\x19\x34\xbf\x5d\x9a\x84\x12\x50\x35\xc9\xb9\xba\x18\x26\xc3\x17\xc3\x71\xbc\x47\xa5\xbf\xc5\x38\xda\xa0\xf0\xa9\xfd\x99\xa3\xc3\x8d\xf0\xba\xee\xd6\xcc\xa7\xe3\x85\xe5\x7c\xda\x94\xe5\x77\x1c\xfb\xa0\xe1\x2e\xd1\xc5\x47\x7e\x9c\x45\xf8\x7a\x3d\xbd\x80\x36\x22\xe9\xde\x9d\xba\x8f\x67\xc6\xb8\x2f\xac\xe7\x24\x2e\xf8\xc7\xae\x8f\x2f\x71\x56\x95\x3c\x35\x7a\x07\x8b\x1f\xc1\x3d\x37\x25\x67\x20\xda\x93\xbb\xd0\x06\x94\xe6\x9d\x6a\x0a\x5d\xd9\x53\xd9\x39\x18\x71\x5d\x78\xd9\x72\xbd\x5f\x64\x4d\xe3\x37\xa8\xe7\x65\x3a\x10\xed\x0e\xfc\x04\xbd\xd8\x3b\xd5\x1c\xc8\x4f\xe7\x60\xc4\xc5\xec\x40\x91\x62\xd8\x99\x68\x56\x1b\x3f\x87\xed\x29\xa1\x0b\xb0\x58\x06\x0f\x74\x28\x6f\x9b\x7f\xeb\x6b\xb0\x3d\x4e\x72\x16\xa9\x30\x00\xf4\x6b\x15\x2a\x83\xda\xb0\xa2\x47\x10\x4c\x44\x74\x00\x14\x2d\xb0\x65\x01\xb4\x8a\xc6\x83\x26\x7a\x07\xf5\x3d\x44\x6d\x97\x98\xe1\x7f\xda\x1b\xf1\x1d\xfd\x01\xf7\xae\xd9\xf3\x2a\x77\xde\xb1\x6e\x3c\xc4\x77\x96\x67\x9b\xc9\xf2\xfc\xe4\x4d\x6a\xa5\xae\xd1\x6b\xdb\x4d\xd3\x79\x08\x32\xd6\x7d\xed\xc1\xc9\x91\x76\x31\x00\x73\xca\xcf\x0f\x9b\x22\x6f\x15\x6b\xe2\xd8\x42\x6d\x91\x97\x30\x53\xe4\x25\x40\x11\x79\xbd\x0c\x6a\x03\x14\x79\x13\x06\x88\xbc\x40\xb1\x44\x5e\x03\x80\x22\xcf\x1f\xc9\xd1\x3b\xd8\x21\xf2\x0c\xff\x27\x23\xf2\x60\x7f\x1c\xc7\x35\x16\x93\x37\x15\xf9\x30\x40\x93\xe5\xe6\x6e\x22\xcf\xea\x1a\xbd\x76\x8a\x3c\xe7\x21\xc8\x58\xf7\x71\x7d\x27\x47\x2c\x91\x57\xe7\x14\x17\x05\x29\x4c\x81\x37\x0a\x35\x51\x14\x30\x5b\xd8\x39\xc4\x14\x75\x5e\xac\x08\xba\x5a\x62\xd3\x06\x85\x5c\x87\x00\x22\xce\x10\x2c\x01\x57\x8a\x41\xf1\xe6\x0f\x36\xa9\xdd\xea\x10\x6e\x86\xfd\x93\x11\x6e\xa0\x37\xa0\x68\xb3\xc7\xa3\xde\x50\xb4\xf1\xf9\xfc\x7c\x76\x47\xd1\xa6\x75\xb5\x3e\x3b\x05\x9b\xf3\x0f\x64\xaa\xfb\x72\x81\x83\x1b\x96\x58\xab\x73\x29\x3f\x15\xe8\x6c\xff\x2f\x47\x45\x7a\x5d\x7b\x21\x1c\x1e\xbd\x8e\x78\x3b\xb3\xab\x6e\x70\x54\xa4\xdd\x7a\xad\x55\xee\x97\x2c\xc0\xbd\x37\x99\x39\x61\xd6\xfc\xeb\xc8\x98\x4a\xdb\xe7\xc2\xab\x6e\x11\x3a\xce\x80\xea\x41\x79\xc7\x56\x90\x4d\x53\x48\x3c\x48\x55\x79\x1f\xf4\x54\x82\xe2\x93\x11\xa2\x6b\x54\x53\xe4\xc6\x44\xa7\x07\x7c\x07\xb5\xad\x10\x31\xa3\x52\x6e\x3c\x20\xe8\x3e\x0c\x79\x53\x65\x87\x96\x39\xee\xae\x3c\x71\x1d\x0b\x35\xaa\x68\x6a\xd5\xbc\x8f\x31\x68\xfc\xad\x26\xd7\x8a\xd9\x76\x63\x97\xc8\x40\x6f\x85\x9d\xd6\xa4\x50\xbc\x76\xc3\x5c\xe9\xea\xfb\x11\x2e\x2a\xf0\xce\x2d\x40\xb4\x63\x9b\xb6\x4d\x94\xaf\xe7\xb0\xd1\xa8\xb8\x63\x0a\xe6\x32\xe7\x15\x95\xe7\xb0\x4c\x3e\x43\x20\x69\xdb\x2c\x0c\x6e\x0c\xe1\xf2\xae\x1d\x57\x39\x2f\xf0\xb1\xe3\x81\x1d\xe4\x7b\xcf\xca\x0e\x3b\x4c\xc2\x3e\x8f\xd0\xf3\xb4\xc5\xa6\x6a\xfa\x4b\x92\x0d\x6a\xdf\x23\x52\x8a\xc4\xe3\x4b\x1d\x0d\x89\x6a\x5d\x28\xfd\x2f\x66\x00\xfd\xe8\x6d\x73\xd8\x33\x29\x36\x9b\xb5\x15\x6b\xbd\xbf\xd6\x37\x6f\x00\xd7\x87\x88\xa5\xf2\xac\xc3\x09\x7d\x35\xc2\x4e\x7a\xd7\xd5\x9e\x4d\x26\x56\xd8\xae\x53\x3c\x68\xd4\xc5\x4d\x6e\x69\xed\x27\x1c\xe9\x1c\x0d\x33\x61\xe6\x29\x12\x3b\xc6\x06\x2a\xa9\x8b\x8a\xd4\xe1\xde\x47\x21\x55\x08\x29\xca\xe2\xbc\x4e\xe8\x5b\xf1\xc6\x39\x94\xa1\xc7\xec\x95\xc3\xc7\xc0\x7b\x79\xf6\x99\xfe\x01\xd7\x09\xdc\x87\xf6\xeb\x12\x17\x3e\x8b\xdd\xb2\xee\xd0\x73\xdb\x40\x69\x69\x17\x9a\x05\x94\xd7\x3c\x1b\xc3\x98\xbd\x51\xa9\x94\xac\x95\x92\xf6\xe7\xda\x42\x5f\x5b\xe8\x6f\xe5\x75\x7c\xb3\x5d\xe5\xa7\xf6\x48\xea\x6c\x36\xb3\xde\xfc\x37\x07\xa7\xf6\xf3\xd0\x9e\xcd\x87\xe7\xb7\xc3\x63\x9e\xe5\xd7\xde\xc2\xf0\x7a\x27\x53\xd8\x8b\x77\xe1\xb2\x7e\xb5\xc7\x5a\x36\x55\xd6\x73\x66\xbc\x59\x10\xf6\xf1\x0c\x79\x00\x7d\xbc\x5c\x5c\x6c\xe3\x84\x3e\xc2\x96\xe4\x7b\xf4\x80\x03\x3e\xb7\x54\xee\x62\x18\xaf\x7b\x59\xaf\x7d\x21\xbd\x57\x40\x37\x10\x3b\x39\x00\x45\xad\x1b\xad\x1a\xe1\x2d\xaa\x93\xea\xd0\xfd\x72\xd7\x76\x6b\x38\xf9\xf4\x42\xad\x52\x5f\x99\x71\x51\xc4\xa5\xa9\x93\x30\x5e\x36\xff\xcc\x2f\xe3\xb0\xf9\xa7\x93\x57\xe4\x41\xd2\x87\xca\xf8\x4d\x61\x92\x63\x16\x5a\x95\x04\xa2\x82\xe4\x11\xb9\x6a\xcc\xc8\x6e\x97\xe0\x3b\x74\x0b\x45\xcd\x3f\xa8\x5b\xc0\xe0\xed\xb5\x68\x42\x78\x4f\xed\x01\x41\xac\x74\x52\x5b\xeb\xd4\x7a\xc7\x2d\x88\xf7\x22\xae\x07\x23\x5a\xab\x1a\x88\xe2\xcd\x9b\x7f\x6f\x38\xcd\xfd\x73\x0a\xea\x05\xbd\x01\xb1\x2e\xa0\x29\x13\x30\x68\xd2\x24\xcc\x9e\xb6\x76\xad\x01\x44\x5b\x20\x40\x55\x01\x32\xb2\x0e\x2d\x62\x2c\xb2\x1e\xac\xf5\x30\x2c\x67\x4e\x85\xde\xb5\xee\x8d\x37\x28\xda\xe1\xde\x77\x10\x59\xa5\x13\x5e\xc0\x36\xda\x5d\xcd\x43\xbc\x98\x6b\x54\x54\x0e\x6b\x2f\x65\x77\x93\x67\x2f\x64\x1b\xe4\x17\xb3\xb3\xed\xd4\x20\xaf\xca\x9f\xa0\x0f\x95\x59\xcb\x4d\x10\x70\xa8\x99\x13\xba\x75\xb6\xd8\x4c\x0d\xde\x01\x6a\x46\x87\xac\xdd\x10\x45\x5e\xf5\x01\x41\xac\x74\x52\x73\xaa\x19\xc7\xb8\x6d\x35\xe3\x40\xb4\xd5\x8c\x0b\x51\x53\x33\x30\x3f\x19\xeb\xde\x70\x9a\xfb\xe7\xb4\x43\xcd\xc8\x2a\x80\x9a\x31\x61\xd0\xa4\x41\x6a\x86\xc3\x60\x35\x63\x01\x01\xaa\x83\xd5\x8c\xbe\xc8\x7a\xb0\x7a\xd4\x4c\xdf\xa3\xf5\x43\x57\xbc\xa1\x6c\x44\x35\x38\xed\x62\x53\x4f\xa4\xd0\xea\x94\x94\x45\xb8\x39\x5f\x84\x46\xeb\xf3\x10\xe1\x79\xa8\x51\x51\x59\xad\xbd\x9a\xdf\x4d\x7e\x3e\x5f\x45\x73\xd3\xde\x4d\x17\x8b\xe5\x74\xa1\x93\x57\x05\x51\xd0\x87\xca\xac\x75\x27\x08\x9c\xa4\x6f\xc0\x6e\xcd\x56\xe7\xf3\xd9\x0a\xea\x16\x30\x78\x40\xdf\x18\x10\x45\x70\xf5\x01\x41\xac\x74\x52\x73\xea\x1b\xc7\xb8\x6d\x7d\xe3\x40\xb4\xf5\x8d\x0b\x71\x80\xbe\x61\xac\x7b\xc3\x69\xee\x9f\xd3\x0e\x7d\x23\xab\x00\xfa\xc6\x84\x41\x93\x06\xe9\x1b\x0e\x83\xf5\x8d\x05\x04\xa8\x0e\xd6\x37\xfa\x22\xeb\xc1\xea\xd1\x37\x7d\x59\xe6\x86\xae\x78\x43\xdf\x88\x6a\x6e\x7d\x43\x93\xb3\x75\x2b\x9b\x4d\x18\x44\xd8\x6c\x7a\xb9\x39\x8f\x50\x4b\x42\x65\x32\x4d\x95\x36\x40\xcd\xcc\x26\x9b\x20\x5a\x18\x84\x27\x9b\x65\x74\xbe\x50\x08\xab\xc2\x47\x29\x5b\x05\xd6\x2a\xa3\xf5\x4e\x52\x2d\x60\x57\xa6\xcb\x15\xda\x84\x56\x57\xcc\xa1\x02\x4a\x45\x2d\x56\x44\x53\xe9\xbe\xc5\x2f\x98\x88\x53\x91\x40\x43\xb4\xb5\x08\x84\x65\xab\x10\x10\x6b\x80\xfe\x60\xfc\xb9\xf3\xfc\xf5\xcc\x57\x87\xda\x60\xf8\x80\xce\xd0\x00\xd6\x84\x40\xda\xa2\x01\xc0\xaa\x42\x87\x98\xc4\x06\x2b\x09\x65\x71\x74\xa1\xf4\xa8\x87\xce\xec\x83\x83\x16\xa8\xa9\x18\x78\x1d\xb7\x62\x10\x09\xf9\x3a\x45\x60\x1b\xa0\x68\x6e\x36\x8d\x31\x9a\xce\x96\x1a\x15\x95\xb1\x22\x2b\xde\x00\x0d\x81\xc3\xd5\xd9\xc4\xfc\x9e\x5b\x9d\x2f\xb6\x41\xa4\x93\x57\x85\x4c\xd0\x87\xca\xac\x75\x24\x08\x9c\xa4\x2d\xc0\x6e\x45\x8b\xf3\xc5\x64\x0a\x75\x0b\x18\x3c\xa0\x33\x0c\x88\x22\xa3\xfa\x80\x20\x56\x3a\xa9\x39\xf5\x87\x63\xdc\xb6\x0a\x71\x20\xda\x5a\xc4\x85\x38\x40\x91\x30\xd6\xbd\xe1\x34\xf7\xcf\x69\x87\x46\x91\x55\x00\xa5\x62\xc2\xa0\x49\x83\x54\x0b\x87\xc1\xda\xc5\x02\x02\x54\x07\xeb\x18\x7d\x91\xf5\x60\xf5\x68\x9a\xde\x9c\x95\x03\x57\xbc\x19\x65\xe1\xd5\xdc\xfa\x86\x27\x75\xec\x16\x94\xd5\x62\x36\xb7\x16\xde\x7c\xb6\x9d\x21\x95\x88\x16\xc8\x62\xc9\x15\x07\x28\x9b\x70\x35\x0b\xa6\xa6\x39\x3b\x5b\x4e\xc2\xc9\x4a\x23\xae\x05\xf9\x18\x75\xa0\xc8\x8e\x68\xb2\xda\x27\x29\x1a\xb0\x4b\x28\x9c\xae\x84\x87\xae\x75\xc9\x1e\x36\x14\xc6\xd5\x00\x6a\x38\x50\x1d\x0a\xc0\x41\x17\x29\x77\x08\x17\x1c\x30\x10\xc1\x05\xf1\x80\x00\x2e\x8c\x37\x40\xbf\x30\x8e\xbd\xd1\xcc\xf6\xce\x63\x57\xf0\x96\xd7\x80\x62\xb7\x3a\x08\x98\x28\x30\x72\x4b\x41\x8e\xc0\xad\x01\xb3\x49\x0e\x0f\xdb\xaa\x8b\xa9\x1b\xa9\x2f\x68\xdb\x9d\xe1\x74\xe0\xb2\x36\x54\x8a\xa8\xe5\x56\x29\x49\x9c\x3d\x35\x22\x2f\xe6\xe6\xad\xbe\xe3\x19\xb4\xf5\x46\xf2\x97\x26\x16\x4d\xc1\xda\x2c\xe8\xdf\x48\x63\x5d\xe9\xca\x1f\x35\x78\xcb\x0c\xe8\xa1\xd5\x21\x75\xd2\xe9\xdf\x9c\xf3\x2a\x83\xb5\xdc\x55\x3d\x15\xc5\x92\x41\x1b\x34\x5b\x58\xdb\x9e\x75\x16\xe1\x22\x89\x33\x40\xbd\x83\x8d\xc0\xc2\x69\x40\xba\x45\x53\xe9\x6b\x27\x8a\xde\x7d\xf1\x26\x10\xb4\x65\xab\xdc\x19\xa1\xf7\xb3\x28\x89\xdd\xe1\x6d\x5d\xa5\x6b\x5b\x28\x53\xa5\x05\x56\x74\x5d\x2a\x45\x65\xca\xfe\x7b\x5d\x1e\xde\xc2\x65\x60\x47\x23\x0a\xf1\x09\xdb\x30\x66\xa8\xd0\xc9\x41\xf5\x70\x18\x4a\x70\x51\x3d\xc9\x1f\xe5\xa3\x16\xfd\x91\x52\x53\x3f\x65\xa8\xa6\x15\xdb\xd4\x55\x45\xb2\xf7\x5b\x5c\x2d\x2d\x00\x2e\x71\xe5\x80\x95\xf5\x26\x8d\x55\xa0\x7e\x5a\x0d\x45\xf8\x20\xb6\xa4\x03\x28\x07\x20\x07\xd2\x4c\x7a\x5e\xc3\x31\x54\x18\xd9\xfd\x20\x8c\x6e\x30\x6b\x77\x1c\x67\x07\x25\x1d\x5b\x48\x92\x04\xe5\x25\x96\xec\x63\xb2\x25\x8a\x1b\x6c\x3d\xdb\x64\x55\x80\x40\x9e\x4e\x94\x5c\x1d\x69\xa6\xd2\x6e\x1c\x36\xb9\xb2\x95\xe6\x83\xcd\x3e\x74\xc6\x45\x24\x90\xd9\x9b\x65\xee\x66\x8b\x5d\x7e\x5e\x90\x1c\x17\xd5\xcd\x9a\x55\x1a\x5d\xc6\x65\xbc\x89\x93\xb8\xba\xb9\x18\x86\x05\xd0\x8c\x6a\xbe\xda\xc6\x33\x2d\x25\xa2\x01\x00\x6a\x56\x71\x1a\x67\x3b\x7f\x5b\x67\xec\xd8\x0c\x46\x25\xbe\xe8\x81\x1f\xc7\x21\x2a\x70\xd5\x75\x72\x34\x68\x59\xa2\x3d\xf4\x96\x5f\x3b\xce\xee\x28\xef\x29\xcd\xf3\x6b\x2f\x42\xe5\x1e\x47\x66\x29\x3b\x96\xf3\x15\x19\x51\xe5\x17\x00\xc1\x03\x3b\x6a\x4a\x61\x10\xe3\x28\x3d\x8c\x11\xfd\x55\xe7\xd0\x35\x44\xd0\x69\x6a\x8f\x9a\x28\x08\x29\xce\x6a\xc7\x75\x43\x9a\x0e\x94\xe7\x64\x10\x17\x0e\x27\x41\x10\x5c\xa8\x82\x7c\xd1\xbe\xd0\xa9\xa4\x9c\x9d\x2c\xcd\xa4\x05\x82\xa3\xf4\xdd\xa9\xc0\x0b\x2e\x92\xb8\xac\x78\x5e\x70\x46\x47\x3f\x86\x64\x3c\xd2\xd6\x79\x7a\x03\xce\x31\xaa\x94\x6a\x87\x5e\xe8\xf9\x98\x01\x49\x48\xd9\xc1\xf1\x46\xa1\xea\xf5\x8d\x63\xf2\x9d\x68\x4a\xa7\x93\x38\x5f\xb7\xd9\x49\xae\x8d\x29\x18\xd3\x47\x25\xa9\x64\x1c\xc4\x25\x4f\xe5\x15\x49\x0d\xd7\x1b\x47\xf1\x65\x1c\xe1\x42\xdc\x6f\x9d\xc8\x63\x8e\xeb\x15\x65\xb5\xb9\x9e\x81\x50\x05\xcb\xd2\xab\x13\x7e\x92\xc4\x4f\x90\xa1\xe5\xc5\x1c\xce\xf2\x6b\x8f\xa6\x0f\x6a\x8f\x1f\x5a\x4e\x53\x47\x26\xda\xc6\xfc\x41\x89\xcc\xed\xf6\x85\xed\x07\x20\xcc\x6a\x43\xa6\xfa\x42\x86\x1e\x9b\x7f\x90\xb0\xb0\xa7\x93\x0c\xaa\xe2\x85\x26\x64\x36\x27\x01\x70\x6f\x5a\xb0\xf5\x95\x01\xf6\x0e\x3c\xe3\xa5\x39\xa0\x56\xcf\x84\xd7\x02\xf4\xad\x05\x39\x7a\xa7\x20\x98\x8e\x4e\x47\x3b\x43\x89\x81\x23\xec\x74\x61\xe1\x83\x6d\xfc\x90\x58\x5e\x90\x5d\x1c\xad\xff\xcb\x7f\xff\x62\x03\xfa\x72\x53\x6d\x4b\x8a\x74\xfc\xa5\x38\x2c\x48\x49\xb6\xd5\x78\xd7\xac\x53\x9c\x55\x0f\x70\xc6\x72\x2d\x7d\xce\xdb\xa2\xa4\xc4\x0f\x2f\xa0\x2c\x41\xec\x8b\x4c\xd7\x6f\xba\x7d\x65\x28\xc8\xa9\x0e\xf9\x32\x94\xcb\xef\x42\x26\xa2\xd1\xd1\x1a\x84\x03\xd7\x8f\xea\x53\xbb\x12\x6b\x8f\x51\xb3\x4a\x7b\x16\x54\xa7\xf3\xa6\x2d\xa2\xc6\x51\xed\x5c\x44\x0d\xa3\x9b\x3f\x5a\x85\xbe\x8d\xaf\x71\x74\xa1\x75\xb2\xf9\xb8\xe1\x47\x56\xd9\x99\x6d\xa1\xdb\x57\xab\xe0\xa8\xe8\x21\x93\x89\x0e\xb5\x54\xe7\x1e\x33\xac\xa3\x71\x86\x2e\x37\xa8\xf0\x69\x9b\xfc\x5c\xac\x27\x89\x70\x2c\xed\x89\x42\x23\x8b\xb8\x6d\x42\x15\x80\xb4\xa2\xf2\x3c\xf5\xbd\xb6\x03\x5a\x4f\x7b\x3b\xa2\x8f\xab\xe9\x09\x9d\x66\x79\xe9\xc5\xce\x8f\xde\x79\x7e\x99\xb7\x46\xf9\x63\x12\x07\x98\xd6\x81\xee\x12\x29\xc5\x5f\x57\xbf\x0f\x84\x4f\xf2\x86\xf9\xd6\x24\x41\x96\x31\x44\x7d\x72\xdb\x6e\xcb\xfc\x48\x61\x75\x00\x17\xc4\x51\x55\xfb\x6a\x06\xe0\xeb\x3e\xb8\xf2\x69\x08\x81\x95\x70\x48\xdb\x3f\xbb\x51\x47\x5b\x70\x13\x0a\xe5\x83\xcc\xbc\xa0\x8c\x90\x7e\x58\x3e\x32\x18\xd3\x16\x5a\x13\xe7\xb5\x3f\xc1\x5a\x0a\x08\xbc\xd6\x60\x5c\x61\xd0\xa1\xe6\x05\x07\xaf\x6d\x41\x2d\x51\x3b\x25\xcb\xd5\x9b\x4d\xa6\x18\x70\xa4\x27\x16\xb9\x27\x0e\x72\x5a\x52\x00\xfd\xa1\x62\xed\x0b\x94\x71\x37\x23\xd5\x03\xf5\x71\x8d\x87\xac\xa4\x7d\x19\x81\x15\x98\x9e\xed\xc3\x03\x18\xa9\x51\x27\x53\x79\xb0\xc3\xb8\x65\xe3\xc6\x3c\xb1\x71\xfe\xa2\x26\x5d\xb1\xb2\x27\xca\x67\x80\x01\xb1\x5a\x6e\x1b\xb2\xf9\xa0\xc9\xa1\xe9\xd7\x5b\xd8\x46\x8f\x9a\x91\x82\x1d\xd2\x00\x66\x7f\x1c\x12\x60\x22\x0c\x98\x33\xa6\x1d\x7a\xa6\x88\x53\xeb\x62\xbf\xc9\x26\x73\x71\x0e\x22\x61\x85\x66\xdf\xca\xe4\xf1\xa6\xbb\xa6\xd0\x16\xc4\x37\x9c\x25\xcf\x12\x04\x4b\x93\x51\x27\xc7\xc2\x53\x7d\x1e\x7d\x18\x8f\x2c\x54\xed\x16\x9c\xfa\xaa\x36\x7f\x37\x5f\x0f\x23\x25\xbb\x3e\x82\xd6\x9c\x25\xbb\x9e\x46\x27\x53\xab\xd5\xc9\x54\x6b\xd6\x31\xca\x9f\xc4\x9d\x89\xae\x0e\xb4\xa1\xdd\x53\xa2\xb7\xc2\x43\x72\x69\x29\x11\x84\x94\xfe\x16\x63\xa2\xe1\x58\x31\xdf\x64\xc1\xbb\x1e\xb4\x3e\x92\x9b\x8e\x8a\xe1\xa0\x17\x78\x0b\x35\x1e\xd8\xed\x13\xe8\x00\xb7\xdf\xf2\xc4\x74\x23\x5c\x01\x46\xf3\xc9\x98\xf6\xf9\x59\x67\x77\xf4\x6b\x7a\xdd\x48\xe2\xbe\x5f\x86\x2e\x45\x85\xe6\xe7\xb0\xfb\x7b\xbd\x6d\x4b\xaa\xea\xcd\x3d\x57\x55\xd3\x1f\x00\xa0\x83\xf8\xd9\x4d\xc7\xe9\x60\xd0\x04\xbf\x6d\x38\x01\x90\x41\x68\xa0\x03\xed\x80\xdb\x69\xeb\x27\xd0\x61\x43\x4c\xff\xb0\xc3\x06\x1c\x5c\x5a\x7d\x9e\x5f\xbb\xf4\xae\x02\xea\xb4\x14\x90\x2e\x77\xf7\xb2\xcb\x5c\x38\x7b\xe9\xb6\x0d\x5d\x3d\xb4\x07\x60\x0c\xaf\x6f\x7a\xef\x68\x98\xef\x40\xeb\x2e\x16\xfa\x24\xbe\xbf\x89\xb1\x3e\x61\x3a\xd4\xf6\x3f\xa8\xcb\x2a\xde\xc6\x38\x1a\x81\x89\x03\x58\xc6\x80\x76\x07\x8e\x86\xf4\xd7\x25\xce\x51\x81\x2a\x0c\x12\x52\x5f\x6b\x62\x01\xff\x04\xdd\x90\xba\x62\x1f\xfb\x60\x15\x6b\xfd\xe9\x10\xcd\xe1\x73\xbc\xea\x2d\xd4\xf1\x7d\x77\x03\xca\x27\x8c\xb6\x19\xd3\x87\xaf\x7f\x32\xb7\x9f\xca\xef\x45\xa8\x42\x5c\x16\xf8\x46\x51\xf9\x3e\x33\x5d\xe0\xe5\xfb\x61\xf8\x3c\xab\xb5\x1b\x59\xb1\x48\xa7\xb6\xe3\xa8\xea\xcc\xce\x4e\xa3\xc2\x05\x0e\x2b\xee\x5e\x04\x0f\xe1\x44\x7c\xaa\xe4\x00\xdf\xda\x0a\x58\x7f\xb7\x5d\x99\xd1\xbe\x24\xcb\xce\x84\x14\x40\x74\xa1\x4d\x3f\xa8\x44\xff\x9d\x4f\xba\x75\x11\xe7\x3b\x13\x82\xe0\xac\x03\x75\xd4\x97\x73\x5a\xf5\x20\x5a\xb9\xed\x6a\xbc\xdf\xee\x00\x8d\x9e\x5c\xeb\xae\x16\xce\xce\x4f\x03\x05\xff\x06\x30\x45\xac\x5c\x57\x00\xc8\x6e\xa8\xef\x92\x78\x67\xe4\x5f\x79\x7d\x0e\xb8\x04\x0f\x3f\x5f\x35\xf0\xb1\x38\xa0\xaf\x8e\x64\xec\x60\x3a\xc3\x01\x79\x0b\x6d\xfa\x4a\x86\x72\xe7\x8e\x7b\x4f\xba\x6d\xb5\xa3\xc9\x6e\x40\x1e\xcb\x01\x09\x2b\xbb\x3b\xea\x3c\x97\xd0\x93\xce\x97\x52\x72\xa4\x36\xe9\xc4\xd3\xde\x60\xf4\x79\xd6\xa1\x8e\x65\xaf\x3e\xc0\x6a\x2f\x31\x27\xd8\xb0\xcd\xdc\xae\xf5\x61\x28\x6a\xb9\x07\xd9\xfa\x66\x35\xb1\x95\xa7\x3a\x4d\x0f\xd8\xf6\x77\x7b\x2a\x7f\xda\x51\xa6\x4e\xbe\x5a\x2f\xab\xba\x27\x4b\x75\xef\x6c\x9a\x2e\xa8\x7b\x22\x1c\x4e\x57\x7f\x75\x28\x3a\xd6\xc3\xe3\x81\x33\x38\x00\xb7\xdb\x39\x1d\x1a\xc3\xe9\xe2\x9f\xf9\xa0\xb0\xa9\xc7\xdb\xa5\x1c\x80\xfb\x40\x06\xbe\x35\x1c\x67\xac\x1e\xce\xde\xda\x20\xdb\x81\x67\x08\x57\x86\x9f\x40\x20\x0f\xa7\x83\x30\x2b\xa8\xfe\xb6\x16\xfb\xc1\xc8\xfb\x03\x74\xfd\x8e\xa2\xd2\xf6\xf6\xc2\xe6\x4e\x86\x2e\xad\x74\x58\x86\xe7\x65\x1c\x7c\xa0\x75\x9e\x24\xf1\x88\xff\xf7\x09\xea\x7b\x30\xe9\xa8\x60\x6a\xea\x7e\xc1\x7b\xa0\xed\xa3\xcb\xbf\x4f\xd9\xbd\x6d\x6c\xb2\x20\xa5\xec\x00\x6b\x1b\xc9\x16\x54\x6f\xb1\x6b\x17\x1a\xde\x25\xef\xdc\x43\x86\xb6\x7a\x33\x74\xe9\xf1\xcd\xdc\x91\xfa\x87\xd2\x11\x59\xe4\x7c\x45\x1f\x9b\x87\xd6\xc5\x86\x3c\xad\x9d\xa1\x4b\xff\xed\x9d\xb6\x10\x73\xf1\x24\x4e\x77\x87\x36\xa6\x25\xc5\xc0\xaf\xd0\xa6\x3c\x38\x9f\x56\xa6\x8f\xe2\x0b\xb4\x27\x49\x7c\x50\xcf\xde\x68\x42\x27\x85\x51\xa0\x3e\x41\xfa\x82\x70\xed\x34\x77\x66\x13\xb2\xdc\x31\x8f\xbf\xb5\x6c\x34\x05\x1d\x2b\x6d\x18\xed\xb1\xff\x31\x87\xa1\x9c\xc2\x80\x4a\x95\xd9\xb4\x61\x9a\x64\x35\x0e\xe7\xd0\x73\x42\x51\x64\x6c\x32\xbb\x65\x8e\xe7\x87\x60\x7d\xce\xe3\x24\x31\x78\xaf\x03\x9e\xa0\x03\xe0\xb6\xaa\x18\x8f\x92\xf8\x60\x1c\x2f\xd3\x11\x0c\x86\x58\xc5\x2a\x47\x6c\xe0\x90\x83\xef\xad\x88\x2b\x9f\xe4\x5c\x07\xf9\x65\x85\xc2\xa7\xca\xdf\x0d\xd3\x2d\xd4\x83\x1a\x83\xd5\x6b\x29\xe3\xa3\x99\x2b\xed\x70\xa2\x46\xcc\xd5\x88\x16\x42\x30\x9b\x57\x85\x05\x00\x1e\xec\xef\x0e\xeb\x99\x71\x93\x68\xff\x01\x81\x36\x32\xd1\x79\x18\x00\x60\x29\xcc\xc2\xce\x20\xcb\xa9\x23\x36\xbf\xb9\xdb\x25\x36\x80\xd5\xe6\x93\xf3\x40\xdd\xe1\x5d\x68\x8f\x99\x80\xcb\xc0\x20\x0b\xac\x7e\x08\x6a\x6a\x01\x10\x87\x6f\xfe\x3b\xfa\x08\xb4\xe4\xc2\x30\x5b\x73\xe2\xa9\x9a\xce\x54\xd5\xdd\x42\x72\x32\x77\xbb\xcc\xc2\x10\xd5\xfc\xff\x49\x9e\xeb\xca\x9b\x5e\xf1\x38\x8e\x2b\xb4\xf1\xf9\xae\xcd\x13\xfa\x47\x8e\x32\xf3\xf8\x35\x3f\x85\xb3\x29\x50\x16\x35\xe6\x78\xa4\xd7\xe2\xc9\xd9\x6c\xbf\x8b\x76\xcd\xd4\x10\xe6\xee\xc9\x5d\x22\xc6\xac\x43\x50\x6a\xd0\x36\xff\xe5\xc2\x7e\xbd\x92\x1e\x21\xeb\xb2\xda\x82\xb2\xb2\x1d\xd5\xe6\xb8\xec\xda\xdc\x52\xab\x0d\x4b\x3a\xc9\x87\x60\xaf\x7b\xc9\x6e\x76\x22\x4e\xef\x8a\x28\x1c\xda\x23\x8d\xc8\x29\x1d\x13\xe7\xf1\x14\xcb\x2d\x69\xca\x43\xfa\xc2\x99\xf3\xaf\xd7\xf4\xf0\x7a\x82\xcd\x1d\xe8\x85\xb2\x29\xcd\xb6\xa9\x17\xda\x94\x3b\x9d\x27\x30\x6b\x75\xc0\x76\x97\xa7\x8b\xc5\x48\xfc\xff\x78\xd2\x26\xca\x93\xdd\x29\xc3\x82\x24\x49\xe3\xeb\xd3\x7c\x91\x56\xc7\x0d\x9e\xca\xe2\xc1\x5c\xd5\x09\x69\x7b\x95\x06\x06\xbd\xdf\x20\xfa\x75\x33\xc0\x20\x6a\x0c\x56\x72\x34\x1b\x47\x01\x8d\x1d\x71\xb3\x55\xeb\x1e\x05\x5d\x94\x3f\x15\xa7\x39\x29\x2a\x94\x55\x17\xca\xeb\x42\x4a\xa9\xf1\x22\xa4\xe2\xae\xf3\xf9\x6d\x71\xfb\x46\xca\x2b\x1c\xe1\xf3\x85\x46\x5d\xe3\x14\x62\x45\x72\x37\x0a\xcb\x3d\x0d\xe2\xf4\x3d\x8e\xf8\xd6\x3a\x43\xbf\x47\xe4\x1b\x41\x81\x7a\xe2\x11\x5d\x8b\xe7\xfb\xf9\x4b\x37\xe7\x41\x7e\xfd\x90\xbd\xf1\x4f\x8a\x18\x67\x15\xfb\x8a\x4b\x50\x16\x95\x21\xca\x71\x3b\xed\x6f\xb3\x57\xd3\x20\xa0\xba\xa4\x91\x65\x14\x67\xb8\xf0\xb7\x49\x1d\x47\x4f\x6c\xb2\x2e\x0c\xa6\x00\x14\x78\x57\x5d\xa3\x96\x19\x50\x58\x18\x6e\xad\xdf\x93\xe5\xf6\xdf\x43\xaf\x03\xc3\x11\x3f\xda\x02\x78\xd0\x6e\x7a\x18\x67\x40\xa8\xca\xea\x5f\xeb\x0a\x35\x73\x53\x03\x14\x57\x5b\x0e\xcc\x03\xcc\xda\x31\x5a\xf5\x36\xca\x2c\xe8\xef\x4e\x4f\x53\x3d\x5d\x6c\x50\xb4\xc3\xca\x3a\x33\xa0\x46\x0e\xed\x5b\xb3\x46\x28\x48\x23\x31\x51\x3c\x34\xe9\x8b\xa8\xdf\xf4\x32\xba\xb3\xe8\xbe\x5f\x38\x55\x5e\x4a\x5a\x04\x4a\xa7\x28\x45\xc5\xaf\x6a\xcb\xdc\xc1\xa0\x5e\x7e\x3e\x69\x85\xcd\xd3\x88\x8e\x6c\x04\x26\xc9\x3a\x9a\x9d\xa3\xbb\x65\xb9\x38\x05\x66\x39\x41\x8c\x2b\x5a\xae\x6a\xdb\x1c\xd3\x78\xcc\xc4\x78\xe3\xfa\xdc\x72\x99\xe8\x36\xc8\xe9\xf7\x15\x4e\x0b\x8d\x18\x43\xb2\x6f\x60\x69\x60\x6f\x1c\x87\x24\xf3\x1b\xef\x09\x3a\x03\x35\x9d\xb6\x13\x6c\x6f\x37\x4d\xac\xd6\x5a\x72\x8f\x5a\xc2\xfa\xbb\xdf\xfd\xcb\x86\xcf\x85\xe6\x36\xcb\x76\xda\x38\xe7\xfa\x6c\xbc\xc8\xaf\x3d\x5f\x46\x1f\x39\x58\x0b\x50\xb6\x8f\xbb\x1b\xe6\x78\x62\x3e\xec\x35\x35\x2d\x8f\xe8\xdb\x99\xda\x37\x19\xd9\x33\x3d\x71\x29\x39\x4c\x07\x29\x27\xc5\xd4\xc7\x21\xb4\x94\xf5\xdd\xa2\xc0\x26\xdd\xed\x9e\xb8\x7a\xe2\x99\xd7\x40\x46\x7d\x35\xf4\x88\xee\x82\x07\x74\xe9\xb9\xbb\xa9\xc1\xdb\x8e\xfa\x16\x2f\x07\x55\x33\x74\x44\x37\xae\x15\x4a\x55\x12\x1b\xf4\x8b\x55\x23\x39\x56\xdc\x52\x59\x10\x4c\x74\xcc\xf0\x9a\x5b\xa8\x16\x80\x50\x69\x3a\x65\x4b\x8a\xd4\x0e\x94\x0f\x71\xd8\xe1\xef\xed\x9e\xcb\xf9\xfd\xce\xfd\xa8\xd3\xf3\x7f\x8b\xa4\x38\x6f\xcf\xe5\xf2\xec\x37\x95\xa4\x48\xdf\xda\xe3\x2d\x36\xcd\x37\x7c\xbc\xc5\x49\xb0\xfb\xf1\x16\xad\xda\x5d\x1f\x6f\x71\x11\x31\xcf\xa8\xb8\xf1\x80\x4d\xfe\x61\xc8\xe6\xe3\x2d\xae\x5a\x1d\x8f\xb7\x68\x55\xee\xf4\x78\x8b\x4e\x41\xbe\xd9\xa1\x15\xbf\xe5\xc7\x5b\xc0\x26\xc5\xe3\x2d\x76\xc3\x8e\xc7\x5b\x60\x2a\xf0\x09\x07\x80\xe8\x1d\x1e\x6f\xd1\xa8\x9c\xf0\x78\x4b\xaf\xd5\xb3\x56\x27\x1c\xd3\x86\x50\xd5\x5d\x66\x2b\x52\x3c\x48\x2d\xd8\x1f\xee\xe6\xe7\xc4\x85\xf1\xad\xa1\xaa\xe9\xc0\xfe\x0e\x1f\x7a\x34\xde\xd4\xff\xee\xd8\x5b\xc7\x61\x88\xde\xc0\x1b\xfc\xa5\xda\xd9\xa4\xe9\xd5\xff\xe4\x4e\x15\x0b\x77\xba\xdd\xa0\x77\x7a\xba\x66\x8a\x91\xb6\x2a\xdb\xf2\xd6\xff\xf6\xcb\x54\xa5\x38\xb1\xe3\x8d\x93\xc0\x4e\x1f\xd2\x43\xf3\xba\xd4\x68\xce\x6d\x9a\x9a\xbf\x8c\xaf\x2b\x0d\x1f\x78\x96\x6c\x90\x2d\xa3\x84\xec\x1d\xd2\x36\x64\x67\x7d\x47\xc8\x3e\xd0\x6b\x98\x6d\x55\x20\x42\xc4\xae\x08\x2b\x5f\x25\x4a\xc4\x09\x7a\x71\x48\xad\xf6\xbf\x74\x22\xfa\xaa\x69\xc5\x5d\x3c\x61\x01\xec\x68\x9e\x37\xff\xcc\x5c\x6b\x67\xcd\x3f\xb3\x36\xfc\x85\x66\x41\x85\x6b\xe5\x44\xa0\xcc\x34\x76\xff\x9d\xcd\x18\xde\x24\x8c\xa3\xef\xdc\xd2\x1d\xf2\x9e\x3c\x41\x3d\x7d\xef\x69\xd5\x38\x07\xd1\xf5\xb0\xc3\xd0\x56\xf5\xcd\x8d\x5e\xac\x01\x1d\x3c\x69\x63\xbb\x67\xc6\x29\x3d\x35\x8d\xc0\x30\xbc\x21\xbd\x74\x1d\xed\xa0\x67\x36\xef\xc2\x4d\xfd\x48\x95\x48\xe4\xc5\xcf\x09\xb8\x2b\xf4\xf4\x55\xcb\x04\x07\xa4\x17\xeb\xa5\xaf\x7c\x96\x03\xcf\x1c\x9c\x9f\x3b\xab\x5b\x11\x4e\x13\x81\x5a\xd4\x93\x16\x30\x65\xbc\x72\xd8\xa5\x07\x67\xc8\x34\xf6\x1d\x8d\xa1\x1d\x52\x4e\xf3\xf6\x7b\x28\x40\x33\x1d\xdf\xaa\x03\xf4\xc9\x29\x5f\xad\xa7\xd5\x7d\x6b\x6a\x00\x6e\x63\x90\x6e\xe8\xa9\x7a\xd7\xf1\xbd\x65\x2d\xe2\x68\x64\x98\x6a\xe9\xad\x7c\xe7\x41\xde\x59\x09\xd9\x63\xdd\xc8\xd4\x79\x8e\x5e\xa8\x19\x03\xbb\xc4\xb5\x4d\x2e\x37\x42\x63\x7a\xd0\x8f\x79\x49\x71\x85\x53\xcf\x2c\xa0\x81\xa1\x38\xdb\x8d\xd8\x15\x92\xc1\xf8\x8a\xd0\xba\x87\xe2\x62\x2b\x9c\x02\xaf\x93\x94\x9d\x97\x6f\x00\xa6\x3b\x4f\x5f\x4f\x8f\x4f\xaa\x62\x4e\xbc\x1c\x44\x9c\x5d\xe2\xa2\xc4\x80\x56\x9b\x4e\xcd\x8c\xc2\xc1\xaa\xf9\x67\x56\x75\x78\x4e\x26\xd4\xf2\x9c\x4c\x04\xd5\x73\x5a\x45\xcd\xbf\xee\x96\x0c\x1e\xc3\x38\xed\xa1\x8b\xae\x3e\xf5\x90\x82\x3d\x22\xf0\xc4\x18\xa4\x0a\x21\x72\xb6\xd6\xeb\xc4\x1a\xd0\xc1\x93\xce\xb5\xf5\xcc\xa4\xcb\x23\xea\xc1\x1b\xd2\x4b\x97\x32\x9a\xcf\xe7\x77\xe3\x26\xe8\x11\xa9\xcb\x14\xae\xd0\xd3\xd7\x1e\x8f\xa8\x9f\x7e\xa7\x47\x44\x13\xac\x3a\xaa\x5b\x1e\x91\x89\x00\x78\x44\x93\xa0\xf9\xd7\x3d\x9d\x86\x47\xd4\x81\x33\x64\x1a\x7b\x3c\x22\x26\x5f\x17\xca\x70\x7b\x3d\x22\xa0\x19\x97\x3d\x33\xd2\x46\x9d\xa4\xa4\x3a\xb6\x21\xf8\xe9\xe5\xbb\xac\x96\x7e\xdf\xad\x47\xa3\x9d\xe2\xbe\x9d\x56\xf7\xad\xe9\xac\xa1\xee\xdb\xe9\x55\xef\x3a\xbe\xb7\xac\xf2\x86\xbb\x6f\x77\xa9\x7c\xe7\x41\xde\x59\x63\xda\x63\xb5\xdc\x37\xb3\x17\xaa\xfb\xd6\x23\xb1\xb6\x5b\x01\x6a\x35\x87\x9b\x65\xc3\x87\x93\x72\xba\x59\x5d\x98\xbd\x6e\x96\xab\xc7\x27\x55\x31\x27\xe8\x38\xde\x14\x18\x45\x61\x51\xa7\x1b\xb9\xb5\x76\x2e\x76\xd6\x80\x03\x8a\x66\xb2\x4f\x57\x82\x46\x68\x1f\xbd\x6d\x8a\x1e\xa7\xce\xd1\x2e\xce\xe8\x71\x05\xc7\x76\x8f\x86\xff\x28\x89\xad\x23\x70\x8f\xff\x9f\x6f\xdd\x93\x47\x06\x68\x62\x93\x0b\xd5\x83\x54\xea\x8b\x63\xa0\xaa\xe7\xaf\xb4\x6f\x1c\xcd\x12\x09\x4e\x03\x7a\x2b\x04\x18\x49\x8e\x76\xb8\xf0\xf4\x41\xa8\xe7\xc0\xd9\x38\x8e\x3a\xb4\x59\x9f\x7a\x41\x99\x23\xe0\x16\xd7\x05\x70\x70\x44\x5e\xdf\x85\xae\x7a\x74\xe5\xd1\xec\x7a\x8f\xce\x71\x99\xc2\xbe\x0b\xa5\xf5\x5a\xbb\xa6\x65\x0e\x49\x03\xd2\xe1\xe9\x1b\x0b\x9d\xe9\x2b\x3a\x42\xec\x66\x1f\x94\xfb\x5c\x56\x17\x14\x18\xed\xc1\xb0\x8c\x1a\x50\xcc\xdf\x9a\x40\xb1\x9e\xcd\x62\xee\xc5\xdb\xb3\x0b\x57\xa0\x10\xe3\xc2\xdc\x85\x9e\x82\x7d\xc8\xfd\x26\x1a\xf6\x52\x28\x2b\xa6\x0e\x2a\x05\xfa\x62\x1d\xfe\x06\x60\x4d\x67\x9d\x80\x0e\x9a\xc0\x20\x67\x17\x03\x2e\xb3\x80\x97\xb8\xac\x1b\x3c\x6a\x83\xaa\x01\x84\xcb\xa1\x6e\x9a\x66\xcb\x01\xb5\x87\xaf\x81\x3a\x29\x2b\x2c\x50\xae\xcc\x0d\x79\xd2\xb6\x59\x87\xd0\x45\xb9\xb6\x19\x3f\xd9\x59\x3a\x85\x97\x31\xb5\x72\x87\xbc\xf6\x36\x7d\xf7\x62\x07\xe0\xc0\x6a\x53\x97\xf1\xd2\xbd\xc2\x97\xfa\x5a\xe3\xb4\x5d\x8b\xdc\x06\xf7\xad\xf3\x65\xc7\x3a\x37\xdb\x2e\x53\x9b\xaf\xac\x4c\xe3\xeb\xc0\x94\xfd\x36\xe9\x0e\x96\xda\xf0\x1e\x96\xce\xdc\x2c\x9d\x41\xc3\x72\xb3\xd4\x02\xf7\xb1\x74\xd6\xc1\xd2\x99\xb4\x91\xdd\x96\xd5\x74\x26\xac\xbb\x5e\x9c\x8a\x38\x12\xcf\xfe\xe8\x3f\x08\xaf\x54\xd2\x8e\xbf\x0b\xb3\xcd\xc7\xce\x7e\xd3\x81\x82\x47\x1d\xb4\xa9\x9e\x43\x67\x0d\xfb\xaf\x24\x8a\x93\x7d\x0b\xd5\x6d\xd0\x14\x92\x28\x39\xfd\x56\x2f\xab\x3c\xce\xf0\x75\xd5\x8e\x88\xfd\x49\x07\xa5\x6c\x6a\x4a\xe4\xbc\xc0\x97\x31\xa9\x4b\xa5\x82\x2c\x52\x2a\xb1\xe3\x5a\x1c\xc1\xd0\xaf\x7a\x91\x3e\x12\x58\xab\x6a\x00\xda\x4a\xaf\x3a\x84\x94\x1f\x3b\x16\xa2\x4f\x95\x9c\xa4\xf1\x14\xa7\xde\x78\xd9\xfc\xcf\x0c\xa7\xca\xaa\x3c\x5b\xdc\xd7\xb2\x9c\x9c\xb9\xb2\x9c\xc8\xa4\xdf\xda\x6d\x43\xfb\x7a\xbe\x79\xca\x65\x83\x4a\xcc\x1e\x47\xd1\xa6\x7c\x3c\x5d\xe0\x94\x77\x7a\x8d\xd3\xbc\xba\x31\x2e\x2e\xd1\xc4\x7d\xfc\xa8\x8b\xe5\x03\x8a\x1b\x48\x47\xc4\xde\xc4\xe1\x6c\x16\x7f\xc9\xc8\x34\xa5\x2e\x60\xec\xaf\x61\x99\xcc\x39\x83\x79\x92\x22\xde\xcf\x8e\xad\x6a\xea\x32\x6b\x48\xef\xed\x0b\xbc\x95\x9f\x38\x10\xc8\xf9\x9e\x21\xdd\x24\x16\xe4\xc4\x03\xec\xee\xdb\xad\x1a\x1e\xd4\xac\x0e\x72\x35\xcb\x9e\x33\x17\xe4\xc4\x3b\xcc\xae\xa7\x58\x0d\x3c\xa8\x59\x1d\xe4\x6a\x96\xbd\x6a\x2c\xc8\xd1\xe7\x58\x5d\x4f\x3c\xaa\x48\x50\x83\x4a\xb9\x33\xf2\x47\x1f\x3a\x15\x84\xc4\x1b\x8f\xae\x67\xde\x0c\x3c\xa8\x4d\x1d\xe4\xdc\x62\xa4\x2f\x26\x4a\x09\x61\x4f\xbd\xb9\x1e\x82\xd2\xd1\x40\x39\x52\x21\xae\x36\xd9\xe3\x69\x47\xfe\x68\x14\x7c\x5a\xad\x7d\xc8\x42\x7d\xc7\x62\x96\x5f\x7b\x67\xb6\xed\x36\xd5\x84\xb2\x86\x74\x8d\x01\xbf\x21\x02\xa8\x8a\x21\x59\x93\xa8\x12\xd4\xad\x05\x3b\x9a\x43\x57\xba\x53\x73\xb0\x51\x77\x68\x0e\xe3\x6c\x4f\x5b\x49\xbc\x10\x24\x68\x68\xc7\xba\xda\xe7\x82\x8c\x4d\x2b\xe1\xd7\x0b\x1a\xed\x9d\x75\xf9\x15\xf1\xe4\x94\xb7\xd0\x0d\xf2\xa2\xae\x66\xb4\x60\x9c\x47\x1c\x55\x3b\x6f\xb3\x30\xef\xde\xb7\xdd\x51\x3f\x41\xa9\x6b\xf2\x41\x9d\x6e\x48\x55\x28\x1f\xfe\x0d\x0b\x66\xc0\xa9\xf6\x99\x7d\x6e\x8a\x16\x39\x2c\xb2\x24\x3c\x6a\x7f\x7a\xe3\xfd\x44\xfd\x73\x3f\xb1\x77\x14\xe5\xfb\x67\xae\x8d\x45\xbb\x06\x7b\xf2\x75\x38\xbe\x7c\x3e\x7a\x78\x15\xf9\xd0\xe3\x69\xdb\x9d\xbd\x83\x71\x54\xeb\x1e\x91\xa3\x52\xef\xb0\x1c\xf5\xfa\xc6\xc6\x65\x38\xce\xf6\xb8\x88\x2b\x65\x66\x3d\xf3\xb0\xa6\x71\xa7\x67\x3a\x31\x14\xc9\x34\x08\x94\xea\x4f\xf6\x85\x9a\x86\x52\xa8\xc5\x45\xf3\xef\xa8\xde\xc7\x51\x44\xc9\xba\x84\xd3\xca\xaf\x95\x04\x0c\xb8\xd0\x6a\xdf\x7a\x55\x87\xd3\x52\x3f\xe8\xb9\x79\xc5\x8e\x48\x19\x16\x18\x67\xec\x8e\xa0\x7d\x44\x0f\x5e\x4a\xf3\x73\x7b\x29\xcd\xe9\x19\xc6\x93\x47\xa8\x8d\x47\x7d\x8c\x88\x8f\x67\x19\x18\xe3\x31\x57\x9b\x92\x6c\x69\x39\xa3\xd7\x09\xaa\x7d\x9d\x6e\x32\x14\x27\x8e\x07\x3d\xec\x73\x8d\x53\xf3\x72\x8b\xcc\xb2\x72\xe7\x2f\x02\xf5\xad\x22\xe5\x2d\x32\x86\xe4\x8d\xa7\xa5\x87\x51\x89\xfd\x38\xf3\x49\x5d\x19\xef\x99\x39\x90\x7a\x31\x94\xa1\x7b\x88\xdf\x95\x17\x05\x34\x93\x8d\xce\x0f\x23\x57\xb3\x72\x2b\x57\x8b\x11\xaa\x05\xea\xeb\x29\x6d\x5b\xe3\x10\xe5\x6a\x9c\x75\xbd\x6a\x23\xb5\xb3\xd9\xec\x88\x5a\x5c\xf1\xc0\x83\x52\x24\xdd\xdb\xb6\x04\x4a\x4b\x23\x9c\x45\xfa\x82\xdd\x41\xbb\x71\x77\xe2\x45\x7b\x28\xe2\x4b\xa9\x7a\xfb\xb9\x7e\x4a\xd9\x50\x12\x0c\x89\xfd\x87\x6d\x55\x18\x1e\x85\x7c\x5f\x6f\xc4\x7f\xd4\xd6\xb1\x7d\x8e\xe2\x47\x71\x99\xc6\x25\xfd\x66\x1a\xe9\x45\xf1\x46\x49\xaf\xce\x18\x3e\x5b\xc8\x3e\xaa\x15\xbd\x71\x98\x90\x12\xaa\xcf\x21\x2e\xf7\xa1\x71\x86\xf8\xa9\x57\xaa\xcf\xa0\x71\x76\x38\xd0\xd1\x76\x1b\x44\x66\x20\x2f\x5a\xe2\x55\xb8\x94\xd3\x1e\x9e\x2d\x67\x91\x41\xca\x03\x95\x63\xb8\xc2\xd3\xcd\xcc\x44\x55\xb9\x2c\x0e\x84\x6c\x16\xf3\xc6\x1f\x64\x10\x87\xa3\x1d\xad\x70\xb4\x3d\x33\xfa\xb6\x09\xf1\xf9\x56\x7e\x08\xce\x26\x67\xc1\xf9\x56\xa5\x03\x77\x0c\x2d\xf1\x04\x6b\xed\x81\xbd\x9a\x2f\xa6\xcb\x95\xc0\xea\x70\xc8\xc3\xed\x39\x9e\x19\x1d\xdb\x22\xbc\x09\x43\xd1\xb1\x73\xb4\x8c\x66\x1b\x83\x14\xdc\xb7\xed\x19\x9e\x6c\x16\x26\x2a\xd0\xbd\xe5\x72\x31\x69\x99\xe6\x74\xdc\xb7\xd3\x08\x5b\x4f\xd0\x37\x7d\x8b\x24\xdb\xd0\x6a\x3e\x9f\x4f\x75\x4a\x70\xe7\xf0\x7c\xb3\x0a\x03\x03\x13\xe8\xdb\xf9\x7c\xb6\x98\xcd\x8f\xe3\xbc\x20\xbb\xa2\x11\x35\x33\x41\x97\x7a\x6d\x16\x5a\xe4\x83\x37\xa7\xfa\xee\x7f\x59\x8f\xc2\x39\xaf\x77\xd9\x98\x6d\xff\xe9\x69\x0b\x2b\xd1\x6e\xab\x5a\x59\x4a\x7c\x77\x3c\x91\x3d\xdb\xd6\x15\xad\x70\x06\xd5\x3b\xc6\xe7\x2b\x17\xcf\xb4\xa7\xf5\x86\x62\x02\x66\x8c\x8e\xcc\x1b\x2f\x99\xf9\x31\xcc\x97\x01\x74\x42\x74\xc6\xf9\x65\x55\xc4\x39\x8e\x46\x6d\x29\x2f\xf1\x74\x06\x5b\xf7\x19\x45\xff\xd8\xd3\x9e\xbe\x7c\x85\x6c\xbe\x88\xf0\x6e\x04\x5c\xb8\x5b\x3c\xf4\xa6\x8b\xfb\x23\xc5\x24\x58\x7f\x2f\x82\xfb\x8e\x9a\x6e\xc8\x99\x41\xc3\xf8\xfb\xa1\x7d\x5d\xd9\x27\xff\x1b\x76\xfa\x7f\xa7\x1e\xd3\x75\x36\x0f\xf2\x6b\x8f\x26\xca\xd0\x04\x49\xbe\x35\x25\x0a\x79\x81\x21\x6e\x42\xba\x50\x16\xa7\x2c\xbe\x06\x48\x6d\xe9\x4d\xc5\xd3\xb2\x5e\x9c\x6d\xe3\x2c\xae\xe8\xaa\x38\xbd\xd2\xc9\x35\xcc\x55\xd4\x1b\xf6\xea\x5e\x5e\x5c\x5d\xf7\xad\x42\x6a\x0e\x7b\x91\x78\x67\x7a\xf1\x00\xab\xf9\x1f\x4b\xfb\x3f\x96\xb6\xd5\xe3\xa3\x2d\x83\x1d\xb1\xd6\x3e\x11\x33\x22\xa5\xc0\x2a\xe8\x88\x70\xd2\x8f\x67\xf3\xfa\x1b\x2f\x86\x9e\x32\xe3\x89\xb6\x29\x7c\xc4\xfe\xe3\x6f\x48\x74\x73\xf8\x88\x90\x74\x3d\x31\x73\x93\x1e\x55\x14\xf9\x91\x46\xf3\xea\x70\x08\xd9\x7c\x80\xc3\xca\x4c\x7c\xa6\xc2\xc6\x71\xba\xf3\xdb\x8f\x61\x33\x8d\x29\x43\xa5\x9f\x01\xbc\x43\x4f\xd4\xc7\x6d\xf5\x40\x83\xd2\x70\x53\xa0\x57\xa0\xb7\xf0\x8c\x30\x84\x52\xa1\x19\xc3\xc8\xae\xcc\xdb\x01\x72\x2b\x1a\xe1\xd7\x8a\xe4\x82\x14\x8b\xc4\x1e\x1c\xf7\x90\x45\x73\x34\xb5\x8b\xb9\x8b\x43\x4b\x05\x8e\x88\x02\x75\xdc\x76\x5e\x28\x23\x8e\xcb\xca\xdc\xe9\xb4\x92\x03\xb7\x81\xa6\x03\xe0\xaa\x9a\x97\x9d\x8d\xb0\x54\x5f\x26\xe1\x0b\x3b\x2d\x82\x9d\x47\xf6\x94\x68\x85\xd5\x83\x41\x0f\xa9\xf4\x1d\xe2\x31\x69\xba\xef\x32\xbf\xd9\x3b\x38\x56\x2c\xd3\x11\x06\x54\x2e\xd4\xd8\x75\xda\x6d\x35\xa3\x9c\x6d\xbe\xc1\x14\x79\x25\x07\xb0\xff\xdd\x63\x47\xe6\x5b\xf6\xe8\xb1\x63\x10\xea\x2b\x32\xfa\x63\xdb\x76\x10\x5f\x6c\xc3\x8e\x9c\x10\xb9\x11\xe3\x82\x77\x25\x60\x56\xb6\x34\xc0\x1d\x5c\x07\x4d\x77\xf8\xb6\xa7\x9b\x77\xa9\x48\xfb\x3f\x38\xf0\x3b\xbc\xcb\x0d\xef\x4f\xef\x6f\x4f\x2d\x47\x67\xad\x5b\xbc\xf0\x66\x8d\x4d\x98\x95\xbb\xe6\x98\x43\x1d\x47\xd3\x4e\x3e\xb5\xe5\xea\xd6\x09\xb3\xd6\x53\xe1\xc9\xb8\x4c\x51\x92\x9c\x5e\xaf\xb3\xda\xc9\xb2\x35\xa8\x5a\x4f\x5f\xfb\x6a\x77\x57\xee\x16\xea\x3b\x56\xeb\xeb\x71\x4f\x6d\x5a\xb9\x6f\x3d\xb9\x26\x0a\x5e\x17\xdd\xac\xea\xac\x33\x60\x25\x41\xfb\x92\xf2\x83\x49\x0b\x5c\x02\xeb\x80\x05\x3d\x6d\x33\x22\x3f\x72\xba\x77\xb0\x74\xfa\x6e\x32\x2e\xab\x24\xe1\x5d\xd6\xc9\x20\xd2\x83\xa4\x5f\x2c\x74\x8e\x3b\xc0\xab\x70\xe9\xee\x70\x1b\xd1\xef\x46\xe8\x1b\x98\x36\x8f\x3d\x5d\x17\x6d\x0e\xc2\x1a\xc4\x0c\x5d\x35\x76\x2a\x44\xc1\x29\xfd\xc2\x17\x8f\x77\x43\x9b\x9e\x07\x2d\xee\x0c\xb1\x98\xc6\xac\x01\x16\xd3\x8f\xec\x8e\xed\x54\x9d\xb2\x83\x80\x93\xf1\x14\xd8\xc9\x6e\xa5\x7a\x17\x86\x2e\x48\xae\x51\x86\x73\x3c\xdb\xce\x1c\x9d\x74\x4b\x91\x02\xed\x1c\xc9\x20\xf9\x51\x9b\xea\x47\xe9\x1f\xfa\x29\x62\xc3\xf9\xa2\x8b\x0d\xdf\x8a\x70\x6c\x5f\x1f\xb4\x8d\x01\xc8\x71\xa4\x9b\x0a\x00\x4f\x39\x81\x9e\xed\x71\x9d\xbe\x9b\x8c\x93\xf1\x02\xde\xc9\x74\x9d\x48\x0f\x92\xc6\x49\xf7\xb8\xd1\x76\x1a\x86\xee\x0e\xbb\xc5\x49\x47\xe8\x1b\xd8\x20\xa1\x32\xda\x1c\x84\x35\x88\x19\x27\x48\x97\xe0\x94\x26\x5d\x62\x3f\x09\x3e\xc0\x71\xd0\xf6\x75\x20\x26\xd3\x3d\x21\x80\xc9\x3c\x44\xd8\x79\x3c\x44\xa7\xee\x24\xe2\x9c\x00\x0e\xee\x64\xbc\x46\xa2\x1b\x47\x63\xa1\x73\xc4\x78\x13\x86\xa0\x58\x31\x2a\x6e\xa9\xd2\xe0\x3d\x63\x1a\x24\x53\x7a\x83\x43\x90\x86\xb0\xe1\x04\x81\x12\x3c\xd2\xdd\x7e\xbe\x05\xe8\xfa\xb6\xea\x0b\xa7\x80\xce\x99\x19\x19\x30\x2e\x2e\x1c\xc7\x39\xca\xac\xf4\x77\xae\xfd\x3f\x28\xde\xd1\xb9\xf5\x0f\xed\x9c\xb1\x9d\xbe\x89\xb1\xd3\x17\xe8\x9b\x66\x4e\x24\xde\x61\x16\xba\x53\xcf\x29\xa8\x80\xf6\xc8\xbb\x2c\x19\x72\xee\x5d\xaf\x6e\x1c\x7e\x6f\x80\x62\x26\x9c\x69\x34\x3b\xb3\x64\x76\x1c\xf8\xef\xb9\x87\xa0\xb4\x0d\xbe\xcf\x22\x5e\xe9\x63\x98\x55\x5c\x19\x7f\xc8\x4f\x11\xbb\x8c\x1d\x4b\x6f\x4b\x8d\x3f\x81\x7a\xbc\x9a\xf9\x69\xa2\xa0\x74\x49\x6a\xa0\x6e\xd1\x2e\xdb\xe1\x6d\x09\xa9\xda\x1b\x0f\x2a\x67\x7b\xb6\xa1\xf5\xcc\xa5\xc0\x83\x46\x2e\x5e\xc3\x37\x42\x78\x7f\x9e\x28\xab\x69\x24\x8a\x58\x4f\xc5\x15\xfe\x27\xee\xa0\x64\x00\x50\xb1\x3e\x9e\x06\x90\xb5\xea\x1c\xec\xcc\xd9\x17\xd6\x83\xa9\x56\xd3\x6a\xf4\xd1\x22\xa9\x3f\x32\xd9\xdb\xa5\xc1\xb4\x0e\xc6\xb3\x06\x6f\x20\xfb\x5a\xfb\x6d\xd4\xd3\x6e\x5e\x7d\x83\xb1\x7f\x24\xc3\x28\x99\x8f\x02\xbd\x1d\xe9\xd2\xd6\xf4\xa3\x53\x44\xe0\x8d\xdf\xe4\x36\x9a\xbe\x43\x53\xfc\xe4\x85\x6a\x71\x1e\xe9\xcb\x18\x42\x76\x4c\x48\xc5\x0e\x69\x71\x28\xf0\x97\x5f\xe0\x32\x27\x59\x49\x8f\x4f\xd3\x12\xe7\x6a\x03\x69\x7b\xfc\xfc\x9c\x4e\x15\x2e\xb5\xdb\xf2\x8c\xd3\x77\x3d\x47\x43\x1d\xc4\xf4\xd7\x19\x29\x14\x5c\x77\x27\xd6\x7b\x52\x51\x73\xa5\x95\x14\x6f\x87\x70\x23\x1f\x83\x09\x0f\x83\x9c\xd8\xdb\x93\xfa\x73\x70\x2b\x91\xbe\x8b\x76\x9f\x0a\xe3\xbd\x2a\xfa\x89\x4c\xb0\x57\xed\x7f\x22\xf3\xfd\xd6\xc6\xd3\xdb\x4e\xc7\x78\xde\xde\x0c\xbc\x3d\x1e\xbf\x3d\x2e\xbe\x09\x9f\x3a\xc4\xff\x53\x15\x71\xc0\xe0\x7e\x1a\x12\xfe\x36\x9a\x19\x30\x35\x3f\x91\x66\xdc\xa3\x79\x6b\xdc\x7f\x6b\xfc\x7d\x6b\x1c\x7c\x03\x1e\x39\x7d\x9c\x4e\xd9\x56\x9f\x2c\x66\x3d\x18\x32\xb9\x5d\xb5\x38\x23\xd5\x82\xe2\x2d\x10\x6d\x1c\xa6\x81\x44\x87\x00\x4e\xea\xe5\xf0\x8e\x0c\xbb\x45\xef\x70\x89\xef\x36\x4b\x3d\x23\x19\x6c\x90\xde\xa8\x8d\x81\xc6\xf5\x0d\xa6\xf8\x2d\x8d\xa3\xa7\x8d\x2e\x03\xf6\x76\x58\xfe\x96\xb8\xfa\x96\x18\x77\x67\xde\x74\x0b\xfa\xa7\x26\xc9\x9f\xb2\x72\x1a\x6a\x43\xdf\x50\x8e\x3f\xf5\x26\x3a\x2c\xd5\x5b\x61\xf7\xdb\xe1\xe8\xdb\x61\xda\x5d\xf9\xd2\x93\x76\x44\x7c\xff\xf3\x2f\xfe\xc1\x71\x88\xd1\x49\xf5\xda\x58\xaa\x11\x88\x69\x8a\x1e\x19\x5f\xf9\x16\x44\x91\x17\x9d\x27\x8f\x20\xc2\x66\x15\x15\xe9\xe0\x0c\x12\x1a\x4b\x99\xe1\x61\xb7\xe0\x9a\x88\xe2\xb1\xe3\xc0\x41\x88\xc9\xcf\x93\xaa\x78\xd2\xa9\xe0\x01\xf4\x2e\x9b\xd3\xa2\x37\x12\x71\x02\x75\x89\x3e\x8c\x7a\x33\xb1\x27\x50\x97\xe8\xc3\xec\xe5\x1d\x79\x35\x88\xc0\x5d\x7b\x30\x88\x9f\x83\x08\xdc\xb5\x07\x83\x78\x3e\x88\x00\x68\xd1\xc4\xd9\xd8\x7e\x69\x75\xaa\x71\x90\xdd\x03\xb0\x15\xde\x9e\x82\x3d\x88\xb6\xc2\xb5\x53\xb0\x07\xd9\xc3\xbb\x71\x69\xa0\x98\xde\xa9\xfe\x10\x4e\x0e\x14\xd2\x3b\xd5\x1f\xc2\xed\x81\x22\x6a\x5b\x2c\xf1\xf4\x4b\x8f\xd0\xe9\x5f\x8e\xbd\x32\x6a\x7c\x7a\xf6\x89\xc6\x50\xea\x20\xba\x49\x7d\x80\x28\x74\xb7\x77\x32\x81\x53\x7a\x30\x68\xc4\x27\x13\xd8\x9b\x1b\x26\xbd\xf3\xa9\xfa\x35\xfd\xd3\xa9\x62\xbb\x67\x93\x0b\xfa\x40\xda\x10\xf6\x5d\xe6\xb2\xab\xb5\x53\xeb\x9f\xd2\xfe\x90\xd1\x9e\x5a\xbf\x6f\x1e\x5b\x7a\xd2\x15\x32\xb7\x7a\x35\x5f\x13\x38\x62\x00\xf9\xa2\xae\xab\x0c\x7a\x5e\x51\xb3\xd6\x23\xbd\x72\xe3\xee\x2d\xba\x7d\x5d\xc7\xe8\x74\x5c\x6b\x37\xab\x13\xcf\x74\x83\x3d\xc0\x11\xb5\x0e\x08\x28\xbe\xa8\xc8\xff\x35\x1a\x5f\xe1\x24\xf1\xe8\xd5\x94\x0f\x6b\x52\x81\x0f\x02\x69\x35\x8c\xfd\xc4\xce\xe7\x5c\x8c\xed\xf3\xa1\x34\xbb\x7c\x7c\x28\x27\x4b\x1f\x3d\x4f\xcf\x76\x24\x3a\x05\xe7\xe2\x07\x09\xc1\x4c\x87\xfa\xc5\x3f\x85\xec\xae\xc9\xe4\x69\xe0\xf1\x7b\x0d\xc7\xc1\xe2\x3b\x9c\xea\xef\x22\x7b\x22\x97\x87\x90\xf4\x4e\x49\x2b\x05\x12\xba\x2b\xa3\xf5\xde\xc9\xab\xb3\x40\x5e\x0b\x03\xc7\x25\xce\xdd\x87\xc7\xc1\x8c\x19\xdd\x94\x4f\x15\xea\x01\x24\x0d\x76\x8b\xae\x39\xce\x39\x3b\x68\xdd\x59\xb4\xb5\x0e\xb2\x0b\x9c\x40\xaa\x0e\x15\xc1\xc5\xeb\xee\xd3\xd4\x60\x06\x90\x0e\xb2\x27\x32\xba\x97\x9e\xc9\x65\xde\x29\xc7\xb1\x60\x88\xd0\x5d\x59\xac\x77\x4d\xde\x7b\x05\xf2\x8e\x18\x38\x0e\x46\xf7\x1c\x3e\x06\x33\x9a\x74\x53\x3e\x91\xd7\x43\x48\x9a\xca\x9a\x77\xcd\x71\x4e\xd6\x41\xeb\xae\x1c\xd7\x3b\x28\xae\x0e\x03\xa9\x54\x74\x14\x07\xbf\x7b\xce\xe3\x82\x39\x5a\x3a\x09\x9f\xc8\xee\x01\x14\x4d\x6e\xf3\x8e\x39\x0e\x91\xc2\xa4\xee\xca\x6c\xd1\x3d\x9c\x6e\x70\xa4\xfa\x78\x7d\x57\x68\xf9\x91\xd2\x36\x13\x62\x60\x5f\xba\x36\x89\x7a\x56\x09\x3f\x18\x67\x21\xd2\x02\xa0\x3c\xde\x16\x28\xc5\x00\x80\xdd\xd1\x06\x00\x97\x71\x84\x49\x3b\x1a\xb4\x29\x49\x52\x57\x2c\x73\x53\x70\x21\x13\xed\x73\xe7\x53\x4d\x2d\xa3\x5c\x14\x6d\x63\x7f\xd6\x00\x26\xcb\xcd\xcd\xea\x60\x64\x6f\x5b\x2c\xc7\xd3\xc5\x7d\x00\x7b\xbe\xb9\x99\x99\xc8\x67\x0d\x66\xe3\x01\x1e\xd2\x38\xd3\x92\xd7\xc8\xc3\x91\xab\x3b\x65\xec\x51\xbd\x4e\x3c\x6b\xfe\x9d\x9e\xca\xa7\xe7\xd8\x6e\x0f\xea\xb1\xdb\xb3\x35\x52\xe3\x30\x6c\x3f\x69\xcf\xdb\x4e\x95\xcb\xcb\x6a\x02\x72\x8a\x57\xa6\x5a\x86\x34\x1d\x8d\x46\xbe\x59\xd6\x2e\x25\x47\x66\x57\xe6\x41\x67\xa6\xe3\x20\x08\xd8\x4d\x61\xed\xa8\x72\xe0\x51\x5f\x90\xe4\x28\x8c\xab\x9b\xf5\x78\x7a\xb1\x8d\x93\x0a\x17\x6b\x94\xe4\x7b\xf4\x80\x97\x7f\x6e\x1a\x3c\xe4\xfd\x10\xb7\x48\xd9\x1f\xda\x99\x71\xd9\x42\x77\xda\xe1\xb6\xb1\x05\xdc\xd8\x22\x78\x28\x6e\x3d\xf3\x84\x65\x72\x6d\x1a\x94\x5a\xc9\x59\x07\xf2\x48\xa9\xf2\x38\x35\xca\x73\x8c\x0a\x94\x85\xe2\x3e\x7e\x4a\x22\x94\x8c\xd8\x7f\x7c\x92\xe3\xcc\xcc\x2e\xc5\x51\xb4\xdc\xab\x17\x72\xd9\xd1\x57\xa6\xf9\x9a\x13\xef\x64\xb7\x47\xd4\xd9\x22\x14\x37\x68\x27\xc1\xa2\xed\x88\x68\xc5\x2f\xc3\x82\x24\x49\x33\x98\x8a\xd4\xe1\xfe\x82\xd4\x55\x33\x5b\x34\x0b\x44\xd3\xf0\x78\x8b\x22\xec\xf1\x0e\x46\x31\x4a\xc8\xee\xa0\xa5\x5a\xda\x92\x22\x65\x0f\xea\x24\xa8\xc2\x0f\x82\x91\x3f\x5d\xdc\x7f\x78\xe1\xa7\x65\x37\x9c\x74\x82\x3b\xab\xda\x99\x9e\xac\x1e\x79\xe3\x19\x4f\x3a\x48\x13\x17\xa6\xe4\x23\x0d\x5d\xfe\x0d\xe0\x6a\x59\xa2\xd4\x6e\xea\x78\x0a\x12\x8c\x21\x18\x18\x67\x27\xb1\x2f\xe8\xe2\x5d\xd0\xc1\xb8\xc0\xc5\xb5\x66\xa9\xb4\x02\xc6\x3b\x23\xe5\xcc\xbf\x16\x79\xcc\x64\xc9\x0d\xcf\x9d\xa8\xf5\xda\x36\x5c\xca\x0b\xee\x3c\xa3\x3e\xcf\xa7\x41\xab\xf1\x1b\x02\x40\xbd\xa1\x09\x20\x56\xab\x95\x5d\xaa\xea\xb6\xe9\x43\x5b\x89\xc1\x37\x25\x66\xf9\xb5\xb7\x32\xf4\xa8\x79\x51\xc2\x85\xa3\xf4\x36\x89\xf3\x75\x6b\x65\xae\xad\xc5\xe2\x37\xc8\x51\x41\xf2\xc3\x1d\x57\xe8\x3c\x80\xde\xe4\x0a\x2c\xfa\x74\x55\x1e\x84\xde\x0a\x60\xb5\xd5\xce\xbb\xac\x16\x67\x87\x21\xca\x8e\x57\xe3\x2f\xc7\x69\x59\x2c\x9d\xf1\x17\x9a\x4d\x7d\xa1\x57\x15\xf7\x47\xb4\xb2\xfe\x1b\x24\x36\x09\xed\x0e\x89\x0a\x16\x29\x24\x95\x80\x95\x3f\x6d\x85\x50\xbd\x46\x61\x5d\xda\xa1\xc9\x53\x25\x8b\xe8\x3d\x18\x4b\x56\xf5\x9b\x31\x0c\xd5\xbc\x62\xd1\xb0\x45\x49\x79\xc1\x2c\x22\xbc\x61\xaa\x33\x89\x11\xd2\x99\xc4\xcb\x86\x32\x49\x25\x01\x30\x89\x81\xe9\xd3\x5b\x8f\xc6\xca\xfb\xfe\x54\xf0\xec\xfc\x2c\x01\x50\x4f\x44\xe8\x40\x12\x2c\xc5\xb7\x5d\x87\x7a\x26\x8f\xda\x9f\xfa\x03\x4c\xa2\x06\xb3\x3d\x1b\x54\xf8\x29\x46\x65\x5d\x60\x87\x3f\xe9\xaf\x56\xab\xc6\x15\x61\x0a\x67\xd1\xb8\x68\x7c\x12\xe9\x6f\x69\x31\x19\xbd\x8e\xa7\xfc\x35\x85\xc6\x40\xcb\xa0\x4d\xdb\x48\xb3\x6a\x7b\xaa\xee\x13\x4a\x0c\x54\x2a\x0b\x7e\xb5\xa6\x53\xab\x38\x90\x24\x0b\x44\xe2\x94\x19\xcd\x9a\x04\xf4\x7d\xb5\x9a\x2a\x7d\x4f\x44\xbf\x57\x0c\x7f\x5c\x11\x92\x54\x71\x0e\x70\xae\xd5\x2a\x67\x81\xf1\x91\x41\xfd\xb3\x2d\x4a\xe3\xe4\x66\x7d\xef\x67\x71\x72\x89\xab\x38\x44\xde\xcf\xe3\x1a\xdf\x1b\xc9\xbf\x47\x3f\x5d\xc4\x28\x19\x95\x28\x2b\xfd\x12\x17\xf1\x96\xbb\x79\x3c\x9d\x50\x91\xa2\x44\xf3\xf4\xe6\x8d\xa7\x87\xab\x0a\x17\x34\xfd\x7c\xb3\x36\x38\x16\xcb\x85\x5f\x60\xf4\x94\x99\x0b\xe7\xfb\x61\x6d\xd2\x18\xf5\xef\xb2\x42\x45\x05\x7b\x72\xaa\x03\xd9\x16\xb4\x76\x90\x96\xe9\x29\xf1\x69\x8f\xae\x48\x11\xf1\x1e\xa9\x25\x46\xbf\x69\xd9\x55\x81\x72\x6d\xb8\x6d\x5a\xcc\x01\x0a\x98\xcf\x8f\xa6\x79\x57\x30\xfa\x4a\xc5\xaf\x48\xae\x29\xb5\x99\xf2\xdd\xd2\x88\x53\xd0\xa2\xb2\x0c\x55\x46\x7e\x79\xfd\x45\xba\x16\x99\xe7\x9d\x52\x48\x77\x50\xa6\x29\xb3\xb4\xf5\xee\xa6\xec\xc7\x99\x9e\xbe\x7b\x1a\x98\x8f\x1d\x9c\x9f\x9e\x38\xb4\x71\xe1\x81\x9d\x0b\xd1\x26\x2a\x0a\x72\x05\xc8\x3e\xbf\x2c\xd3\x7e\x4d\x6b\xdf\x47\xc0\x65\x42\x26\xd4\x54\x4b\x6b\x73\xe0\x19\x4d\xe9\xa6\x7b\x11\xdc\xd7\x1f\xaa\x53\x2c\x24\x57\x56\xec\x1d\x03\x4f\xbb\x48\xa4\xda\x75\xa5\x2d\x4a\xc3\x68\x70\xa4\x21\xd0\x99\x76\x76\xc9\xc8\xb5\xf5\xd6\xfb\x72\x50\xde\x38\xe8\xed\x95\xb0\x30\x86\x98\x9a\x68\x74\x87\x29\xb8\x7f\xa1\xbd\xcc\xc4\x24\xde\xd9\x7f\x6d\x0c\xec\x5c\x16\x34\x0a\x68\x04\xa2\x39\xe1\x89\xf5\xb6\x17\x88\x16\x2f\x94\xe3\x1f\x60\x73\x8c\xed\x50\x83\x83\x85\xc5\x6a\x4c\x8f\x29\x01\xcd\x81\xd3\xa4\x3a\x9b\x8a\x8d\x87\x07\x79\x87\x36\x9d\x13\x29\x06\xfa\x36\xda\xcc\x49\x4e\x3f\xe5\x7b\x22\x4c\xad\x91\x5b\xb6\x46\x8e\x2a\x7d\x45\x11\x9d\x2d\xd5\xa0\x8f\x88\x4f\xfc\x87\xfd\x7b\x23\xfb\xd7\xf1\x14\x59\xc7\x17\x93\xfd\x95\x17\x86\xe1\xdb\xfa\xca\x13\xaf\xe1\xd9\xd5\xfb\x91\xa4\xc4\x59\x96\x97\x7d\xd4\x0a\xa8\x6d\x6c\x75\xb8\x6d\x5f\x75\xb8\x6d\x52\x35\xb8\xf9\xc5\xa2\xbd\x86\x3b\xd7\x1f\x0b\x72\xcc\xc0\x59\xf3\xaf\xe3\x3b\x6d\xd3\xfc\x33\x58\x2a\xd5\xaa\xd7\x2e\xbd\xf6\x0b\xbe\x8d\x06\xd2\x3e\x48\x8c\x27\x63\x6e\xa3\xf4\xbf\xf9\xd7\x88\xbd\x72\x75\x27\xf4\xcd\x6c\xb4\xde\xa4\x71\x9f\x7b\x62\x77\x92\x77\x4a\xc7\x63\x99\xcc\xf9\x27\xd6\x3d\x4d\x04\x04\x61\x58\x75\x4f\x26\x96\xf2\x12\xc3\xb1\x6d\x6b\x1b\xc7\x50\x4a\x35\xe9\xa3\x1f\x0d\x3c\x43\xa6\xda\x77\xa5\x23\xe2\x13\x4f\xf9\x20\x6c\xe7\xf6\xc2\x12\xa8\xc1\x9d\x63\xdb\xd5\xaa\x70\x8b\x91\x6b\xa6\x99\x8d\x58\x5b\x16\x13\xc3\x28\x1a\x6d\x68\x86\x59\x61\x81\x5a\x6e\x30\x01\xee\x08\x30\x72\xb6\xee\x68\xfb\x9c\x6b\xc1\x09\x9d\xd1\x86\xcc\x28\x0c\x9f\x6d\xe5\x2a\x36\x6c\xbd\x94\xa1\x6a\x00\x73\xc2\x25\x13\x1d\x7d\x01\x86\xcd\x83\x09\xee\xd9\xee\xed\x9c\x36\xf4\xa6\xbe\x39\xd9\xfc\xbd\x8d\xce\xd9\x66\xcc\x34\xda\x51\xfd\x22\x85\x05\x4a\xb1\x73\xb2\x95\x7e\x00\x83\xe6\xb7\xc2\x4f\x68\x9e\x45\x13\x5b\xc1\x38\x8e\x43\x54\x90\xba\xc4\xc9\x48\xfe\xe2\x5f\x2b\x56\xc0\xe7\x68\x62\x98\x8f\x3d\x28\x4f\x13\x19\xa8\x4f\xc6\x34\xb3\x04\x1c\xa8\x97\x01\x25\x20\x78\x2d\x5e\x1a\xe0\xef\xe3\x78\xd4\xfc\xeb\x61\x68\x10\xa5\x07\x0e\xf7\xef\x09\x7f\x72\x07\x84\x9d\xf4\xfa\x8e\xe6\xc4\x88\xc0\x05\x4a\x12\xf6\x48\x93\x74\x41\xfc\x59\xf4\x70\xf4\xc0\x8a\x79\x37\xc5\x07\x98\x83\xc3\xe2\xfb\x4b\xe3\x6d\xa2\x9e\x10\xbf\x89\xde\x11\xe5\x5f\x3a\x1f\x34\xea\xa0\x27\xfc\x11\x14\x3e\xdd\xa2\x10\xfb\x97\x71\x19\x6f\xe2\xa4\xf9\xde\xe7\x92\x43\x7b\xd4\x01\xef\xaa\xca\xa9\xe7\xb8\x28\x73\xcc\xb2\x23\x4d\x68\x9e\x6d\x46\x15\x28\xb7\x8b\x60\x79\xe0\xc9\x96\xc6\x3c\xc3\x36\x88\x92\xe1\xeb\xaa\x6b\xd7\x62\x16\x3d\x68\xa4\x63\xe4\xda\x83\xd0\xe0\x22\x04\xd8\xd5\x19\x96\x7f\x1b\xc4\xc8\x0b\x7c\xd9\xd3\x17\xbf\xaf\x33\xfe\x49\xbd\xe9\x60\x4a\x5f\x47\xb9\xb7\xd8\xdd\xdd\xa0\xab\xab\x81\xd6\x4d\xbb\x9f\xae\x2e\x66\x34\x09\xa9\x59\x4a\x99\x67\xe4\x62\x3f\x85\xa2\x63\xbc\xca\x50\x2d\x7e\x36\xaa\xbc\x20\x96\xe6\xed\xec\xa1\xeb\x9b\xaf\x4b\xf7\x52\x19\xe5\xfe\x38\x04\xef\x94\x2c\xda\xaa\x30\xa7\x1d\xb5\x95\x31\x1a\x78\x7c\x98\x07\xf3\xe0\x03\xef\xf2\xe2\xfe\x90\x4d\x66\x75\x1b\xbd\xf7\x91\x1d\x7b\xeb\xdc\x7c\xf4\x67\xf9\xd0\xfe\x3c\x50\xdc\x6a\xbb\xf7\xec\xe3\x64\xf0\xf3\x16\x94\x97\x7a\x58\xdb\x13\x8f\x26\x88\xb3\x0a\x41\x30\x79\xe8\x35\xbc\x1a\xf6\xf8\xc4\x9b\x92\x34\xe9\x55\xc4\x63\x8a\xed\x4e\x34\x0b\x9c\x63\x54\xad\xd9\x7f\xfc\x6b\x31\x6f\x79\x41\x76\x71\xb4\xfe\x2f\xff\xfd\x8b\x4d\x9b\x5f\x16\xeb\x76\xfc\xa5\x38\x2c\x48\x49\xb6\xd5\x58\xb6\x4f\xbf\xdc\xbf\xd0\x70\xbe\xac\x8a\xcf\xbd\xfb\xce\x79\xc0\xfe\xef\xdd\x91\x87\xb3\x48\x01\x04\x2d\xe0\xbf\xf1\xca\x5f\xbe\xc9\xf1\xe7\x26\x0f\x81\x69\x52\xc4\x90\x5a\x62\xb9\xaf\x78\xe7\x99\x63\x0c\x30\xb8\xb2\x78\xa3\x99\x3b\x91\xe4\xa0\x99\x1b\x4c\xf3\xed\xcf\x5c\xe0\x9a\xb9\xf3\x13\x66\x4e\x9e\x55\x31\xcb\xd9\xb1\x15\xb9\x9d\x6c\xad\x7c\x33\xec\x33\x68\x2b\xc1\x6c\xc5\x1b\xef\x92\x9b\x7c\x1f\x87\x24\xf3\xc3\x3d\xbe\x2c\x48\xe6\x1b\xfa\xb0\x03\xd3\x74\x10\x24\x2a\xc5\x32\xb4\xb9\x0e\xec\x50\xea\xca\xb7\x96\x0c\xb6\xc8\xa0\xde\xe2\x02\x7a\x4e\xf9\xcd\x06\xd6\x76\xc8\xf1\xad\xa7\x7b\x4a\x6f\xc4\x10\x11\x30\x6f\xdb\x10\x9f\x57\x8e\x46\x06\xb2\x52\xec\xaf\xb4\xfb\xa0\xf6\xd3\xa0\x5a\x8c\x93\x46\x2f\x9d\x0d\x36\x34\xcd\x0d\xe7\x77\xbf\x32\x0d\x66\xab\x77\x3b\x3b\x09\xd6\x41\xef\x6a\xd6\x33\x8a\x43\x54\x91\xa2\x04\xa6\x5f\x84\x2f\x68\xd7\xc5\x5c\xc8\x78\xee\xe2\x42\xec\xcf\x1a\x13\x34\x0b\xee\x5f\x74\xbf\x72\x62\x1b\x4b\xb0\x4b\x5e\x12\xc3\xcf\x75\x2b\xc1\x21\x79\x6e\xb1\xdd\x23\x9e\x88\xdd\xfe\xa6\xa3\x59\x45\x37\xa7\xa1\xa7\x3c\x95\x43\x2d\xea\x8b\xda\xee\x13\x63\x4a\xf8\xdb\xfb\xca\x6a\xa8\xe9\x56\xc6\xc3\xbd\x94\x36\x78\xc8\x07\x32\x55\x06\x32\x75\x45\x6d\xd5\x99\x16\x99\xda\xac\x29\xe3\x39\xdb\x44\x98\xa0\xf9\xa5\x9e\x9b\x6c\x83\xf1\x17\xea\x3b\xbc\x53\xe0\x49\xeb\xb7\xe3\xdf\xd8\x9d\xa6\xc7\x0f\x0e\x66\x54\x7c\xd8\x2b\xc2\xff\xbe\xf4\xa5\x38\x16\xd0\x4e\x9e\xfa\x0c\x78\xab\x2c\x5b\x9f\x71\x36\x58\x75\xf5\x2b\xc7\x4f\x4f\x27\x76\x6b\x42\x2e\x7a\xb4\xdd\xa9\x8c\x47\x4d\x95\x35\xaf\x3c\x80\x0e\x6b\x1a\xf5\x62\xdc\x71\x4c\xcf\xc4\x6c\xe3\x6b\x71\xca\x46\xfe\xdd\x7f\xc2\x46\xaf\xaa\x9d\xae\x61\x32\xca\xcf\xb6\x98\x11\x93\xfe\x47\x89\x95\x07\xac\x94\x53\xb4\x3f\x15\xa7\x39\x29\x2a\xd4\xac\xef\xf6\xc5\xaa\xf6\xa5\x4e\x15\xbe\x8f\x23\xac\x85\x9a\x54\x60\xb9\x27\x57\x7a\xaf\x54\x68\x9c\xd1\x00\x43\x82\x0f\x56\xa0\xe1\x38\xa6\x2b\x87\x12\x6f\xe4\x6a\x1d\x3c\x0e\x3c\x74\x61\x47\xe4\xad\x6d\xa7\x2e\x6d\xa5\x1c\xf3\x66\xcd\x38\x3b\x8e\xb6\xdb\xf8\xda\x38\x3b\x77\xfc\xbc\x9f\x96\xfe\x65\x8c\xaf\x1a\x34\xbe\x2c\x22\x7c\x19\x87\x98\xad\xdf\xe3\x98\x8f\xc7\x4f\x76\x23\xe5\x37\x9b\x1d\xad\x84\xa9\x79\xa0\xc8\xc4\x4d\x23\xf5\xb7\x0d\xb5\x28\xc9\x22\x13\xb7\x4c\xd5\xdf\x36\xd4\xa2\x24\x8b\x4c\xdc\xeb\x52\xfd\x6d\x43\x2d\x4a\xb2\xc8\x90\x53\x83\xeb\xf2\xc8\x91\x0c\xf5\x9d\x2d\xcf\xa8\x42\xec\x6a\xd9\x29\x5f\x74\xf1\x28\xe8\xfa\xd3\x69\x2a\x62\xe1\xc4\xf2\x0b\x72\xa5\x62\x46\x6a\x57\xaa\xbd\xbb\x5e\x88\x93\x44\x15\x28\x8b\x15\x86\xc5\xef\xc4\x35\x06\xaa\x16\x2a\xf5\x9c\xc7\xcd\xb8\x99\x91\x5c\x5d\xad\x26\x1a\x57\x21\xc9\x18\xc8\xd5\x32\x1d\xc2\x55\x13\xcb\xcd\xd5\x32\x55\xb9\x6a\xd5\x73\x71\x55\x8a\xea\x00\xae\x1a\x62\x7d\x07\xae\xb2\x83\x70\x26\x57\x27\x93\xc6\xfd\x52\xd8\x0a\x2d\xdd\x81\x6c\x4d\xa3\x21\x6c\x35\xb1\xdc\x6c\x4d\x23\x95\xad\x56\x3d\x17\x5b\xa5\x2e\x19\xc0\x56\x43\xef\xdc\x81\xad\x13\x7a\x4e\x4b\x61\x20\xa4\x45\x07\x32\x30\xd9\x0d\x61\xa0\x89\xe5\x66\x60\xb2\x53\x19\x68\xd5\x73\x31\x50\xaa\xf5\x01\x0c\x34\x4c\xc0\x70\x06\x5a\xda\x92\xd9\x36\x55\x27\x19\x8a\xf6\x64\x4d\xc1\x29\x96\xe9\x09\x14\x7b\x56\x09\x27\xa9\xc8\x62\x3f\x49\x29\x21\xbc\xb2\x32\x0f\x66\x65\xc9\xd6\xbc\x88\xb3\xaa\xc7\xe2\x30\x1c\x47\x95\x6e\x41\xd3\x71\x3b\x64\x0d\x40\x74\x8b\x1b\x45\x56\x25\x0e\xaa\x6d\x08\x9d\x31\xe4\x41\xa6\x16\x1a\x78\xcf\x3a\x33\x9b\x31\xe4\xfb\x84\x76\xfa\x56\x06\xdc\xd2\xdd\xc7\x75\xca\xea\x12\x12\xd6\x29\x3d\xc7\xcf\x3c\xfe\xec\x4f\x7d\xc6\xfb\xac\xe7\xfd\x0c\xc9\x2a\xef\xa7\xaf\x70\x49\x52\xec\xcd\xc7\xd3\x71\xe0\x6d\x6e\xbc\xcf\x47\xe8\x12\xef\x50\x16\xdd\x78\xbe\xb7\xaf\xaa\x7c\xfd\xf8\x71\xe3\xcc\x22\x86\x38\x8e\x89\xe7\x7b\x9f\x57\x4a\x28\xad\x9f\x8b\x43\x9c\x95\xd8\x55\xe5\x71\xc2\xe1\x0f\x9a\x46\xd7\xde\x2f\x7c\xf1\xe7\xbc\xff\xff\xcf\xfc\x9c\x37\x19\x4f\x46\xde\x17\x7e\xe1\x17\xd6\xde\x97\xbe\xf8\x65\x41\xe4\xe1\x67\xbc\xcf\x3e\xfe\xcc\xe7\x45\xf4\xf5\x29\xbe\xa1\xd7\x2b\x4b\x6f\x8b\xfc\x32\x8f\xb3\x43\x70\x1f\xd8\x13\x2a\x48\x45\xef\xeb\x44\x78\xa7\xee\x06\xa9\xc5\xc7\x8a\xb8\x2b\xce\x16\x2b\xb8\x2a\x07\x1c\x8f\x9f\xff\xf7\xd3\x13\x1e\x9d\x0a\xd9\x77\x86\x88\x53\xbd\xdb\x30\x97\x4f\xe8\xbb\x17\x65\x11\xae\xeb\x22\x79\x30\x1e\xd3\xc9\x28\xd5\x29\x69\xda\x6e\xfe\x1c\x63\x52\xfd\xa7\xcb\xcf\xd1\xc9\x7f\x38\xbc\xc6\x3b\x31\xde\xc6\xd7\xff\x3f\x51\xd1\x6b\x3a\x89\xaa\x07\xf7\xe8\xc5\xd2\x08\x47\xf4\xa6\x54\x75\x93\xe3\x7b\x0f\x47\xbd\x04\xaf\xc8\x76\xfb\x9f\x2c\x52\x4d\xe9\x90\xda\x55\x05\x54\xae\x8a\x1a\x0f\x6d\xbe\xbc\xdc\x09\x02\xef\x28\xf0\x02\xef\xea\x04\x15\x2d\xcd\xf2\x72\x77\xef\xa1\x75\x60\xd1\x3a\xd2\x78\x1c\x6f\x11\x1c\x17\xa3\x5f\x84\x93\x79\x7e\xfd\x78\xe2\x29\x13\xa5\x44\x20\xf8\x23\x26\xec\xf3\xb0\xc0\x59\x84\x8b\x38\xdb\xb1\xcf\x60\x21\x2d\x0c\x3b\x25\xa4\xda\x53\x58\x56\xc5\x28\x89\x51\x89\x23\xb6\x97\x4d\xca\x6b\x13\x67\x57\xa0\x9b\x32\x44\xcd\x37\xf9\x16\x35\xe6\x47\x39\x04\x37\x9e\x35\xff\x87\x53\x2d\xfa\x39\x3e\x5b\xe0\xd4\x7c\xa0\xd9\x9f\x2c\xee\x53\x02\xd3\x6b\x85\xc0\x14\xa7\xb4\x70\xa6\x16\xce\x78\xe1\x5c\x2d\x9c\xf3\xc2\x85\x5a\xb8\xe0\x85\xdb\x2b\xf1\x00\xee\x78\x7a\xbe\x38\x9b\xe0\x14\x8a\x42\x6e\x91\x5f\x27\xe6\x1b\xcd\x6a\xc0\x60\x3a\x9e\xcc\xa7\xe7\x4b\x3a\x1c\x11\xd3\xf4\x1b\x41\xe0\xd7\x45\x29\x81\x27\x49\x0c\x1d\x5c\x69\x58\x13\xbb\x82\x76\x7e\x4b\x99\x47\x8f\xe5\xdf\x15\xc9\xd7\xed\x1f\x60\xa7\x93\x98\x33\x9e\xc7\x83\xc6\xcd\x10\x05\x3f\xd8\xd7\xbd\x3c\x25\x38\x9e\xe2\xd4\x1b\x4f\x17\xcd\xff\x4e\x9a\x69\xe0\x5f\xff\x2c\x2a\x3a\x0e\xce\x71\xea\xd1\x57\x7a\xf5\xd8\xe8\x78\xd2\x90\x83\x03\x23\x70\x38\xa4\x69\x5c\x01\x68\x61\x96\x31\x9f\x41\x95\xa0\xca\x67\x01\x67\x4a\x50\xde\xce\xcd\xe2\x94\x6d\xb2\x70\x88\x37\x2d\xbd\x38\xdb\xc6\x59\x5c\x61\x8f\xed\x50\x5d\x0c\x41\xa2\xa4\x99\xce\xf3\x57\xc1\x61\xf0\xee\xd3\x7f\x46\x65\x1c\x52\xe8\x03\x5a\x3b\x26\xd9\xe7\x26\xc6\x85\x57\x55\x9f\xae\x98\x8a\xd6\xef\x8b\xea\x30\x47\xb9\xda\xc3\xc9\xf9\x1b\x76\x71\xda\xd1\xc5\xc9\x79\x47\x1f\x05\xd0\x05\x50\x7b\x39\x3d\x7b\xc3\x5e\xce\x3a\x7a\x39\x3d\xeb\xe8\xa5\x00\xba\x00\x4c\x01\x24\x71\xee\xef\x49\x11\x7f\xd4\xa8\xe0\xe4\xcd\xba\x1a\x40\x5d\xa5\x1a\xf0\x81\x3f\x19\x4d\xcc\x7e\xaa\x10\xb0\xb4\xed\xa1\xd0\x89\x9f\xc2\x84\xb3\xf6\x26\x23\xdf\xd1\x3f\x06\x01\x4b\x8f\xeb\x82\x90\xca\x03\xf8\x38\x32\x20\xa2\xff\x4a\x79\x2b\xc5\x76\xe1\xf4\x0c\x28\x6c\x57\xa4\x54\xaa\x65\x85\xc2\xa7\x1d\xb9\x39\x80\xfd\xa1\x29\x4e\xe5\xee\x9b\x61\x7e\xa6\xb6\xf1\x11\x8f\xff\x8b\xb6\xfc\xc9\xf5\xa8\xfd\x63\x7a\xed\xd2\xdb\xea\xa1\x17\x87\x72\x16\xf4\x0e\x6a\x17\xe4\x73\x62\x6a\x1b\xb6\xd5\x8b\xb3\x4b\x5c\x94\x58\x7b\x7a\x78\x8b\xfc\x5d\x82\xca\xd2\x8a\x90\xff\xf8\xe3\x8f\xef\x51\x70\x5a\x97\x71\x08\x80\x9f\x31\x70\x89\x51\x11\xee\x01\xf8\x73\x06\xc7\xd9\x25\x4e\x48\x8e\x7d\x02\xe0\xbc\x60\x38\x7b\x8c\x0a\x6b\x83\xf1\xde\x8f\x3f\x7e\x79\x4f\x0c\xc9\xbe\x23\xfb\xe3\x8f\x5f\xb5\x50\x90\xf8\x2d\x83\xd7\x25\x70\xc3\xf6\xc7\x1f\xbf\x66\xd0\x6d\x9c\xa4\x00\xf4\x13\x06\xad\xf6\x7e\x82\x8a\x1d\x06\x30\xbe\x2a\x30\x00\xd8\xd7\xda\xda\x71\x09\x0d\xec\x17\x19\x42\xb8\xc7\xe1\x53\x00\xfc\x75\x0e\xa6\x49\x2e\x18\x98\xca\x4f\x81\x53\x72\xa9\x95\x54\x71\x8a\xa1\xb9\xfb\x86\x3a\x39\x7e\x9e\xd4\x10\xd2\x37\x35\xa4\x34\xce\x40\xac\x5f\x62\x58\x39\xb9\xc2\x85\x4f\xb6\x5b\x00\xe5\x97\x39\xa1\x78\x97\xa1\x04\x80\xff\x0a\x1f\x0f\xd9\xa9\x7d\xdf\x61\x70\x5a\x7f\x95\x33\xaf\x40\xe5\x1e\x9c\xd7\x5f\xe3\x42\x43\x52\x68\x5e\x7e\x5d\xce\x2b\x2c\x72\xbf\x21\x79\x1b\x3e\x05\x11\x7e\xf3\x1e\x37\x44\x28\x02\xa0\xbf\xc5\xa0\x11\xb9\xca\x12\x18\xe3\xb7\x19\x06\x3d\xe1\xec\x87\x71\x11\x36\x1d\xa1\x15\x00\xe4\xdf\x01\x91\xeb\x1c\x40\xfd\xdd\x7b\x7c\x11\x6f\x88\xbd\x9f\xf5\xe3\x8f\x7f\x8f\x4f\x53\x82\x6e\x24\x21\x00\xed\xf7\xf9\xe8\xd8\x91\x19\x55\xb6\x98\xba\x64\x1e\x95\x5d\xef\x0f\x44\xbd\x6d\x81\x4b\x48\xe6\xff\xf0\x1e\x77\x1e\xcb\x46\x6b\x41\x24\xfe\x88\x63\x10\x50\xe6\xff\x98\xcf\x5b\x82\x76\x00\xf4\x4f\xa4\xa2\x88\xf2\x3d\xc9\x40\x99\xff\x36\xc3\xb9\x24\x49\x9d\x62\x87\xa4\x7e\x47\xc3\x71\x4c\xca\x77\x35\x24\x70\x32\xfe\x94\xa1\x7c\x58\x84\x24\x82\xa4\xf0\xcf\x18\x7c\x83\x5c\x08\x7f\xce\xa5\x1c\x1c\xed\x5f\x48\x20\x34\xce\xbf\xe4\xb4\x09\x81\xf8\xf8\x57\x2d\x34\x45\x05\x84\xf1\xd7\x5c\x52\x8a\x38\x83\xa6\xe9\xff\xe6\x0b\x04\xa5\xb8\x40\x00\xfc\x7b\x7c\xa2\x08\x58\xfb\x6f\x44\xf3\x09\xb4\x36\xfe\x96\xcb\x70\x85\x12\xd0\xa6\xfc\x1d\x1f\x38\xdd\x97\xc4\x0e\x51\xfc\xbe\x82\xc4\xec\xa5\x8d\xf3\x03\xbe\xae\x1a\xf3\xc9\x3e\x02\x6c\x9c\x1f\xaa\x38\xcc\xc4\x02\x58\x3f\x52\xb1\x5c\xab\xe3\xef\x55\xa4\x0f\xea\xb2\x8a\xb7\xf6\xe3\xa9\x3f\xfe\xf8\x1f\xda\x35\x02\x40\xff\x91\xab\x16\x4c\x4f\x9a\x28\x4b\x93\xd4\x95\x52\xa4\x56\xf9\x27\xa1\x13\x1c\xf0\x7f\xe6\x92\x1c\x47\x98\xb8\x67\xf4\x5f\x38\x15\x7a\x34\x4f\x69\x37\xdf\x93\x8a\x68\x05\x71\x58\xd5\x05\xac\x58\xfe\x95\x4b\x15\xce\xc2\x18\xb0\x01\xcf\x84\x37\x81\x72\xbf\x91\x4b\x88\xd7\xcf\xb8\x4b\x81\xa2\x86\x85\x00\x9c\xbb\x14\x15\x28\xb8\xcf\xb8\x33\x81\xa3\x58\xe3\x1e\xeb\x91\x5f\x7e\x58\x23\xb8\xeb\xcf\x84\x9b\xb1\x6f\x10\x3a\xf0\x5e\x29\x56\xbb\x0b\xef\x56\x51\xea\xc0\x02\x7e\xf6\x5a\x38\x2e\x38\xa7\x57\x04\xae\x50\x01\x2c\x96\x67\xdc\x07\xd9\xa2\xb2\xea\x42\xfb\xaa\x50\x35\x4e\x8c\xaf\xb5\xa6\x01\x80\x72\x5f\x24\x47\x75\x09\x28\xaa\x67\x5f\x17\x9d\x25\x80\x1e\x7c\xf6\x0d\xa1\x0c\x0a\x47\xdb\xdf\x54\x06\xe1\xc6\xfa\x25\x85\x23\x6e\x2c\xee\x66\xe0\x0f\x70\x08\xcd\xff\xaf\xc8\xe9\x91\xc7\x5e\x00\xac\x5f\xd5\xb1\x1c\x2b\xfa\xd9\xaf\x09\x9e\xd5\x25\x37\xa7\x00\x12\xf7\x35\xa8\xe7\xe4\xc6\xfa\x0d\x21\xb5\x29\xee\xc0\xfa\x4d\x55\xb8\x9c\x58\xdc\xfd\xf8\xb0\xc6\x65\xf3\xfd\xe0\x46\xfc\x6d\xa1\x19\xb6\xc4\x8d\xc4\xbd\x8f\xe6\xe3\xaf\xdc\xa3\xb8\x80\x84\xf5\x77\xed\xde\x83\x42\xff\x7b\x76\xff\x41\xbc\xdf\x17\xe2\x0a\x58\xde\x67\x7f\xa0\xba\x43\x8e\x09\xfc\x96\x8a\xe3\x9a\xbe\x3f\x54\x91\x20\x0b\xfe\xec\x8f\x54\x0c\xd8\x11\x78\xf6\xc7\x42\x67\xc5\x89\x21\x96\x23\xa9\x30\x80\x6a\xdc\x59\xc1\xd7\x39\xca\x20\x39\xfe\xb6\x70\x87\xd3\xbc\xc0\xd0\xa7\xd7\xb3\xef\xb4\xd2\x07\x40\xbf\xab\x88\x1d\x00\xe6\xbe\x09\x2a\x2b\x5c\xc4\x25\xe0\x01\x3c\xfb\x33\xd1\xc1\x30\x41\x2c\xbc\xe5\x96\x11\xee\xa8\xec\x62\x70\x32\xb8\xa7\x92\x60\x04\x78\x5b\xcf\xfe\x52\xf8\xe2\x20\x9b\xb8\xa7\x82\x6f\x20\xe0\x5f\x4b\xa0\x5f\x26\x08\xf2\x38\x9f\x7d\xcf\x1e\x45\x55\xc4\x28\xdb\x25\x9a\x15\xe3\x09\x55\x01\x02\x7f\x23\xf5\x62\x06\x75\xe1\x6f\x85\x23\x94\xe0\x2c\x82\x3e\x55\x9e\x71\x77\xa5\x40\x59\x44\x80\xaf\xc8\x67\xdf\x97\x13\x9d\x82\xf6\xf9\xd9\x0f\x84\x80\xed\x32\x0c\xc1\x7f\xa8\x2b\x2b\x50\x90\x7f\xa4\xe3\x38\x44\xf9\xef\x85\xff\x5e\x5d\x61\xb0\xa9\x7f\x10\x46\x90\xe4\x79\x9c\xed\xfc\x10\xfc\x24\x7f\xf6\x8f\x42\xe1\x27\x50\x76\xaf\x1f\x3f\xfb\x27\x15\x4e\x37\x56\x00\xa4\x7f\x56\x0d\xa4\x6f\x1d\x47\xbe\xf7\xe3\x67\xff\xa2\x61\x40\xb3\xff\xaf\xd2\xc3\xf6\xc3\x3d\x2a\x2a\x5f\xf3\x54\x64\xb1\x5d\xf3\x39\xf7\x44\xaa\xab\x98\x65\x6f\xa0\x86\x1c\xc0\x7b\x26\x4c\x57\x88\x1b\x6f\xda\x8d\xf8\x5c\xf5\x98\x1b\x16\x17\x80\xe2\x7b\xce\xdd\x93\xa7\x18\x30\xc2\xcf\x5f\xca\x2f\xe4\xd2\xfc\x44\x06\x96\xf8\xf3\x57\x9a\x60\x41\x18\xb7\x22\x04\x51\xa7\x9b\xd2\xf1\x49\xf9\xfc\xb5\x81\x04\xcb\xce\xf3\x4f\x94\x40\xcb\x1e\x25\xc0\x4a\x7f\xfe\x55\x25\x90\x03\xa9\xfd\xe7\x5f\x6b\x43\x04\x8d\x3f\x0b\x60\xfc\xa2\x70\x8e\xb3\xa7\x38\x8a\x33\x37\xbb\xbf\xae\xf4\xda\xaf\x10\xf4\x35\xf9\xfc\x1b\x42\x37\x54\xb8\xc8\x50\x42\xa9\x02\x68\xdf\x54\x7a\x15\x43\x43\xff\x25\x11\x8c\x20\xf9\x1e\x9a\xb7\x5f\x16\xda\xb1\xda\xd7\x1b\x77\x97\xb9\x6b\x52\xe7\x70\xcc\xe0\xf9\xaf\x0a\x35\x9a\x92\x0c\x64\x9f\x70\x47\x9a\x6f\x5f\x00\xcc\x1d\x11\xb7\x4f\xfa\xfc\x37\xf4\xcf\x42\x10\xe7\x37\x95\x46\xdc\x63\xf9\x2d\x6d\xf9\x00\x08\xbf\xad\xaf\x1b\x00\xe3\x77\x54\xae\x01\x70\xee\x73\xd4\x19\x1c\x2b\x78\x2e\x7c\x8d\xa2\xf1\xf5\x1b\x4d\x05\xf1\x54\x44\x3a\x20\x03\xfb\x9c\x3b\x1a\xfb\x28\x02\x39\xc1\x7d\x8c\x4d\x9d\x24\x7b\x52\x40\x82\xc1\x1d\x8c\x0d\x4e\x80\x8f\x9d\x17\xdc\x30\x84\xb8\xa8\xe2\x6d\x1c\xa2\x0a\x62\x24\xf7\x2e\xf6\x28\x8b\x7c\xe2\xf2\x64\x9e\xff\x89\x86\x05\xfb\x44\xcf\xbf\xad\x21\x81\x6b\xfd\x3b\x1a\x8a\x63\xa5\x7f\x57\x75\x8a\xb8\x13\xe7\x68\xf2\x4f\x01\x54\xd7\x18\xfe\x0c\xc0\x05\x3b\xf9\xe7\x00\xa2\xa3\xab\xdc\xf3\xd8\x25\x64\x03\x31\x97\xbb\x1e\x57\x05\xce\xa0\xe8\xf4\xf3\xbf\x12\x21\x96\xf2\x29\x24\x1f\x7f\x2d\x3c\x97\x04\x96\x71\xee\x7b\x6c\x8a\x18\x6f\x43\x04\x7d\x38\x3d\xff\x1b\xcd\x84\x81\x21\xb1\xe7\xdc\xc5\xa0\x49\x17\x55\xbd\x5f\x97\x18\xd2\xfb\x2f\x3e\x16\xb6\x1e\x49\x6d\x35\x12\x4a\x13\x40\xe7\x16\x2c\x4c\x48\x0d\xac\x90\x17\xdc\x6e\x6d\x13\x04\x79\x89\x2f\xb8\xc1\x0a\x6b\xed\x73\xba\x0c\xe3\xb2\x24\x60\xef\xa4\x11\xcb\x6f\xd4\x1a\xdb\x38\xc1\x25\xb4\xcc\x5e\xbc\x12\x9f\x9e\x39\x2e\x68\xf2\x20\x1b\xe5\x56\xf4\x91\xe4\xf9\x8d\x6e\xe3\x4b\x74\x09\xf0\xfd\xc5\x6b\x55\x17\x02\xf0\x4f\xa4\xe3\xa0\x99\xda\x0c\x5d\xc6\x21\xd1\xb8\x5a\x60\xb6\x99\x6d\xd3\xf8\x6a\x1b\xc8\xf1\x6b\x48\x01\x7c\x4d\x41\x20\x10\xc2\x2f\x0a\x9b\x5a\xc4\x4f\x71\xb5\x2f\x48\xbd\x03\xc4\xf4\xc5\xd7\x85\x26\x8c\x70\x41\x6f\x0b\xda\x28\xdf\x10\x92\xbc\x81\xfc\xf7\x17\xdf\x94\x2e\x26\x14\x71\x7b\x21\x0d\x5c\x0d\x69\xda\x17\xdc\xbe\xe5\xf4\xfa\x12\x86\x82\x32\x2f\x7e\xc5\x40\x71\x73\x9e\x5b\xb9\x1d\x21\xbb\x04\xb3\x4f\x6b\x27\xee\xaf\x59\xb8\x00\x92\xf8\xfe\x26\x19\xe4\x54\xbd\x10\xa1\x7e\x54\xe0\xca\xa1\x46\x5e\xfc\xa6\x8a\x03\xe9\xa4\x17\xbf\xa5\x62\xc0\xca\xf0\xc5\x6f\xab\x38\x0e\x2d\xf8\x42\x7c\x6d\x93\xa4\x4e\x33\x68\x38\xdc\xec\x95\xa4\xd0\x56\x5c\x9d\x35\x25\x18\x5a\xc1\xbf\xd7\xd6\xf0\x23\x5c\x86\xda\xf2\xa0\x85\xf0\xa8\x7f\x5f\xa9\x87\x80\x6a\x20\x23\xb8\xcd\x14\x1b\x7a\x00\xc6\xb7\x74\x17\x0e\xc0\xe0\x66\x93\x6f\x36\x28\xdc\xe4\x23\x8d\x20\x35\xc1\x3f\xd5\x77\xe8\x12\x27\x9a\xd6\xc3\x3b\x68\xbb\xe9\x05\x37\xac\x11\x2a\xf7\x1b\x62\x7c\xb3\x57\x28\xdc\x93\x14\x83\x5a\xfd\xc5\x9f\x68\x6e\x35\xa8\xb2\xbe\xad\x7b\xde\x20\xce\x77\x84\xaf\x95\x68\xa3\xdb\xc2\xdf\xb1\x2f\xbe\x2b\x9c\xd0\x0a\xa7\x08\x62\x3c\x37\xb5\x75\xba\x29\x70\x92\x00\x11\xdc\x17\x7f\x26\x14\x7d\x9c\x5b\x23\xce\x51\x09\xb9\x1f\x2f\xfe\x5c\x4c\xd6\x6e\x5f\x6d\xea\x64\x03\x0e\xe5\x2f\xe4\x37\xf6\x1e\x65\xd0\x3e\xe8\x8b\xbf\x54\x6c\x4c\xc7\xb6\xd8\x8b\xbf\x52\xf1\x5c\x8e\xf0\x0b\x6e\x77\x1b\xfb\xe7\xa7\x10\xc2\xf7\x84\xde\xc4\xd5\x9e\x94\x21\x2c\x87\xdc\xf4\x96\x75\x5c\xc1\xc6\xf9\xc5\xdf\xb6\xee\x1b\xe8\x00\xfe\x91\x98\xe6\xed\x16\x43\xf5\xbf\x2f\x6d\x63\x82\x0b\x48\xf7\xfc\x40\x3a\x10\x98\xed\x54\x40\xdc\xfd\xa1\x70\x33\xe3\x84\x9e\x11\x83\x70\xf8\xe7\xfd\x9e\x94\x79\x5c\x21\xb0\xb7\x2f\xc4\xf6\x43\xba\xa9\x13\xfa\xdc\x80\x8d\xc2\x3f\xef\x53\x1c\x3d\x8d\x21\xe5\x24\xbe\xeb\x1b\x69\xc0\x85\xff\x01\x14\x23\x78\xf1\x4f\x82\x6b\xe0\xea\xe1\x5f\xf5\x7b\xb7\x52\xff\x17\x11\x70\xe9\xd2\xfc\xfc\xc3\x9e\xc6\x71\xfc\x88\xd4\x1b\xa7\x0f\xfa\xf2\x63\x00\xd5\xa1\x7d\x5f\x3e\x03\x70\x21\x35\xf7\xf2\x39\x80\x08\xab\xd1\x97\x2f\x54\x54\x47\x1f\x5f\xaa\x38\xae\xce\xbd\x52\x91\xc0\x5e\xdd\xea\xbd\x02\xbb\xc3\x3d\x9f\x08\x97\x4f\xc1\x68\xfd\x4b\xee\xfa\x24\x28\x87\xe1\x5f\x55\x5c\x09\xa8\x9b\x5f\x13\xf6\x76\xd3\x88\xb5\xfa\x31\x3a\x6a\xcb\x81\x7a\xe2\xb8\x83\x33\x2a\xfc\x92\xfb\x39\xf4\x79\x13\x17\x27\xbf\xa1\xe2\xb8\x38\x29\xbe\xe8\x73\x9a\xcb\x05\x40\xf8\x25\xb5\x2f\x00\xfc\x97\x85\xbf\x14\x27\x7e\x81\xf3\xe4\x46\xf7\x08\xdb\x02\xb5\xd2\xaf\x68\x61\x00\xd0\xd7\x7f\xc9\xbd\x1f\x11\x19\x03\x30\x7e\x4d\xc7\xc8\x31\x18\x0b\x78\x29\x3e\xf6\x53\xc7\x11\x87\x97\xdc\x63\xd9\x16\xe4\x0a\xa6\xf0\xdb\x42\x19\x80\x07\x2c\x5e\x8a\xcf\x73\x94\xe2\x1c\xd2\xd2\x2f\xb9\xa3\xf2\x14\xdf\x50\x6b\x03\x12\xf9\x3d\xf9\x71\x01\xea\xb5\x97\xbf\xaf\xc0\xe9\xa6\x01\x2e\x20\x17\xe7\x25\xf7\x3a\x2a\x5c\xa4\x31\x78\xba\xe4\xe5\xb7\x84\xae\x86\x36\xda\x5f\xfe\xa1\x39\x9f\x3e\x4a\x12\x6b\x4e\x95\x42\xb5\xf2\x1f\x09\x6b\xc3\x23\x5f\x3e\x4e\xf3\x4a\x93\x88\x16\xb4\xad\x75\xba\x2d\x04\x1a\x3e\xf7\x51\x12\x12\xb2\xc8\x35\x4b\x88\x67\xe3\x09\x97\xa4\x00\x17\xec\xb7\xdb\xa1\xfb\x5b\x02\xed\xf6\xbf\xfc\x8e\xf2\xc9\xe8\x6f\x0a\xf2\x14\x67\xba\xcb\x05\x7f\x3b\xbe\xfc\xae\x58\x6d\x6c\xa7\x09\xc0\xe0\x6e\x49\x9c\x6d\xa1\x01\xda\x7b\x0c\x00\xd2\x9f\x0b\x43\x9d\xe3\xa2\x0c\x8b\x38\x87\x16\xce\x5f\x08\xa4\x8d\x13\x85\x3b\x21\xb8\x40\xe0\x61\xaf\x97\xdc\xf9\xc8\xeb\x8f\x3e\x6a\x14\x57\x8c\x21\x1b\xf9\x92\x3b\x1f\x69\xdc\x70\x1b\x8e\xb4\xbd\xfc\x9e\x89\xe3\xda\x9e\x78\x29\x9c\x90\x7d\x8c\xa1\xb3\x10\x2f\x8d\xed\x05\x50\x4a\xfe\x4e\x58\xe5\x02\xfb\xf8\xba\x8a\xb3\x5d\x1d\x97\x7b\x70\x88\xdf\x17\x9e\x75\xf8\x14\xd4\xdd\x72\xab\xe1\x3a\x8c\xa0\xa9\x30\xb6\x1a\x3a\x83\x3f\x2f\x7f\x04\x22\xbb\x74\xf2\xdf\x83\xd8\xa0\x99\xfb\x07\x10\xd5\x61\xef\xb8\xcf\xb2\xaf\xd2\x64\x01\x80\xb9\xb7\x12\x96\xe5\x0c\x80\x8a\x3d\x88\x2c\xdc\x13\x88\x9f\xff\x22\xbe\xbc\xe9\x41\x31\x58\xa5\x8b\x3d\x88\x3a\x49\x4a\x70\x1b\xeb\x15\xf7\x50\x70\x92\xc4\x79\x19\x83\x3b\x19\xaf\x9e\x19\x38\xc0\x7e\xc8\x2b\xee\x94\x14\xa5\xdb\x71\x7a\xf5\x42\x78\x57\xf2\x04\x18\x80\xc4\xdd\x91\x2a\x86\xc5\xe4\xd5\x2b\x21\xdf\x59\x87\x8f\xf6\xea\xd6\xc6\x82\xe4\xf7\x15\xf7\x48\x12\x7c\x89\x13\x70\xc2\x5f\x7d\xa2\x62\xc0\xf3\xfc\xea\xab\x52\x28\x42\xf7\x2e\xcc\x2b\x71\xca\x41\x3d\xee\x01\xa0\xfd\xa2\xd0\x4b\xca\xce\x80\x1b\x5b\x9c\x7e\x50\x8e\x86\x00\x58\xdf\x90\x1f\x85\x39\x78\x94\xf6\xd5\x37\xd5\xf8\x80\xe0\x97\x3a\x5c\xf6\x6d\x4a\x76\x6e\xef\xee\xd5\x2f\x81\x34\xf4\xb8\x21\xa7\x00\x72\xfa\x97\xc1\xfa\xea\x9a\x55\x49\x38\xd6\xf2\x2b\xee\xe9\xe0\xba\x50\x2b\xe1\x1a\xda\xf2\x7a\x25\x02\x3e\x1b\xa8\x3f\xdc\xdb\x89\x48\x92\x20\x8d\x58\x5d\x02\xea\xf2\xd5\xaf\x0b\x6b\xa3\xe1\x16\x75\x0e\x7d\x9f\xbd\x12\xa1\x9f\x4c\x33\xd6\x1f\xe8\x81\xc9\x22\xdd\xa8\x7f\xde\x40\x7b\x95\xaf\xe4\x71\xd0\x7a\xa3\xef\x2b\x17\xf5\xc6\xf8\x13\x5c\x70\xdc\x19\x7b\x5a\x5c\xa9\xd8\x57\x90\x39\x7c\xc5\xfd\xb2\x4d\x5c\x85\x44\x0f\xf0\x6e\x2a\x20\x78\xf7\xea\x77\xda\x8f\x4c\x00\xfa\xbb\xc6\x27\x28\x80\xa2\xc6\x8f\x68\x4e\x31\x25\x1a\xa4\xe2\xfd\xbe\x85\xa7\x44\x9b\x54\xc4\x3f\x50\x11\x53\x52\x67\x95\x83\xe2\xb7\x6c\x44\x07\xc9\x3f\x54\x30\xb3\x3a\xc5\x45\x1c\x3a\x68\xfe\x11\x80\xe9\x20\xca\xdd\x2f\xbe\xf3\x09\xae\x97\x3f\xd1\x50\x1c\x8b\x92\x3b\x60\x37\xa4\xae\xea\x4d\x87\x86\xf8\x8e\x86\x07\x20\x70\x77\xeb\x1a\x3c\xa9\xf0\xea\x4f\x5b\xa8\xbb\x8d\x3f\xd3\xfb\x02\x1f\xf7\x7a\xc5\x5d\xae\xa8\x20\x39\x78\x90\xf8\xd5\x5f\x48\x9f\x37\x7c\x2a\x5f\x0e\x04\xf0\xfe\x52\x2c\xc8\xb2\x42\xbb\x02\x01\xe7\x1f\x5e\xfd\x95\xf0\xf1\xe3\xf0\x29\x60\x63\x5f\x71\x87\x0b\x41\x0e\xc9\xab\xef\xc9\xc5\xb0\xa9\x1d\xc6\xea\x6f\x0c\x14\x37\x6f\xb8\xb3\x55\xd5\xe9\x26\x81\x7a\xf2\x77\x2a\xdc\x4d\xe6\xfb\xc2\x6b\xcf\x76\x9d\x27\x87\x5e\xfd\xc0\x42\x04\x65\xec\x87\x16\x1a\xec\x70\xbd\xfa\x91\x85\xe8\x52\xd0\x22\x16\x94\xe7\xa0\x52\xe0\x0e\xd6\x55\x9c\x45\xe0\xe1\xc4\x57\xff\x28\xfc\xa2\xa8\x20\x31\xa4\x88\xb9\x5b\x95\xc4\x59\x0d\x89\xcf\x3f\x0b\xf9\x8a\x37\x1b\x58\x23\x72\xcf\xaa\x7c\x7a\x03\x05\xef\x5e\xfd\xab\xf8\x0a\xae\x0b\xd7\x3c\xdc\x8a\x23\x1c\x05\x4e\x12\xc0\xf0\xdc\x8a\xa3\x1b\x38\x45\x50\x17\x6e\x9f\x0b\x67\x18\x84\xbe\x90\x5f\xf3\x15\xb4\x27\x75\x2b\xce\x8b\xd6\xe0\xd7\xf5\xad\x70\xa1\x08\xbc\x97\x7f\x2b\x8f\x87\x86\xfb\x18\xda\xc1\xba\x7d\x2d\xdc\x4a\x40\x15\xdc\x72\x87\xe9\x12\xf8\x66\xbb\xe5\x8e\xd2\x15\x8e\x37\x50\xc3\xdc\x43\x2a\x70\x56\x40\xa6\xee\x56\x9e\x04\xdd\xe1\x24\x06\xcf\xd8\xdf\x7e\x5d\x55\x0e\xee\xa8\xf0\xed\x37\xc4\x18\xb5\x7b\x0d\x0e\x99\xbd\xfd\x26\x88\x0d\x2f\x85\x5b\xd8\x0f\x32\xb7\x12\xb8\x1b\xe3\xa0\xf1\xcb\xc2\xf7\xa8\x3a\x82\x52\xb7\xdc\xd7\xb9\xda\x63\x9c\x34\xdf\xcf\x80\xe2\xb8\xe5\x2e\xce\x65\x9c\x62\xe2\xd4\x1b\xb7\xbf\x26\xe4\x55\xf3\x3f\xaa\xba\x78\x1a\x97\x7b\x3f\x89\xa1\x63\xd9\xb7\xbf\x2e\xfc\xf9\x4e\x1f\xfb\xf6\x37\x44\xc4\x0b\x85\xd8\x2f\xf7\x75\x55\x81\x42\xcd\xfd\x98\x32\x01\x0f\xcc\xdc\x72\x37\x45\xde\xd6\x72\x0e\x85\xfb\x28\x57\xa4\x88\x1c\xa7\x17\x6f\xb9\x5b\x42\x72\x9c\x41\x1a\xe4\x96\x3b\x26\x1b\x24\x03\x0f\x23\x61\x49\xe2\xaa\x56\x42\x05\x3c\x42\x11\x5f\xe2\xa2\x8c\x2b\xc0\x9a\xdd\x72\x07\x66\x57\xa0\xa8\xe6\x07\x19\x91\xe6\x11\xa7\xa4\xa8\x50\xe1\xab\xbb\x22\x6a\x7d\xee\xd8\xdc\xa0\x3d\x81\x78\xcb\xdd\x19\xb6\x0b\x09\xc0\xbf\x25\x56\x54\x14\x41\x51\xf5\xdb\x3f\x54\xe1\x6e\x9e\xca\x30\x53\x63\x7e\x70\x9d\x77\x9c\xc7\xbc\xfd\x63\x0b\x17\x40\xe2\xbe\x4b\x84\x93\x38\x8c\x09\xb4\x77\x7a\xcb\x3d\x97\x28\xde\x41\x5a\x46\x1c\x40\x8d\x71\xe4\xe7\x71\x0e\x45\x1c\x6e\xbf\x6b\xe2\xc0\x5f\xc9\xb7\x7f\x2a\x2c\x42\x9d\x43\xf1\xbb\x5b\xee\xb7\x7c\x40\x48\x0a\x6d\x6e\xdd\x8a\x8d\x2a\x94\xed\x6a\x04\x6a\x1b\xee\xb2\x6c\x11\x60\x90\x6e\xc5\x75\x19\xbe\xc9\x02\x60\x88\xad\xa9\x7d\x0c\x05\x6a\x6e\xc5\x7d\x19\x04\x78\x41\xb7\x62\x3f\x2a\x27\xe0\x44\x70\x0f\x25\x04\x9d\xbe\xdb\xbf\x6d\xa1\xd0\x14\x71\xa7\x64\x83\xf7\xf0\xa6\xce\xed\xf7\x35\x04\xb7\x7c\xfd\x40\xc8\x0c\x86\xdc\xb4\xdb\x1f\x2a\x60\x37\x91\x1f\x09\x59\x0e\x6f\x60\xc9\x14\x9e\x47\x5d\x11\x6d\x27\x60\xc4\xd5\x35\x50\x45\x84\x7b\x90\xf6\x29\x55\xa1\xeb\x18\x40\xfe\x47\x69\xf4\xa1\xd6\xff\x49\x4e\x04\x7c\xf9\xe6\x56\x38\x26\xf8\x32\x46\x59\x05\x1e\x0d\xbd\x15\xae\x09\xa9\xb3\xc8\x71\x6a\xe6\x96\xfb\x27\x11\xaa\xd0\x06\xdc\x5c\x7c\xfd\xb1\xf2\xe1\x95\x47\x60\xfc\xf7\xf5\x33\x05\x87\x3e\xbc\x05\x21\x3d\x57\x90\xf0\x75\x88\xc1\xdd\xbf\xd7\x2f\xd4\xe6\xc8\x15\x2e\x68\xb6\x59\x10\xf5\xa5\x82\x4a\xaf\xfb\xe8\x27\x6a\xb6\x7c\x5b\xa7\x22\x50\xb9\xfb\xf2\xcf\xeb\x57\x0a\x5d\xee\xd7\x00\x14\x3e\x8a\x73\xb0\xf6\xad\x5a\xbb\x8e\x62\xa8\x75\x3a\x25\x60\xed\xd7\x4a\xed\x94\x5c\xc6\x50\xcb\xec\x02\x14\x54\xfb\x13\xa5\x36\x0d\xa3\x43\x48\x5f\x15\x46\x1e\x0a\x09\xbf\xfe\x9a\x88\xfe\x44\x18\x3c\xee\xfc\x9a\xfb\x55\x1f\x94\x5b\x7a\x2f\x1c\xc0\xf8\xba\xf0\xa9\xb7\xd8\xdf\x90\x5a\xf3\x12\x58\x61\x4d\xec\xc2\xa2\x55\x67\x6d\x61\x89\x2e\xb1\x16\x22\x29\xeb\x3c\x27\x90\xb4\xbf\x16\x61\x2b\xe1\x70\x65\xa4\x82\xce\xca\xbd\xe6\xee\x99\x74\x52\xf8\xb6\xc9\x06\x03\xda\xfc\x35\x77\xcf\x70\x9a\xb7\xa7\xfe\xf9\x59\x66\x00\xbb\x3d\x3f\xeb\x54\x3c\xaf\xdb\xcd\x35\x00\xc8\xfd\xaf\x3d\x0a\x9f\xe2\xc2\xcf\x30\xf4\x4d\xf3\x5a\xb8\x5f\x38\x0b\x31\x4b\x88\x03\xf9\xc7\xaf\xb9\xc3\xf5\xe1\x87\x00\xec\x37\x84\x6b\x1d\xee\xf5\x7b\xb3\x57\x38\xbe\x86\x8e\xba\xbc\x16\xa7\x69\x51\x63\x19\xd5\x3b\x06\x6c\x56\x30\x74\x35\xe4\xf5\x6f\x59\x95\x8c\x33\x6f\xd8\xb1\x0a\xb8\x5b\xb6\x8f\xcb\x8a\x40\x07\x12\x5e\xff\x8e\x36\xd9\xd5\x1e\xec\x33\xf7\xcc\x1c\x6f\x77\xff\xf8\xb5\xb8\x64\x8c\x8a\xe6\x93\x3f\x87\x84\x45\x84\x8a\x92\x38\x02\xcf\x30\xbe\x16\x21\x22\x1a\x52\x05\x3d\x86\xd7\xdf\x32\x50\xdc\xb2\x21\x8e\xe1\x92\x14\x38\x47\xfc\x9a\xfb\x55\xdb\xba\xda\x90\xc4\x60\x24\x09\x43\x5c\xf8\x1b\x04\x1f\x00\x79\x2d\x02\x44\x90\xd7\xf9\xfa\x4f\x44\xdc\x21\x23\x61\x9d\x80\x47\xf4\x5f\x7f\x5b\x7a\xef\x80\xcb\xf1\xfa\x3b\x0a\x97\x1c\xd7\x88\x5e\x73\xff\xaa\xba\x8a\xe1\x65\xc9\xfd\xaa\x1b\x9c\x00\x1f\xa9\xaf\xb9\x57\xd5\xac\x08\x26\x4d\xd0\x28\xff\x5c\x44\x03\xb6\x80\xc1\x7d\xfd\x17\xc2\x3a\x27\xcd\x28\x2b\x68\x9b\xe4\xf5\x5f\x0a\x89\xb8\x01\x9d\xbb\xd7\x7f\xa5\x7a\xd0\xfe\x15\x4a\xc0\x93\x05\xaf\xb9\x8f\x15\x86\xfe\x65\x5c\x02\x4e\xe0\xeb\xef\x49\x84\x94\x5e\x6c\x82\x0f\x7d\xbf\xfe\x1b\x89\x16\xc5\x65\x48\x2e\x41\x29\xfe\x5b\x89\x84\x52\x0c\xb8\x8c\xaf\xff\x4e\x22\x38\xc7\xf5\x7d\x89\x52\x56\x45\x0c\x05\x32\x5e\xff\x40\x78\x67\x49\xe2\xda\x24\x7c\xfd\x43\x13\x07\x9c\xa6\x1f\x09\xcf\x07\x26\x22\xf6\xd7\x48\x7e\xe3\xf8\xc2\x7e\xcd\x1d\x2d\x04\xc1\xb8\x5f\x85\x6f\x70\x54\x90\x1c\xf4\xf5\x5f\xff\x93\x98\x66\x9a\xbc\xb0\xa8\xc1\x7e\xfc\xb3\x58\x16\x45\xb5\x8f\xd0\x8d\x1f\xa2\xa7\x10\x5f\xe4\xdd\x1e\x8c\x5c\x77\x74\x5e\xff\xab\xfc\xb6\x70\xa1\x7c\xf2\xb1\x8c\x43\xb9\x71\x9e\x89\x4f\x87\xb2\xda\x02\x9e\xef\x27\xcf\x55\xb8\x53\xcb\x7c\xc2\x3d\x2c\x1e\x53\x00\x33\x0b\x7c\xf2\x52\xc7\x01\xb4\xeb\x27\xaf\x04\x7f\x1c\x1e\xf4\x27\xb7\xe2\x53\x05\x50\x28\x9f\x70\x3f\x27\x26\xd7\x7b\x02\x1d\xc4\xfd\x84\xbb\x32\x28\xdb\xe1\x04\xbe\x62\xfe\x89\xd8\x49\x03\x42\xe2\x9f\x70\x37\x26\x4e\xb4\x63\xd1\xe5\x1e\x3f\xd5\x4f\x5d\x96\x7b\xfc\x21\x64\xf9\x3f\xe1\x6e\x4e\x8a\x51\x96\x23\xe8\x56\xfe\x27\x5f\xbf\x77\xdc\x90\xe8\x46\x79\x74\x88\xbd\x07\xa0\xbd\xd0\xfb\x7f\xa0\x38\xb9\x42\x37\xea\xd3\xbc\xed\xa3\xbc\x3c\x4f\xbb\x9a\x9e\xfd\x9d\x14\xc5\x7a\x92\x71\xf5\xe9\xa8\xff\x37\x00\x00\xff\xff\xda\x62\xd1\x2e\xbd\x2b\x02\x00")
+var _staticCssAppCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x7d\xed\xb2\xe3\xba\x8d\xe0\xff\x79\x0a\x4f\x77\xdd\xca\xed\x5c\xc9\x2d\xc9\x96\x3f\xe4\x4a\x6f\x66\xb3\xb3\x3b\xa9\x9a\xcc\x9f\xc9\x56\x6d\xd5\x4d\xef\x14\x25\xd2\x92\xd2\xd4\x47\x44\xf9\xb4\xcf\xf5\x78\x9f\x7d\x8b\x14\x29\x91\x14\x28\xfb\x74\x27\x93\x74\x25\xf1\x21\x40\x00\x04\x40\x80\x82\x48\xea\xb7\x59\x81\x3a\x46\xfa\xd5\xbb\xff\xfd\xc7\xff\xe9\x1f\xde\x9d\xde\xd7\xe8\x25\x45\xdd\xad\x6d\x58\xd9\x97\x4d\x9d\x9c\xcb\x2b\xc1\xa7\xbe\x69\x93\xe0\x44\xc9\xb9\x4f\xa2\x38\x68\xaf\xa7\xae\xcc\x8b\x3e\x09\x4e\x05\x11\x3f\x44\x5b\x8a\xb2\x2f\x79\xd7\x5c\x6a\x9c\xbc\x27\x07\xfe\xef\x94\x36\x57\x9f\x15\x08\x37\x5f\x93\xb2\xe6\x7c\x82\x95\x1f\xb6\xd7\x55\xb0\x0a\x56\xef\x53\x9c\x6d\xb2\xc3\x5d\xb2\x5c\x5d\xe8\x0d\x97\xac\xa5\xe8\x35\x49\x69\x93\x7d\x39\x55\xa8\xcb\xcb\x3a\x09\x4e\x2d\xc2\xb8\xac\x73\x93\x9d\xd6\x6f\x45\xcb\x9b\x2e\x48\x76\xe9\x58\xd3\x25\x6d\x53\xd6\x3d\xe9\x4e\xb4\x64\xbd\xcf\xfa\x57\x4a\x92\xba\xa9\x89\xf6\xb7\xdf\xbf\xb6\x43\xa3\x46\x6d\x5d\xa3\x17\xbf\x42\x65\xcd\xc9\x9e\x69\x83\xfa\x84\x0f\x5c\x8a\xe3\x0f\x23\x0f\xdb\xab\x6a\xe0\x40\xf1\xb7\x14\x53\x36\x6c\xb5\x16\xd9\x87\x37\xd1\xb2\x26\xbe\x14\x76\x7b\x6c\xaf\xa7\x73\x53\xf7\x3e\x2b\x7f\x21\x49\xb8\x55\x7f\x7e\x55\x83\x09\x4e\x59\x43\x9b\x2e\x79\xbf\x8b\x77\x78\x7f\x70\x48\x99\x14\xcd\x0b\xe9\x6e\xba\xfe\xcf\xe7\xb3\xea\x1a\x04\x81\xab\xdf\xb9\xec\x58\xef\x67\x45\x49\xf1\x4d\x1f\x8d\xab\xc3\x9a\x11\x4a\xb2\x9e\x28\xec\x24\x38\xb9\x99\x9e\xd2\xa6\xc3\xa4\x9b\x14\xb6\x62\x0d\x2d\xb1\xb2\xbb\x02\x2b\xf5\xc1\x50\xee\x78\xdb\x09\x18\x1e\x11\x3a\x60\x05\x4c\x9b\xbe\x6f\x2a\x61\x3f\x43\x6f\xfb\xe0\xe1\x00\x8c\xa1\x4b\x72\x42\x12\x4e\xec\xfe\x9e\x95\x98\x18\xd3\x00\xa5\xac\xa1\x97\x9e\xe8\x33\x21\x38\x7d\x2d\x71\x5f\xc8\x19\x21\x6d\x1a\x06\xc1\x0f\x86\x52\x36\xf1\x26\xde\x6e\x46\x92\xab\x75\x8f\x52\x42\x99\xcf\xbd\xd0\x49\x5f\x8e\x2c\x8a\xb8\x3b\x1b\xcc\x04\x7d\x6e\xee\x33\x6d\xbe\xfa\xaf\x09\xba\xf4\x8d\x46\xbc\xac\xcf\x4d\x57\x21\x2e\xf3\x6a\xfd\xb5\x43\xad\x07\x32\x1e\x60\x6a\xd2\x3c\xa0\x39\xeb\xb7\x5a\xf7\x65\x4f\x89\x53\x7a\x4d\x52\xc9\x21\x0e\x2c\xd7\x8f\x03\x6d\x7a\x88\x01\x86\x9b\xf6\xaa\xbc\x87\x3b\x92\xae\xc3\x28\x8e\xe2\xe8\xbc\x28\xd2\x85\x4e\x3e\x29\xe9\x26\xc1\x83\x1e\xef\x7b\x94\x52\xc2\x6e\x0a\x9f\x0b\xc5\x43\x93\x3e\x27\x37\xed\xf5\x01\x15\x1e\x26\x46\x8e\x32\x28\x4c\xa1\x6a\x63\x8f\x5c\x36\x3c\x0e\x49\x76\x18\x93\xba\xc9\x32\x1c\xe2\xe3\x63\x99\xa6\xb9\x6a\x4d\x0d\x35\x7b\x84\xd6\xe7\x73\x6b\xd1\x04\x27\xff\x2b\x49\xbf\x94\xbd\x2f\x68\xb2\xaa\x69\xfa\x82\x8f\x1b\xd5\x7d\x89\x68\x89\x18\xc1\x8f\x25\x03\xc2\xd5\x53\x06\x5e\xd1\x72\xc5\x5a\x54\x4f\xda\x5e\xc5\xc2\x60\xc2\x75\x54\xee\x28\x6b\xa1\xed\x21\x85\x0c\x9e\xc8\xfd\xf9\x54\x95\xb5\x3f\x39\x26\x3c\x65\x00\x8f\x96\x33\xd1\x9a\xf2\xba\x6f\x0f\x93\x74\x71\x34\xb3\x59\xb9\x1a\x5c\xef\x3f\x74\xde\x6a\x00\x46\x04\x82\xfa\xca\xd9\xb7\xe8\x60\x66\x2e\x32\xa7\xd6\x22\xf1\x0b\x1d\xd5\x1b\xcf\x67\x43\xd4\x5e\x1f\xf4\xd6\x72\x71\x64\xe7\xbb\x48\xcb\x89\x49\xb0\x3a\x4c\xc6\x73\xf9\xb6\x83\x83\xe1\x06\x32\x65\x69\x06\x1e\xb3\xe6\xf9\x7c\xbe\xf7\x05\x41\xd8\x70\x36\xb2\x23\x47\x82\xef\x7d\xda\xe0\xd7\x55\x5f\x18\xf3\x63\xcb\x53\x47\xd6\xd4\x3d\xa9\x5d\xd1\x39\x1e\x63\xb2\xb9\x14\x1a\x3d\x45\x45\x52\x15\x47\xfb\xee\x92\xf5\x97\x8e\xc8\x00\x25\x6d\x12\x4f\x2b\x08\x99\x21\x63\xcd\x8b\x07\x27\x28\xeb\xf6\xd2\x8f\xea\x14\xdc\x46\xe2\x45\x89\x31\xa9\xa1\x0e\x83\x2d\x5a\xa2\xa5\xaf\x8e\x50\xd4\x97\x2f\x33\x8c\xd5\x7b\x82\xcb\xbe\xe9\x46\x1e\x41\xd0\x5e\x47\x24\x94\xf1\xce\x4c\xc9\xcd\x07\x1f\x06\xf6\xc2\x47\xef\x90\xf6\xf5\x6d\x32\xc3\x91\xe3\x0e\xc1\x26\x99\x56\x03\x08\x97\x17\xc6\x43\x73\x73\xe9\xdb\x8b\x4b\xc9\x51\x1c\x4e\x99\x6f\xa6\xe1\x79\xa4\x1f\xd3\x97\x50\xba\xa5\x95\x81\xd3\xfa\x7c\xa1\xf4\xc6\x89\x8f\xcc\x97\x34\xf5\x0f\x1f\x7f\xfd\x8f\xff\xb0\xfa\xf5\xea\xbf\x37\x4d\xcf\x7a\xee\x7a\x2f\x9b\xf5\x66\xbd\x5f\xfd\x58\xf4\x7d\x9b\x7c\xfc\x98\x93\x3e\x55\xb0\x75\xd6\x54\x1f\x38\xf6\xef\x9a\xf6\x55\x88\xbb\x8a\x82\x30\xf4\xa3\x20\xdc\xad\xfe\xf8\xb5\xec\x7b\xd2\x79\xab\xdf\xd7\xd9\x9a\x23\xfd\x6b\x99\x91\x9a\x11\xbc\xba\xd4\x98\x74\xab\x3f\xfc\xfe\x8f\x03\x51\xc6\xa9\x96\x7d\x71\x49\x39\xbd\x8f\xfd\xd7\x94\x7d\x1c\x59\x7c\x4c\x69\x93\x7e\xac\x10\xeb\x49\xf7\xf1\x5f\x7f\xff\xbb\x7f\xfe\xb7\x7f\xff\x67\xce\xf2\x23\x17\x74\x55\xf3\x89\x48\xcb\x5f\xc8\x3a\x63\x6c\xf5\xb2\x59\x07\xeb\xcd\xea\x3f\x05\x69\xc9\x6d\xf5\x9f\x2b\x8d\x76\x4d\xb2\x86\x22\xf6\xd1\xec\xf7\xeb\x8f\xff\x50\xf4\x15\x1d\x66\xc4\x19\x55\x25\x7d\x4d\x18\xaa\x99\xcf\x48\x57\x9e\x4f\x7e\xc5\xfc\x9e\x5c\x87\xd4\xe8\x23\xfc\xe7\x0b\x93\x8b\x07\x95\x16\x40\xe8\x9d\x4f\x34\xe9\x44\x49\x70\x47\x5d\x5f\x66\x94\x78\x88\xc7\x11\x0f\x93\x1e\x95\x94\x79\xe7\x32\xcf\x50\xcb\x4d\xc0\x7f\x5e\x3a\xe2\x9d\x9b\x86\x6b\x8d\x4f\x5f\xfe\x7f\x3c\xb8\xb6\x1e\x5f\xc7\x79\x15\xa9\x2f\x5e\x8d\x5e\x3c\x46\x84\x87\x7a\xec\x52\x55\xa8\x7b\x35\x9f\x1f\xee\xe8\x82\xcb\xc6\xcb\x50\xfd\x82\x98\xd7\x76\x4d\xde\x11\xc6\xbc\x97\x12\x93\xe6\x06\x66\x8b\x17\xc2\x45\x43\xd4\x47\xb4\xcc\xeb\x24\x45\x8c\xf0\xf0\x35\x10\x4a\xea\xa6\xff\xf1\x67\x1e\x1a\xba\x86\xb2\xcf\x1f\x46\x12\x7c\xaa\xaa\x5c\x10\xdc\x7f\x1e\xe6\xe5\x67\xaf\x27\x55\x4b\x51\x4f\x0c\xbc\x3b\xd2\x22\x91\x3f\x44\xa9\xbe\x43\x35\x6b\x51\x47\xea\xfe\x8e\x12\x3e\xe7\x5e\x88\x87\x64\x92\x6c\x2e\x3d\x17\x81\xab\x2d\x4d\xbb\x9f\x45\xe4\xff\x7c\x93\x73\x49\xce\x07\xfe\x40\x85\x9b\xbe\x27\xf8\x9e\x7a\xac\xef\x9a\x3a\xb7\x73\xfe\x1d\x9f\xeb\xa1\x4d\x3c\xf7\x24\x65\x8f\x68\x99\xdd\x8b\x50\x36\xf2\xe0\x1e\x91\x4a\xce\xec\x64\xbd\xdb\x93\x6a\x15\xdc\x2b\xd4\x7d\x31\x42\xe7\xf9\x3c\xc6\x56\xfe\x58\xc1\x2a\x44\xa5\xb7\x70\x97\x48\x0e\xc1\x0f\x77\x76\x49\x3d\x76\x69\xb5\xd6\x7d\xfc\x83\x91\x06\x82\xd3\x6c\xb6\x39\x55\xcf\x29\xf1\x29\xeb\xaf\x63\x52\x71\xda\x37\x39\x68\x7f\x1d\xf1\x96\xb2\xca\xa5\x36\x92\xe0\xce\x5e\x72\x61\xa5\xa4\x6b\x9a\xfe\xc3\xcd\x0a\x95\xf7\xc1\xaf\x94\x23\x86\xa4\x5a\x6d\x79\xf0\x2a\xba\x9b\x78\x4c\x2d\x7f\xe1\x2b\x19\x19\xfb\xfd\xb4\x19\x97\xf4\xc1\xbd\xed\xc8\x44\x8d\x07\x98\x7b\xd6\x60\xe2\x7d\x49\xb1\xd7\x76\xc4\x63\xa8\x6a\x8d\x39\x53\x35\x75\xc3\x5a\x94\x11\x6f\xfc\xa5\x67\x51\x52\xdd\xd3\x4b\xdf\x37\xb5\x27\x02\xad\xd7\xb4\xfd\xe0\xdd\xc3\x92\xcd\xe3\xb3\x08\x75\x04\xdd\x06\x55\x97\x75\x41\xba\xb2\x17\x14\xc6\x3f\xe4\x28\x02\x49\x69\x12\xef\xa5\x64\x65\x4a\x89\xe2\x30\x90\xbc\x71\x92\xbe\xf0\x34\x9e\x50\x07\x5f\x94\x18\x7c\xc6\xaf\x84\x20\x3f\xf7\xaf\x2d\xf9\xcd\xd0\xfc\xd9\xd3\x9a\x3a\xc2\x48\x6f\xb4\xb0\x4b\x5a\x95\xfd\xe7\x9b\x9a\xf8\x3c\x8a\xa2\x0e\xd5\x19\x49\x86\xfe\xd6\xea\x55\x32\xfb\x19\x97\x0c\xa5\x94\xe0\xcf\x3a\xdb\xb1\xf1\x26\x3b\x61\x72\x46\x17\xda\xcb\x4e\x49\xe2\x57\xcd\x2f\xfe\xb9\xc9\x2e\xcc\x2f\xeb\x9a\x74\x83\x24\xf3\xf6\xd1\x15\xa6\xc4\x70\x17\xa8\x37\xdd\xff\x86\x98\x77\xd7\x46\x93\x15\x24\xfb\x92\x36\x57\x73\xd0\x08\x97\xcd\x67\xdd\x37\xc6\x69\xa7\xad\x62\x74\x32\xf5\xa5\x4a\x49\xf7\x39\x49\x94\x56\x84\xb0\x3e\x6b\xcb\xda\x97\xca\x5e\xc2\x6e\x2e\xbd\x89\xad\xb2\xb2\xf0\x38\xad\x27\x23\xa8\xcb\x0a\x50\xf9\xdc\xce\xe7\x92\x50\x7c\xd2\xe4\xd6\x7c\x1a\x20\x33\x09\x30\x34\xf8\x19\xa7\x44\x01\x89\x5d\x1d\x30\xc9\x9a\x4e\x2c\xd2\x20\x91\x84\xaf\x09\x99\x18\xe9\x95\x85\xa6\x07\x7e\x56\xd2\x17\xd2\xa9\xc8\x13\xac\x22\x6d\x8d\xb8\xde\xc4\xa4\x5a\xad\x77\x7c\xaa\xaf\xd6\x7b\x3e\xe1\x29\xc9\x49\xad\x1e\xd9\x93\xe0\x3e\xce\x15\x73\x7e\xaa\x29\x35\x0b\x84\x62\xdd\x2d\xbb\xf3\x00\x4c\x51\xcb\x48\xa2\x7e\xa8\x55\x0a\x9f\xb2\x83\x79\x07\x7e\x5e\x8f\xbd\xbe\x18\x57\xc6\xc1\x5d\x24\xdd\x7f\x6f\x2e\x5d\x46\x92\x15\x90\xbb\x8b\x38\x6d\x3f\x72\x17\x8f\xfd\xb4\x29\x29\xe9\x44\x2e\x30\x72\x38\xeb\xb2\x8f\x19\x63\x1f\x79\x4a\x53\xd9\xf7\xb7\x4a\x7f\x5f\xc8\xeb\xb9\x43\x15\x61\x2b\x95\xb8\xfc\x14\x75\x3e\xeb\xbb\xb2\x25\xec\x16\xfc\xa0\xe7\x91\x31\x9c\xf2\x78\xb6\x0a\xee\x7d\x03\x42\x83\x55\x70\xbf\xff\xf6\x6f\x47\xb9\x22\xb8\x44\xab\xb6\x2b\xeb\xfe\xf6\x6b\x2f\x41\x67\x9e\xbe\x93\x94\x9c\x9b\x8e\x68\xdd\x92\x60\x15\xfc\x63\x59\xb5\x4d\xd7\xa3\xba\xd7\xd2\x89\xd6\xa8\xd5\x0d\xb9\xfb\x68\x10\x6e\x6f\x18\x74\x47\x1e\x12\xd1\x8f\x3f\xf3\x0a\xb4\xc9\x2f\x13\xb1\xd2\xe2\x41\xe0\x8e\x7e\x2e\x3a\x72\xfe\x3c\x88\x77\x93\x53\x23\x79\xb7\xfa\xf1\xdd\x0a\xf5\x7d\xf7\x23\x87\x7e\x58\xbd\xfb\xf0\x4e\xcf\xb8\x4e\x6c\x01\x96\xe8\x82\xf0\xff\xfd\xcd\xbb\xf7\xef\x24\xbe\x37\x36\xfd\x19\xbd\x20\x96\x75\x65\xdb\x27\xef\x66\xc4\xde\xdd\xc5\xf2\xe3\x2f\x97\xa6\x27\x3c\x9f\xcc\xe7\xc8\xfb\xe3\xf1\x28\x1f\x64\xd4\x6a\x42\xf8\xb1\xcf\x9b\x48\xe7\x73\x7b\xb4\x3a\x95\xb2\xca\x39\x25\xaf\xef\x6e\x2d\xca\x89\x9f\x76\x04\x7d\xf1\xcb\x9a\x2f\xb9\x12\xf4\xd2\x94\x58\x64\xce\x0a\x5d\xe5\x5a\x9d\xaf\xe2\x34\x55\x16\x91\x57\x6c\xbc\xf6\xd6\x74\x6d\x81\x6a\x96\x6c\xf8\x73\x6f\xf3\x95\x25\x9b\x01\xa4\x53\x15\x43\x95\x44\xd7\xb2\x7c\x6c\xac\x79\xd6\x69\x5f\x7f\x5a\x67\xa8\x23\xbd\xb7\xc6\x5d\xd3\x5e\xda\x4f\x5a\x9b\x9a\x8c\x7d\xd3\xfa\x90\x33\xdc\xd7\x14\xa5\x84\x02\x5a\xe1\x4b\x90\xf5\xf2\x84\xd6\xc9\x08\xcc\x55\x8f\x3d\xf5\xab\xd0\x9d\x59\xb2\x3e\x9f\xcf\xb3\x3e\xfe\xc0\x9a\xe0\xa9\xb3\xd6\x54\x00\x92\x61\x8c\x35\x2a\xf7\xdf\xca\xc5\x41\x46\x8c\x65\xc2\xaf\xfe\x17\x7d\x6d\x8b\x32\x6b\x6a\xb6\xfa\x17\x44\xcf\xb4\xac\x73\xf6\xab\x13\xeb\xb2\xe4\xd2\xd1\x1f\xd7\xeb\x8f\x1c\x5b\x7f\x06\xc8\xc7\x0e\x7e\xa1\x3a\xf8\x1d\xc9\x2f\x14\x75\x6b\xd2\xf4\x1f\xbe\xa7\xf3\x7f\x7b\x5f\x92\x73\x79\xfd\xb0\xe2\x4b\x04\xd4\xff\xf8\x8e\x54\x29\xc1\x98\x60\xbf\x69\x49\xdd\xbf\xb6\xe4\xdd\x07\xef\x5b\x68\x7f\x6d\xce\xe7\x68\x22\x2b\xfe\xfc\x0e\x52\x26\xa5\x6f\x24\xd4\xf7\x1a\x9d\xbe\xbb\x90\xef\x18\x1f\x7b\xc9\xdf\x4f\x08\xff\x31\x22\x48\xf8\xc4\x87\xbd\xe4\xef\x3e\xdc\xd7\x23\xee\xfc\xb1\xf3\xc4\x17\xbb\xa1\xab\x9c\xf5\x84\xf3\x68\x8b\xfc\x61\x9d\x63\x54\xc6\xb7\x41\x60\x2c\xc5\xc3\x27\x0a\x7a\x27\xb1\xca\x6a\xd8\xd5\xc6\xc9\x3b\xf4\xca\x32\x44\x89\x36\x22\x5f\x3c\x9f\x96\xec\x8b\x8a\xfb\x63\x94\xfb\x53\x10\x44\xe8\x9d\x8e\xda\xd2\x0b\x03\xd1\x52\x03\x8d\x5c\x3a\x89\xe5\x99\xad\xcd\xbc\x73\x14\xa0\xcc\xe8\x5c\x95\x35\xc4\x24\x8a\xc2\xc8\xc0\xcb\x68\x73\xc1\x00\xde\x2e\x08\x0d\x3c\x52\xbf\x10\xda\xb4\x04\x40\xdd\x07\x47\x03\xb5\x25\x75\x56\x52\x10\xf1\x6c\x20\xe6\x14\x31\x40\x46\x12\x58\xbc\xab\x0b\x2b\x33\x10\xcf\x1c\xcb\xb0\x4c\x03\x11\x37\x06\x62\x41\x50\xd7\x83\x78\xb1\x49\xb0\x47\x1d\x88\xb6\x9b\xa1\xf9\xa4\x6a\xfb\x57\x10\x79\x6f\x20\x5f\x18\x81\x69\x1e\x0c\xb4\x73\x49\x2b\x10\xcd\xd4\x75\x5f\xf8\x14\x75\x39\x60\x16\x12\x84\x81\x85\x0a\x22\x99\xaa\xe6\xf4\x4a\x06\xea\xc6\x72\x9c\x06\xf0\x74\x12\x84\xa6\xa2\x3b\x52\x35\x2f\xb0\x70\x5b\x03\xf1\x97\xa6\xa9\xfc\xb2\x06\x31\x4d\x9b\x08\xcc\xe6\x02\x8b\x68\xda\xa5\x39\x9f\x41\x2c\xd3\x20\xac\xcc\x6b\x04\xb8\x2b\x09\x42\xd3\x24\x59\x93\x83\x58\x96\x45\x3a\xc4\x40\x1f\x8c\x4c\x73\x14\x4d\x05\x2a\x26\x32\x0d\x72\x2e\x29\x8c\x66\x5a\xa3\x2f\x1d\xd4\x2c\x7b\x34\x08\x98\xec\x24\x88\x4c\x6b\xe0\xe6\x6b\x4d\x1b\x84\x7d\x44\x41\x3d\x47\x31\x88\x0e\xa2\x9a\x26\xb9\xb4\x4e\x44\xd3\x2a\x65\x9d\x36\x57\x10\xcf\x34\x0a\xaf\x30\xf9\x59\xd9\x65\x0e\x35\x99\xc6\xe9\x48\x4b\x10\x38\xa4\x8d\x69\x9d\x8e\x9c\x3b\x02\xdb\x71\x63\x1a\x88\xbf\xa9\x71\xe9\x69\x63\x1a\x89\xa7\x32\x10\xcd\x34\xd2\x99\x22\xd0\xd1\x36\xa6\x91\xf8\x12\xb8\x2d\x9a\x9a\x80\x21\x74\x63\x9a\xe8\xa5\xa1\x97\x8a\xb8\x66\xc4\x66\x07\x21\x73\xb3\x82\xd8\x7b\x08\xfb\xd2\x82\xb8\xa6\xb5\xfe\xd2\xf1\xfa\x11\x88\x68\x1a\x2a\x45\x4e\xcc\xad\x69\xa9\x1e\x56\xd6\xd6\xb4\x52\x8f\x72\x50\x4d\x5b\xd3\x42\x69\x03\x87\xb5\xad\x69\x21\x8e\xc6\x4b\x84\x20\xaa\x69\x25\xf1\x54\x08\xe2\x99\x06\xca\x50\x45\x3a\x04\x22\x9a\xc6\x39\x37\x0e\x7a\xa6\x55\xd2\x86\x82\xd3\x6c\x6b\x1a\x64\xa8\x87\x82\x88\xa6\x41\xc4\x73\xa5\x5c\x3c\x01\xd8\xb1\x65\x14\x8e\x3d\x3c\x60\x41\xc8\xa6\x6d\x44\xe5\x73\x78\xa9\x02\x21\x9b\x16\x1a\x90\x33\xc2\x37\xb8\x80\xe8\xa6\xa5\x06\xf4\xce\x29\xb6\x69\xac\x01\x9b\xd7\xef\xcb\x33\x98\xcb\x63\xd3\x68\xae\x54\x19\x9b\x26\x2b\x6b\xcc\xab\x50\xce\x11\xee\x21\x6c\xb7\xcc\xa6\x05\xcf\x28\x23\x3c\xfa\xfb\xa2\xbe\x0f\x76\x30\x2d\xd9\x96\xe2\xa5\x1c\x84\xb9\x33\xad\x58\xa1\xd6\xe7\x6e\x0e\x6b\x7a\x67\x19\x66\x78\x2b\x02\x21\x9a\x26\xe9\x1d\x13\x62\x67\xda\x82\xbf\x9f\x03\xd1\x4c\x13\xb0\x02\x39\xc6\x62\xda\x40\x54\x3a\x41\x3c\x53\xfb\xae\xf5\xca\xce\xd4\x3a\xeb\x49\xeb\xf3\x87\xe8\xaf\xa8\x03\xe7\xd9\xce\x54\xfa\x19\xb1\x7e\x11\x7f\x6f\xaa\x7e\x11\xd5\x9c\x3e\x3c\x03\x82\x68\xa6\x7d\x5a\x74\x61\xe0\xc8\xf6\xa6\x79\x18\x7f\x10\x83\xd0\x4c\xf3\x9c\x9b\xce\x29\x9f\x69\x21\x31\xf4\x25\x74\xd3\x50\x42\xb3\x4b\xe8\xa6\xbd\xc8\x9f\x49\x06\xfa\xc9\xde\x34\x58\x56\x90\x97\xae\x91\xef\x6e\x21\xf4\x23\x88\xee\x9c\x85\x07\xd3\x5e\xfc\x91\x4e\xac\x24\x41\x5c\xd3\x60\xe2\xd1\xcc\x8d\x6c\x9a\x6d\x58\x41\xbb\xb1\x4d\xe3\x35\x5f\xdc\x98\xa6\xfd\xfe\x72\x21\x8c\x17\x08\xdd\xf8\xa6\x15\xf9\x86\x04\x37\xae\x65\xc2\xac\x23\xa4\x66\x45\x03\x6b\x6e\x0f\x0d\xd0\xbd\x84\x3b\x98\x86\x6c\xbe\x2c\x2c\xf7\x0e\xa6\x15\x53\x54\x2f\x20\x1f\x4d\x13\xa2\xae\x6b\xbe\x3a\xfd\xe3\x68\xda\x70\x40\x76\x7a\xc7\xd1\x34\xe2\x80\x0d\xaf\x90\x8e\xa6\x05\x07\x54\xd7\xd2\xeb\x68\x1a\x51\x04\x3f\xd7\xe2\xf3\x68\x1a\xb0\x23\xfc\x1d\x9c\xcf\xf7\x02\x80\xd8\x3b\x08\x5b\xbc\xe7\x04\xd1\xad\x59\x78\xcd\x28\xaa\xd0\x92\x43\x85\xd6\x43\x7d\x5e\x82\x8a\x0e\x03\x53\x75\x94\x20\x68\xc9\x1a\x06\xa6\xda\xce\x25\x98\x05\xc2\xc0\x54\x18\x79\x25\xa2\xa6\x07\xa2\x9a\xfa\xe2\xa8\x19\x6d\xc0\x98\x19\x5a\x05\x80\xaf\xa8\xab\xf9\x86\x1c\xe7\xd0\x4d\x6d\xb5\x14\xd5\x30\x59\x2b\x66\x21\x4a\x6a\x0c\x96\x20\x42\xab\xe6\xd2\xa1\x1a\x37\x50\xc1\x20\xb4\xaa\x00\x59\x53\x55\x04\x4c\xc0\x61\x68\x1a\xa8\x42\x79\x4d\x60\xc4\x08\x8c\x95\xa0\x7f\x87\xe1\x06\x44\x76\x78\x78\x68\xd5\x05\x3a\xd2\x7f\x25\x0e\x29\x4c\x7b\xb1\xa2\x69\x5b\x6e\x84\x0c\xae\xed\x84\x56\x71\xe0\xdc\x50\x51\x38\x77\x99\x38\xdc\x43\xe8\x2e\xe7\x09\x0f\xd0\xf4\x51\x2f\xf7\xc1\x1e\x96\x01\x87\x1e\x45\xd3\x95\xbf\x34\x75\x0f\xf7\xb1\x4b\x08\x18\xca\x90\xa1\x55\x41\x48\x2f\x94\x16\x4d\x07\x8a\x6d\x55\x11\x52\x02\xce\xf6\x30\xb2\x6c\xc8\x87\x75\x2e\x33\xd4\x83\x9a\xb3\x8a\x09\x7d\x71\xa9\x52\xe6\xf0\x8e\x28\x86\x70\x5d\xce\x11\x99\x26\x2c\x50\x8d\x9d\x31\x38\x8c\x4c\x03\x0a\x64\x47\x74\x0f\x23\xd3\x7c\x02\xd7\x21\xb0\x69\x36\x81\xe9\x12\xd7\xaa\x29\x0c\x99\xe8\x41\xea\x08\x37\xa1\xbb\x93\x4b\xfc\x4d\xe4\xee\x03\x0f\x63\xb3\x71\xf7\x70\x0e\xc7\xb4\x6b\x4e\x9b\x14\xb4\xbf\x55\x7a\xf8\xda\x91\x1a\xac\xca\x86\x56\xd9\xa1\x47\xec\x0b\xf4\x90\x1e\x6e\xac\xa9\x58\x52\xf8\xe1\x2f\xdc\x98\x66\x4c\xbb\x92\x9c\x33\x04\xcf\xef\x8d\x69\x48\x9e\x17\x87\x75\x0b\x84\x6c\xd5\x1c\x30\x62\x45\xda\xc0\x0b\xd4\xd0\xaa\x3c\xb4\xa8\x25\x5d\x46\x4b\xd0\x0c\x56\xf9\x41\xd4\xa5\x9d\x95\xe4\xd0\xaa\x42\xd0\xb2\x86\x2a\x10\xe1\xd6\xb4\x93\xa8\x11\x81\x78\xa6\x9d\xda\x0b\x2b\x5a\xb0\x04\x1b\x6e\x4d\x43\x5d\x18\x3c\x70\x53\xfb\x79\x0a\x0f\xd9\xd4\x3b\x6b\xe0\x68\x6d\x15\x14\x38\x9a\x9f\xbe\xfa\x88\xb6\x05\x4a\xe1\x84\x10\x87\x8b\x5d\x1c\xeb\xa4\x30\x8e\xc0\x6e\xc3\xab\x4d\x08\x7f\xe3\xc6\x77\xf2\xd8\x82\x7d\xf8\x9b\xf4\x32\xbd\xf4\x60\x09\x2f\x8c\xe3\x07\x9d\x9c\xdc\x2c\x73\xd5\xe2\xe1\x97\x80\x46\x8b\xcd\xb9\x45\xae\x2d\xaa\x61\x44\xd3\xba\xea\x3d\xb3\x33\x56\xc7\x47\x18\x1f\x8e\x47\x56\xe5\x81\x36\x39\xfc\x36\x20\xdc\x99\x46\x3e\x53\xb8\xda\x1e\x5a\xd5\x09\x4e\x10\x7e\x69\x10\x5a\xe5\x89\x9a\x7c\xf5\xbf\x96\x35\xdf\x66\x01\x21\x9b\x86\xec\xf8\x46\x0b\x50\x5d\x3b\xcb\x78\x08\x2c\x2b\x84\x3b\xd3\x54\xae\xe5\xc5\xce\xb4\x12\x43\x2f\xb0\x35\xad\x2a\xc5\xf0\x26\x1e\x44\x34\xad\x43\xae\x2e\x44\xab\x2e\xc1\x08\xec\x1d\x7b\xdb\x2c\x4d\xdb\xbe\xfa\x18\x7c\x1f\x4a\xc2\x7d\x04\x61\x3b\x47\xb5\xdf\x40\xe8\xce\x77\x4b\xe1\x7e\xeb\x22\x0f\x62\xc7\x10\xb6\xcb\x12\x56\xb5\x22\xeb\x78\x9d\xca\xcf\x1c\xb9\xc0\x2a\x56\x0c\xfb\x10\xe1\xb0\x62\xd7\x2b\x2e\x3d\x25\x1d\x98\x06\xac\x52\x05\x7f\x0f\x00\x93\xb4\x6a\x14\x59\x53\xb5\x7c\xdb\x13\xac\xe4\x83\x69\x41\x82\x3a\x67\xe2\xb0\x4a\x14\x02\xcf\x15\x8b\xac\x02\x45\xdf\x7c\x75\xc8\x6a\xda\x8c\xf5\xa8\x07\x83\xa2\x55\x96\x60\xd8\x59\xf7\x0c\xad\xaa\x44\xb1\x84\x6a\xcd\xaf\x4b\x2a\xf6\x37\xc1\x12\x98\x86\x62\x62\x47\x18\x7f\xf9\xef\x20\x6d\x5a\x6b\x40\xc7\x0d\x4d\x41\xdb\x5a\x35\x89\x01\x3b\xf6\x43\x10\xd7\xb4\xd8\x80\xbb\x73\xe0\x9a\x26\x1b\x70\xf7\x0e\x5c\xd3\x64\x99\xda\xd9\xef\x3b\x5e\x79\x84\x56\x55\xa2\x23\x79\xc9\x37\x8f\x88\x6a\x80\xb3\x8f\x69\x47\xb1\x0d\x61\xe9\x45\x62\x78\x34\x4d\x34\x74\x70\xbe\x4e\x0c\x8f\xa6\x95\xfa\x8e\x10\x3f\x6b\xea\xf2\x0c\xbb\xdf\xd1\xb4\x92\x40\xc7\x24\x2b\xf1\xa5\x81\xb6\x51\x90\x28\xb0\xe6\x16\x24\x44\x64\x95\x3c\x78\x04\x72\xbd\xd0\x8d\xac\xba\x07\x8f\x3f\x6e\x5c\xd3\x42\x94\xbc\x10\x0a\x2f\xf4\x23\xab\x00\xc2\x8d\x09\xa2\x99\xe6\x68\xf9\x6e\x16\x10\xcf\x9c\x53\x88\x12\x30\x6d\x44\x56\x79\x82\xfc\xe5\x22\x0e\x69\x40\xba\x8f\xac\x0a\xc5\x17\xbe\xc5\x06\x42\x33\x95\xf9\x97\x0b\xbc\x56\xb7\x37\xb8\xb4\x08\x5c\x9f\x44\x56\x5d\x22\x2d\x79\x01\x01\x44\x34\x35\xf8\xa5\x76\x3c\xb9\x45\xa1\xa9\xc3\x14\xa5\xaf\x3e\xdf\x00\x75\xa1\xd0\x7b\xbd\xc8\xaa\x47\xf4\x60\x55\x26\xda\x9d\xcd\xbd\x43\x29\x45\xd9\x17\xd7\xb3\x47\x64\x95\x21\x52\x30\xd4\x47\xa1\x69\x1c\xd4\xb6\x90\x9b\x9d\x0f\x67\x73\xbb\x0e\xe9\xe0\x47\xa9\xc8\x2a\x38\x14\xcd\xa5\x73\x6c\xed\x89\x36\xa1\xb9\xc7\x89\xa2\x0a\x54\xba\x55\x71\xc0\x97\x96\xba\xea\x0d\x91\x55\x6f\x68\xcb\x3c\x7f\xf5\x53\x04\x3e\x1c\x45\x56\xc1\x81\x65\x25\x63\x4d\x07\x4e\x71\xab\xda\x90\x96\x7d\xd6\x94\x35\xb0\x25\x2b\xed\x33\xa0\xf5\x9a\x82\x5e\x62\x95\x25\xfe\xdc\xbe\x02\x7d\x5f\x21\xd7\x0e\x02\x64\x0a\xdf\x5d\x52\xa0\x6f\x77\x49\x21\x73\x46\x41\x8a\x8d\xde\x62\x07\xdb\x1c\x8f\x44\x56\xd9\xa2\xcc\x88\x4f\x1b\x4a\xc1\xb8\xb1\x09\x61\x5c\xbf\xe7\x11\x04\xf4\x3e\xab\x58\x41\xf0\x25\x13\x85\x63\x10\xd7\x74\x83\x46\x9c\x93\x5a\x2e\x92\x45\x9b\x2d\xd4\x67\xa1\x14\x17\x59\x05\x0b\x7e\xca\xca\x2f\x50\x95\x5e\xba\x1c\x8e\x58\x56\xe1\xa2\x6a\x30\xa2\xee\x87\x86\xc8\xaa\x5f\x34\xd0\xe6\x38\x12\x59\xc5\x8b\xbc\x43\xe0\x13\x50\x64\x15\x2e\xd8\xa5\x16\x93\x0d\x7c\x86\x8c\xec\xcd\x12\xea\x98\x1a\x88\x6b\xda\x52\xbc\xf1\x1f\x36\x07\x43\xc8\xa6\x15\x05\xb2\xb6\xd5\x1d\xea\x61\xd9\x32\xe5\x6f\xee\xe4\x2b\x77\xf8\x9d\x63\x64\x55\x32\x8c\x2e\xf2\x38\x14\xd4\x2b\x76\xf7\x5a\x76\x9d\xed\xce\xdd\xd3\x51\x7b\x8b\xb6\x7b\x77\x9f\x25\xa7\xdb\x1e\xdc\xfd\x5c\xc5\xc1\xc8\x2a\x9e\xf4\x5d\x89\xea\x9c\x12\x77\x87\x38\x80\x3b\xb8\x46\x13\x87\x30\xbe\x5b\xdb\x71\x04\xf7\x70\x98\xd4\xaa\x9e\xf0\x7d\xc3\x0d\x1c\x86\xac\xbd\x19\xec\xd2\x92\x4e\x1e\x25\x80\xb0\x4d\xa3\xb3\x4b\xba\x80\x6b\x4d\x5f\x3e\xdf\x9d\x0a\x31\xcd\x2b\x62\x83\x5b\xdb\xa6\x4d\x05\xb2\xa3\x3e\x12\x59\xf5\x11\x81\x0b\x2f\xe1\x78\x6d\xc4\x3e\x56\xe2\x2d\x9c\x8a\x82\x0f\x6e\x89\xcd\xcc\x30\x08\x6c\xbd\x4f\x07\x62\x45\xc0\x10\x07\xa0\x15\x8b\x1e\xb5\x7e\x51\xe6\x05\xe5\x9a\x00\x8e\x73\x8a\x83\xaf\xfa\xa6\xed\x77\xff\x42\xe8\x0b\xe1\x27\x1d\x57\xff\x46\x2e\xe4\x9d\x37\xfe\xed\xfd\x53\x57\x22\xea\x69\xa7\x6d\x35\x9e\xf6\x41\xfa\x70\xbd\x8d\x0e\xf1\x5e\x9d\x9d\xd9\x6c\x36\x27\xf0\x24\x83\x79\xcc\xd0\x3e\x5d\xa8\x0b\xa6\x9f\x31\x1c\x98\xaa\x16\x9d\xaf\x6c\xbb\xab\x93\x89\xef\x51\x80\xb7\xbb\xc3\xc9\x3e\x6d\x23\x8e\x7d\xa0\x44\x9c\xd8\x1b\x8f\xb2\xca\x2e\x7b\x94\xa2\x4d\x7c\x5a\x38\xa0\x23\xfb\x41\xe7\xf3\xe6\x20\xfe\x6c\x00\x34\x0f\x87\xf7\x86\xf6\xf1\x10\x2d\xbf\xd0\x80\x9f\xa5\x5b\x29\xfb\x09\xb0\xdf\x89\xd7\x65\x5c\xba\x93\xc4\xf4\x9b\xf3\x99\x91\x3e\xf1\xf9\x7d\x07\xe6\xb1\xd1\x40\x1c\x9c\xb1\x8e\xab\x56\x25\xc6\x7c\x1b\x7c\x59\xe5\xfc\x9d\x57\xdb\xd4\xac\x7c\x21\xb3\xeb\x8c\xf4\xc3\x36\xea\x70\x29\x97\x47\x76\xe4\x19\x83\x8c\xb7\xd1\xf0\x11\x5c\x58\xb2\x6b\xaf\x03\x58\xbc\x3f\xaa\x51\x39\xdd\xcf\xe0\xf2\x0a\xd0\x15\x4e\xe0\x21\x95\x93\xc9\x6c\xab\xfb\x36\xf7\x63\x79\x32\x9f\xd2\xd5\x3a\x62\x2b\x82\x18\xf1\xcb\xda\x6f\x2e\xfd\xc9\x6f\x1e\x61\x3c\x00\x83\x07\x1c\x1e\xe9\x68\x78\x41\x63\xa9\x28\x0e\x7e\xb8\x17\x9d\x34\x90\x08\xb8\x91\x76\x4b\x81\x0c\xd9\x11\x74\x19\x81\x3c\x6c\xa1\x14\x42\x08\xb9\xaf\x59\xe7\x37\x35\x7d\x05\xee\x25\x90\x82\x69\x77\xfd\x8c\x5c\x12\x5f\xbb\x00\x2a\x09\x66\xb7\x34\x88\x17\x1f\x1d\xc9\xfa\x1f\x03\x4f\xfc\xfb\x30\xca\x32\x72\x1c\x0e\xb2\x22\xbe\x6a\x95\x87\xc0\x01\x88\xc0\x99\x64\xe3\xf5\xa1\x32\xd3\xaf\x39\xd1\x54\xa6\x64\x0b\x4e\xf6\xe1\xe0\x41\x1e\xa1\xd6\x9f\xbb\x86\x8e\x67\x7e\x6f\xd6\xa1\xdd\x75\x11\x7a\xeb\x22\xf2\xd6\xc5\xc6\x5b\x17\x5b\x6f\x5d\xc4\xde\xba\xd8\x79\x45\xe8\x89\x63\x60\x5e\xb1\xf5\x8a\xd8\x2b\x76\xee\x58\x22\x0f\x9e\xc4\xf6\xc1\x93\x75\x28\xa3\x97\x8a\x29\xeb\x22\x5c\xad\xc5\x46\x0b\x8f\xff\x54\xbf\xa2\xa9\x31\x1a\x1b\x37\x53\xe3\x66\x6c\xdc\x4e\x8d\xdb\xb1\x31\x9e\x1a\xe3\xb1\x71\x37\x35\xee\x64\xe3\xc4\x7c\xe4\x3d\xb1\x1e\x39\x4f\x8c\x47\xbe\x13\xdb\x91\xeb\xc4\x74\xe4\x39\xb1\x54\x1c\x6f\xcb\xc7\x72\x54\xb0\xdc\xef\x0d\x23\x28\xc5\x3f\x70\x76\x9e\xa4\xbe\x57\xa1\xdf\xaa\x11\x2d\x57\xee\xe2\x1f\xee\x86\xdb\x28\x6f\x71\x5c\x28\x62\x4a\xff\x7d\xf6\xfc\x2e\xb3\xa8\x6b\x0b\xb8\x0e\x3d\xe3\x92\x84\x8d\x88\xc7\xdc\xf9\x23\xbd\x75\x90\x78\xe3\x15\x1b\xad\x95\x5f\x77\x23\xc6\x5f\x6c\xb5\xd6\xf0\x20\x5a\x63\xaf\x88\xf5\xd6\x01\x77\x37\xce\xa5\xe9\xbe\x9d\x56\xaa\x8b\x9f\x9b\x5d\x0d\x96\xa5\xfc\x4a\x1b\x53\x69\xc2\x0b\xb4\x9e\x3b\xeb\x2e\xb9\xcd\x6c\x02\x6e\xd5\x89\xdd\x1f\xa7\x2b\x5b\xf6\xbb\x43\x7b\xfd\x70\x1b\x18\x4c\xd4\xa2\xb0\xbd\xde\xef\x52\x55\xb6\x9e\x0e\x5c\x4f\xbc\xbe\xe9\x59\xd7\x45\x8c\xd9\x27\x3b\x1f\xc8\x66\x3a\xd4\x1d\x91\xea\xbe\x16\xf9\x9f\xaf\x36\xf9\xb9\x6a\xf1\x87\x58\xf3\x8b\xab\xf6\x24\x54\xac\x2f\x75\x98\x68\x90\xc0\x61\xeb\xb3\x0e\x1d\x5a\x24\x58\x6e\x5e\xd6\xe1\xb2\x49\x22\xd4\x0d\xbf\xfa\xe5\xf6\xb5\x28\x7b\x22\x8e\x7b\xf3\x83\xea\xbc\x49\xc2\x29\xaf\xd0\x67\x88\x11\xfb\xea\x84\x11\x70\x5f\x97\x75\x29\x4e\xc9\xb1\xca\x1b\x3a\x5d\xda\x16\xee\x34\x02\x24\xf5\x0c\xb5\x62\xcb\xf9\x2f\x33\xcc\x09\x22\x51\xab\x0b\x3f\xcf\xac\xc7\x03\xd1\xdc\x76\xa5\xb8\xf2\x44\x02\x86\x85\xd8\x1d\x19\x40\xb9\x2c\xb2\x1a\x8d\xd5\xd8\x61\x9f\x1d\xb7\x81\xa4\xc9\x2e\x59\x46\x18\x53\xb0\x4d\xb6\xdf\x6d\xf0\x1d\x19\x40\x93\xa6\x6a\x34\x68\x46\x69\xbc\x8d\x32\x49\x93\x6f\x98\x1c\x09\x86\xfb\xe0\x70\x56\x04\x39\xc4\xa4\x26\x5a\x4c\x52\xdb\x38\xda\x1d\x25\x29\xb9\xbd\x4c\xc1\x0e\x68\x87\x37\xe9\x1d\x19\x40\x93\xa0\x6a\x34\x68\xee\x76\x71\x38\x8a\x87\x51\x9d\x4f\x20\x74\xdc\x6e\xb7\x91\x22\x39\xc0\x4c\x8a\xb2\xcd\x20\x78\xd8\x6e\xe2\xcd\xf6\xbe\x4e\x73\xa5\xe3\x1b\x78\x89\x9a\x6f\xdb\x6a\xea\x30\x32\xd1\x9a\xec\x5b\xd2\x7c\xcb\x64\x69\xae\xd4\x0f\x20\xe1\xf3\x39\xc0\x92\x87\x32\x92\xc6\x43\x35\xb9\x78\x64\x21\x89\xd2\x8d\xe0\xc1\x8d\x02\x31\x38\x12\x7c\xde\x0f\x0c\x0c\x4b\xaa\xbf\x5d\xa4\xd1\x19\x1f\xf9\x3a\x2b\xcd\x95\x79\x00\xa4\x21\x64\xdc\x91\x86\xa5\x33\x50\x4d\x2e\x1e\xe7\x3d\xc9\xd2\x58\xf0\x90\x06\x06\x70\x22\x4c\x30\x19\x58\x58\x96\x9e\x5a\x5c\x0c\xc8\x36\x3d\xa6\xc7\xfb\x5a\x1c\x83\x1f\xde\x3c\xaa\x65\xb9\x0a\xc8\xc7\x31\xaf\xc9\xcb\x13\x56\xda\x12\x54\x21\x59\x8b\x4f\x4c\xbd\x86\x7a\xe3\x55\x86\x22\x41\xaa\xdb\xae\xc6\x2e\x3c\x03\x34\x74\xd5\x70\xdc\xd5\x85\xa3\xf3\xdf\x17\xba\x9a\xfa\x49\xd4\xe0\xbe\xe6\x47\x33\xfc\x61\x89\xed\x0d\x7f\x5c\x6a\x71\x05\x10\xb6\xaf\x4f\xb3\xae\x24\x34\xba\x2a\xba\x3c\x38\x27\x7e\xdc\x5e\x0d\xe8\x27\x5a\xde\xc0\xd5\xbc\xc1\x21\x9e\x16\xc8\xb2\x4e\xc3\xe9\x60\x5b\x66\xae\xa4\x3b\xc6\x1e\xee\x6f\xc0\xb3\xcd\x1d\xf7\xb3\x1b\x3d\xb0\x7d\x77\xe9\x1a\x53\xad\x36\xba\xc2\x58\xd6\x0c\xe6\xed\x76\x95\x61\xf5\x6e\x3c\x77\x2d\x0e\xfe\xbb\x48\xdd\x32\x4a\xf8\x7e\xd4\xa6\x2f\x16\x92\xa8\xd5\xb7\xd7\x2f\x93\x95\xcf\x12\x3b\xee\x12\x03\x31\xae\xa4\x93\x96\xae\x84\x8e\x66\x0f\x12\x02\x61\x6c\x24\x94\x96\x2d\x2b\xd9\x09\x4a\x63\xb6\xe8\x86\x96\xc2\x03\xd7\xf3\x70\xc5\x05\x46\x3d\xf2\x9b\xae\xcc\xcb\x1a\x51\x5f\xbc\xf1\xfd\xec\xe9\xd7\x4d\xc9\xa7\x82\x82\xd0\x16\xf0\xdf\xe1\xea\xa9\xd5\x90\x9b\xa6\x94\xa8\xad\x10\x8e\xc1\x0f\xda\xe5\x14\xca\xf1\xc4\x3a\x6f\xa5\xad\x5e\xc5\x02\xc7\x5e\xc7\xec\xd7\xf1\x34\x6b\x94\x23\xe9\x73\x66\x22\xbc\x6a\x68\x42\x91\xba\xd5\xd5\xd3\x00\xad\xa3\xfd\xa2\x77\xb0\x1c\x31\xd0\x29\xcb\xa5\x8f\xd6\x22\x6f\x27\xd3\x5a\x04\x8a\xf5\xcc\x3f\x0d\xe4\x10\xfc\x70\x02\x1c\x5a\x95\x70\xb8\xf2\x34\x5a\x6b\x7d\xc7\xb9\x2e\xf1\xc0\x16\x00\x80\x5b\xd4\x7f\xf5\xa7\x28\x08\xb7\xab\x3f\x05\xc1\x3f\x05\xbf\xba\xaf\x27\x74\xbf\x23\x2f\xa4\x63\x3a\x85\x75\x7b\xa1\x74\x98\x99\x63\x68\xd0\x2e\x26\xb4\xa2\x85\x34\xc8\x38\x91\x35\x8b\x18\xc6\x0a\x66\x2e\x0d\x89\xb1\x5a\xeb\xe2\x7b\x10\x86\x39\x70\x08\xc3\x20\x01\x8e\x6b\xb5\x7e\x02\xc7\xa5\x61\x1d\x07\x56\xf6\xaf\x96\x86\x26\x43\x90\x7b\x64\x6e\x04\x9d\x00\x2c\xcf\xfa\x31\x8a\xc1\x05\x46\xd1\x88\x68\x1e\xc4\x7d\x67\x25\xfc\xe8\x57\x77\x84\x31\xdf\x39\xe3\x7e\xf4\xd0\xef\x9d\x80\xc2\xf7\xf2\xb5\x69\x7f\x20\x35\x6d\xbc\x3f\x34\x35\xca\x1a\xef\x77\xa2\x24\x8e\x98\xf7\xee\x77\xcd\xa5\x2b\x49\xb7\xfa\x37\xf2\xf5\xdd\x74\xa1\x9a\x41\x6b\x8c\x29\x51\x7b\x5d\x8d\xd7\x68\xab\xe0\xa3\x26\x59\xb6\x8f\xe2\x2d\x81\xea\x63\xc7\x73\x74\xde\x9e\xcc\xb2\x12\x7f\x22\xfb\x92\xe2\xe5\xb5\x9c\x28\xbd\x1a\xdd\xf8\x75\xa6\xee\x6b\xcf\xbb\x3c\x45\xb2\x06\xb4\x8e\xe2\x0f\xf7\x2f\x29\x5e\x71\x26\x4a\x7e\xe3\xb6\x55\x7e\xc9\xac\x95\xed\x74\xd2\xa2\xca\xca\x07\x6f\xc6\x1c\x45\xea\xb8\x9e\xae\x18\x1d\x9f\x1d\x75\xf2\x1b\x47\xfd\xf0\x6b\xd3\xe1\xe1\xc6\xa0\x44\xde\xf0\x43\xe9\xd0\xc8\x13\x8b\x6c\xe3\x7f\x3f\xa8\x3f\xc7\xe7\xf8\x1c\x4b\xed\xe8\x2b\x9d\x2c\xcb\xf8\x8d\x7a\x2b\x6e\x40\x70\xe0\xaa\x80\x64\x5e\x7f\xa7\xe7\xb8\xb6\x23\x42\x18\x4b\xf7\xc1\x7d\xcd\x21\x2c\xeb\xf8\xb6\x4c\x7e\xd1\x17\x2f\x28\xca\xe1\x6d\xb6\xfa\xfd\xa9\xfe\x6b\x32\xa0\xdd\xd7\xdc\xd7\x51\x59\x93\xb1\x84\xd8\xd9\x25\xb4\x61\x65\x21\x1a\xa4\xbc\x32\x8b\xce\x17\x35\x3c\x58\x6a\x24\xe5\x84\xd3\x1a\x1e\xaf\x39\xac\xce\xcf\x2d\x36\xc6\x4e\x37\xd9\xce\x6f\x8b\xbd\x03\x1d\x8e\xc7\x08\xec\x70\xdc\x3b\x3a\x84\x51\x10\x80\x3d\xc2\x70\xe8\x32\x01\xfc\x33\xbd\x94\xf8\x6f\xa0\xc6\x81\xf0\x4c\x99\xb2\xf9\x0d\x2a\xd5\x09\xe9\x8a\x5d\x77\xcd\xd7\x9b\x2e\xa5\x3f\xbf\xa0\x57\x34\x09\x4c\x25\x48\xd7\x7c\x7d\x82\xf9\xd8\xc1\x60\x98\x35\xd4\xa7\xb9\x1f\xf2\xe1\x0c\xbf\x82\xe9\xa7\xd6\x1a\x8d\x3f\xa7\x5f\x9b\xf1\xd7\x76\xfc\x15\x8f\xbf\x76\xe3\xaf\xfd\xf8\xeb\x30\xfe\x3a\x0e\xbf\x2a\xac\x58\x57\x78\x64\x5d\xe1\x91\x75\x85\x47\xd6\x15\xf6\xa7\x5f\x92\x75\x85\x15\xeb\x0a\x2b\xd6\x15\x56\xac\x2b\xac\x58\x57\x58\xb1\xae\xb0\x62\xcd\x2a\xc5\x9a\x55\x23\x6b\x56\x8d\xac\x59\x35\xb2\x66\x95\x62\xcd\x2a\xc5\x9a\x55\x8a\x35\xab\x14\x6b\x56\x29\xd6\xac\x52\xac\x59\xa5\x58\xb3\x4a\xb1\xbe\x32\xc5\xfa\xca\x46\xd6\x57\x36\xb2\xbe\xb2\x91\xf5\x95\x29\xd6\x57\xa6\x58\x5f\x99\x62\x7d\x65\x8a\xf5\x95\x29\xd6\x57\xa6\x58\x5f\x99\x62\x7d\x65\xfe\x11\xb8\xe5\x89\x4f\x47\x15\x75\xdb\xeb\xd3\xb3\xe1\xef\x35\x80\xe9\xc1\x65\x92\x42\x06\x81\xc3\x7a\xc3\xff\xf3\xc3\x08\x88\x54\x74\xd8\xad\x77\xbb\xdd\x6e\x3f\x41\x36\x12\x12\xc5\x53\xdb\x56\xb6\x6d\x36\x36\x9d\x58\x42\xb6\xa1\x4d\x67\x27\x21\x71\x30\xb5\xed\x55\xdb\x4c\x9e\x83\x84\xec\x66\xf2\x1c\x25\x64\xaf\xc9\x13\x06\xb2\xf1\x30\x13\x28\x54\x43\x3e\xce\x24\x0a\xc7\x41\x07\x9a\x4c\x62\xf5\x15\xdc\xa6\x30\x68\x42\x42\x09\x99\x49\x2c\xfa\x45\x12\x3a\x57\xa3\x00\x6f\x24\x58\xd7\xa5\x00\x6c\x25\x60\xae\x50\x01\x8e\x25\x78\xae\x55\x01\xde\x49\xb0\xae\x5a\x01\xd8\x2b\x00\x2c\xed\x41\x82\xe7\x4a\x16\xbd\x8f\x12\xac\x6b\x5a\x00\x42\xa5\x9e\xb9\xba\x07\xb8\x52\xd2\x5c\xe7\x03\x7c\x54\x93\xa9\x78\x56\xf8\xc1\x6d\xcc\x36\x26\x20\x1c\x00\xc0\x40\x58\xe1\x47\x03\x10\xd2\x3a\x2b\xfc\xcd\x4d\xde\xfb\x6e\xb5\x6f\x87\x76\x48\xe7\xac\xf0\xe3\x01\x0a\xa9\x9c\x15\xfe\x6e\x80\x9a\x1a\x67\x85\xbf\x97\xed\xb0\x9c\x87\x01\x0a\xe9\x9b\x15\xfe\x71\x80\x9a\xea\x66\x85\x1f\x4a\xa5\x40\xda\xe6\x60\xa9\x1a\x48\xd9\x1c\xac\x94\xa3\xeb\x7a\x78\x53\xee\x07\x46\xf2\x0c\x6c\x70\x68\x80\x67\x43\x92\x58\x91\x81\x35\xb7\x81\x44\x1b\xdf\x7e\xcd\x8c\x21\x11\xb6\x06\xc2\xdc\x2a\x12\x2d\x36\xd0\xe6\xe6\x91\x68\xe3\xeb\xaa\x99\x9d\x24\xc2\xde\x44\x70\x8d\xee\x60\xa0\xcd\x2d\x27\xd1\x8e\x06\xda\x3e\x9e\x21\x84\xa6\xae\xe7\xb6\x54\x78\xa6\xd2\xe7\x46\x55\x78\x96\xda\xb9\x75\x97\xd6\x99\x7f\xa7\x04\x3e\x4b\x42\x9c\x8b\x0c\xbb\xa6\xca\xf9\xa2\x41\xc5\x63\x53\xcb\x7c\x11\x21\x21\xa3\xdb\xf0\xe5\x84\x6c\xb3\x3c\x85\x2f\x2f\x24\xc4\x72\x0e\xbe\xdc\x90\x90\xd1\x1f\xf8\xc2\x43\xb5\xcd\xe4\x71\x24\x21\xbe\x30\x91\x90\xd1\xd0\xac\x72\x26\x21\x56\x39\x93\x10\xab\xa0\x24\xc4\x2a\x57\x12\x62\xd5\x52\x12\x62\xd5\x62\x12\x62\x95\x23\x09\xb1\x6a\x31\x09\xb1\x6a\x31\x09\xb1\xca\x91\x84\x58\xb5\x98\x84\x58\xb5\x98\x84\x58\xe5\x48\x42\xac\x5a\x4e\x42\xac\x5a\x4e\x42\xac\x72\x26\x21\x56\x39\x92\x10\xab\x16\x92\x10\xab\x96\x92\x10\xab\xe0\x24\xc4\xaa\xa5\x24\xc4\xaa\xa5\x24\xc4\x2a\x38\x09\xb1\x6a\x29\x09\xb1\x6a\x29\x09\xb1\x0a\x4e\x42\xac\x5a\x4c\x42\xac\x5a\x4c\x42\xac\x72\x25\x21\x56\x2d\x26\x21\x56\x3d\x93\x84\x58\xf5\x54\x12\x62\xd5\x83\x24\xc4\xaa\xa7\x92\x10\xab\x9e\x4a\x42\xac\x7a\x90\x84\x58\xf5\x54\x12\x62\xd5\x53\x49\x88\x55\x0f\x92\x10\xab\x9e\x4b\x42\xac\x7a\x2e\x09\xb1\x6a\x29\x09\x2d\x16\x2f\xfe\x4e\x4f\xb0\xb3\x2c\xc4\xb9\xc8\xb8\x6b\xea\x9c\x3f\x35\xab\x80\x6c\xaa\xb9\xc2\xf3\x2c\xc4\x9f\xa7\x65\x9b\xe5\x2a\xfc\xf9\x5a\x42\x2c\xef\xe0\xcf\xdb\x12\x32\x3a\x04\x7f\xf2\x56\x6d\x33\x79\x1c\x59\x88\x3f\x99\x4b\xc8\x68\xe9\x0a\x3b\xb3\x50\x85\x9d\x59\xa8\xc2\x50\x16\xaa\xb0\x2b\x0b\x55\x78\x29\x0b\x55\x78\x31\x0b\x55\xd8\x91\x85\x2a\xbc\x98\x85\x2a\xbc\x98\x85\x2a\xec\xc8\x42\x15\x5e\xcc\x42\x15\x5e\xcc\x42\x15\x76\x64\xa1\x0a\x2f\x67\xa1\x0a\x2f\x67\xa1\x0a\x3b\xb3\x50\x85\x1d\x59\xa8\xc2\x0b\x59\xa8\xc2\x4b\x59\xa8\xc2\x70\x16\xaa\xf0\x52\x16\xaa\xf0\x52\x16\xaa\x30\x9c\x85\x2a\xbc\x94\x85\x2a\xbc\x94\x85\x2a\x0c\x67\xa1\x0a\x2f\x66\xa1\x0a\x2f\x66\xa1\x0a\xbb\xb2\x50\x85\x17\xb3\x50\x85\x9f\xc9\x42\x15\x7e\x2a\x0b\x55\xf8\x41\x16\xaa\xf0\x53\x59\xa8\xc2\x4f\x65\xa1\x0a\x3f\xc8\x42\x15\x7e\x2a\x0b\x55\xf8\xa9\x2c\x54\xe1\x07\x59\xa8\xc2\xcf\x65\xa1\x0a\x3f\x97\x85\x2a\xfc\xc6\x2c\xa4\x55\xc4\xff\x4e\x35\xdc\x59\x1a\xe2\x5c\x64\xe0\x35\x95\xce\xeb\xc6\x2a\x22\x9b\x7a\xa6\xf9\x3c\x0d\xf1\x8a\xb2\x6c\xb3\x7c\x85\x57\x98\x25\xc4\x72\x0f\x5e\x71\x96\x90\xd1\x23\x78\xed\x59\xb5\xcd\xe4\x71\xa4\x21\x5e\x9b\x96\x90\xd1\xd4\x34\x77\xa6\x21\x9a\x3b\xd3\x10\xcd\xa1\x34\x44\x73\x57\x1a\xa2\xf9\x52\x1a\xa2\xf9\x62\x1a\xa2\xb9\x23\x0d\xd1\x7c\x31\x0d\xd1\x7c\x31\x0d\xd1\xdc\x91\x86\x68\xbe\x98\x86\x68\xbe\x98\x86\x68\xee\x48\x43\x34\x5f\x4e\x43\x34\x5f\x4e\x43\x34\x77\xa6\x21\x9a\x3b\xd2\x10\xcd\x17\xd2\x10\xcd\x97\xd2\x10\xcd\xe1\x34\x44\xf3\xa5\x34\x44\xf3\xa5\x34\x44\x73\x38\x0d\xd1\x7c\x29\x0d\xd1\x7c\x29\x0d\xd1\x1c\x4e\x43\x34\x5f\x4c\x43\x34\x5f\x4c\x43\x34\x77\xa5\x21\x9a\x2f\xa6\x21\x9a\x3f\x93\x86\x68\xfe\x54\x1a\xa2\xf9\x83\x34\x44\xf3\xa7\xd2\x10\xcd\x9f\x4a\x43\x34\x7f\x90\x86\x68\xfe\x54\x1a\xa2\xf9\x53\x69\x88\xe6\x0f\xd2\x10\xcd\x9f\x4b\x43\x34\x7f\x2e\x0d\xd1\x7c\x31\x0d\xa9\x37\xe6\x9e\xfc\xe0\xcd\xd2\x67\x04\xe5\x97\x14\xd5\xcb\x75\xb1\x8f\xf0\xa0\xbd\x55\x92\x1b\x37\x78\x93\x7c\x59\xbf\xdf\xef\x4f\xf3\x5d\xd8\x82\xd3\x14\x47\xed\x23\x4c\xf3\x6d\x20\xb2\xcf\xa7\x9e\xef\x89\xfc\xd4\x77\x9f\xb4\x8f\x66\x1d\x5c\xc7\x95\xc0\xb3\x38\x18\xe3\x91\x18\x3f\x46\x27\x88\x61\x6f\xd6\x54\x8c\x4d\x7c\x87\x8b\x85\x35\x36\x15\xde\x4c\x30\xfc\x50\x30\xeb\x90\x57\xdf\xb4\x4f\xc8\xaa\x0d\xdc\xea\x3f\x28\x5d\x91\x50\x4a\xb3\xa8\xb4\xa8\x26\xf4\x93\x22\xc6\x47\xa9\x7f\xa9\xfc\x53\xdf\xe9\x7f\x8a\xcf\x11\xbd\xb1\xc7\xa8\x09\xe9\x23\x3f\x29\x89\xcd\x5e\xf8\x39\xb4\x89\x5a\x43\xf9\xee\x8f\xf6\x21\xb9\x65\xbc\x91\x9e\x00\x9b\x30\x17\xc9\xc7\xa8\xea\x0b\x4d\xc2\x6e\xea\x93\x51\x83\xae\x7e\x12\xff\xab\xc3\x6d\x8b\x08\x26\xab\xb5\x63\xd6\xf1\x6d\x41\x12\x87\xdf\xab\x82\xc5\x77\x5d\x01\x8f\x05\x81\x05\x00\x9c\x79\x31\x08\x84\x7a\x4a\x85\xc2\x3d\x81\xf9\x18\xb7\x57\xfb\xfb\x56\x9e\xf5\x37\x34\x10\x00\x56\xcc\x61\xf3\x61\x00\x30\xa0\xdf\x7c\x10\x00\xcc\xf1\xc5\xad\xbb\xbb\xc7\x33\xd4\xe4\x94\x94\x01\x2e\x9a\xb4\x33\x7c\x74\x6f\x1a\x70\x52\xf7\x85\xdf\x9c\xc5\x97\xda\x7f\x6c\x30\xfe\x00\xb9\xc5\xf1\x7c\x3c\x1f\x15\x05\xb1\x8d\x7c\xea\x2f\xfe\x94\x12\x8d\xad\xeb\xe1\xd0\xdf\x24\xeb\x1c\x52\xd8\x90\x4f\x3d\x96\xc0\x39\xa4\xb0\x21\x52\xed\x00\x1f\x1b\x52\xd8\x10\x80\xcf\x08\x99\xf1\x91\x8a\x05\xf8\xd8\x90\xc2\x86\x00\x7c\x46\x88\xe2\x03\x29\x5b\xec\x34\xbb\x8b\x1e\xab\xac\xa1\x3f\x67\xfc\x26\x88\x5f\xff\x86\x67\xd5\xcf\xb3\xc3\x92\xc3\xa3\x13\xdf\x3c\x67\x6e\xd4\xe1\x33\xfa\x52\xd5\x92\x4c\x8f\x0d\x2a\x9e\x6c\x2d\xbe\x95\x36\xa1\x14\xf6\x06\x39\xac\x44\xb6\x62\xef\x19\xac\x02\xc6\x92\x60\x89\x0c\xe3\x4c\x3a\x36\xfc\x70\x86\xa5\xf4\xed\x3e\x03\x71\x20\x07\x72\x90\x83\x1a\x3b\xae\xe5\xd1\x12\xdd\xee\x33\x50\x61\x83\xb8\x50\x12\x3a\x07\x15\x33\x90\x74\x3e\x88\xd7\x0c\x54\xd8\x20\x88\xd7\x08\x9a\xf3\x92\x0e\x08\xf1\x9a\x81\x0a\x1b\x04\xf1\x1a\x41\x23\x2f\xf7\xf9\x1d\xd0\x36\xaa\x9b\x72\x06\xec\x3d\x85\x56\xc0\x68\x12\xac\xb0\x61\x24\x6d\x1c\x8f\xbc\xc6\x40\x83\x46\x16\x90\x63\xb6\x9b\xb9\x0d\x3f\x43\xa4\xeb\xd6\x6c\x2f\xec\x76\x2e\x10\x07\xcd\xdb\x0b\xb3\x5d\x5a\x76\x46\xdf\x6c\x2f\xec\xf6\x19\xfd\xb1\xdd\xa2\x2f\xad\x39\xa3\x6f\xb6\x17\x76\xfb\x8c\xfe\xd8\x3e\xd0\x87\x14\x37\x9c\xb8\x02\x15\xbf\x9e\x8e\x5c\x4d\x42\x2c\xe1\x14\x30\x8e\x04\xeb\xa2\x59\x18\x4a\xf0\x47\x6e\x30\xe1\x00\x43\xc9\xb6\x64\x73\xde\xcc\x7c\x40\x1e\xeb\xd2\xd5\x38\x03\x15\x36\x88\x0b\x24\xa1\x73\x50\x31\x03\x49\x3b\x42\xbc\x66\xa0\xc2\x06\x41\xbc\x46\xd0\x9c\x97\xb4\x29\xc4\x6b\x06\x2a\x6c\x10\xc4\x6b\x04\x8d\xbc\xdc\x67\xe7\x40\xcb\xa8\x6e\xca\x0d\xb0\xf7\x14\x5a\x01\xa3\x49\xb0\xc2\x86\x91\xb4\x71\x3c\xf2\x19\x03\x0d\x1a\x19\x3a\x47\x59\x36\x73\x9b\xe1\xf4\x9e\xae\x5d\x1b\x52\xd8\x10\x2e\xd4\x00\x9c\x43\x0a\x1b\x22\x2d\x0c\xf0\xb1\x21\x85\x0d\x01\xf8\x8c\x90\x19\x1f\x69\x5d\x80\x8f\x0d\x29\x6c\x08\xc0\x67\x84\x28\x3e\x90\x42\x87\x33\x90\xa0\x41\x64\x2f\xe5\x02\xd8\x7b\x06\xab\x80\xb1\x24\x58\x22\xc3\x38\xd3\x10\x1e\xb9\x89\x8e\x05\x0c\x8a\xa4\x59\x36\x7a\x89\x7e\x1d\xcb\xb8\xf5\xff\x2a\x37\xa5\x4f\x9b\x70\xd7\x41\x38\x6e\x7a\x1a\xae\x3f\x5f\xa1\x1a\xaf\x7e\x9c\x0a\x1e\xfb\xdd\x5e\x94\xfc\x67\x54\x9d\xf5\x90\x30\x36\x8f\x1b\xc8\x13\x7c\x7e\xc5\xfc\xb1\x55\x1c\xc5\x4c\x78\x13\x97\xa8\x28\xf9\x83\x99\x3c\xbe\x90\xa2\xee\xb4\xf8\x98\x33\xc9\x20\x9f\xfe\x6f\x26\xff\xc0\x89\xa8\xeb\xdc\x7b\x02\xa9\x58\x40\x9a\x1c\xfd\x19\xa4\x25\x4a\x93\x2b\x3f\x83\x54\xc0\x47\xf7\xe1\x7e\xe3\x63\x9f\x7a\x70\x0c\x1e\xa3\xea\x4a\xd2\xcb\x09\xde\x5b\x7a\x16\x6f\xed\x39\xa9\xf3\x9b\x7b\xbe\x99\xe7\xa4\xf8\x6f\xee\x69\xf0\x54\xcf\xd3\xaa\xf0\xfc\x06\x7d\x61\xfd\xa0\xe6\x5b\x3a\x16\x6f\xec\xa8\xe9\xf9\x1b\x3b\xbe\x95\xa3\xa6\xe5\x6f\xec\xa8\x73\x54\x3a\x1e\xde\xe2\xbc\x45\xc9\x1a\x91\x4f\xfd\x53\x02\x40\x1d\x8b\x67\x3a\x4a\x5d\xbd\x9d\x23\xd4\xd1\x2a\xd3\x24\xc1\xfd\x7e\x2e\x09\xc5\x8c\xf4\x1e\x25\x39\xa9\xf5\x43\x74\xe3\x34\x57\x28\x32\x36\x26\xc1\x69\x7a\x8d\x1b\xdc\x65\xbf\xc5\x92\xb6\x76\x2e\x2d\x0a\xad\x4a\xb1\x79\x50\x4d\x3f\x0c\x28\x09\x68\x91\x9b\xc4\xfc\xdf\x7d\xf8\x96\x3d\x78\x5c\x7e\xb1\xba\x1e\xb7\x57\xfb\x2c\xe0\x1d\xba\x21\x6d\x7e\x09\x9a\x1a\xfa\xb6\xbd\xae\x82\xd5\x78\x9d\x80\xaa\x61\xff\xe9\x68\x0c\x69\x38\xbc\xa9\x93\x16\x37\xac\x29\x15\x2b\xc1\xc5\x51\x43\x1d\xab\xe3\x49\xf9\xb3\x09\x57\xa7\xdc\xf9\xfb\x8b\xe1\xf2\xb9\x9f\xab\x0b\xed\xcb\x96\x13\x94\x0d\x5c\xb1\x9f\xc7\x3b\xe9\xd6\xfc\x0e\x14\x5e\xb7\xec\xbb\x86\xde\xa4\x48\x3c\x35\xde\x0d\x88\xd7\x5c\xfa\xf6\xd2\x5b\xdc\x26\x43\x3d\xba\x30\x2f\x8e\xe3\xbb\x24\x21\x7d\x46\x94\x86\xf7\xed\xd5\xe4\xa3\xbd\x43\x56\x17\x6b\x6d\xb6\xd3\x7b\x14\x7e\x39\xdb\x2a\x8c\xf8\x81\xcf\xf9\xd2\xca\x3c\x2e\x5a\x56\x28\xe7\x47\xf3\x6b\x02\x24\xf5\x2c\xcb\xac\x83\x8c\xfa\x45\x6c\xc0\x61\x52\xde\x97\xff\x57\x3f\x4d\x1a\xec\xe3\x0f\xa7\xb7\xe0\x02\x17\xbd\x49\x21\xc4\x10\xf4\xcb\xda\x56\xeb\x30\x66\xde\x44\x7c\x06\xb3\xae\x83\xfb\x76\x3a\x7f\x0d\x22\xa6\x0d\xe5\x1d\x80\x3a\xb5\xe4\xfd\x6e\x87\xce\xe4\xa8\xae\xfb\x4b\x82\x6f\x51\xb6\xc7\xcf\xd4\x1e\x14\x20\x0c\x22\x2f\xdc\xc7\x5e\xb4\xd9\x78\xeb\xdd\x87\xd3\x5f\x8b\x90\x35\x98\x44\xdc\x5f\xd9\x52\x94\x91\x42\x7c\x8a\x4b\x1d\x4f\x3e\x1e\x8f\xa7\xa6\x45\x59\xd9\xbf\x26\xa1\xd5\x89\xaf\x2f\xc5\x54\x75\x74\x9c\xf1\x90\xca\x78\x53\x9f\x8a\xc9\xaf\xa6\xc8\x38\x9d\x04\xa7\xc5\x77\x94\x46\xff\x9f\x71\xc9\x78\xf2\xc0\x9f\x3d\xb3\xbd\x23\x08\xf3\x2b\xf0\x3e\x7b\x2a\x92\x4f\xa8\x2b\x03\x15\x7a\x12\x20\xc4\xa5\x13\x8d\xe1\x43\xc2\xf2\xfa\x8b\xba\xe1\x6f\x47\xf9\xf5\x4f\xf8\xbe\x70\xed\x28\x6a\x5b\x82\x3a\x54\x67\xf2\x1e\x15\xe0\xa9\x42\xa1\xf2\x54\x84\xc9\x0b\xbf\x59\xba\x2d\xaf\x84\xfa\xe2\x2e\xcc\x24\xf8\x70\xd3\xe8\x63\xd4\x93\xcf\x86\x40\x7a\x88\xe7\x50\xf1\xd9\x5b\xda\x64\x88\xba\xf1\xaa\xa6\xee\x0b\x37\x98\x93\x30\xa1\xc6\xed\x2b\x3c\xe8\xf1\xbb\x3d\xb8\x3b\xf0\xd7\x5f\xad\xcf\xaa\x95\xd6\x9d\x4b\xf1\xd9\x7b\x80\xa0\x89\xb9\x84\x3a\x48\xba\x84\xc1\x09\xcd\x10\x3e\x19\x7f\xa7\x7d\xfd\x49\xfc\xbd\x4e\xfb\x7a\x41\xc6\xa7\x7a\x2d\x08\xfe\xb0\x3f\x3c\x9a\x87\xdd\xe0\x21\x0e\x48\xba\x95\x96\x86\xb6\x84\xbd\x34\x24\x57\x3f\xc7\x50\x5c\xe8\x4b\x43\xd0\x1b\x11\xc6\x4d\xfd\x78\x1c\x0b\x5d\x1e\x0f\xc6\xd1\x79\x71\x44\x8e\x3e\x9c\x99\xb1\xc8\xe2\xea\xfc\x2c\x49\xb0\xca\x86\x68\xb2\x81\x38\x72\x5e\x42\x20\xde\x75\x82\x98\xf3\x31\xb0\xe7\x23\xcd\xf5\x19\x02\x28\x72\x8e\xe0\x54\x9b\x89\x0a\x29\xc9\xc4\x00\x2c\x4d\xf3\x87\x2e\x0e\xca\xf8\x54\xaf\x05\xc1\x1f\xf6\x87\x47\xf3\xb0\x1b\x3c\x44\x97\xeb\xc3\x43\x5b\xc2\x5e\x1a\x92\xab\x9f\x63\x28\x2e\xf4\xa5\x21\x38\x7c\x7d\x69\x1c\x0b\x5d\x1e\x0f\xc6\xd1\x79\x71\x44\xdf\x32\x1f\x69\x6e\x43\x34\xd9\x40\x1c\x63\x3e\xd2\xdc\x35\x1f\x69\x6e\xcc\xc7\x2d\xbf\x15\x54\x2e\x30\x84\x84\x37\xf3\xe1\x4d\x9e\xdf\x97\xcf\x68\xde\x9a\xaf\xf2\x1b\xe0\x5a\x00\xf3\x79\xe6\x99\x5b\x52\xd5\x73\xdf\x4a\x3c\x57\x7a\xe3\xdf\xe3\x9d\x6f\x82\x95\x82\x8a\x3f\x24\x48\x3d\x2d\xcb\x3d\x7b\x73\x06\x81\xf1\xbc\xb9\x0d\x82\x93\x7d\x3b\xb0\xcd\x5c\xe3\x75\xd3\x6e\x38\x88\x4c\x49\x35\x95\xaa\xb6\xcf\x33\xc1\x1d\x58\x03\x07\x0d\x26\x1a\x3e\x9b\x23\x9b\xc3\x81\xcb\x9c\xe5\x58\xc5\xe0\x7d\xfd\x0a\x5d\xfe\x20\xc8\x1f\x95\xff\x74\x9c\x64\xfe\x69\xfc\x25\x39\xfd\x24\x4d\xa8\xf5\xf1\x0d\x1b\x4b\x51\x4c\xc1\x16\x2c\x6e\x14\x02\x1c\xb7\x8b\x5b\xb4\x7f\xb2\x1b\x4c\x66\x3f\x19\x7f\x81\x37\x0a\xca\xdd\x85\x80\xdc\x6b\xb5\x16\x9e\x0c\x33\x36\x41\xce\xa4\xe1\x8b\x66\x1b\x19\x5a\x63\x2b\xc2\x4f\xa0\x48\x2e\x20\x8e\xee\xe0\x4e\xf8\x02\x01\xcd\x59\x14\xbb\xcf\x0f\xf0\xa4\xd3\x41\x3d\x27\x45\x40\xd0\x89\x9c\x0e\x1e\xc8\x81\x3d\x07\xd0\xd4\x0d\x7a\x16\x31\x62\xbd\x3f\x6c\x72\xb1\xcb\x1a\xaa\x62\xa1\xe6\xf6\x1e\x98\xed\xda\x8c\x1d\xd6\xfb\x00\xdd\x29\x30\x2e\x40\x59\x35\x0b\xe1\x20\xb6\xde\xf6\x5c\x0f\x1d\x47\xe4\x80\x79\x37\xfd\x6f\x9e\xc8\x41\x3a\x69\x6f\xf5\x64\x15\x8c\xa8\xb7\x3d\xd7\xe3\x81\x88\xd0\xda\x1f\xa4\x93\xf6\xb5\x19\xa1\x83\xd1\x86\x63\xcd\x77\xca\x37\xfc\x71\xeb\x0d\xc2\x2a\x28\xab\x54\x8d\x6d\x13\x68\x65\x2d\x7e\x9f\x9f\x7d\x59\x58\x34\xbb\x2c\x2c\xb6\x6a\x56\x9b\xf9\x33\xa2\x39\x56\xa1\x0e\x83\xa1\x45\x70\xb9\xbb\x50\x55\xda\x9b\x14\xfe\xc6\x22\x73\x9e\x43\xa1\x12\xf4\x19\x09\x5a\x54\xbc\xc4\xd1\x5b\x85\x22\x64\x01\x14\xb6\x84\x2e\xf6\x26\x18\x27\xa3\x22\xba\xb2\xab\xa9\x96\x75\x4d\x64\xb0\xb8\x3a\x93\x73\x61\xdc\x5a\xd5\xf6\x61\xaf\x91\xd9\x93\xfa\x5a\xa0\x2d\xb1\xf5\x56\xa1\xb9\x85\x2e\xcf\x0d\x75\xc4\x72\x19\x65\xe2\x20\xbb\xb2\x6a\x2a\x51\xdb\x06\xd3\xca\xd3\x96\x7d\x0c\x29\xde\x64\x5f\x28\x24\x18\x04\xf4\x60\x1d\x69\xf3\x60\xf7\xdc\x3c\x30\xf8\xd1\x7c\xf5\x86\x58\xac\xa0\x34\x57\x02\x6d\x77\x9a\x00\x9c\xf9\x6a\xba\x7d\x7e\xbc\xf2\xde\x92\x40\x1c\x90\xb0\x66\xe3\xce\x9e\x8d\x34\x5f\x08\x20\xdb\x1d\x4c\x74\x99\x84\x1d\x44\xfe\xcb\x64\x5f\x8a\x24\x34\x87\x66\x86\x0b\xc7\xe5\xb4\xb0\x5d\x74\xd1\xb7\x3b\xcb\xd3\x68\xfe\x86\x48\x42\xf3\x67\x22\xc9\xf2\xb8\x27\x3e\x8f\x7b\xb9\x23\x09\xac\xaf\x05\xda\x12\xfb\xe9\x48\x42\xf3\x4f\xcf\x0d\x75\xc4\x72\x19\x65\xe2\x20\xbb\xd2\xdc\x8e\x24\x34\x77\x46\x92\xd1\x3e\x86\x14\x6f\xb2\xef\x52\x24\xd9\xee\xac\x48\xa2\x9d\x11\x4a\xc2\xf0\xf9\xc9\x70\x5f\x17\x88\xf9\x67\x42\x30\x2f\xc2\xcf\x1f\x73\x4c\xb8\x19\x6d\xc6\x55\xce\xb0\xac\xd9\x46\xeb\x78\x1c\x85\x12\x7b\x4e\x79\x7c\x8e\x1b\x1e\x68\xe4\x92\xe8\xf4\x8b\x5f\xd6\x98\x5c\x93\xe8\x04\xbd\x88\xe4\xcb\x5a\xe3\x3d\x9e\x3e\x14\xd1\xa0\x1d\x81\x1a\x3e\x27\x71\x92\x0f\xbb\x3e\x79\x21\x75\xcf\xe4\xd5\xe8\x0b\x2a\xfe\x09\x96\xdc\xf6\x9c\x27\xd1\x3e\x7d\x0b\x6d\x73\x2a\x09\x6f\xfc\xa6\x9e\x2a\x56\x3e\xe8\xec\x1c\x8c\x7c\x7b\xba\xdd\x4d\x5a\x7f\xec\xb2\x76\xf2\x7b\xc0\x5c\x75\x7a\x12\xed\x1b\xf4\xc9\xaa\x6f\xd5\x27\xab\xbe\x49\x9f\xac\x5a\xd6\xe7\x26\x98\xf4\xe9\x58\x4c\xf0\xd9\x26\xf7\x61\x4f\x0f\xd1\x1e\xdc\xac\x1e\x8d\x2d\xa8\xe4\x2d\x9f\xf5\x0d\x18\xbf\x07\x7e\xa8\x54\x58\x00\x9e\xc7\x1b\xa8\x0d\xe2\x61\x3f\xff\x83\x30\xd9\x11\x42\xd1\x9f\xfb\xe7\x00\xa3\xa3\xf5\x41\x13\x1d\xdb\x74\x36\xb5\xc5\xc4\xc0\xff\x5b\xbf\x88\x5f\x90\x07\x7c\x77\x3d\x7c\x5e\xe5\x9b\xdf\x58\xf3\x15\xe2\xfb\xdd\x3e\x0d\x77\x87\xd3\x77\xf4\xb5\xa4\xd6\xfd\x5c\xcc\x10\x53\xe7\x27\x73\x08\xaa\xd1\x79\xec\xc1\xa9\x91\x69\x32\x18\xa4\x06\x69\xe4\xfe\x61\xdb\xe5\x67\xcd\xd2\x39\x6c\xe8\xdc\xe5\x47\x98\xed\xf2\x23\x40\x73\x79\xb3\x0d\xe2\x31\x0a\xa0\xbb\xad\x0d\x93\x1d\x21\x94\x99\xcb\x1b\x00\xa3\xa3\xf5\x91\x1c\x1d\x7b\xd1\xe5\x07\xfc\xff\x1a\x97\x07\xe5\x71\x6c\xd7\x88\xc3\xef\x75\xf9\x2c\x40\xe1\x2e\xfd\x36\x97\x1f\xfa\x5a\x52\x3b\x5d\x5e\xea\x10\x54\xac\x7b\xbb\xbe\x53\x23\x33\x97\xd7\x6d\x4a\xba\xae\xe9\x6c\x87\xb7\x1a\xa5\x63\x98\xb0\xb9\xb3\x4b\x88\xed\xea\xb2\x59\x73\x74\xbd\x65\x4e\x7b\x64\xab\x3b\xaa\x09\x91\x9d\xe6\x08\x33\x07\xd7\x9a\x8d\x4e\x4a\x15\xf2\x83\x4d\x13\xee\xa2\x73\x0f\xd8\xff\x35\xce\x0d\x48\x03\xba\xf6\xf0\xf1\xa8\xef\x74\x6d\x72\xd8\x1e\x36\xdf\xe8\xda\xa2\xaf\x21\xb3\xd3\xb1\xa5\xfe\x40\xa5\xba\x0f\x17\x38\xb4\x31\x73\x6b\xdd\x96\x0a\x36\xb8\xc8\xff\x73\x74\xe4\x6b\xff\x28\x6e\xaf\x50\x1f\xf5\xed\xcc\xa5\xbe\xc1\x5d\xf3\x76\x6b\x33\xa1\xf6\xbe\x24\x9e\x15\xcb\x79\x91\x43\xed\x25\xdc\x6f\xf8\x3f\xe0\x96\x20\x75\x63\xaa\xe0\x2f\x9d\x57\x5b\xe9\xde\xc0\x57\x3f\x26\x9f\xc0\xf5\x2a\x68\x4e\x53\x0e\x10\xa6\xaa\x7d\x1f\xf4\xad\x04\xd5\x23\x23\x44\xd7\xea\xa6\xf9\x8d\x8d\x2e\x36\xf8\x3e\x35\x18\x8d\xc8\x0a\xaa\x45\x80\x78\xf3\xa7\xef\x27\x91\x79\x39\x79\x52\x8e\x5b\x14\xf3\xb9\x41\x76\xe1\xbb\x74\xad\x2e\x12\xc1\x97\xef\x40\xbf\xc1\x98\x2a\x46\x5a\x23\x10\x21\x70\xd1\x65\xa0\x6f\x85\xbd\x8d\xa5\x0a\xbc\x73\xc6\x32\xe8\xaa\x27\x75\xb5\xb1\x1f\xa6\xa2\xbd\xbe\x52\x6d\x9f\x3d\x13\xd7\xf1\x1a\x77\x5e\x38\x50\xe3\x01\x39\x1a\x93\x7e\x71\x9a\xcb\x8e\xda\xe7\xb0\x46\x71\x3d\x37\x48\x32\x02\x30\x64\x32\x84\xdb\x97\xde\xb8\x8e\x76\xb1\xde\xcf\xbd\x4d\x40\xf9\xee\x79\x2a\xdc\x44\x30\x09\x2d\xd8\x3c\xf7\x69\x8b\xb4\xe7\x6f\x88\x1b\x9a\x22\xf5\xa5\x20\xa3\x49\x7e\x13\x69\x89\x91\xea\xb6\x84\xf2\xf8\x8b\x19\x80\x1c\x0f\x79\xea\x1f\xd7\x78\x10\x8c\x75\x32\xe6\x8c\xd5\xea\x3f\xa2\xa4\xf4\xd0\x6e\x80\xd6\x9f\x71\x4b\x79\x45\x16\xcf\x5d\x6f\x90\x75\x1a\xb2\x28\x3b\x99\xa2\xeb\x92\x85\xe1\xac\x6c\xb7\xe8\x1e\xfc\x2d\xd1\x02\x39\xab\x08\xc8\xaf\xc6\x10\x36\x5a\x8c\x47\xce\x5d\x24\xf3\x1a\x1b\x18\xa4\x4e\x7d\x73\xc9\x0a\x9f\x5f\x42\xd0\xd4\x49\x85\xea\xb2\xbd\xf0\xa0\xd0\xd4\xd6\x3e\x94\x67\xb7\xd9\x6b\x9b\x8f\x81\xef\xe5\xcd\xf7\xf4\x3f\x71\x9c\xc0\x7c\x07\xa0\x6f\xda\xbf\x30\xd2\xf9\x43\x09\x58\xd4\x0c\x4f\x62\xdf\x36\xd0\xca\xe6\xa8\x76\x83\xd0\xb5\xbc\x8d\x61\x2d\xd6\x90\x9e\xd6\x22\xbf\x5a\x29\x5a\xa6\x9f\xc9\x0c\x3d\x99\xa1\xcb\xf5\xe8\xf7\x7d\x1d\xdf\xe6\xab\xfd\x34\x3e\x92\xca\x5f\x99\xd8\xdf\xfc\xb7\x07\xa7\xcb\x39\x7e\xb4\x3f\x70\xd8\x77\x61\xc5\xbc\x69\xaf\xab\xd8\x5a\x31\x87\x11\xbc\x8a\x77\xe1\x0e\x72\xa9\x8d\x17\x42\xb2\x69\x1b\x06\xb4\x3f\x84\x63\x00\xdb\x33\xc6\x0d\xe8\xeb\x5d\x7c\x3a\x97\x54\x7c\xea\x8d\xb6\x05\xfa\x51\x02\x7e\xb3\x8b\x3f\x40\x83\x91\x4e\x6c\xfc\x7d\x47\xa6\x54\x80\x18\x02\xe3\x06\x56\xad\x79\x54\xc5\xe4\x8c\x2e\xb4\xd7\xad\xa2\xa9\x57\xb6\x8a\xcf\xd6\x1a\x8b\x7c\x71\xa0\x56\xeb\xaf\x59\x5c\x35\x49\x6f\x5a\x24\x4c\x76\xfc\x9f\x45\xfb\x90\xf1\x7f\x26\x79\xcd\x1f\x46\xfa\x50\x9b\x3c\x29\xdc\xb4\xa4\xfe\xa4\x03\xd6\xb8\x6b\x5a\xdc\x7c\xe5\x69\x24\xcf\x29\xf9\x06\xb1\x10\xe6\xff\x20\xb1\x80\xc1\xaf\x67\x93\xcb\x86\x48\x49\x35\x48\xe2\xa4\x96\x38\xa9\x25\x26\xb5\x87\xe3\x56\xc4\x1f\x22\x26\x4f\x23\xce\x66\xf5\x5c\x9f\x78\xcb\xff\x7d\xa7\x99\x1f\x4a\x72\x03\xe3\x82\xc9\x40\xcd\x0b\x40\xc9\x23\x2c\x59\x82\xcd\xcd\x36\xcd\x35\x80\xe8\x04\x4c\x16\x81\x03\x59\x47\x14\x51\xf8\x92\xfe\x03\xac\xe4\x39\x2c\xe7\x9d\x0a\x0f\xe7\xfa\x6a\x9d\x22\x9c\x93\x1b\x7c\xb0\x4d\xb6\x8a\x97\x80\xbc\x93\xfc\x7a\xf5\x32\xf6\xf0\x05\x6c\x8b\xef\x71\x9b\x91\x78\x6b\x50\xd1\x35\x2c\x9b\xcc\x30\x03\x93\x1f\xbe\x90\x6d\x91\x8f\x37\xfb\x73\x64\x91\xd7\xfd\x4f\xd1\x87\xda\x66\xd3\x4d\x02\x66\x2e\xf9\x76\xb1\xf6\x71\x1a\x59\xba\x93\xd3\x02\x18\xbc\x84\x24\x6e\x88\xe6\xaf\xe6\x80\x20\x55\x3a\xa9\x25\x26\xb5\x87\xe3\x56\xc4\x1f\x22\x26\x4f\x23\x1a\x61\x06\xd6\xe7\xa0\xba\xef\x34\xf3\x43\x49\x96\xc2\xcc\xd8\x45\xce\x39\x40\xc9\x53\x28\x59\x82\xcd\xcd\x36\xcd\x63\x80\xe8\x04\x4c\x16\x81\xcb\x61\x46\xe2\xaf\x97\x03\x88\xc4\x4a\x9e\xc3\x72\x85\x99\x67\x67\xbc\x15\x6c\x54\xb7\x19\x3d\x71\xed\x22\x1f\x84\x7c\x4b\xb4\xec\x29\x71\x96\x1e\xe2\xf1\x8c\xad\x6c\xdc\x66\x88\x6c\x33\x83\x8a\xae\x6a\xd9\xf4\x4c\xbc\xd9\x6e\x8f\x78\x6b\xe7\xbb\x28\x8e\x77\x51\x7c\xd7\x69\x19\x8e\xa8\xe8\x43\x6d\xb3\x79\x27\x01\x33\xdf\x7c\xbb\x58\x9b\xe3\x61\xbb\x39\x42\x62\x01\x83\x97\x90\xc4\x0d\xd1\x1c\xd7\x1c\x10\xa4\x4a\x27\xb5\xc4\xa4\xf6\x70\xdc\x8a\xf8\x43\xc4\xe4\x69\xc4\x27\xe2\xcd\xa0\xba\xef\x34\xf3\x43\x49\x96\xe2\xcd\xd8\x45\x4e\x3e\x40\xc9\x53\x4c\x59\x82\xcd\xcd\x36\x4d\x68\x80\xe8\x04\x4c\x16\x81\xcb\xf1\x46\xe2\xaf\x97\x23\x89\xc4\x4a\x9e\xc3\x72\xc5\x9b\x67\x67\xbc\x15\x6f\x54\x37\x77\xbc\xe1\xf7\x9a\x2d\xbb\x49\x9c\x66\x01\x56\xf5\x07\xd5\xb8\xdd\xa5\x07\x8c\x26\x12\xba\x92\xf9\xdf\xcf\x84\x99\x4d\x98\x06\x78\xdc\xc2\x2c\x1b\xc3\x74\x87\x0f\xd2\xff\x38\x21\xc3\xf9\x78\x43\x32\x6b\x98\xcd\x32\x8e\x36\x73\xc3\xb7\x8b\x12\xed\x8e\x28\xcd\x66\xa2\xd8\x43\x95\xcd\x89\xa3\x59\x73\x4d\x4d\xfc\x99\xbe\x60\x22\x89\x49\x64\x79\x88\x8a\xe6\x32\x56\xf2\x1c\xd6\x13\xf1\x63\xd0\xcf\x37\xdb\x6f\x59\x80\xa5\xb0\x31\xe0\xcb\x09\x64\x6b\x72\x0a\x0a\x4e\x80\x65\x92\x69\x2e\xda\xb4\x26\x48\xe2\x86\x2c\x07\x09\x6d\x72\x2c\xa1\x24\x4f\xa0\x38\x63\xc3\x33\x13\xd4\x0e\x0c\xb2\x8f\x3b\x30\xc8\x77\xf7\xcb\x2e\x70\x0e\x10\xde\xda\xac\x09\x41\xd1\x66\x67\x50\xd1\x15\x2b\x9b\x9e\x89\x10\x24\x3b\xee\x43\xfb\x79\xee\x78\x88\xcf\x01\xbe\xeb\xb4\x0c\x27\x53\xf4\xa1\xb6\xd9\x3c\x92\x80\x99\xf7\xbd\x5d\x2c\x1c\x1f\xe2\x30\x82\xc4\x02\x06\x2f\x21\x89\x1b\xa2\xf9\xa8\x39\x20\x48\x95\x4e\x6a\x89\x49\xed\xe1\xb8\x15\xf1\x87\x88\xc9\xd3\x88\x4f\x04\x92\x41\x75\xdf\x69\xe6\x87\x92\x2c\x45\x94\xb1\x8b\x9c\x76\x80\x92\xa7\xf0\xb1\x04\x9b\x9b\x6d\x9a\xca\x00\xd1\x09\x98\x2c\x02\x97\x63\x8c\xc4\x5f\x2f\xc7\x10\x89\x95\x3c\x87\xe5\x0a\x36\xcf\xce\x78\x2b\xde\xa8\x6e\xee\x78\x23\x2f\x75\x5c\x76\x94\x63\xbc\xd9\xce\x26\xde\x76\x73\xde\xc8\x48\x37\x10\xd1\x15\x2d\x2f\x57\x7c\x22\xd8\x64\xc7\x4d\x10\xd9\xe9\x6c\xbf\x0b\xb3\xf0\x68\x10\xd7\x9d\x50\x52\x07\x9a\x66\x33\x4e\xf6\xb6\x9d\xf2\xed\x22\xa1\x2c\x3a\x46\x31\x20\xd2\x7c\xd8\x12\x90\x38\x01\x9a\xbb\x1a\x43\x01\x34\xe8\x22\x95\x98\xa4\x1e\x0d\x58\x51\x7e\x84\x97\x3c\x8b\xf7\x44\x7c\x19\x34\xf6\x5d\x96\x7d\x24\xc6\x52\x70\x51\x3d\xe4\x44\x9b\xeb\x76\x0a\x1f\x0b\xa0\x99\xa9\xa6\x99\x3b\xa7\x38\xc1\x92\x25\xd8\x72\x58\x91\x12\x2c\xc7\x0b\xa9\xa7\xa7\x90\x5c\x31\xe5\xc9\x69\x6d\x85\x14\xd5\xcb\x1d\x52\x68\x59\x4f\xbb\xb1\x86\xca\x8b\xfd\xf2\xd6\x7c\xe3\x19\x4c\xfd\xbc\xf1\x97\xe1\x16\xbc\x21\xb1\x1b\xa6\xe1\x3a\x35\xc0\xfb\xdd\x96\xee\x8f\x7a\xfa\x95\xd9\x48\x6e\x41\x20\xdd\xe8\x02\x41\x6a\x5e\x57\xb0\xc6\xfb\xfe\xa8\xa3\xd4\xe1\x1e\xa5\x68\x13\xcf\x5e\x7b\x5e\x6a\x4c\x3a\xfe\x6e\xf3\xb4\x34\xc0\x3b\xa0\x2e\x9b\xdd\xd3\xae\xa9\xc9\xba\x88\x62\x8a\xbf\xdf\xcf\x64\x9f\x54\x3a\x9d\x19\x11\x27\x3d\x79\x13\xcd\x6f\x7f\xad\xa3\x74\x13\x07\x56\x69\x1c\x86\xa6\x2b\xd3\x9a\xf8\x69\x73\x8e\x7d\x65\xb7\xbf\xc2\x61\x60\x07\x13\x8d\x38\xdf\x50\x30\x6e\x96\x81\x76\x0e\xea\x9b\xc3\x10\x25\x5d\xff\xa9\xfd\xa9\xf5\x26\xf4\x9f\xb4\x9e\xe6\x2e\x43\xfd\x5a\xb1\xf4\xd2\xf7\x4d\xfd\x79\xc2\x35\xae\x05\x20\x8c\xf4\x0e\x18\xbb\xa4\x55\xa9\x03\xcd\xdd\x6a\x08\x93\x9b\x7a\x25\x3d\x5d\x7b\xa7\x5d\xbb\x27\x81\xe2\x3a\xbe\x15\xb7\x16\xea\xac\xdb\xfd\x20\x8c\x65\xf0\xc0\x77\x5d\xd6\x23\xeb\x50\x7c\xf3\x8a\xa2\x96\x91\x51\x7d\x83\x6f\xa9\x66\x8e\x6d\x28\xf6\xde\x77\x20\x50\x5e\x27\xda\x7c\xbd\x8b\x2b\x4e\x97\x71\x86\xed\x33\x23\x17\xfe\xc0\x36\xdf\x74\x26\x5d\x24\x18\x6f\x6f\x1e\xef\x6e\x9e\xa9\xcb\x6f\xbb\xa6\x25\x5d\xff\x9a\x0c\x9d\xbc\x97\x92\x95\x69\x49\xcb\xfe\xf5\xf4\x1c\xd6\xdc\x04\x3e\xbe\xc8\xd9\xb6\xde\x18\x57\x22\x5a\x00\xa0\x67\x5f\x56\x7c\xbb\xc6\xf9\x52\x0f\xdb\x66\xf8\xd5\x88\xa7\x07\xf0\xfb\x3a\x43\x1d\xe9\xe1\xcd\x3c\xf2\xa6\xd4\x49\x25\xd2\x63\xc5\x86\x3c\xbe\x4b\x06\xde\xbb\x23\x67\x96\xba\x4b\x05\x23\x56\x10\x6c\xb7\x0e\xdb\x72\xfe\x34\x56\x54\xe5\x01\x40\x70\xc3\x8e\x44\x11\x6c\x41\x8c\xfb\xb8\xc2\xf0\xc4\xaf\x4b\x3b\x37\xec\xdd\x5e\x85\xc8\x67\xea\x71\xab\x89\x86\x50\x91\xfa\xe2\x38\x6e\xc8\xa7\xf6\x49\xde\xc9\xa0\x0e\x1c\x86\x41\x10\x8c\xbb\xd8\xb8\x23\xcb\x4f\xc1\x70\x34\xed\xca\xd9\x70\x67\x5f\x5a\xa0\x34\x2a\xbe\x04\xc6\xaf\x67\xa5\x25\xeb\xe5\xbd\xe0\x03\x9d\x29\x86\x59\x27\x14\x05\xed\xa5\x37\xba\xf0\x1d\xa3\x5a\xab\xb1\xe9\x25\xfe\x60\x05\x44\xc7\x25\xa4\xc3\xc6\xf1\x30\xb2\x37\xcd\x58\xdb\xe4\x17\xd1\x34\xa1\x69\xd9\x26\x52\x21\x3c\x97\x5b\x26\x58\x8b\x4f\x76\x0a\xcf\x90\x9b\xe6\x82\x41\xf5\x7c\xa7\x92\x85\xbb\x5a\xe3\xf2\xa5\xe4\xd7\x54\x4a\x6f\x0d\xc7\x6d\x8e\xc9\x91\x6b\x77\x36\x9f\xe7\xca\x93\xb7\xf4\x9a\x84\x3f\xd1\xf2\x13\x1a\xe7\x87\x08\x46\xa3\x0d\xf9\xfe\x21\x71\x7d\xd0\xb4\xfd\x70\xb6\x68\x5a\xb8\x89\x96\xa7\x3f\xe8\x22\xf3\x39\x7f\x95\xfb\x01\xc8\x90\xb5\xa1\x54\xad\xd8\x44\x3b\xfe\xef\xe4\xfc\x74\x92\x45\x55\x2e\xe1\x3e\x21\xcf\x05\x80\xa5\x99\xc0\xc6\x42\x82\x7b\x24\x28\x1d\xb8\xc7\x4b\x76\x1a\x5e\xfd\xcd\x24\x53\xab\x16\x40\xb6\x09\xe4\x90\x4e\x43\xb0\x17\x3a\xf7\xef\x27\x06\x8e\x70\x36\x2e\x23\xa8\x41\xcf\x40\x6a\x93\x58\xdb\x35\x79\x89\x93\xff\xf1\x7f\x7e\xcf\xf7\xbc\xfd\x91\x77\xe3\x3b\x38\xd7\x7f\x28\xb3\xae\x61\xcd\xb9\x5f\xe7\x7c\x9e\x92\xba\xff\x91\xd4\x42\xd4\xd5\x6f\x56\x67\x44\x19\xf9\x70\x9a\x6f\x43\xbb\xcb\x07\x43\x63\x10\xa6\x4b\x4b\x14\x34\xed\xbc\xb3\x94\x32\xec\x15\x9e\x3e\xe2\xaa\x4e\x5d\xdb\x68\x1c\xe1\x26\xe3\xa3\xfe\xa9\xdd\x11\x8b\xdf\x68\x4e\xba\x47\x13\x6a\x71\xf1\x66\x4c\x22\xfe\xf1\xca\xc5\x49\xc4\xad\xc0\xff\x98\x02\xfa\xb9\xbc\x12\xac\x82\xb8\x0a\x2c\xe3\x96\x55\x9e\xb7\xa6\xd8\x7e\x3c\x06\x77\x2d\x0e\xd9\x4a\x74\x84\xa5\x4b\xbb\x1a\x12\xab\xb7\xae\xd1\x4b\x8a\x3a\x5f\xf0\x94\x5b\x89\x57\x23\x11\x89\xa5\x1e\x36\x06\xd6\xf2\x0f\x29\xd0\x3c\x85\x6a\x80\x31\x8b\x8e\xfb\xa9\xdf\x4d\x02\x18\x92\x3e\x14\xc4\xc0\xbe\x71\x49\xb8\xe1\x94\x5a\xa0\xfb\xd1\x17\xf7\x2f\x4b\x6e\x42\x3f\xab\xc7\x4a\x5b\x40\x77\xb9\x94\xb6\x5e\xd7\x9f\x0f\xd4\x9a\x64\xbe\x02\x38\x81\x8b\x1c\x70\x0d\xa3\xd1\x16\x0f\x02\xc6\x27\xb7\xe7\xbc\xb4\x87\x05\xad\xcf\x4c\x00\x67\x57\x19\x3a\xa1\x61\x7c\xd2\xb6\xc5\xba\xe1\x32\x4a\xb9\xc0\x5a\x39\x64\x92\x6f\xce\xd4\xc1\x0b\x66\xa1\x51\xbe\xa9\xc9\x22\x2b\xfa\xf2\xac\x8d\x38\xed\x6e\x2a\x66\x6a\x9c\x19\x6e\x35\xfd\x04\x7b\x69\x20\xf0\x58\x43\x7b\x35\x8e\x0e\x98\x50\xfb\x80\xc3\x6a\xe2\xa0\xb7\xe8\x42\x8d\xed\xda\x49\xa0\x99\x1b\x48\x24\xcd\xfe\x7a\x0b\x40\xce\xb8\x14\xc0\x90\x71\x14\x51\xd3\x6e\xdd\xf4\x3f\xea\x1f\xd7\xf8\x30\xb4\x4c\x5f\x46\x18\x1a\xa6\xf9\x32\xd4\xd7\x3e\xdc\xcc\xb5\x5c\x30\x23\xac\x7f\xb0\x43\x17\x62\x11\xf3\x8d\xcc\x87\x30\x31\x4c\xe9\x51\x12\x15\xc3\xfa\xa6\xb5\x20\x33\xce\x13\xa3\xb9\x1e\x34\xe7\xf8\x34\x5b\xd7\xcf\xb0\x2d\x89\xb8\xba\x41\x81\x0c\x80\x2d\x8f\xc3\x03\x6c\x84\x27\x6c\x26\x14\xab\x64\x5a\x66\xb7\xa8\x7e\x5b\x4d\xde\xb7\x90\xb0\x94\xf7\x57\x32\x9e\x64\x3d\x71\x9a\xab\x65\xee\x88\xdf\x69\xa5\xd5\xcc\x11\x66\x91\x4c\x2c\x72\x66\x78\xfa\x9a\x67\x44\x95\x97\x75\xd8\xa8\x72\xa1\x22\xbf\xf5\x3d\x3d\xce\xc9\xa3\x54\x07\x63\x12\xfb\x34\x7f\x44\x70\x66\x33\x9a\xcf\x70\x4c\xa6\x61\x34\xe3\x1a\xaa\xb3\x18\x8b\xa3\x04\x9e\xe6\x1e\x9d\x83\x38\xbd\x01\xf7\xa1\x00\x63\xa1\xf1\xf6\x96\xea\xad\x5a\x21\xb9\xa2\x94\x2a\x42\x4a\x3c\x55\x8b\x54\xdd\xa4\xdf\x0c\x95\x8c\x58\x8a\x2e\x17\xad\x46\x62\x99\xd1\xd1\x31\x1c\xf4\x82\x95\xa4\x78\x77\x65\x5e\xcf\x05\xd0\x53\x83\x13\xfc\xc9\x38\x67\x65\x17\x18\xed\x0f\xb2\x4f\x9f\x9f\x75\x8a\x63\x1e\xd3\x5b\x46\x52\xe7\xfd\x6a\xf4\xa2\x3a\xf0\x9f\xcf\x9d\xdf\x7b\xc8\x7b\xa4\xaa\x9f\xdc\x73\x75\xb5\xd7\x03\x00\xf4\x29\x7d\x2e\xd3\x71\x2e\x30\xf8\x5a\xd8\x9f\xca\x09\x80\x0f\x02\x74\x9e\xcd\x03\x4e\x71\x9e\x20\xa0\xbc\x11\x08\x86\x16\x2d\x8d\x8c\x93\xca\x2c\xaa\xf3\x12\x90\x23\xee\x6a\xa0\xc5\x4c\x01\xc5\x72\xb7\x94\x93\x50\xf3\xc1\x3b\xa5\x74\xe7\x86\x25\x09\xe7\x03\xd0\xbb\x6e\xdb\xeb\x23\xf3\x7e\x63\x62\xfe\x06\x5a\x76\x1c\x5f\x18\x55\xb0\x30\xa6\x99\xde\xff\x3f\x7b\xdf\xda\xe4\x48\x8e\x1c\xf6\xfd\x7e\x45\xdd\x6c\x8c\x77\xfa\xa6\xc8\x2d\x92\xcd\x7e\xc6\xb6\x56\x96\x43\xf6\x45\x9c\xec\x0f\x77\x1f\x1c\x21\xf9\x03\x9a\x04\x9b\x75\x53\x64\x71\xab\x8a\x3d\xdd\xc7\x68\xc7\x76\x8f\x6d\xf9\x1d\xb6\x24\xcb\x92\x25\x3b\xe4\x87\x9e\x7e\x49\x7e\x48\x7e\xc9\x1f\xfc\x87\xf6\x2f\x38\x00\x24\x50\x09\x20\x81\xaa\xea\xe9\x55\xe8\x22\xee\x3a\x6e\x87\x05\x24\x12\x89\x44\x22\x91\x48\x24\x00\x97\x8a\x18\xf7\x83\x93\xf5\x80\xee\xc0\xf5\xff\x70\x5f\x37\xf9\x2a\x17\x67\xeb\xb0\x3d\x6c\x69\x12\x8d\x48\xbb\xf4\x2f\x6a\xbe\x63\x15\x6b\x38\x89\x08\x6d\x74\x5c\xca\xe2\xa3\x82\xdd\x97\xfb\xe6\x42\x2e\xbc\xc9\x22\xde\xf8\xb3\x73\x80\xa6\xe8\xab\xde\x5a\x1d\xbf\x0e\x57\x80\x96\x30\xd6\x66\x4c\x17\xbc\xe9\x76\xe1\x71\x6a\xfd\x2e\x0f\x3f\xbf\x64\x0d\x03\x59\x80\x8d\xa2\xfa\x6f\xc8\xa6\xd0\x87\xef\xfb\xc1\xc3\xad\xd6\x61\x60\x34\x23\x0d\xad\x27\x50\x34\x78\x3b\xbb\xf4\x0a\x57\x7c\xd1\x80\x79\x91\x1d\xd1\x17\xf1\x61\xc9\x21\xd6\xda\x28\xdb\x7e\xb7\x1d\xf5\xa8\x65\x59\xb5\x07\xbc\xc1\x41\x61\xe1\x80\x23\xd3\x70\x50\xda\xaf\x10\x5d\x3f\xd8\xae\x11\xec\xe9\xda\x3a\x1a\x1d\x41\x0e\x3b\x13\x1a\xe1\x2c\x02\x9a\x52\xd7\x5f\xe0\x24\x6c\x41\xe0\xd7\xe8\x83\x18\xfd\xc1\xef\xe9\x24\xa2\xd2\xc1\xa5\x9e\x3b\xc3\x79\x35\x93\x4f\x81\x7a\x50\x5e\xe5\x7a\x2c\xbe\x0e\x39\x80\x3c\x14\x87\xae\x43\xe2\x51\xcf\xbf\x76\x57\xce\xe7\x73\xe2\x10\xbc\xef\x01\xe7\x9c\xfb\x5b\x36\x68\x23\x47\xb3\xc4\x7b\x9c\x49\xd2\x0a\x29\xf5\x26\x7e\x29\x21\xc1\x1a\x17\x44\xde\x5b\x88\x13\x64\x19\x7c\x43\x79\x70\xc7\xbd\xe3\xba\x6d\x4c\x68\x71\x13\xbf\x8d\x92\x20\x94\xbc\xb0\x32\x4e\x68\x30\x2e\xa1\xe3\x3a\x5f\x89\x89\xd6\x7a\x71\x38\x50\x71\x30\xf2\xe1\xd6\xa1\xf0\xc8\x43\x43\x81\x40\x1c\xce\x76\xe6\x66\x98\xd7\xba\x20\x90\x5a\xee\x00\x76\x2c\x10\x1f\xba\x60\x04\x66\x6f\x30\x07\xaa\x72\x0a\x7f\xd3\x5e\xa6\x28\x5f\x8d\xbe\xe9\x9c\x00\x10\x85\x76\x93\x14\xce\x50\x6e\xb8\x23\x7c\x6d\xd8\xa7\x6b\x48\x35\xea\x97\x69\xc9\xe9\xd7\x09\x51\xd9\x28\x58\x50\x34\x0e\x11\xfb\x33\x62\x18\xc6\xf8\xa7\x51\xea\x55\x97\x43\xcd\xa1\x1d\xca\x19\xb9\x0f\xe4\xc0\x7b\xcd\x09\xfa\xea\x29\x38\xb9\x3a\xb4\x7c\x11\x62\x61\x48\xc3\x1a\xf7\x13\x99\x09\xee\x74\x32\xcf\x73\xaa\x77\x89\x40\xda\x09\x01\x66\x92\x73\xef\x0f\x41\xba\x2b\x09\x69\x17\x00\x60\x36\xd4\x5a\xab\x64\xc5\x9d\x2d\xbb\x3d\x38\xd6\x8f\x6b\x79\x39\x81\x0f\xb2\xcc\x55\x91\xa7\xf0\xef\x15\x23\x0c\x2e\x67\x03\xb3\x85\xb4\xd4\xfd\x1c\x28\xb0\xf6\xd1\xcd\xf7\x90\xdd\x5b\xf1\xa4\xa4\x46\x85\x76\x80\xad\x8d\x64\x2f\xd7\xae\x31\xb6\x0b\x7d\xd9\x8f\x0a\xbc\x87\x4c\x6d\xf5\x6e\xd9\x6d\x02\x9b\xb9\x29\xfe\x40\x84\x98\xa4\x50\x8c\x69\x6b\x7c\xb8\x1b\xf2\xb2\xf4\x96\xdd\x8e\x5e\x2e\xda\x42\xf7\xc5\x55\xbe\xb9\x39\xb4\x3e\x2d\x23\x06\xa3\x86\x5d\x9b\x8b\x13\x41\x7e\x90\x49\xb4\x5c\x2e\x5b\xb0\xab\x22\x47\x1e\xfa\x4b\x5b\xe8\x8c\x30\x6a\xd0\x2b\xa6\xc5\x52\x4e\x12\x17\xa1\x9d\x66\xcf\x0e\xb3\xf6\xf1\x2d\xdb\x41\xec\xd6\xc2\x5b\xcb\x4e\x55\xd0\xdf\x36\x5b\x39\xe7\x89\x10\x2b\xbf\x19\xb0\x3b\x07\x9d\xe8\xa5\xa2\xde\xf4\xf3\x2c\xc9\x12\x06\x67\xdf\x38\xa1\xe5\xd2\xd9\x64\x0e\xcb\x1c\xdc\x0f\xa1\x1a\xb9\xcb\x8b\xc2\xe1\xbd\x9d\x71\xc5\x0e\x1e\x9f\x6c\x88\xb7\x45\x7e\x70\xc2\xcb\x6c\x00\xd3\xbc\x94\x4e\xc6\x1c\xf1\x33\xfb\x04\xbe\xb7\x22\x8e\x96\xe4\xa0\x83\xc4\x4d\x88\x8b\x77\xe8\x5b\x30\xdd\x03\x85\xf6\xb7\xa2\xdb\x96\x42\xed\x13\x76\xe0\xd4\x77\x27\x5a\xc8\x42\x95\x58\x2e\x04\x2b\x47\x0a\x59\x84\xb6\x2b\x76\xf0\xd7\x1d\xf6\x00\xd1\xaa\x12\x95\x33\x13\x7c\x30\x40\xa0\xf5\x4c\x44\x83\x01\x2c\x5a\x22\x64\x1e\xa2\x4e\x96\xa1\x2d\xb6\xdb\x97\x3d\xb4\x43\xac\x85\x0c\x61\x70\x54\x4e\x46\x95\xed\x4f\x82\x8e\x79\x08\x0c\x03\x07\xad\x11\xdc\x34\x9a\xeb\x6a\x01\x12\x06\x36\xff\x03\x34\x1a\xb8\x6e\x08\xb7\xb6\x20\x1c\xd6\x74\x8e\x7a\xe9\x10\x92\xc1\xdc\xb5\xfb\x88\x56\x65\x31\xd5\x4c\xd1\x9f\x46\x73\x7f\x0c\x78\x6e\x2b\x6f\xa1\xee\x1e\x1e\xc6\x0d\xbb\x1e\xc1\xae\xcd\x95\xfc\xd8\xb1\xad\x1b\x7e\x0d\x51\x38\xd7\x15\xdb\x2e\xc5\x74\x9c\xda\xa5\xe0\x72\x36\xdf\xee\x92\xdd\xe3\x6a\x08\x77\xf7\x24\xb8\x26\x8b\x2c\x0c\x14\x41\x84\xed\x87\x1e\x2e\x99\xfb\xaf\x57\xca\x98\xcc\xd8\xac\xad\x31\xa3\xed\xa8\xf6\x8e\xcb\xd8\xe6\x16\x2e\xd6\xef\xd2\x49\x68\x02\xb4\x11\x9a\x26\xc6\xbd\x46\x06\x11\x71\x36\x29\x3a\xb1\x2f\x45\x16\x92\x21\x84\x41\x41\x3c\x73\x1b\x9c\xda\xf3\x7e\xd0\xc6\xdc\xe8\xee\x42\x06\xaf\x17\xdc\xdd\x81\x9e\xa3\x4d\x69\xd1\x87\x17\x93\xb9\xd5\xe5\x74\x37\x50\xfb\xcb\x02\x30\x53\x51\xc3\xd3\xf9\x3c\xd5\xff\x1f\x4f\xda\x8b\xf2\x0c\x39\xf5\xa2\x2a\x8b\x42\xb8\x76\xe4\x7d\x91\x1e\xe1\x0e\x4f\x4d\x72\x6f\xae\xda\x88\x30\x5f\x5d\x08\x71\x62\xc1\xd0\x75\xdf\x63\x42\xc4\x85\x61\x56\x87\x80\x3b\xc3\xb3\xcc\xdf\x11\x77\x6b\x35\x18\x34\xfd\x72\x50\x7e\x3b\xdf\xec\xca\xaa\x61\xdb\x46\xc7\xee\x0b\xd4\x28\x55\x77\x15\x8c\x17\x64\xae\x43\xff\xb6\xb0\x5d\x2d\x85\x02\x0f\x74\x7c\xa1\x53\xd6\x74\x85\xdc\x95\x11\x8d\x0c\x83\xa8\xbb\xa7\x49\x98\x43\x87\xdf\xfe\xc5\x88\x91\xeb\x11\x60\xe1\xec\x38\xc3\x11\x8f\xec\x4e\x3f\xdf\x0f\x2f\xdd\x9c\x65\xbb\xbb\x23\xf5\xc6\x7f\x59\xe5\x7c\x2b\xc8\x2f\xc5\x72\x79\xbb\xac\x17\x6c\xc7\x8f\x0e\xdf\x04\x55\xd3\x4c\x50\x25\xce\xb4\x6c\x1b\x96\x6f\x79\x35\x5a\x15\xfb\x7c\x79\xe5\x96\x49\x83\x10\x4a\x01\xa0\xfc\x58\x59\xa7\x94\xeb\x50\x40\x77\x0b\xc3\xfa\x3f\x7e\xcb\x6d\x88\xa6\x3f\x4b\xaa\xed\x63\x2d\x48\x78\x5a\x01\x34\xbe\x18\x79\xd2\xc3\x89\x01\x91\x2a\x2b\xd2\x46\x1f\x9b\x3d\x17\x04\xc4\xd5\x97\x83\x76\x1a\x94\xa2\x61\x22\x97\x61\x10\xb4\x34\xce\xb2\x6e\x72\x3a\xaa\xea\x20\x51\xb4\xc2\x0a\x56\xb6\x99\x61\x03\xab\x4a\x0e\x46\xd7\x00\xbb\xcd\xb7\x85\x62\x82\x2c\x34\x63\x8b\xa0\xd9\x49\x8f\x75\x35\xc1\x38\x7e\x7c\xbc\x78\x9f\xa2\x97\x92\xe6\x19\x22\x4a\x62\x44\x76\x55\x9b\x16\x76\x06\x75\xf2\xf3\xaa\x15\x36\x33\x5c\x25\xd2\xd4\x07\x50\xe3\xcf\x06\x3b\xf8\xa3\xc6\x10\x0c\xae\x55\xdf\x08\x52\x5c\xb1\xee\xaa\xf6\xa7\x63\x79\xfa\x05\x3f\x21\x2e\xfa\xed\x6c\x77\xe7\x74\x83\x48\xf1\xd6\xc4\xd6\x54\x4d\x9e\x57\x88\x19\x59\x97\xb6\x10\xe9\xb5\x4e\xdb\x24\xff\x04\x96\x95\x9d\x8c\xf3\x45\xb9\x1d\x89\x60\x5e\x6b\x76\x83\xf5\xe0\x74\xda\x76\xb0\xbf\xdd\x84\xc5\xd0\x45\xf7\xb6\x45\x8c\x78\x72\xdc\x67\x14\x43\x5f\x68\x7a\x04\x0f\xda\xb1\xd1\xfa\x39\x2f\x4e\xc5\x43\x76\x09\xdc\xac\xde\x66\x5b\x0e\xca\xf6\x71\x77\x9d\x00\x7d\x31\x71\x1f\xf6\x9a\xba\x33\x8f\xa6\xed\x14\xd3\x66\x3c\x7b\xae\x25\x6e\x24\x47\x69\x34\x14\x29\x06\xac\x14\xd6\x01\x16\x8f\xac\x43\x14\x64\xbf\x46\xcc\x93\x10\x25\xe8\x53\xeb\xef\x8e\x12\xb6\x47\x77\x0e\x0e\x5d\x19\x77\x37\x75\x78\x1b\x29\xef\xf1\xb2\x57\x31\x47\x47\xc4\x61\x61\xfd\x45\x0d\x92\x87\x6e\xb1\x12\x92\x83\x74\x1c\x08\x51\x3b\x20\x94\x8f\x14\x81\x74\x08\xd5\x9c\x10\x2a\x4b\xa7\x88\x18\x02\xdf\x51\xde\xc7\x60\xa7\xd7\xdb\x18\x86\x08\xef\xec\x36\xee\xd3\xa8\xe5\xff\x82\xa8\x80\xb7\x67\x66\x78\x76\x76\x8e\xe0\x15\x6c\xfe\x91\xef\x9b\xd0\x37\xdf\x07\xc2\x0a\x7c\x9c\xb0\xa1\x48\x63\x45\xe3\x73\x28\x42\xb0\x38\x48\xbc\x4e\x31\xb4\xc5\x33\xe8\xf1\x96\x10\x12\x9b\x90\x34\x02\x47\x6c\xf2\xf7\x03\x6e\x43\x38\xac\x03\x44\x5e\xa9\xc8\xe3\x2d\x56\x11\xfb\x01\x84\xe7\x74\xa6\x0e\x0a\x70\x5a\xf0\xc2\x8f\xb7\x90\x55\xea\xc7\x5b\xfc\x8a\x03\x8f\xb7\xd0\x58\xe8\x08\x07\x02\xa9\x1f\xe2\xe0\x1b\x27\xb6\x51\x6d\x63\x19\xf0\x78\x4b\xe7\xac\x87\x04\xdf\xda\xe3\x04\x46\xe2\x49\xc2\x05\x45\x5b\x99\x6e\x8f\xf7\xd0\xd9\x02\x0f\x12\x41\x50\x8d\x6d\x4f\x82\x59\xee\xac\x35\x74\x4f\xc8\xe6\xb9\x3a\x3a\xa3\x74\x27\x19\x1a\xef\xea\x7f\x7b\x4a\x3a\xd8\x73\xf9\xb3\x1d\x6f\x96\x01\x9f\xf4\xaa\xd2\xb4\x24\x54\x2b\x8a\xbc\x75\xeb\x45\x59\xe0\xc1\xa4\xe9\x85\x4c\x9a\x64\xb4\x41\x1f\xb4\x74\x71\xf4\xa5\x08\x78\x6a\x8b\x8a\x8c\xd4\xf9\x16\xef\xf3\x23\x8c\x93\xcc\x43\x39\xc9\x6c\x9c\x77\x75\x27\xce\xbb\xda\xc2\x79\xec\xe3\xb4\xec\x65\x7e\x67\x8e\x40\x98\x09\xde\x81\xef\x35\x97\x89\xc5\x8d\x6f\x69\x20\x97\x9d\xb7\x8e\x30\x34\x08\x18\x54\x94\xf0\x10\xc9\xde\x02\x10\xf9\xbb\x85\xb9\xf4\x1d\x05\x76\xb1\xbf\x69\x23\xb1\xc0\xd1\xf2\x13\xb6\x1d\x89\x3d\xeb\xd5\x99\xf8\xd3\x22\x02\x89\xfc\x54\xfc\xb9\xa5\xed\xa5\x57\x1a\xca\x05\x39\x67\x41\x00\xc9\x4c\x67\xf7\x9f\x82\xa3\x56\x9c\x34\x8c\xbd\x73\x2b\xf6\xc7\xe3\x11\x00\x0f\x5d\xb4\x77\xd4\x6a\xc0\xba\x1f\x76\xe8\x5b\xab\xd9\x92\x48\x7b\x41\xf5\x20\xb0\x85\xed\xb1\xb1\xdd\xd1\xe3\x92\xc2\x36\x08\x23\xed\x09\xd7\x87\x4a\x04\x6d\xd1\x29\x63\x36\x9f\xc3\x4d\x58\x6b\xda\x12\xad\xe3\x04\xc2\x05\x3a\x68\x05\x20\x6f\xbd\xd2\x1b\x3f\x5a\x96\xfb\xc5\xcf\xce\xce\x82\xc5\x5b\xc7\x5c\x00\x40\xce\xa8\x83\x06\xb0\xec\x4e\x14\xec\xd2\x01\xd3\xa7\x1b\xbb\x42\x63\xa4\x7c\xa1\x68\xde\x6e\x0b\x85\xa8\x26\xbc\x90\xec\xa3\x4f\xe2\x2b\xd1\xce\x36\x46\xca\xbe\x98\x1a\xa0\xeb\x30\x23\x39\x7d\x7e\xd1\xe7\xb6\xaf\x45\xf0\x22\x5a\x24\x50\x49\xab\x04\xd2\x8f\x29\xfc\xec\x46\x22\x14\xc3\x94\x90\xdf\x56\x7d\x5c\x33\x48\x05\xbe\x31\x30\x26\xae\xed\xe5\x72\x29\x1b\xcb\x40\x3f\xa1\x33\x76\xa3\xbc\xe1\x9b\xc4\x4d\x90\x1b\x0b\xf9\xf6\x26\x55\x47\x5b\x7a\xc3\x6b\x42\xe4\x5b\x34\xa1\xa6\x84\xd8\x6a\xf2\x2d\x9e\x45\x51\xb5\x37\xe8\x75\x21\xed\x75\x4f\x5f\x07\xc5\x83\x8a\xb8\x1d\x6f\x1a\x91\x6f\x6f\x79\x55\x5b\xd7\x6f\x02\xd4\x74\xea\xde\x28\x9c\x9d\x8b\x3f\xb7\x68\xc0\x72\x72\x73\xb5\x59\x11\x04\xc0\x96\xd3\xf9\x52\xfc\xc5\x6b\x72\x78\x4c\xc3\xb4\x41\x17\x31\x9a\x3a\x50\x19\xb0\xee\x88\x31\x4a\x15\x52\xe8\x8c\xe6\x49\x7b\x41\xf5\x20\xb0\x85\xed\xa6\xb2\xab\x27\x1d\xdb\x25\xed\x09\xd7\x87\x4a\x04\x6d\xd1\x79\x7c\x7c\xec\xd3\xd9\x87\x9b\xa4\x45\x84\x87\x29\x5d\xa0\x83\xd6\x0e\x8b\xa8\x1b\x7f\xd4\x22\x12\xd1\x37\xa1\xe2\x9e\x45\xe4\x02\x10\x16\xd1\x24\x13\x7f\x41\x94\xc8\x86\x49\x7b\xc0\xf4\xe9\xc6\x0e\x8b\x48\xc9\x97\xb6\x88\x44\x73\x3b\x2d\x22\xa2\x9a\xd0\x7c\xa6\x3f\x61\xe7\x76\x90\x92\x0a\xa1\x6d\xef\x8a\x0b\xb5\x66\x30\x4a\xcb\x76\xeb\xd0\x68\x51\x1c\xdd\x1d\x12\x29\xfb\x62\x3a\x8b\xae\xc3\xa8\x9d\xf4\xf9\x45\x9f\xdb\xbe\x16\x41\x77\x23\x9f\xdd\x89\x48\x63\xa5\x1f\x53\xf8\xd9\x8d\x44\x28\x86\x69\x4c\xbf\xad\xda\x1c\x08\x52\x81\xcd\xb7\x0e\x89\xf5\xcd\x0a\x52\xab\x19\x0b\x24\xd0\x7c\x93\xdf\x1f\x55\x6b\xf0\x74\x21\x1d\x62\x66\x79\x85\xbb\xcd\xac\x8e\x46\x1c\x1f\x8b\xa7\xcc\x2a\xce\x96\x8b\x6a\xbf\xb9\xd6\x0e\xf7\x8b\x33\xbd\xb3\x66\x3b\xeb\xc4\x26\xa4\x77\xd9\xa7\x2f\xc7\xea\x82\x46\x6d\x99\x81\xab\x53\xfa\x05\xdb\xaa\x64\x38\xf5\x8e\xdd\xe4\x5b\x19\xae\x40\xee\x34\x38\xf0\x6f\x8b\xdc\x0b\x2c\xfc\xec\xff\xfd\xd6\x2b\xed\x8e\x56\x17\x9b\x68\x8d\xae\x9e\x7f\x6d\xcb\xc3\x48\xd4\x2d\x97\x96\x3f\xaa\x1f\x70\xd8\xee\x6f\xd9\xe0\x24\xa3\x5a\xb2\x63\xe2\xb6\x72\xbb\x11\x38\x0e\x5c\xed\x98\xe0\x2a\xc0\xb4\xb4\x13\xea\x1d\xdb\x06\x43\x24\xac\xc0\x11\x73\x7c\x97\x3a\xea\x41\x9e\xc1\xb1\x6f\x45\x1f\x72\x98\xc2\x8e\xea\xd8\xdd\x39\xcd\xb0\x8e\x69\xb1\x34\x92\x29\x9b\x87\xb1\xc5\x9c\xe1\x71\x17\xbb\x4b\x43\xc1\xc2\x24\xa0\x3c\x49\x41\xc4\x3b\xef\x54\xea\xe6\x39\xb5\xb6\x3a\xd2\x4d\x06\x2b\xde\x4e\x16\x95\xd3\x05\x64\x8e\x73\x60\xee\xd2\xbe\x82\xbd\xcf\xf9\x26\xe9\x56\x43\x98\xcd\x74\x93\x92\xa9\x04\x2d\x6d\x9e\xdf\x02\x9d\x27\x88\x0d\x66\x44\x70\x12\x8d\x9c\x5d\x46\x67\x40\x2d\xab\x56\x23\x21\xd1\x3d\xc1\x83\x2b\xd4\x8a\xcf\x6d\x78\x9b\x4e\x91\x89\x72\x89\xc6\x9b\x5c\xbf\xf9\x56\x56\x14\x33\x62\x01\xb4\x46\x5c\xfc\x19\x1b\x8b\x3a\x45\x8c\x43\x68\xb2\x75\x27\x6a\x5b\x8d\xb8\xfb\xc9\xd5\x29\x90\x26\xaa\xed\x3e\x3f\x6e\x6b\x12\x79\xaf\xbd\x8f\x3f\x3c\xd8\x89\x7c\x62\xb4\xe1\x61\x7c\x12\x1e\xe1\x27\xbb\x3b\xa2\xee\x82\x45\xab\x1e\x30\xce\x4f\x22\xe3\xdc\xad\xbb\xde\xf8\x7c\xad\x37\x1e\x5f\x43\x17\x08\xd8\x6c\x9d\xfb\xa8\x23\x2c\xad\x37\x03\x59\x3a\x0b\xb3\x74\xe6\xb0\xb4\xde\x38\x3c\x63\x69\x34\xbb\x8b\xa5\xb3\x08\x4b\x75\xdd\xbc\x8a\xcf\xac\xae\x31\xe1\x9d\xf5\x02\x2c\x3a\x24\x5e\x7d\x74\x07\xc2\xa3\x42\x56\xf8\xbb\x9e\xb6\x81\xed\x72\x0a\x57\xb2\x43\x86\x3a\x58\x5d\x7d\xbc\xbb\x8b\x8e\x5c\x77\x16\x05\xde\x00\x47\x84\x39\x85\xeb\x47\x6a\x43\xa7\x0c\x3f\xd5\xab\x0a\x8f\xb7\xfc\xae\x69\x5b\xa4\x3e\x65\xa3\xd0\xa6\xa6\x01\xde\x55\xfc\x36\x2f\xf7\x35\x2a\x60\x92\x50\x21\x21\x49\xa6\x8c\xd1\x67\x2c\xf5\x93\xec\x96\xd0\x5a\xd5\xca\x90\xb5\x74\xaa\x43\x4a\xf9\xa9\xb0\x10\xbb\xab\x8c\x85\x34\x9e\x0a\x47\xeb\x89\xf8\xcf\x8c\x6f\xd0\xa8\x3c\x9d\xbf\xb6\xee\x37\x3f\x0d\xdd\x72\xb2\x5a\xad\x7c\x09\x24\x8e\xe7\xbb\x51\x2e\xd7\xac\xe6\x92\x14\xbb\xcb\xc7\xd3\x39\xdf\x00\xd1\x17\x7c\xb3\x6b\xee\x0d\xe9\xe8\xa2\x40\x08\x75\xf1\x6c\x40\x7d\x02\xe9\x81\xa9\x37\x71\x80\xcd\xfa\xcb\x78\xa6\x45\x71\x93\x27\x91\xd9\x13\x8e\x69\x94\x67\x1f\x2a\x06\xc3\x25\x45\x40\xa7\x76\xcc\x12\x3e\x06\x69\x32\x5b\x40\x3f\xbf\xae\xf8\xca\x2c\x71\xa8\xac\x90\xf7\x45\x6c\x11\xcf\xb9\x46\x07\x0f\x29\x1f\xc2\xa7\x5b\x2d\x38\xaa\x5a\x3b\x2b\x54\xad\x7a\x65\x5d\xa3\x83\xf7\x54\x09\x38\xf5\xa6\xaa\x03\x47\x55\x6b\x67\x85\xaa\x55\x8f\x2d\x6b\x74\xe2\xa9\x46\xaa\x4e\xf9\x5c\x23\x06\xa2\x2a\x44\xe9\xa1\xda\xd4\x9b\xab\x1a\x11\xbc\xd5\x46\xc0\xa9\xf7\xda\x1c\x38\xaa\x4e\x3b\x2b\x54\xad\x7a\xc8\x51\xa3\x83\xa7\xde\x7c\x30\xf5\xa4\x93\x0d\x46\x55\x6a\xe5\x84\xea\x54\xef\xb9\x3d\xc0\xa3\x51\xa4\x0a\x6f\x63\x4a\x70\xcc\xb2\xbc\xb2\xfd\xd4\x9f\xbb\x5d\x35\x01\xf5\x88\x31\x64\x6b\x0c\x32\xce\x8d\x52\x15\xbe\x3e\xf1\x9b\x21\x6d\x42\x7b\xb6\x50\xa1\x39\xa2\x59\x61\xcd\xa1\x5a\x1d\xd1\x1c\x4e\x6c\x4f\x5b\x48\xbf\x10\xa4\x71\x58\x61\x5d\xed\x73\x41\xce\xa6\x95\xb6\xeb\x35\x8e\xf6\xcc\xba\xce\x61\x90\x77\xb0\x4d\x77\xbf\xc5\xd2\x11\xe3\xa0\xd7\x65\xad\x49\x8b\x86\x79\x0b\xa0\xb0\xb4\x94\xb0\xed\x39\x70\x7d\x8e\xbe\x25\x07\x2f\x41\xa5\x69\xf2\xc3\xfd\xe6\xba\x6c\x2a\xb4\xf0\x17\x2c\x98\x11\x51\xed\x33\x3f\x6e\x4a\x26\xf9\x6d\x92\xf7\x6c\x18\xc4\x69\xfb\x33\x19\xaf\x27\xf8\x73\x3d\xf1\x77\x14\x41\xde\xc3\x1b\x8b\x7e\x09\xa1\x0a\x86\xc0\x83\xae\x1a\x52\x04\x46\xfe\xd0\xed\xce\xce\xc6\x04\x8a\xc5\x5b\x14\x28\xd4\xd9\xac\x40\xb9\xae\xb6\x81\x0c\xe7\xdb\x35\xaf\xf2\x06\xf5\x6c\xe2\x06\x6b\x3a\x67\x7a\xa6\x13\x47\x91\x4c\xb3\x0c\x15\xbf\x5a\x9b\xfd\x00\x61\xb1\x83\xf0\x2c\xe7\xe2\xef\x01\x9f\xc7\x31\x25\x52\xff\x10\x8e\xc9\x3b\xd8\x7a\xe3\x84\x3c\xd0\xaa\x53\x70\x78\x9c\xc1\x90\xb4\xd8\x0f\xed\xd6\x87\xb8\xaa\x57\xef\x88\xd4\x8b\x8a\xf3\xad\x3a\x23\xe8\x87\xe8\xb5\xa4\xe8\x6a\xc4\x50\x3a\x3e\xf3\x87\x92\x48\x7b\x46\x0b\x35\x16\xd9\x9e\x93\xcc\x6b\xcf\x49\xe6\xb4\xc7\x1d\x6d\xe8\xb2\xa5\x13\x31\xfa\x1f\xc6\xcd\x7a\xbf\xb9\xde\xb2\xbc\xb5\x25\x6d\x7b\xff\x38\xe4\xfa\xf4\x5d\x6f\xa4\x6e\xeb\xb5\x22\xc0\x6f\x15\xb5\xef\x5d\x5d\xa8\xa2\xc9\x78\x5a\x27\xe2\xa9\xab\x51\xbe\x1d\x95\xfb\xc6\x79\xcf\x2c\x00\xd4\x09\x81\x9a\x9e\x30\x38\x2b\xaf\x13\xc4\xa7\xc3\x0f\x5b\x1e\xf4\xb1\x25\x7c\xee\xc6\xdc\xa3\xa1\x13\xf0\xeb\x29\x6d\x5d\xe3\x05\xdb\x61\x3f\xab\x38\xde\xa5\x27\x57\xe1\x76\x60\x2d\x2c\xcc\x25\x29\x4a\x02\xcb\x00\xa7\x80\x45\x40\x38\x8a\xe0\x05\x3b\x53\x53\xc8\x8f\xed\x75\x11\xda\x98\xf0\x7b\x0a\xb0\x26\xeb\x63\x3d\xf8\x85\x94\x67\x97\x8e\x92\x50\x40\x0a\x56\xfa\xdb\x0f\x8e\x45\x01\x20\x57\xbb\x14\x7e\xec\xbd\xb0\x7d\x00\x19\x2d\xf3\x7a\x93\xd7\x72\xcd\x94\xda\x49\xf9\x35\xba\x5e\x5d\x31\x7c\x36\xdf\xdd\x11\x05\x93\xf1\xa2\x28\x6b\xaa\x3c\xe4\x84\xcc\x07\x61\x0c\x41\xd4\xab\xd4\x67\x54\x3b\xf5\x8c\x42\x18\x66\xcb\xd5\x2a\x5b\xba\x8e\xbc\xe5\x09\x3f\x5f\x9c\x98\x6e\x5f\x9c\x9e\xcc\x96\x0e\xaa\x84\x54\x8e\x8b\x73\x3e\xbd\x9e\xb9\xa0\xf0\x89\x37\x84\xa6\xd7\xf3\x63\x61\x0f\xaa\x9c\x80\xa1\xbd\x3c\xe7\xcb\x95\x31\xb8\x20\xf1\x7a\xc1\xcf\x56\x66\x21\x38\x9b\x9c\x66\x67\x2b\x8c\x87\x26\x8c\x9d\xf0\x09\xb7\xea\x23\xa9\x3a\x9e\x4f\x4f\xce\x35\x14\xcc\x3b\x04\x61\xab\xc5\xea\x8c\xcf\x1c\xc2\x56\x8c\x5f\x2f\x16\x9a\xb0\x33\x76\xb2\x9c\x5d\x3b\xa8\x68\xda\x56\xa7\x7c\x72\x3d\x77\x41\x09\xf2\x4e\x4e\xe6\x93\x96\x69\x41\xc3\x7d\x35\x5d\x72\xef\x09\x7a\x41\xdb\xd2\xb0\x8d\x9d\x1f\x1f\x1f\x4f\x6d\x4c\x34\x71\xfc\xf8\xfa\x7c\x91\x39\x90\x04\x6d\x67\xc7\xb3\xf9\xec\xf8\x61\x2c\x5e\x96\xaa\xc4\x5a\xcd\xbd\xa0\x0b\x54\xd2\xd4\xb7\xd2\xa6\xb4\x95\x16\xda\x9c\xea\x3a\xff\xe5\x3d\x0a\x77\x74\xd9\x1b\xb2\xa5\x5f\x46\x5b\xa0\xdd\x1d\x38\x80\x6c\x54\xab\x54\xb3\x11\x7f\xa2\x6c\x94\xbb\xb0\xef\x58\x5d\x80\x11\x1e\x69\xdf\x08\x1d\x3c\xd3\x44\xd3\xcf\x28\x04\x20\x35\x6e\x34\xff\xc8\x96\x25\xe3\x13\x35\xfd\x38\xd3\x97\x93\x19\xcc\xb1\x19\x37\xaa\x9b\x2a\xdf\x89\xab\x8f\x4c\x2a\xa4\x24\x16\x1c\x16\xde\x5c\xbc\x44\x76\xa1\xe9\x13\x6b\x38\x56\x8d\xcc\x2b\x64\xc7\xf3\x25\xbf\x49\x89\x03\x77\xf3\xa3\x64\x3a\x7f\x9d\xa2\x29\xc1\xfb\x9e\x67\xaf\x03\x25\xc3\x39\xa7\x0e\x0e\xe7\xfb\xe8\xd2\xa7\xbc\xfc\x31\x24\xfa\xc7\x89\x62\x69\x16\x8a\x3b\x32\x12\xf1\x1f\x5b\xe0\xb4\x29\x62\x12\x21\xc1\x11\x37\x2d\x5d\x6c\x9b\x6f\x94\xcf\x96\x90\xda\x3a\x99\xea\xa7\x65\x93\x7c\xbb\xca\xb7\x79\x23\x47\xc5\xf0\x42\x83\x4b\xb8\xa3\xa8\xd3\xed\x15\x1f\x5e\xa0\xae\xbb\x46\xa1\x9c\x0e\x3b\x81\x80\x98\x4e\x38\x98\xbf\x7e\x32\xb4\x7f\x32\xb4\xa3\x43\xdb\x91\xf5\x0e\x5f\x6b\x97\x88\x39\x9e\x52\x0b\xbc\xd3\xc3\x29\x17\xcf\x78\xb5\x20\x56\x22\x90\x8c\xb7\xf8\x30\x48\x06\xf9\xa9\xfa\x67\x24\x5e\x87\x3e\xfc\xa8\x14\x87\xf5\x2e\x1d\xd3\x07\x20\x15\x88\x59\xa4\xc9\x7b\x75\x20\xa7\xbc\xfe\x21\x5f\xb4\x8f\x24\x43\x4c\x0d\xce\x1b\xe7\x9b\x9b\x91\x59\x54\xa1\xe5\xbf\xda\x9f\x50\xa0\x72\x19\x00\x04\x5d\xa1\x47\x25\xcd\x0a\x44\x58\x32\xe0\xb1\x94\x40\xd2\xcf\x66\x17\x10\x29\xce\x8a\x05\x17\x10\x6d\x48\xfd\xc2\x50\x0f\x71\xb7\xa2\xe3\x7e\x6d\xca\x9d\x46\xa5\x2e\xc2\x3f\x90\xfe\xd9\xb6\x3a\x61\x21\xba\x30\x2a\x55\xc3\x68\x2f\x10\xee\x1c\xc7\xc2\x6c\xbb\x73\x24\x7c\x56\x87\xae\xcb\x81\x5b\x47\xd3\xc1\x46\x34\xc5\x4e\x0d\x7d\xf4\xd8\x71\x4b\x11\x0b\x35\xda\x77\xd1\x5e\x8b\x60\x57\x22\x4c\xb7\x4b\x5f\x5a\x83\xde\x0a\x8f\x02\x4b\x66\x43\x1b\xbf\x5d\x41\x3c\x2e\xce\x76\xbf\xd9\xe1\x89\x1b\x29\x14\xaa\x05\xb2\xdd\x8a\x3c\x5f\x66\xc0\x7d\xa8\x17\x1a\xe2\x48\x94\x57\xc6\xf8\x1d\xdc\x74\xe9\x7d\x08\x60\x84\x42\x81\xcc\xc8\x1e\x6f\x4b\x09\xd5\x4d\x72\xd9\xf2\x40\x63\x6d\x87\xff\x6b\xbc\x22\x10\x3c\xf1\x38\x6e\xb6\x61\xd3\x60\x0e\xb4\x20\x9c\x1f\xda\x8e\x11\x01\x4a\xf0\x53\x6c\x69\x90\x3b\xb8\x01\x9c\x61\xf7\x6d\xb0\x88\x22\xf3\x39\x05\x25\xfd\xc1\x82\x07\xc7\xd7\x11\x42\xe3\x23\x10\xbc\x1f\x4e\x6f\x47\xa9\x00\xb1\xde\x29\x5e\x17\x81\xb6\x5d\xe9\xf4\x50\x1f\x43\x6e\x20\x34\x6d\x70\xd4\x56\x88\xac\x20\xf3\xd3\xa1\x05\xae\xc6\xf5\x86\x15\xc5\xf0\x72\xd1\x62\x83\x65\xab\x57\xb1\xab\xf1\xc7\x54\xda\x41\x71\x5c\xa8\x9f\x59\xec\x6a\xfc\x31\x95\x2a\x8a\xbb\xc6\x53\xa8\xa3\xe8\x71\x11\x67\x55\xb4\x4c\x8f\x91\x44\xed\x4b\x9a\x05\x13\xc0\x28\xc7\x25\x31\x0e\x94\xd3\xf3\x81\x85\x10\x04\x26\x0c\x1a\x7f\x18\x0d\x0c\xdd\x70\x7e\x6c\x76\x72\x90\x74\x00\x59\x81\x24\xe1\x76\x67\xc2\xaf\x1b\x26\x18\xf8\x9f\x76\x01\x74\x35\x4c\x83\xf5\x69\x1f\xc0\xf6\x83\xea\xc5\x0c\x0d\xeb\x05\xd7\xf8\x0c\xd1\x9c\xb2\x14\x22\x74\xab\x87\x5e\x2e\x51\x34\x8c\xf4\x3b\x53\x2c\x96\x3e\x6b\x82\xc5\xa2\x74\x88\x70\x1f\x73\x00\x41\x90\xf1\x02\x43\x9c\xdd\xa8\x78\x0c\xc2\x16\xa4\x50\x2b\x17\xc7\x7c\xb6\x9a\x05\x5a\x09\xfc\x4f\xa3\xb9\xd1\x96\xf4\x92\x1f\x04\xd8\x03\xa4\xbb\xe9\x43\xc4\x06\xf8\x62\x8b\x0d\x6c\x45\xb8\xb8\xf5\x6a\x15\xc0\xd4\xc6\x00\x81\x54\x6d\x2a\x3c\xb0\x10\x82\x10\xed\x24\xfe\x30\x9a\x20\xe3\x75\x7e\x94\xe9\x36\x92\x0e\x20\x8b\x93\xe1\x76\xb3\xd5\x74\xb1\x08\x13\x0c\xfd\x92\x76\x01\x74\x35\xac\x97\x50\xd9\xb0\xfd\xa0\x7a\x31\x63\x80\x74\x69\x4e\x59\xd2\xa5\xf7\x93\x5c\xf4\xe0\xdc\x00\x28\xb5\xaf\x43\x31\x59\xee\x09\x11\x4c\x06\x17\x61\x80\x78\x0a\x7b\x10\x49\xb0\x03\x20\x3b\xca\x78\x0b\x45\x1c\xc6\x62\x61\xb0\xc5\x62\xc3\x8b\x14\x2b\x55\x13\xf4\x47\xda\x91\xdf\xd1\xa6\x5e\x32\x65\x81\xf6\x02\xea\xc3\x86\x01\x02\xa5\x79\x64\x09\x94\xde\x02\x0c\x58\x84\x9d\xee\x14\xb7\x1c\xbe\x42\xca\x78\x06\xac\x50\xbc\xf1\x4c\x84\x29\x6f\xbd\xeb\xef\xa6\x59\x7f\x7f\x07\xf2\x20\xea\xf6\x20\x4f\x03\xb1\x73\xa6\xf6\x04\x27\xce\x9e\x60\x66\x6f\x9a\x05\x81\x80\x60\xe5\xba\x33\x7e\x1b\x08\x10\xd7\x19\x6d\xc8\xbb\x49\xe9\x13\xf7\x6e\x17\x77\x82\xdf\x45\xa6\xee\x09\xdf\x5f\x04\x2d\x07\xfe\x45\xb9\x43\x05\xfc\x87\xfc\x3e\x70\x16\x00\xd5\xdd\x1e\x90\x4e\xfc\x57\xfa\x54\x13\x9a\xbc\x71\x3e\xcc\x52\xc4\x4f\xbb\x62\x76\xaa\xf3\x49\x94\x83\x62\xee\xd2\x04\x81\xc4\x24\x35\xc3\x61\xa3\x70\x7a\x44\x14\x5c\x95\x65\xc3\x2b\x8a\xb3\x1d\xdb\xd0\xf6\xcd\xa5\x38\x56\xa8\xe3\xe0\x05\xe1\xfd\x6a\xd9\x7d\x85\x46\x21\x34\xff\x0a\x28\xd5\x47\xf8\x31\x88\x33\x7e\x32\x02\x8b\xb7\x78\xea\x81\xd6\x2b\xa3\xc3\x01\xc0\x69\x45\x9c\xb0\xa4\xaa\xc6\xde\xc7\x24\xe6\x9a\xec\x41\x52\x6f\x5c\x28\x72\xe1\x22\xfb\x38\xd9\xb7\xea\x2f\x58\xb8\xfa\x82\x0d\x69\x49\x3f\x4c\xba\x1d\x46\x7e\x5f\x44\xba\xac\x31\xfd\x36\x42\x65\x5f\x1e\xdb\x84\x64\x21\xa6\x66\x0f\x6e\xd5\xcf\xa8\x0a\x22\x2f\xf0\x8c\xf3\xd6\x1e\xc6\x14\x70\xa0\x43\xe4\xce\x84\xe9\x2e\xe2\x6b\x54\xf1\x7a\x57\x6e\x6b\x11\x24\x2d\x5e\xa7\x29\x78\x70\xb4\x91\xb8\x13\x88\x9f\xb3\xb1\xd2\xa9\x7e\x5d\x1a\xee\xd0\x2f\x34\x34\x80\x0c\x73\x12\x72\x71\x52\xfa\xcc\x72\x57\x8d\x9c\xae\xac\x94\xea\x65\x10\x0b\xd1\xec\x8d\xb8\x5f\xce\x40\x6a\x07\xd1\x73\x08\xc8\xbb\xa3\x5f\xdc\xd1\xfa\xf0\x4d\x31\x3e\x69\x96\xe1\x96\xbd\x68\x3d\xeb\x97\xa9\xa7\x83\xbf\x2f\xd6\x9e\xce\x7a\x22\xed\x19\xc8\x99\x08\xc5\x03\x31\xf5\xa6\xe9\x63\xb8\x38\x10\xd3\xba\xa7\xf8\x7f\xa3\x22\x5e\x30\xba\x2d\x2f\x5a\xcd\xfa\x45\xaa\xe9\xd1\x35\x7f\x26\xd5\x84\x5b\x33\x90\x2d\x61\x7a\x07\x22\xea\x4b\xd1\x47\x70\x70\x20\x22\x4c\xd1\xe1\x59\xea\xbb\x2d\xaf\x29\x08\x91\xd6\xb7\x14\x30\x12\x27\x54\x2f\x80\x54\x18\x4c\x3d\x91\xf6\xc9\x18\x44\x65\x7f\x42\x0e\x51\xab\xf6\xd2\xce\x7d\x99\x5e\xea\x68\x49\x54\x95\xbe\x58\x1d\xeb\x97\xa8\x23\xca\xd9\x17\x6a\x47\x47\x1d\x91\x76\x0c\x61\x47\x84\xd4\x21\x68\xfa\x52\xf3\x7c\xc6\x0d\x41\x63\x51\x13\x17\xf4\x6f\x4c\x92\x0b\x46\xb7\xe3\xc5\xaa\x58\xbf\x40\x15\x9d\xdd\xf1\x8d\x57\x11\x6e\xc5\x10\x5e\x84\x09\x1d\x82\xa5\x27\x2d\xcf\x66\xda\x10\x2c\xeb\xb0\xaa\x0e\x28\x63\xbd\xe2\xef\xed\x87\x48\x07\x95\x6b\x7d\xa9\xa6\x51\x6d\xd2\x5b\x67\x95\xef\xe5\x20\x79\x31\x40\x32\xe3\x2d\x85\xd8\x2d\x82\x81\x0e\x41\x27\xa1\x33\x94\x15\x1c\x5f\x06\xb1\xba\x80\x80\xf9\x22\x0b\x20\x52\x23\xf2\xaa\xa9\xae\x62\x7a\x8a\x02\x5f\xf7\x02\x17\x72\x35\x00\xbb\x01\xef\x87\x5d\x08\xc4\x00\xec\x06\x3c\x86\x3d\xcc\xcb\x9e\xbc\xea\x85\xe0\xb9\x14\xf4\xe2\x67\x2f\x04\xcf\xa5\xa0\x17\xcf\x7b\x21\xb0\x28\xd0\x43\x40\xc7\xc6\x5a\xe8\x08\x06\x86\x35\x12\x05\xbd\xee\x03\x8d\x78\x3b\x04\xba\x17\x6e\xc4\xb5\x21\xd0\x11\xdc\x31\x16\xf7\xe1\x52\xaf\xf2\xcf\xac\xbf\x0f\x27\x7b\x95\x7f\x66\xfd\x7d\xb8\xdd\xab\x3c\xae\x5f\x4b\xa8\x7e\xfa\xc5\x46\xe7\x31\x0f\x0b\xf7\x55\xb3\x4c\x07\x81\xaf\x83\xe0\x40\x5a\x5f\xec\x24\xf8\x7a\x00\x2b\x7a\xb5\x66\x30\x82\x21\x14\xf4\x6a\xf1\x60\x04\x6b\x77\xc3\xa4\xb3\x3f\x0b\x16\xae\x3e\x0e\xbd\x0e\x42\x83\xa0\xf7\xc4\x4d\x41\x0f\xe1\x64\x9f\x96\x0c\x2d\x3f\xa4\xfe\x3e\xad\x1d\x5a\xbe\xab\x1f\x5b\x7c\x00\xe8\x6f\xf5\x42\x89\xe0\xb9\x8d\x07\xc2\xa6\x74\xf7\x76\xb4\x6f\xc1\xbe\x57\xd4\x2d\xf5\xd6\x2e\x2c\xcc\xbd\x39\x0d\xa9\x6d\xd6\x40\xeb\x6c\x58\x6f\x37\x2b\x0a\xe7\x9a\xc1\x09\x61\x88\x42\x75\xa4\x2d\xaa\xef\xff\x4a\xc7\xef\x79\x51\x24\xf2\x68\xca\x97\xfb\xb2\xd1\x0c\xd6\xfb\xdf\x7e\x89\x2b\xa7\x6d\xb1\xe7\x5c\x9c\xed\xf3\xbe\x38\xdd\xc6\x5d\x11\x8d\x43\x87\xd4\x3b\xf1\xe9\xeb\x93\x1c\xa2\x3c\x6a\xe1\x62\x4d\x02\x11\xcd\x74\x8a\x2e\x58\x0a\xf9\xa4\xc1\xcd\x67\x1a\xce\x09\xbf\xb7\x60\x1c\xf2\x3b\xc2\x7b\x62\x51\xfd\x31\xb4\x03\xb9\xdc\x07\xa5\xc3\x68\x7d\xe0\xc0\xa3\x59\x86\x6f\x93\x88\x9e\xcb\x68\x9b\x3a\x08\x05\xd6\xa0\x00\xa3\xee\xb5\x70\x60\x9c\x16\x1c\x9c\xd0\x60\x8f\xf4\xc8\x8d\x19\x71\xcc\x03\xd9\xdd\x07\xa5\xc3\x6e\x4d\x9a\x47\xb3\x0e\x69\x26\x71\x3d\x5b\xb4\x2d\x02\x45\x08\xad\x86\x03\x00\x75\x55\x07\x06\x70\xc8\x3f\x38\xf1\xb4\x43\x6e\x00\x89\xa0\x1d\xc8\xe8\x4e\x7c\x2e\x97\x81\x28\x9f\xcb\x10\x01\xec\x23\x7a\x2e\x8b\x6d\xd2\x20\x94\x54\x83\x02\x8c\xba\x77\xc4\x81\x71\x5a\x70\x70\x42\x4b\x87\xdc\x68\x12\xc7\x3c\x90\xd7\x7d\x50\x3a\xec\xd6\xa4\x79\x34\xeb\x90\x58\x12\xd7\x73\x39\x6e\x13\x08\xf1\xaf\x00\x09\x20\x02\x60\x69\x3a\x45\x81\x38\x4d\x38\x38\x91\x97\x43\xee\x68\x89\x22\x1e\xc8\xee\x1e\x18\x5d\x6e\x03\x61\x1e\xc5\x3a\x5e\x94\x42\xf5\x5c\x66\x6b\xf2\xf8\xe6\x9a\x2f\xb1\x8d\xd7\x75\x84\x76\xcd\xed\xe7\x6e\x2f\x32\xff\xd0\xb5\x8b\x34\xf1\x52\x20\x30\xce\x03\x94\x09\x44\x7a\xbe\xaa\xd8\x86\x13\x19\xea\x8c\x36\x91\x21\x5e\x81\x29\xdb\xd6\xb0\xeb\xba\x2c\xf6\x8d\xba\xf8\x31\xbb\x84\xe3\xc7\xda\x28\xb4\xae\x96\x41\x07\x45\x5b\xdf\x9f\x8b\x7f\x34\x39\xb9\xbe\x3f\x37\xc1\x42\x80\x68\x7e\x32\x9e\xce\x5f\x13\xd0\xc7\xd7\xf7\x33\x17\xf8\x54\x40\x0a\x0b\xf0\x20\x2e\x8c\x03\x0a\xf0\x31\xe7\x8b\xc9\xf9\xb3\x6e\xec\xc1\x1e\x50\x3e\x13\x7f\xbe\x71\xdd\x75\x95\x4f\x47\xd8\x6e\x07\xe8\x43\xdc\xb2\xc5\xd0\x13\x0d\x3d\x2a\xda\x78\xdb\x29\x3a\xbc\x0c\x34\xcb\x10\x52\x09\x57\xb7\xcf\xdb\x9f\x7b\x60\x32\x98\x50\xdd\xda\x85\xee\xc8\x8c\xdd\x3c\x18\xbc\xe9\x38\xcb\x32\x75\x52\x18\xda\xac\xdf\x9e\x97\xe1\xd1\xe5\x8e\x2d\xf2\xe6\xfe\x62\x3c\xbd\x5c\xe5\x45\xc3\xab\x0b\x56\xec\xd6\xec\x0d\xa4\x7f\x3e\xcd\x8e\x80\x0e\x88\x28\x87\x0f\x2b\x66\xdc\xd4\x10\xbf\x76\xb8\xad\x6c\x4e\x57\x36\xcf\x8e\xf4\xa9\x67\xb8\xb0\x0c\xf8\x93\xb9\x98\x5a\xc9\xb9\xc8\x4c\x48\x29\x7a\x9c\x9a\xed\x76\x9c\x55\x6c\xbb\xd0\xe7\xf1\x37\xe5\x92\x15\xa9\xfa\x67\x24\x1e\xb6\x71\x6f\x97\x02\x10\x73\x83\x82\x3c\xa6\x6d\x86\x9d\x7c\x65\x1a\xc6\x1c\xb8\x7d\x2e\x41\x92\xcd\x20\xd4\x27\x68\x27\xd9\xbc\x25\x44\xd7\x32\xaa\x17\x55\x59\x14\xa2\xb3\x9b\x72\xbf\x58\x5f\x96\xfb\x46\xf4\x96\x18\x93\xb2\xe2\xf1\x8a\x2d\x79\x02\x04\x2e\x73\x56\x94\x37\x07\x8d\x44\x06\x65\x8b\x87\xb6\xd4\xc3\xba\x05\x6b\xf8\x9b\x2c\x1d\x4d\xe7\xaf\x8f\x2e\x47\x9b\x3a\x9e\x5f\x46\xb3\xa3\x45\x71\xf5\x4a\xfd\x78\x14\x25\xe3\x19\x5c\x3a\x28\x2f\x2e\xdc\x94\x3f\xb2\xc0\xcd\x37\x01\x6b\xdd\x12\x35\x2a\x43\x70\x08\x88\x86\xd0\x0c\xcc\xb7\x83\xd8\x97\xc5\x78\x97\x45\x18\x97\x85\xb8\x26\x86\x4a\x2b\x60\x40\x8c\x91\xb3\xd1\x1d\x48\x9a\x99\x67\x46\xf7\x70\x77\xa2\x45\xb5\x3f\x71\xa1\x17\xdc\xe1\x46\x7d\xb8\x4f\x43\x16\x83\x13\x02\xc4\x84\xd7\xf3\x40\xc4\x27\xe7\xe7\xe7\x7e\x2a\xd6\x6d\xd3\x23\x47\x3b\x9d\x84\x4e\x4a\xcc\x76\x77\xc9\xb9\xa3\x47\xdd\x83\x12\x21\x18\x44\x6d\x91\xef\x2e\x40\x37\x0a\xc5\xee\x0d\x96\x91\x68\x9a\x38\x4d\x70\x78\xe6\x08\x3d\xce\xac\xfa\x8c\x1a\x73\xf1\xcb\x51\x79\xd0\x7a\x2b\xa3\xd5\x56\x76\xe4\x15\xcb\xb7\xa6\x50\x4c\xd9\x41\x31\x78\x39\x4e\xcf\x06\xd1\x03\x1a\x9f\xc8\xdb\xd4\xe7\xba\x46\x55\x54\x9f\x1f\xb1\xd2\xba\x4f\x90\xf8\x28\xac\x33\x24\x38\x5b\x5f\x21\x89\x1c\x56\xa3\x69\x2b\x84\xf8\x18\x85\x77\x68\x47\x5e\x9e\xaa\x21\xa5\x29\xe7\xcb\x2a\x6e\xbb\x06\x05\x6f\x96\xc5\x16\x74\xe5\x85\xec\xe5\x4b\x7a\xc3\xd4\x66\x92\x42\x64\x33\x09\xd2\xfa\x32\x09\xa3\x20\x98\xa4\xb2\xe5\x53\x5f\x6f\xc7\xe8\x7d\x7f\x39\x35\xcc\x3d\xeb\x27\x23\xca\x69\x0f\x1d\x89\x42\x5c\xe8\x42\x95\x91\xb6\xec\xdb\xf6\xa7\x55\xc8\xd4\xa2\xe6\x1e\xf1\x46\xd8\x86\xb3\x7a\x5f\xf1\x80\x3d\x39\x3a\x3f\x3f\x17\xa6\x88\x52\x38\x73\x61\xc2\x41\x27\xca\xdf\x5a\x6f\x5d\x28\x7c\xed\x23\x8a\xde\x3d\xc1\x96\x42\x53\xd8\x4e\xb2\xf6\xda\x46\x79\xab\x76\x82\x75\x1f\xf0\xff\x40\x2a\x95\x39\x1c\xad\x89\x6a\x95\x00\x90\x61\x81\xbe\x38\x65\x26\xe8\x78\x20\x68\x3f\x3f\x9f\x22\xda\x0b\x4d\xf7\xb9\x82\x1f\x37\x65\x59\x34\xf9\x8e\xe0\x5c\xab\x55\x4e\x33\x23\x3c\xb2\x37\x94\xb9\xb6\x62\x9b\xbc\xb8\xbf\x78\xf5\x57\x78\x71\xcb\xc5\x25\x44\xc9\x5f\xe5\x7b\xfe\x2a\x35\xdf\xe9\x4f\x57\x39\x2b\xd2\x9a\x6d\xeb\x51\xcd\xab\x7c\x05\x66\x1e\x5c\x27\x54\x6d\x58\x61\x59\x7a\xc7\xc2\xd2\xe3\x4d\xc3\x2b\x79\xfd\xbc\x18\x1b\x00\x25\x94\xe4\x48\x3c\xa4\xf6\x4e\xce\x2c\xd4\x38\x74\x2f\x8d\xc1\xdf\x75\xc3\xaa\x86\xb6\xe4\xb0\x01\xd9\x26\xb4\xf3\xa0\x4c\xb3\xaf\xc4\x97\x14\xbd\x2f\xab\x25\x50\x84\x53\x1c\xba\x25\xd4\xfb\x8a\xed\x74\x02\x3a\x73\x25\x6e\xa7\xed\xa1\x80\xa1\x7f\x2c\xcd\x7b\x4e\x83\x9f\x63\xf8\xa6\x34\x9e\x7e\x39\x04\x66\x68\xdd\x22\x64\x2a\x6b\x41\xa5\xc2\xb1\x46\x18\x06\x96\x72\xda\x02\xc3\xbd\x53\x08\x75\x04\xb3\x40\x66\x21\x1e\x85\x31\x8f\xf2\xad\x7d\x7d\xf7\x34\xc3\x6b\x2d\x31\xbb\x8a\xfb\xb8\xd1\x8c\xdf\xe7\xe2\x50\x61\xc2\xdb\x73\xfc\x31\xae\x93\x55\x55\xf9\x9e\x90\x7d\x38\x2c\xa3\xd5\x84\xc1\xe1\x3d\xff\xa8\x33\xd4\x63\x82\x52\x4b\x1b\xec\xe3\xa6\xdc\x25\x4e\x55\x5a\x51\xaa\xa9\x7b\x9e\xbd\xd6\x1a\x54\x7e\x8f\xd0\x0c\x09\xca\x4a\xbd\x63\xd0\x1e\x2f\x43\x2e\x0c\x39\xaf\xa3\xba\x64\x94\x9c\x53\x61\xaa\x3f\xc7\x26\x6e\x37\x48\x12\x50\x02\xdf\x2f\x4f\xcb\x01\xbd\x71\xd0\x49\x95\x9e\x61\x5a\x50\x12\x4c\xc8\xb6\xe0\x22\x58\x42\xd0\x04\x91\x1a\xa6\xdf\x6a\x83\xc4\x4a\xb6\x82\x6a\x81\xae\x4e\x5b\x62\x9d\xf5\x65\xba\x46\x9d\x25\xb0\x92\xd5\x29\xb6\x53\x15\xf6\x16\x16\xaf\x32\xdb\xa7\x44\x54\x47\x76\x13\x36\x36\xd1\x1c\x4f\x37\xf2\x19\x75\x06\x3b\x52\x37\xf4\x25\xea\xdc\x95\x3b\x31\xaf\x13\x63\x1b\xd5\x84\x4d\xe7\x93\x76\x92\x93\x4a\x1f\x29\xa2\x53\xf4\x24\xc1\x85\xf1\x4f\xfc\x64\xfe\xfb\xa8\xf9\x2f\xf2\x14\x59\x64\xc5\xe4\xaf\xf2\x16\x8b\x85\x9f\xfa\xbc\x55\x9e\x7e\x0d\xcf\x2f\xde\x0d\x64\x24\xce\x9b\x79\x05\xc6\x36\xd7\x9f\x6c\xed\x7c\x7f\x7e\xb5\xf3\xfd\x29\xd5\xca\x77\x57\x2c\x5a\x6a\xcf\xf4\xeb\x6f\xdd\x3d\x70\xba\x3a\x6d\x37\x96\x60\x32\x40\xfc\xe6\xd7\xe2\xcf\x61\x29\x8c\xc7\x24\x4b\xda\xa1\x67\x8c\x5f\x4d\xc3\x39\xd0\x60\x20\xae\xc6\x30\x47\xd9\xdf\xb0\x1a\xf1\x47\xae\x6d\x84\x7e\xdc\x1c\x6d\x57\xe9\x9c\xe7\x9e\xf8\x44\x02\x51\x36\x9c\x30\x50\xa0\x95\x17\xaf\x5e\x99\x22\x42\x04\x34\x62\x5a\x75\x4f\x26\x9e\xf2\xd2\xcd\xf1\xe7\xd6\xd6\x8f\x81\x52\x2d\xe9\x13\x6e\x06\x7d\x43\x26\xa6\x1d\x11\x02\xf4\x1b\x6a\x93\x57\xba\xc8\x64\x77\xe7\x10\x97\x0d\x20\x4e\x6d\x57\x43\x75\x52\xb8\xaf\xc6\xf6\x5c\x89\x5a\x0c\xd5\x88\x1c\x8b\x05\x02\xc4\xad\xc3\x9a\x98\x11\x0b\x70\xba\xc3\x04\x9a\x10\xa2\xe5\xa2\x3e\xa1\x43\x5a\xae\x65\x03\x88\xb1\x9a\xac\x30\xf4\xef\x6d\x74\x14\xdb\xe1\xb1\xdf\x54\x2b\xc3\xed\x70\xc3\xc4\x00\x2d\x44\xb3\xc1\x99\x10\xee\xed\x4e\xe2\xac\xa6\x8b\xf2\x6e\x67\xc3\x7b\x1b\xd1\xde\x96\x30\x6e\x3d\xd8\x2e\x42\x2c\x40\xc9\xc1\xce\x46\x74\x10\x8d\x86\x53\xe1\x03\xaa\x97\x73\x10\x12\x8c\x87\xf1\x82\x55\xe5\xbe\xe6\x45\x6a\x7e\xc1\x6a\xc5\x73\xf8\x3c\xb8\x10\xee\x63\x0f\x5a\x73\x64\xaf\x5d\xd0\xab\xb1\xbc\x59\xc2\x32\x42\x3c\xfc\x66\xe2\x42\xee\xe3\xf1\x89\xf5\x3e\x4e\x22\xf8\xe1\xb8\xa1\x49\x90\x8e\x7c\x9a\xbe\x2b\x78\x72\x87\xcc\x1b\xf4\xfa\x8e\x65\xc4\x68\xc7\x05\x2b\x0a\xf5\x48\x93\x31\x41\x46\xb3\xe5\x51\xfa\xc6\x6a\xb6\x4e\x3e\xd0\x1c\x24\x58\xe4\x15\x4f\x9c\x16\x77\xba\xf8\x5d\x70\x8b\xbd\xa3\x32\x02\x8a\xe0\x22\xf8\x80\x40\x61\x11\xad\xd8\x82\x8f\x6e\xf3\x3a\xbf\xce\x0b\xe1\x1d\x00\xc9\x91\x14\x46\xf2\x63\x45\x01\xfb\x8e\x57\xf5\x8e\xab\xdb\x91\xc4\x45\xdb\xc2\x10\x12\x58\x89\x74\x3f\x89\x96\x07\xb8\x6c\x69\x0c\x37\x6c\x93\x20\xe2\x31\x57\xbb\x57\xec\x3d\x86\xd9\xf2\x8d\x90\x8e\x34\xb4\x07\x61\xe5\x6b\x17\x60\x8c\x18\x01\x13\xa0\x45\xbc\x13\xdb\x41\xcb\xa8\x8b\x98\xd1\x20\x6a\x22\x4c\xe9\x22\x14\xac\xc5\x38\xb9\x59\x8c\xd4\xcc\x22\xd3\xa7\x33\x44\xa2\xe8\x32\x3f\x55\xd0\x64\x0f\xf0\x61\x18\x03\xed\x45\x4d\xf5\xf8\x29\x0c\x96\xaa\x2c\x06\x51\xe8\x5b\x8e\x62\x0e\xca\xa2\xba\x57\x90\x77\x00\x7b\x9c\xca\x8f\x4a\x96\x68\xc0\x41\x4f\xa7\x91\xd2\xa8\x8d\x0e\x1c\x34\xf3\x00\xcb\x52\xb0\x4b\x0c\xc9\xf3\xd7\x7d\x36\x99\xd1\xf2\xaa\xfb\x91\x1d\xbc\xf2\xa3\x1f\xfd\x39\xb1\x37\xaf\x5c\xb3\xda\xa7\x5e\x72\xe7\xe0\x3f\x12\x01\xe2\xeb\xbe\xbb\x20\xa0\x53\x5c\xe1\xfc\x28\xc9\x5e\x5b\x29\x59\x96\x4d\x8e\x12\xc1\xab\xa3\x4b\x1f\x6f\xf9\xe2\x28\x5d\x7c\x4d\x99\xc8\x3e\x7b\x1e\xce\x8a\xef\xb8\x78\xbb\x5a\xfe\x33\xba\xd3\xfd\x26\x9e\x60\xc8\x97\x17\x7f\xe9\xaf\x7f\x57\x34\xe3\x07\x7a\xdc\x8e\x7f\x2e\x5f\x54\x65\x5d\xae\x9a\xb1\xa9\x5f\xae\xdc\x7f\x46\x70\xbe\x6e\xaa\xcf\x3f\xfd\xe4\x2c\x53\xff\xfb\x34\x4d\xf8\x76\x89\x32\x20\x3d\xfb\x34\x4d\xfe\x32\x14\xfe\xc1\xfd\x8e\x7f\x3e\x39\x22\xba\x09\x89\xa1\x9c\x89\xcd\xbe\xa2\xcb\x0e\xad\x78\x3a\xd9\xac\xfa\xc9\xe1\xca\xfc\xa3\x7a\x6e\x20\xca\x5e\x3d\xd7\x1b\xe7\xcb\xf7\x5c\x16\xea\xb9\xb3\x01\x3d\x67\x62\x55\xdc\x74\x15\xb6\x62\xb6\x93\xbd\x91\xef\xba\x7d\x7a\x6d\x25\xb8\xb5\x27\xe3\x9b\xe2\x7e\xb7\xce\x17\xe5\x76\xb4\x58\xf3\xdb\xaa\xdc\xc2\x4b\x17\x7d\x20\x5d\x03\xc1\x80\x4a\x7c\x8e\x36\xb7\x33\x23\x4a\x1d\xad\xb5\x8c\xb3\xc5\x38\xf5\xe6\x97\xd4\x73\xca\x1f\xd7\xb0\x96\xa0\xc0\x5a\xcf\xb6\x94\x3e\x8a\x21\xda\x61\xde\xd6\xa1\x97\x57\x81\x4a\x7a\xb2\x52\xef\xaf\xb4\xfb\xa0\xfe\xd3\xa0\x96\x8f\x53\xee\xde\x05\x2b\x14\x38\xdd\x0d\xe7\x4f\x7f\x61\x9a\xcd\xce\x3f\x8d\x12\x49\x96\x61\xb8\x4c\xbe\x5d\xe6\x0b\xd6\x94\x55\x4d\x74\x3f\xcc\x91\x72\xfd\x6a\xfa\xc2\xf8\x73\xe7\x30\x75\x9e\xb8\x1d\x34\xcb\x5e\x6b\xaf\x58\xe0\x95\x13\x7f\xb2\x24\x49\x4a\x8a\xfc\x40\xc9\x97\x9e\xb3\x11\x7b\x27\x68\x8f\x78\xa2\x77\xfb\x05\xa1\xdb\x46\x6e\x4e\xef\xee\x7c\x17\x26\x0a\x6a\xd1\x5e\x36\x89\x26\x18\x31\xa6\x97\xaf\x59\x96\x25\xbf\x70\xde\x77\xea\x46\xed\x01\x2b\xa5\x75\x1e\x42\x43\xa6\xa8\x21\xd3\x90\xd7\x16\xa1\x34\x37\xb5\x79\x5d\x06\x77\xb6\x69\x37\xc1\x64\xfe\x5a\x5b\x3a\x53\x3c\x6e\x27\xc6\x6f\x29\x3c\x4d\xf6\xd3\x32\x18\xfe\xa3\xed\x1b\x9f\x68\x19\x7e\x70\xc0\x65\xc5\x3a\xbc\xdf\x2b\xc2\xbe\xa8\xf7\x57\x2b\xcf\x51\x0f\x7d\x06\xf9\x0c\x0d\x72\xfc\x0c\x78\xab\x2c\x5b\x9b\x71\xd6\x5b\x75\x75\x2b\x47\x6b\xc8\x4d\x7a\x23\xee\xa1\x13\xe3\x9a\x10\x44\x4f\x10\x78\x31\x35\xfe\xa8\x69\xf6\xda\x15\x20\xa7\xb1\xed\x30\xd0\xfb\xa1\x42\xea\x1e\x44\x8c\x28\x67\xd5\x2a\xbf\xd3\x51\x36\xe6\xbb\x3b\xc2\xc6\x2e\x6a\x45\xd7\x28\x19\x85\xd8\x16\xd7\x63\xd2\xfd\x28\x31\x7a\xc0\x0a\x45\xd1\x7e\x3b\xdf\xec\xca\xaa\x61\xc2\x34\x6f\x5f\xac\x6a\x5f\xea\xc4\xf9\xeb\x7c\xc9\x8d\xf6\x12\x22\x8e\x33\xeb\x75\xf9\xde\x64\x4a\xaa\x70\x6e\xbe\x95\xbe\x09\xf1\x40\x95\xeb\x68\x78\x18\xcb\x91\x23\x91\x0b\xb9\xba\xc8\x3e\xcb\x12\x76\xe9\xe9\x1f\x6b\x70\x8a\xda\xa3\xda\x4a\xeb\xc6\x4c\x92\xbd\xe4\xdb\x20\xe1\x6c\xb5\xca\xef\x5a\xbd\x23\x63\xe7\x1e\xbe\x10\xe1\x90\xb7\x39\x7f\x2f\xc0\x60\x58\x2c\xf9\x6d\xbe\xe0\xca\x07\xf9\x30\x86\xf6\x8c\x8a\x9b\x14\xfd\x56\xbd\x63\xa5\x28\x35\x4f\x24\xb9\xb0\x9b\x25\xfe\xed\xe7\x42\x31\x22\xc9\x85\xad\x37\xf8\xb7\x9f\xeb\x61\xaa\x37\x01\x4c\x77\x35\xfe\xed\xe7\x7a\x98\x4c\x92\x23\xa7\x0e\xd7\x41\x37\xbe\x69\x5d\x7d\xa7\x27\xa7\x32\xe4\xa8\x45\x64\x21\x25\xa4\x1e\xa1\x93\xa7\x5a\x11\xb8\x01\x94\x19\x18\xb0\x0a\x42\x8d\xaa\xf2\x3d\x86\x5c\x22\xc8\xb4\x59\x87\xcb\x89\x87\xd9\x50\x41\x9f\x15\x06\x5e\x7d\x46\x61\x1d\xb6\xe1\x44\x54\x8e\x08\xd9\x52\x13\x0a\x4c\x33\x86\xab\xe7\xe7\x13\x8b\xab\x94\x64\xf4\xe4\x6a\xbd\xe9\xc3\xd5\x7a\xd3\x97\xab\xf5\x06\x73\xb5\xde\xf4\xe4\xaa\x11\xd5\x1e\x5c\x75\xc4\xfa\x19\x5c\x55\x81\x70\x2e\x57\x27\x93\xf3\x73\x8b\xad\xd4\xd0\xed\xc9\xd6\xcd\xb2\x0f\x5b\x37\xcb\xbe\x6c\xdd\x2c\x31\x5b\x37\xcb\x9e\x6c\x35\xba\xa4\x07\x5b\x1d\xbd\xf3\x0c\xb6\x4e\x64\x9c\xd6\xd1\x21\xaa\x45\x7b\x32\xb0\xb8\xe9\xc3\xc0\xe2\xa6\x2f\x03\x8b\x1b\xcc\xc0\xe2\xa6\x27\x03\x8d\x5a\xef\xc1\x40\x67\x0a\xe8\xcf\x40\x4f\x5b\xaa\xb9\x0d\xeb\x24\x47\xd1\x0e\xd6\x14\x80\xb1\xde\x0c\xc0\xd8\x31\x4a\x00\xe5\x66\x39\x00\xa5\x91\x10\x28\x5c\xdc\x04\x0b\x1b\xb6\xee\xaa\x7c\xdb\x84\xc0\xa0\x0a\x05\x13\x28\x12\x17\x34\x1b\x36\x22\x6b\x04\x60\x58\xdc\x24\x30\x96\x38\xaa\xb4\x23\x74\xad\x24\x49\x60\x47\x8a\xfa\x37\xdc\x29\xe8\xc9\x9d\x03\xed\xc8\xf7\x80\x7a\xba\x46\x86\xdb\x20\x72\x74\x0c\xae\xcf\x29\x8f\x13\x11\x1e\xb0\x0f\x1d\xbe\x3b\x95\x3d\x7c\xeb\xb3\xef\x7c\xfb\x5b\xc9\x77\x92\xe4\x67\xcb\x6d\x93\xfc\xf4\x7b\x5e\x97\x1b\x9e\x1c\x8f\xa7\xe3\x2c\xb9\xbe\x4f\xbe\x58\xb2\x5b\x7e\xc3\xb6\xcb\xfb\x64\x94\xac\x9b\x66\x77\xf1\xd9\x67\xc2\x98\x65\x0a\x70\x9c\x97\xc9\x28\xf9\x02\xa5\x48\x5c\xdf\xcb\x17\x7c\x5b\xf3\x50\x91\xcf\x0a\xc8\x7f\x23\x2a\xbd\x48\xbe\xff\xdd\xef\x25\x7f\xed\x67\xbf\x97\x4c\xc6\x93\x34\xf9\x99\xef\x7f\xff\x22\xf9\xb9\xef\xfe\x40\x23\x39\xfa\x56\xf2\x9d\xcf\xbe\xf5\x85\xf6\xbe\xbe\xe3\xf7\xf2\x78\x65\x9d\xac\xd8\xa8\xde\xe5\xdb\x43\xf6\x9a\xd8\xc2\xaa\xca\x46\x9e\xd7\x59\xf2\x9b\xa3\x4b\x3a\xf9\xa1\x29\xc3\x05\x67\xf3\x73\xba\x28\x64\x3c\x3c\x7c\xf1\xe7\x87\x12\xf0\x4e\x2d\xf8\x01\xfb\xa9\x3e\x15\xcc\x85\x0e\xfd\xf4\xb2\xae\x16\x17\xfb\xaa\x78\x33\x1e\xcb\xfe\xab\x71\x97\x88\xba\xc5\xe7\x98\x97\xcd\x4f\xdd\x7e\x2e\x3b\xff\xa8\x7f\x89\x4f\x72\xbe\xca\xef\xfe\x82\x2e\x98\x08\x22\x59\xf3\xe6\x95\x3c\x58\xba\xe4\x4b\x79\x14\xaf\xb9\xdf\xf1\x57\x47\x69\x27\xc2\xf7\xe5\x6a\xf5\x53\x1e\x2a\x91\xda\xa7\x74\xd3\x10\x85\x9b\x6a\xcf\xfb\x56\x5f\xdf\xde\x68\x04\x9f\xa0\xfc\x8a\xdf\xec\x0b\x56\xb5\x38\xeb\xdb\x9b\x57\x47\x5e\xc0\xa2\x17\xd2\xf8\x30\x5e\x31\x72\x9c\xca\xa2\x17\x22\x00\xec\xb3\x49\x82\x3a\x0a\x50\x08\x0f\x04\x3c\x62\xa2\x96\x87\x15\xdf\x2e\x79\x25\x02\xc7\xc4\xa2\xd7\xec\x61\x2b\xe8\x4d\x59\x36\x6b\x99\xb7\x6d\x72\x56\xe4\xac\xe6\x4b\xb5\x97\x5d\xd6\x77\x2e\xcc\x4d\xc5\xee\xeb\x05\x13\x6b\xf2\x15\x13\xe7\x58\xdb\x1a\x27\xe3\x99\xf8\x1f\xdf\x58\xde\xcf\xf1\xe9\x9c\x6f\x2e\x9d\xb7\x95\x47\x13\x71\x20\x78\xc5\x46\xd3\x3b\x84\x60\xca\x37\x32\x71\x86\x13\x67\x90\x78\x8c\x13\x8f\x21\x71\x8e\x13\xe7\x90\xb8\x7a\x0f\x2b\xd3\xc9\x58\x44\x73\x4e\xf8\x86\xf2\x42\xae\xd8\x68\x5f\x1c\x1c\xef\x25\x76\x18\x4c\xc7\x93\xe3\xe9\xd9\x09\xdf\x20\x9f\xe6\x48\x08\x02\x1c\x17\x95\x08\xae\x8a\xfc\xe0\x05\x96\x48\xe4\x45\x1e\x72\xda\x8d\x5a\xcc\xe0\x3d\x36\xdf\x4d\xb9\xbb\x68\x3f\x48\xa2\x8b\x1c\x18\x0f\xfe\xa0\xb1\x68\xa2\xe6\x87\x5a\xdd\xeb\x56\x5d\x8c\xa7\x7c\x93\x8c\xa7\x73\xf1\xdf\x89\xe8\x06\x58\xfd\x2b\xaf\xe8\x38\x3b\xe3\x9b\x44\xbe\xd2\x6b\xfb\x46\xc7\x13\xbe\x09\x39\x46\x68\x77\x88\xa0\x0c\x65\x58\x6e\x96\x31\xf4\x20\x46\x88\xf9\xac\xf3\x95\x12\xd4\xa2\xc9\xb6\xf9\x46\xc5\xd6\x42\x4e\x32\xad\x93\x7c\xbb\xca\xb7\x79\xc3\x13\x21\x60\xac\xba\xec\x03\x24\x51\x2b\x65\x38\x3a\xcf\x0e\xbd\x77\x9f\xfe\x22\xab\xf3\x85\xcc\x7d\x23\x4b\xe7\xe5\xf6\xf3\xc9\xd1\x65\x50\xd1\x9e\x2b\x15\x6d\x9f\x17\xb5\xf3\x02\xe9\x98\xc2\xc9\xd9\x47\x92\x38\x8d\x90\x38\x39\x8b\xd0\xa8\x33\x43\x19\x98\xca\xe9\xe9\x47\x52\x39\x8b\x50\x39\x3d\x8d\x50\xa9\x33\x43\x19\x92\xca\x55\x91\xef\x46\xeb\xb2\xca\x7f\x24\x54\x70\xf1\x71\xa4\x66\x14\xa9\x52\x03\xbe\x19\x4d\xd2\x89\x4b\x27\xce\x21\x53\x5b\x0a\xb5\x4e\xfc\x06\x3a\x5c\xd5\x37\x49\x47\x01\xfa\x54\x0e\x99\xfa\x70\x51\x95\x65\x93\x10\x7c\x4c\x9d\x1c\x4d\x3f\x4a\x6f\xa5\xd8\x4f\x9c\x9e\x12\x89\xed\x88\x34\x4a\xb5\x6e\xd8\xe2\x9d\xaf\x54\xc9\xfd\x47\xad\x40\xf9\x46\x3b\xe6\xa7\xce\xf4\x33\xf5\x27\x1f\xfd\xf8\xbf\xae\x6b\x34\xb9\x4b\xdb\x8f\xe9\x5d\x48\x6f\x67\x97\xf4\xbb\xea\x48\x39\x6b\x7c\x07\x4c\x82\x79\x4e\x0c\xd7\xe1\xcf\x7a\xf9\xf6\x96\x57\x75\x7b\x45\xca\x6a\x25\x51\xde\x14\xac\xae\xdd\xed\xbd\x57\x5f\x7f\xf5\xd5\x2b\x99\xbd\xd9\xd7\xf9\x82\xc8\x7e\x54\xd9\x35\x67\xd5\x62\x4d\xe4\x3f\xa9\x7c\xbe\xbd\xe5\x45\xb9\xe3\xa3\x92\x80\xf9\xa0\x60\xd6\x9c\x55\x0d\x91\xfd\xb7\xa0\x8a\x86\xf9\x67\x64\xbf\xfe\xea\x6f\xb7\xb9\x24\xf2\xbf\xa3\xf2\xf7\x35\x71\xc2\xf6\xeb\xaf\x7e\x51\xe5\xae\xf2\x62\x43\xe4\xfe\x5d\x95\xdb\xac\x47\x05\xab\x6e\x38\x01\xf1\xf7\x34\x04\x91\xf7\xf7\xdb\xd2\x79\x4d\x35\xec\x1f\x28\x80\xc5\x9a\x2f\xde\x11\xd9\xff\x10\xb2\xc5\xf5\x13\x90\x2d\xe5\xa7\xe2\x9b\xf2\xd6\x4a\x69\xf2\x0d\xa7\xfa\xee\x1f\x01\x6f\x64\xe7\x8c\x76\xc5\x9e\x02\xfa\xc7\x16\xd0\x26\xdf\x92\x50\xff\x44\x41\xed\xca\xf7\xbc\x1a\x95\xab\x15\x01\xf2\x4f\x01\x51\x7e\xb3\x65\x05\x91\xff\x4b\xd0\x9e\xf2\x06\x32\x25\xed\x37\x9c\xec\xd6\x5f\x06\xe6\x55\xac\x5e\x93\xfd\xfa\x2b\x20\x34\xe5\x86\x13\xb9\xff\xcc\xf4\x2b\x2d\x72\xbf\x6a\x78\xbb\x78\x47\x02\xfc\xf3\x57\x30\x11\xb1\x25\x91\xfb\x6b\x2a\x77\x59\xbe\xdf\x16\x34\xc4\xaf\x2b\x08\x19\xcd\x3e\x5a\xe4\xd5\xa2\xe0\xa3\x52\x16\x20\x80\xff\x05\x09\xbc\xdf\x11\xa0\xbf\xf1\x0a\x06\xf1\x75\xe9\xef\x67\x7d\xfd\xd5\x6f\x42\x37\x15\xec\xde\x20\x22\xc0\xfe\x25\xb4\x4e\x85\xcc\xa0\xde\x00\x75\x29\xad\x27\xa2\xdc\xbf\xd2\xe5\x56\x15\xaf\x29\x99\xff\xd7\xaf\xc0\x78\xac\x85\xd6\xa2\x84\xfe\xdf\x00\x44\x49\xca\xfc\xbf\x85\x7e\x2b\xd8\x0d\x91\xfb\xef\x8c\xa2\x58\xee\xd6\xe5\x96\x94\xf9\xdf\x56\x30\xb7\x65\xb1\xdf\xf0\x80\xa4\xfe\x8e\x05\x13\xe8\x94\xdf\xb5\x80\xc8\xce\xf8\x3d\x05\xf2\x65\xb5\x28\x97\x94\x14\xfe\xbe\xca\xbf\x66\x21\x80\x3f\x00\x29\x27\x5b\xfb\xef\x4d\x26\xd5\xce\xff\x00\xb8\xcb\x92\xe2\xe3\x7f\x6c\x73\x37\xac\xa2\x20\xfe\x13\x48\x8a\x70\xbb\x10\xd9\xff\x19\x06\x08\xdb\xf0\x8a\x11\xf9\x7f\x08\x1d\x55\x92\xa5\xff\x48\x57\x5f\x50\x63\xe3\xbf\x80\x0c\x37\xac\x20\xe7\x94\xff\x0a\x0d\x17\x53\x1f\xcc\x6d\x3e\xd0\x7f\x43\x40\x6a\xbe\xf4\x61\xfe\x3b\x8c\x2b\x31\x1d\xab\x45\x80\x0f\xf3\xc7\x18\x46\x4d\xb1\x04\xd4\x9f\x60\xa8\xd0\xe8\xf8\x1f\x18\xe8\x87\xfb\xba\xc9\x57\xfe\xe3\xa9\x5f\x7f\xf5\x3f\xdb\x31\x42\xe4\xfe\x2f\x50\x2d\x5c\x46\x9a\xa0\xa1\x59\xee\x1b\x94\x84\x8b\xfc\x6f\xad\x13\x02\xf9\xff\x07\x24\x59\x5c\xdf\x15\xee\xd1\x3f\x05\x2c\xc2\xec\x83\x6c\x59\xef\x6e\x5d\x36\x5a\x43\xaa\x84\x7c\xd1\xec\x2b\x5a\xb1\xfc\x5f\x90\x2a\xbe\x5d\xe4\xc4\x1c\xf0\xa8\xad\x09\xb6\x1b\x09\xb9\xa4\x78\xfd\x08\x26\x05\x5b\x0a\x16\x12\xf9\x60\x52\x34\xa4\xe0\x3e\x82\x31\xc1\x97\xb9\xce\x4d\x5b\x8a\x46\xf5\x97\x7b\x46\x93\xfe\xa8\xcd\x8c\xb5\x00\x88\xc0\x81\xc1\x21\x67\xed\x18\x1c\x18\x1e\x52\xa9\x13\x03\xf8\x11\x4c\x8f\xba\xe1\x3b\x79\x87\xcc\x7b\x56\x11\x83\xe5\x11\x6c\x90\x95\xb8\x8c\x39\x02\x06\x86\x48\x04\x02\xcc\x11\x69\xdb\xfa\xb9\x60\x8b\xec\xd8\xbe\xe6\x44\x36\xd8\x22\x75\x53\x12\x7a\xf0\x11\xec\x8c\x55\x59\x05\xea\x06\x1b\x43\x36\x22\x0c\x05\x36\x86\xe4\x48\x18\x0a\xcc\x0c\x2e\x2e\xa9\x23\xb2\xb5\x95\x81\xc2\x5e\x08\xa8\x5f\xb6\xa1\x02\x23\xfa\x11\x8c\x0c\x61\x39\xc1\x74\x4a\x00\x81\xad\x21\x2d\xa7\x30\x14\x58\x1c\xd2\x54\x0b\x43\x81\xd9\xa1\x84\x2b\x08\x05\xe6\xc7\x97\x7b\x5e\x8b\xf5\x43\x18\x10\xac\x10\x71\x79\x67\x18\x08\xac\x0f\xb1\xf8\xab\xd7\x2c\xaf\x28\x61\xfd\x0d\x9f\x7a\x52\xe8\x7f\xd3\xa7\x9f\x84\x03\x03\xe4\x9a\x11\x33\xef\x23\x58\x19\x72\xe4\x84\x3a\xf0\xb7\x30\x4c\xa8\xfb\xc0\x1a\x51\x40\xd4\x0c\xfe\x08\xd6\x88\x82\xa0\x0d\x81\x47\xb0\x49\x36\x2c\x2f\x1c\xb1\x4c\x8d\xc2\x20\x8a\x81\xb1\xc2\xef\x76\x6c\x4b\xc9\x31\x18\x2a\x8b\x72\xb3\xab\x38\xb5\xf4\x7a\x04\x33\x85\xb6\xdb\x1f\xc1\x3e\x09\x18\xec\x8f\x60\x9b\xb0\xba\xe1\x55\x5e\x13\x16\xc0\x23\x58\x27\xfc\x6e\x51\x30\xe5\x03\x83\x1e\x23\x60\xc1\x50\xb9\xc9\xc9\xce\x00\x4b\xa5\xe0\x8c\xb0\xb6\x1e\xc1\x52\x59\xe5\x24\x9b\xc0\x52\xe1\xf7\x54\x26\x18\x29\xfc\x9e\x8f\xea\x82\x51\x16\xe7\xe3\x1f\xfa\xad\x68\xaa\x9c\x6d\x6f\x4c\x3b\x64\x2f\xc1\x85\xaa\x04\x02\xb0\x55\x76\x05\xdb\x52\x24\x80\xb1\x22\xdc\x19\xdb\x25\xb5\x54\x79\x04\x73\xa5\x62\xdb\x65\x49\xac\x22\x1f\xc1\x52\x59\x94\x9b\x0d\x39\x3f\x3f\x82\x99\xb2\x61\x37\x5b\x4e\xe5\xff\xb1\xad\xac\x48\x41\xfe\x13\x1b\x26\x20\xca\x60\xa1\x54\xbc\x79\xcf\xc9\xaa\xc0\x36\xa9\xd7\xe5\x6e\x27\x22\xef\x16\xe4\x92\xfc\x11\x8c\x94\x55\x59\x50\xb7\x7b\x7d\xfd\x08\x16\x89\xca\x97\x1b\x2b\x04\x10\x98\x25\x72\xec\xd5\x23\x2f\x1c\xf9\xd5\xd7\x8f\x7f\x6a\x41\x50\xbd\x0f\xf6\x86\xb8\x4f\x6a\xb1\x66\x55\x63\x94\x4d\x6a\x25\xfb\x25\x9f\xc0\x12\x69\xde\xe7\xea\xf6\x22\x39\x91\x13\x70\x60\x8d\x88\x53\x7a\xc2\x9a\x0e\x03\x82\x59\xa2\xec\xab\x51\xc5\x9b\x8a\x50\x7c\x4f\x60\x9e\xbc\xe3\xc4\x24\xfc\x04\x26\xc8\xa2\xbc\xa9\xdd\x25\x32\x31\xc4\x9f\xb4\x25\xa2\x04\x8b\x82\x00\x1b\xa4\x59\xef\x37\xd7\x75\x60\x49\xf9\xf4\x8b\x0e\x10\x2d\x3b\x4f\x60\x88\x48\x47\xcb\x9a\x15\xc4\x48\x7f\x02\x23\x64\xcd\x71\x4f\x60\x00\xb0\x41\x6a\x61\x20\x97\x7b\xaa\x5b\xc0\x0e\x29\xf2\xed\x3b\xbe\xcc\xb7\x61\x76\x83\x45\x22\x9b\x36\x12\xde\x2e\x02\x06\xec\x12\x7e\xd7\xf0\x6a\x2b\x6e\xd2\xca\xb7\x14\x98\xf6\x80\x08\xaa\x72\xaa\xe9\x60\x98\x34\x55\xb9\x5b\x53\xfd\x06\x26\xc9\x4d\xde\xac\xf7\xd7\x61\x92\xc1\x34\xd9\xef\x68\x9f\xc1\x13\x18\x25\x05\xdf\x94\x5b\x92\x7d\xda\x1c\x11\x6b\x5f\x22\x1b\x0c\x91\xb0\x4d\xfa\xf4\xab\xf6\xb2\x90\x84\x01\x13\x44\x56\x12\x6e\xcb\xaf\x59\xc3\x87\x00\xf8\x75\x7b\xdc\x10\x10\x60\x77\x28\xae\x11\xf9\x60\x73\xec\xb7\xb4\xaf\xe0\x49\xdb\x1a\x95\xb0\xf5\x47\x0b\xd2\x5a\x7c\xd2\x9e\x0e\x6a\x82\x7d\x02\x43\x63\xbd\x5c\x92\x9c\x00\x1b\xe3\x7a\x5f\x14\xeb\xb2\xa2\x04\x03\x0c\x8c\x6b\x5e\x10\x8b\x9d\x0f\x30\x31\x2c\x84\xff\x7a\x25\x4e\x20\x50\x8c\x04\xeb\x62\xcd\xb6\xcb\x51\x19\xb2\x64\x9e\xb4\xe7\x43\x41\xd1\x36\xd1\xd3\x6f\x5b\x40\xe4\x58\xff\x1d\x0b\x24\x30\xd2\x7f\x97\x70\x47\x05\xaa\xfc\x3d\x02\x34\xd4\x86\xdf\x27\x60\x49\x22\xff\x80\x00\x0c\x90\x0a\x96\xc7\x4d\x51\x5e\x53\xcc\x05\xd3\xe3\x7d\xc5\xb7\x94\x77\xfa\x09\x8c\x8f\x86\xd5\xef\x28\xf9\x00\xf3\x03\x76\x0e\xfc\x7c\xb0\x3d\xae\xab\x9c\xaf\x16\x8c\x5a\x38\x3d\xfd\x91\x35\x85\x91\x2e\xb1\x27\x30\x31\xc4\xf9\x0c\xcd\x8e\x54\xfb\xac\x09\xb2\x3e\xc0\xd4\xb5\x58\x33\xa3\xad\x52\xad\x34\x09\x70\x98\xc1\x16\x45\xb9\x27\x46\xc8\x07\x98\xb7\x56\x05\xa3\xac\xc4\x0f\x30\x61\x2d\x8c\xb6\x96\x55\xd5\x8b\xbc\xae\x4b\x6a\xa9\xf0\xc1\x4c\x62\x3b\xad\x2a\x53\xed\x8a\xad\xa9\x61\xf6\x01\xa6\xb1\x1d\xdb\xf1\x4a\x5e\xb7\xea\x83\xc0\x3c\xb6\x2a\xca\xdd\xee\xde\x20\x91\x78\x6b\x76\xcb\x89\x12\x7a\x75\x1d\xd0\x5f\x1f\x60\x36\xbb\x6e\x67\x56\x89\x6d\xcb\x6e\xc5\x81\x08\x9c\x54\x71\xb9\x69\x4d\xe0\x80\xe9\x4e\x38\x72\x46\x7b\x4a\x01\xc0\x74\x27\x01\x4a\x0a\x00\x66\xbb\xba\xa9\xf2\x77\xbc\x59\x57\xe5\xfe\x86\x10\xd3\x0f\x30\xd7\xed\x45\x70\x85\xd8\xf1\x21\x40\x60\xaa\x93\xf1\x6a\x44\x36\x4c\x71\x1b\x76\x43\x79\xdc\x3e\x98\x09\x6e\x4f\x69\xda\x0f\x30\xbf\xed\xe4\x85\xd7\x9c\x72\xca\x7c\xf8\x25\x07\x24\xcc\x79\x98\xe5\x6e\xca\xf2\x46\x04\x8c\x89\xa5\x75\x10\xf6\x57\x3c\x58\x02\x48\xaf\xbf\xcb\x2d\x65\x54\x7d\x80\x39\x6f\xc1\x2a\xde\x04\xd4\xc8\x07\xbd\xec\x96\x30\x94\x4e\xfa\xff\x94\x9d\x5b\x6f\x24\xb7\x95\xc7\xdf\xe7\x53\x34\x56\xaf\x6a\x41\x97\xd1\xdc\x0c\xf8\x65\x9f\xfd\xb2\x5f\x40\x60\x55\xb1\xbb\x39\x62\x15\x6b\xea\x22\xa9\x2d\x08\x58\x5b\xdd\x55\x5a\xac\x77\xb1\x57\xc0\x48\x80\xc0\xb1\x8d\x18\x06\x12\x20\x01\x12\x3b\xf9\x54\xf9\x0a\x01\x59\x7f\x76\x17\xab\xff\x1c\xcf\x3c\xcd\xa8\x79\xc8\x62\xf1\x76\x7e\x3c\xe7\x14\xf9\x08\x7d\x37\x94\xc2\x17\xc3\x47\xa8\xbc\x41\x26\xb2\x0a\x3e\xfa\xdd\xb6\xb5\x4f\x17\xec\x75\xa0\xf6\x6a\xb3\xc3\x56\x37\x32\xdb\xc2\xfe\x22\xd9\x0c\x86\x22\xb4\xe9\xf3\x4c\xd6\xbe\x83\x8f\xf7\x3f\xf2\xb7\x86\x6e\x74\xf9\x04\xc9\x46\x1b\xe2\x37\xa1\x43\x8f\x48\x7c\x13\x22\x1c\x91\x80\xda\x84\xb3\x61\xd4\x9a\x78\xd3\x8c\x2d\x13\xd8\xaa\x2f\xc5\x8d\xf4\x53\xc9\x89\x6b\xb9\x64\xee\xa6\x47\x28\xd6\x4c\xd4\xab\xc4\xec\xe1\xc0\xe5\x69\x44\xba\x32\xb9\xa4\xab\xfa\xe3\xf7\x01\x56\xd3\x25\x6b\xbf\x77\xb7\x22\x7c\x59\x83\xaa\x4d\xcc\x6e\xcd\x3f\xf6\xeb\x2c\x9b\xe3\x50\xba\xb5\x6a\x64\x2e\x58\xc3\x43\xd5\xb6\x79\x52\x49\xad\x89\x05\xf7\x11\x0a\xd6\xae\xa1\x07\x6f\x5c\x5a\x43\x00\xc9\x03\x5d\xab\xed\x68\x4d\x5a\x9d\xd0\x57\x81\x96\x95\x77\xe9\x4a\x14\xcc\x0f\xfa\x08\x45\xeb\x74\xcc\x7b\xdc\x62\x8f\x50\xb8\x83\x5c\x0c\x84\x1f\xa1\x77\xdb\x5a\x56\xf3\x9c\x09\x40\xf1\xd6\x8d\x6c\x56\xa6\x4e\xf9\x38\x84\xea\xad\x5b\xd5\x70\xe5\xfc\x08\xc5\x6b\xf1\x8d\xbd\xf7\x97\x18\x72\xa9\x59\x2c\x24\xcb\xef\x77\xf6\x6d\xa3\x65\xc5\xd6\x1e\xec\xec\x9d\x1b\xd2\x79\x2a\x58\xeb\x62\x7b\x9f\xb4\x4a\xdb\x68\x2a\xda\x03\xd8\xde\xaf\x4c\x5d\xaa\x46\xd0\xda\x3e\x7a\xf7\x43\x9e\xb4\xda\x5d\x37\x70\x28\x82\xed\x7d\x2e\xb3\x6b\xd5\x90\x74\xbf\xaf\xb7\xa3\x41\x56\xf3\xb7\xcc\x46\xf0\x88\xcd\x7d\x22\xe9\xec\xc1\xae\x7e\x15\x5f\xd4\xb1\xab\x7f\xff\xca\x8f\x8d\xbd\xb3\xe3\xcc\x33\xd3\x26\x51\x06\xdd\xfc\x2b\x11\x8d\xac\xbe\x9b\x2f\x88\x2c\x5b\xe6\x36\x5f\x12\x41\xae\x3c\x36\x20\xa4\xa1\xaa\x91\x3a\x02\x8a\x06\x99\x58\xe5\x00\x42\x83\x10\xad\x55\x17\xd6\x8a\x56\x07\xe4\x93\xc9\xfa\x9a\x5a\xeb\x37\x40\x1f\x2d\x4a\x9e\x0e\xac\x71\x28\xc1\xaa\x09\xaa\xc9\x4d\x62\x87\xf5\x78\x33\x7a\xbc\xff\x9d\xe4\x03\xec\x00\xe8\xc9\x08\xde\x80\x73\xdc\xf5\x26\xb1\x96\x04\xe8\x0c\x32\xb1\x96\xf4\x3b\xfa\xd2\x1d\xf6\x42\x04\x00\x3c\x31\x7b\xe7\x06\xc4\xe3\x6c\xbe\x95\x2c\xf5\x3a\x24\xc2\xfd\x0f\xe3\x4c\xff\x13\x98\x01\x28\xeb\x6f\xfe\x37\xb4\x8c\x11\x89\xff\x0b\x25\x4a\x49\x6d\x01\x1b\xbf\xd9\xcf\x23\x21\x0e\x1b\x10\xcb\xa2\x32\xb7\xbc\x04\xe0\x4a\x2e\x69\x80\xc5\xc6\x6f\xcf\x45\x2e\x4b\xb6\x4a\x6f\x00\x2a\xd7\x72\xed\xb4\x0d\x2d\x04\x68\xb2\xd0\x82\xae\x6b\x1b\x20\x88\x4b\x77\x4e\x0f\x7b\xa9\x26\x91\x03\x75\x34\xb2\xca\x15\x8d\x2e\xd9\x80\x3a\xb8\xa3\x7d\xf3\xdb\x69\x7f\xce\x85\xd6\x07\x7d\x3a\xfa\x71\x9c\xf9\xdb\x89\xe5\x6b\x2e\xf3\xb2\x09\x46\xc4\x3e\x69\xd1\x6a\xcd\x53\xd8\xeb\x83\x51\xb4\x49\x07\xfb\xbb\xb3\x85\x12\x39\x8f\x24\x15\x9d\xb0\x3b\x1a\xc9\xa4\x75\x50\x10\x82\xdf\x00\x46\xdc\x96\x71\x9e\x54\xe6\x5a\x16\x21\x72\xf1\xbd\xe3\xe6\x87\xd0\xd3\x44\x24\x80\x25\xd6\xc5\x44\x52\x7f\x3c\xb0\xce\x13\x21\x30\x48\xdd\xda\x03\xcc\xd2\x4a\x95\x6c\xe2\x80\x41\xea\x36\x89\x8a\x00\x42\x64\x25\x68\xb0\xd7\x06\xf0\x51\xb6\x9f\x7f\x6e\x17\x2e\x25\x99\x8e\xdc\x00\x3e\x72\x1b\x58\x1a\xb1\xb4\x6d\xfe\x38\x95\x89\xb9\x27\x36\x1e\x42\x56\x4a\xb2\x58\x88\xcd\xc4\xbd\x40\x47\x09\xec\x48\xd6\x81\x32\x97\x77\x8d\x2a\x96\xad\xaa\x57\xf4\x15\x41\x24\x95\x49\xaf\x99\xee\xde\xec\x5c\x0d\x77\x69\xc6\xba\x62\xe2\x6a\xc0\x5a\x1d\x59\x8a\x7f\xa6\xc2\xb1\x35\xf9\xaf\x54\x9a\xaa\xb9\xbf\x51\xd1\x88\xbe\x03\xb3\xac\x9a\x5c\x5f\x92\x64\xd0\x4a\x5a\xd7\x17\x24\xd5\xfb\x20\x8a\x74\x65\x58\x7b\x82\x55\x06\x1b\x64\x64\x49\xf7\x3e\x88\x56\xeb\x9a\xba\xb1\xb6\x20\x14\xa9\xb5\x2a\x6b\x45\x3d\x19\xdb\x2f\x26\x32\xc4\x1f\xb2\x05\x94\x54\x75\x1c\x9c\xb6\xa0\x91\x51\x04\x18\x11\x02\x8e\x34\x8a\x0f\x93\x2d\x48\xc4\xf9\x16\xe3\x8f\xea\x0e\xa5\xd8\xf8\xdd\x82\x48\xb4\xbc\x91\x9a\x76\xf8\xd6\x23\x89\x93\xe0\xfd\xbc\xfd\xb7\xc3\xb8\x0b\x22\x05\x38\x09\xc2\x3d\x88\xd8\xbf\x13\xcf\x40\x5c\xfa\xab\xc3\xd0\x10\x22\x05\x32\xb1\x0e\x5d\x1a\x4a\xbb\x05\x95\x0c\xf6\x01\xdf\x5e\xe3\x61\xed\x74\x49\x63\x96\x71\xba\xdb\xfe\x17\x2d\xa3\x2d\x49\x09\xb4\xa5\xff\x9b\xe6\x1f\xcf\xd9\x71\x11\x91\xb9\xbc\x05\xe9\xc8\xd6\xcf\x99\x63\xfc\xc9\xba\xdf\x1b\x7c\x12\x56\x1f\xd0\x4e\x66\xb4\x16\x55\x68\xfc\x24\xcb\xe5\x16\xd4\xa3\x8a\x40\xb6\x6a\x4b\xb6\x3f\xdb\x7a\xd3\x4f\x11\x28\xeb\xb7\xa1\x61\xb2\xca\xbd\xf3\xc1\xa5\xae\x99\xaf\x72\x0b\x03\x51\xe5\x36\x02\x63\xf1\xaa\x4d\x26\x7f\xd2\x09\x07\x18\xbb\xae\x6e\xc7\xd2\xb7\x4c\x1d\x6e\xc1\x65\x89\x6a\x52\x13\x1a\x78\x93\x26\x25\xf2\x00\xb5\x05\xa5\xee\xed\xaf\x27\x5b\x50\x22\x32\xb6\x1f\xb9\x33\xc5\x46\xd6\xa0\xb1\x5c\x60\x2f\x72\x72\x99\xa4\x82\xa0\x35\x67\x44\x12\xb9\x69\x8b\x26\x52\xe2\x37\x87\x82\x91\x22\x01\x70\xae\xc8\xa2\xcd\x65\xa5\xd2\x48\x99\xdf\x12\xc9\x48\xa1\xdf\x05\x9e\x4f\x3a\x5f\xbe\x0f\x44\x22\x93\x12\x00\xb6\x36\x6d\xd3\x26\xef\x59\x21\x7e\x17\xc8\x11\x01\xe0\xd6\x1d\x8d\x54\xd8\x02\xb5\x6c\x6a\xfc\x19\x3f\x06\xcf\x88\x84\x7b\x6d\x81\x5c\xf6\x82\x30\x1a\x48\xbc\xf5\xb8\x65\x3d\xab\xbb\x9b\x03\x89\x1c\x98\x4b\x15\x75\x23\x96\x95\x20\xf1\x0f\x5b\x60\xd7\x42\xab\xf4\xba\x22\xe9\x00\x2e\xc1\x80\x64\x0b\xd2\x4a\x54\x93\xb4\x11\x65\xf5\xa7\x89\x48\xbc\x6d\x00\x5b\x4d\x9b\x27\x9a\xd5\x04\xa0\x35\xa4\xc7\x8b\x01\x64\x69\x53\x2c\xe1\xa4\x8a\x8c\x8b\xbf\x1c\x08\xd2\x31\xf6\xd3\x81\x18\x07\xae\xed\xcf\x07\x82\xb1\x05\xda\xdb\x82\xca\x92\x2e\x0a\x00\xac\x5b\x55\x64\x34\x38\x71\x0b\xa8\x12\x45\x56\x19\xc5\x16\x62\x60\x95\x56\x45\xcb\x86\x0f\xb8\x2a\xab\x54\x92\x50\x8f\xc4\x16\x64\x55\x5f\xaf\x99\xf1\x6e\x0b\xa8\x5a\x98\xb6\x8a\xf5\x43\x07\xac\x6a\xac\x3d\x94\x28\x9e\x0e\x48\xb5\x90\xb9\x60\x55\xe8\x80\x53\x91\x54\x80\xd4\x52\x35\x0d\xf3\x49\x75\x60\xa8\xba\xa5\xbb\xeb\xce\x23\x94\xe1\xbe\xfc\xce\x9b\x72\xaa\x74\xa5\x98\x07\xab\x03\x35\x25\x2d\x59\x0a\x3a\x00\xd3\x0d\xd9\xb3\x75\x00\xa5\x5b\xa9\x12\xf6\x60\x10\x52\x25\x8b\x8a\xa9\xba\x0e\x68\x54\x8a\xa5\xb4\xbe\x26\x32\x3a\x3a\xcf\x43\x6e\x71\x88\x5b\x85\xbb\xff\x20\x8e\xdc\xa8\x8f\xbb\xfb\x4f\x2a\xcd\xa7\x42\xc7\x39\x68\x24\x3c\xc6\x98\x48\x19\x60\xa1\xcc\x34\xbb\xc7\x11\x29\xb0\xce\xed\x4a\x4a\x6d\xf7\xcf\x64\xe1\xe8\x80\x38\x37\x2a\x97\x26\xba\x6e\x74\x60\x9d\x66\x67\x2a\x1e\xea\xd8\x56\xd7\xaa\x5e\xcd\xb5\x62\x61\xd9\xdd\xff\x1f\x58\x4b\x69\x2d\x81\x3a\xf6\x32\x17\x39\xaf\x57\x6d\xd3\xd0\x41\x0d\x8e\xa9\x35\x0d\x98\xe9\xbe\x0e\x9d\x3b\xf1\x57\x01\xa3\xd8\xcb\xd2\x22\xd1\x8b\x1d\xb0\xc4\x06\x7f\xb1\x15\xa4\x03\x98\x24\x62\x67\x78\x38\xf6\x9a\x44\x35\xed\xc8\x54\x00\x0b\x85\xb2\x9f\xad\xa9\x66\x4d\x8a\x02\xc0\xd8\x23\x78\x5b\x04\x32\x8a\x32\xb4\x49\x56\xd6\x42\x33\xf6\x8a\x8c\xf3\x03\x6c\xd6\x62\x65\x58\xdb\x02\x67\x06\x8f\x25\x49\x07\xc5\x54\x32\xdb\x07\x9d\x8f\xd3\xc1\x2e\x43\x7a\xbc\x4d\x77\x66\x26\xab\x7e\x64\x5b\xee\x76\xbe\x44\xf6\xbb\x03\x59\x22\x04\x76\xc9\xa4\x56\xa9\x32\xcc\x77\xda\x81\x5c\x32\xb5\x64\xab\x0c\x78\xa5\x54\x32\x9b\x97\xaa\x64\x16\x87\xee\x87\xa9\x0c\xdf\x25\x77\x80\x97\xac\x6a\x4b\x66\xbf\xeb\xc0\x2d\x6f\x8d\xc9\x99\x73\xab\x03\xb1\x68\x51\x2c\xdb\xfd\x17\x0a\x63\x09\x20\xcb\x42\x10\x85\xd4\xfd\x3e\x74\xb2\x10\x09\x60\x4a\xba\x52\xcc\x50\xd3\x81\x52\x4a\x71\x4b\x12\x41\x29\x75\x69\x68\x47\x80\x50\x52\x0a\x7d\x1d\xa8\xc4\xa6\xb2\x2e\x02\x94\x24\x72\xc5\x9d\x3a\xdd\x9f\x03\x81\xf8\xf8\x02\x8d\xd4\x8d\x64\x98\xd6\x81\x42\x5c\x72\xbc\x10\x20\x48\x25\xd3\x35\x1f\x99\x9e\x3c\xda\xc6\x04\x9e\x80\x63\x2c\xd7\x24\x8b\x37\xf7\x88\x60\x2b\xd5\x88\x3b\x45\x84\x41\x26\x4d\xc5\x76\x7c\x1d\xb0\xa4\x2e\x0d\xff\xf8\xa6\xf3\x60\x22\x6f\x94\x28\x1a\x1a\x1a\xda\x79\x34\xb1\xc7\xa5\x46\xa2\x66\x3a\xf0\x49\x26\x1a\x91\x50\xe7\x62\x0f\x3a\xb1\xdb\xb2\x79\x99\x51\xfb\x6f\xef\x09\xc5\xca\xd8\xb5\x94\x0a\x01\x53\x5c\x41\xf2\x2e\x95\xd4\xfb\xd7\x03\x57\x9c\x94\xfb\x4a\xd3\xdd\x4f\x4e\x45\x41\x2e\x4e\xd4\x1d\x1a\x3e\x37\xd3\x48\x9d\xe1\xab\x1f\xf6\x7b\xfc\xe3\x9f\x1e\xc8\xe3\xe4\xc0\x35\xa4\x84\xcf\x55\x49\x73\x03\x88\x9c\x8c\x68\x33\xc5\x9e\xee\xba\x84\xe6\x06\x2d\xb9\xdc\xb9\xb9\x51\x92\xe4\x1e\x3e\x80\x62\xb9\x81\x53\x2e\xb7\xf5\x20\xd0\x47\x80\xab\x6e\x68\x00\x4e\x0f\xac\xb2\xb9\x69\xb8\x73\x0f\xae\x7a\x5b\x2f\xdc\x77\xe1\x44\x02\x58\xa5\xd5\x42\xce\x13\xd3\xae\xc3\xd8\x2e\xfb\x63\x6b\x0e\x7f\xac\xf6\xcb\xd9\xfe\x47\x1b\x15\xe5\xa7\xda\x31\x8c\xeb\xe5\x3e\xa2\x64\xfc\x54\x6f\xb6\xf2\xc0\x55\x98\x86\xc5\xca\xf5\xc0\xb3\x4a\x84\x6e\x93\x44\x6a\x22\x0c\x3c\x93\x79\xb9\x8f\xfa\x47\x2c\x33\x91\x06\x88\x2d\xdf\xa3\x1d\x7b\x60\xd8\x92\x69\xd8\x1e\xfc\xb5\x12\xe9\xb5\xac\xe6\x85\x64\x7b\x9a\xde\xe3\x97\x2c\xec\x67\x7e\x31\x3e\xee\x01\x5c\xef\xde\x91\x34\x50\xd6\xad\x4c\x57\xe1\x77\xb3\xb7\x52\xdd\xb1\x50\x97\x1e\xc0\xe5\x82\xdb\x82\x6f\x0c\x5c\x6b\xd4\x92\x7d\x1a\xd2\x7f\x7d\x90\x29\x1c\xcd\x36\x1b\x1d\xa2\xc0\xb2\x95\xaa\x1b\xc3\x02\x12\xfa\x5f\x05\x9d\x6d\x8f\xe3\x21\x42\x20\xb3\xc8\xdd\xdd\x7f\xef\x81\x5b\xa5\xa8\xec\x96\xbf\x64\x83\xc5\x9b\x8a\xb4\xca\x68\x0c\x63\xef\x4d\x44\xce\xa4\x4a\x89\xa1\xff\x66\x22\x12\x1f\x1b\x00\xac\xc4\xe4\x09\x49\x05\x57\x2d\xda\x26\x31\xfb\xb5\x13\x61\x4e\x69\x2a\xab\x79\x22\x78\x00\x48\xef\x0d\x44\x8c\x3a\x7b\xe0\x55\xa2\x0a\x93\xda\x63\x97\xd8\x6b\x82\xaf\x4a\xcd\x76\x71\x3d\xf8\xca\xb5\x52\xe4\x33\xa2\x1e\x7c\x65\xbf\x53\xa0\xd3\x12\x5c\xb5\x96\x9a\x6c\x52\x7b\x50\x55\x21\x6f\xeb\x61\x08\xb2\xb7\x04\x5a\xdd\xaa\x05\x51\xb8\x3d\xb0\x2a\x15\xda\xbe\x65\xc3\xdc\x24\x3d\xe8\xaa\x14\x6b\x0a\x77\xfd\x1f\x82\x38\xbe\x5b\xa1\x69\x64\x41\x0f\xc6\x4a\x53\x7b\x1f\x12\x81\xc0\x1e\x9c\x95\xa6\xf3\xdc\xc6\x33\x55\x3c\xe8\xbb\xf7\xbc\x95\xce\x33\x55\xa7\x66\xbf\x14\x8e\x85\x3c\x76\xa5\x73\x91\x4b\x82\x8c\x3d\xc0\x2b\x4d\xe3\xef\x05\xf4\x4a\x53\x17\xc2\xc9\x0c\x19\x3d\xa8\xcb\xc5\x18\x45\x9c\x84\xfd\x4f\x53\x19\xda\x4d\x40\xaf\xa6\xe2\x85\x00\xbc\x52\x53\xae\x23\x3b\xec\x1e\xa0\x25\x58\x1a\xb8\x4a\xae\xa5\x35\x0a\x52\xd6\xef\x41\x57\xa5\xb0\x64\x91\x54\x2d\xad\x07\x08\x2b\x51\x55\xb3\xca\xac\x13\x4a\x5c\xb3\x76\x01\x64\x89\x4a\x8a\xd8\x37\x3a\x3d\x20\xab\x54\x32\x26\xf2\x04\xca\xb2\x96\x8a\xa8\x0c\x28\x4b\x8b\xba\x59\x10\xf2\x7d\x02\x60\x0d\xe9\xd1\x55\xe6\x09\x84\x05\x9b\x02\x3d\x59\xe0\x69\x13\xca\x90\xd5\xf5\x09\x94\x94\xa8\x08\x41\x3f\x01\x84\x12\xb6\x61\x7b\x02\xe7\x28\x73\xb7\x32\x2c\x10\xf7\x09\x28\x63\x2d\x32\x9a\x7f\x62\xfe\x04\x90\x49\x89\x49\xfc\x09\x18\xa3\x74\x1d\x7e\xe3\x28\xaf\xc3\xa8\xcb\x7a\x25\xdf\x31\xcd\xff\x04\xcc\xc9\xa5\x28\x4a\xc1\xbe\xca\x7f\xfa\xea\x9f\x1e\x12\x93\xad\x47\x97\x0e\xb9\xdb\x08\xc2\x1b\x7a\xff\x45\x28\x7d\x2b\xd6\xe3\xab\x79\xf7\x97\xf2\xe2\x9c\xf6\xf1\xf1\xec\x47\xb9\x50\xe1\x21\xe3\xa3\x53\x74\x1e\x8e\xac\x35\xab\x0a\x93\xfd\xe1\x65\xaf\x70\x39\xec\xe8\x4c\xbb\xb3\x8b\xf2\x0e\x79\x86\xcb\x05\xf6\x07\xa3\xbe\xdc\x5f\xbd\xf8\xe6\x74\x72\x9e\xd9\xa9\xcf\xe3\xfe\xb9\xb2\x21\x11\xbb\x23\xd2\x4e\xdd\x33\x1e\x8e\x86\x6f\x09\x6a\x5e\x95\x41\x68\xf6\x8a\x54\x06\xf9\x86\xea\xc4\x9f\xef\xc5\x5a\xbd\x7b\xc2\x70\xee\x26\x8e\x9c\x7b\x73\x3a\x7a\xd8\xe9\x6c\x52\xab\x59\xab\xa3\x37\x64\x04\x67\xad\x8d\x2f\x57\xb6\x57\x50\xbd\x08\xae\x98\x78\x73\x24\x5f\xc8\xd7\x32\x9b\xdc\x7b\xf1\x70\x64\xf9\x87\xbf\x79\xbc\x13\xb4\x28\x66\x27\xc3\x0d\xb9\xe3\x73\x0c\x5f\x9e\xee\x4e\xd1\xc3\xe1\xff\xcf\x77\xe2\xad\xbe\x8f\xbd\xad\x6d\xda\xfd\x31\x7b\xae\x0e\x43\xb5\x3e\x3d\x38\xa4\x0f\x85\xd9\xf3\xf5\x50\x86\x7d\x53\xff\xf3\x49\x26\x1b\xa1\x74\x70\x94\xe5\x67\xb2\xd0\xe6\xf8\x33\x53\x88\xd4\x1c\xff\xb3\x29\x6a\xa3\x45\x7d\x9c\x9b\xc2\x38\xb3\x9c\xcf\x89\x4f\x51\x83\xc3\xe9\x5e\x8d\x1a\xf4\x0c\x0d\x3a\x6a\x8a\xb3\xf0\xe6\x8c\x60\xe6\x9c\x8a\xec\xb9\x2f\xdb\x1e\xda\x67\x4f\xbf\xab\x53\x51\x7c\xea\xab\x88\x8c\xd9\xeb\xcb\x8b\xe7\x8b\x4f\x26\x8d\x38\xa9\xd4\x89\xcd\x19\xcc\xcc\x21\xdb\xc3\x91\x2a\xca\xb6\x19\xee\x1b\x31\x45\x3d\xd3\x22\x91\xfa\xe0\x4e\xe4\x49\xf7\x4d\x4f\x9e\x7c\x38\x1a\x62\x77\xae\x2a\x59\xb7\xba\xa9\x3f\x72\x2c\x84\x99\x67\x27\xb5\x0d\x1f\xb7\x01\xd8\xf7\xe1\x48\x38\x3b\x27\xd2\x65\xb5\xbf\x62\x79\x76\x3a\x7b\x3e\x6a\xf0\xe7\x68\xf0\xf1\x9d\xd9\x65\x25\xdd\x2d\xd8\x87\x4f\xcd\x65\x5d\x8b\xe5\xee\xfc\xac\x17\x97\x2f\xb2\x97\xaf\x0e\xc5\x64\x55\x99\xea\x17\xdb\x5e\x54\x22\x8f\x34\xc3\x8b\xe8\x94\x70\x99\x3e\x66\x52\x0c\x19\x5c\x40\x27\x8e\xac\x1c\xdd\x52\xf1\x66\x57\x8f\x59\xb3\x3a\xde\xfd\x37\x0b\x86\xfd\x2b\xdc\xc5\xe2\x97\x9c\x20\xd3\xc7\x4c\x02\x32\x24\x54\x26\x13\x51\xcd\x4e\x1a\x3b\xa4\x6a\x77\x58\xd6\xec\xc4\xb6\xfd\xb0\x1c\x9d\xd8\x91\x5e\xfa\xda\x60\xac\x5d\xec\x6f\xd5\xc9\xe4\x42\xb4\xba\xf1\xf3\x63\xe8\x8f\x71\xa3\xed\x6e\xef\xd9\x9f\x43\xd7\x96\xf6\x5b\x23\x51\xcb\x0f\x7d\x3c\x6e\xeb\x1a\x4d\x0c\xdb\x57\xbf\x9c\x7b\x56\xe7\x42\xeb\xe9\x58\xc9\x94\xdd\x38\xe5\xbc\xdf\xed\x3c\x9a\x9d\x5d\x96\x77\x3b\xb9\xdd\xac\x9b\x8e\xf3\xd3\x40\x28\x54\x54\xaf\xdf\xa7\xa8\xc8\x62\xbd\x2b\xe8\xca\xed\xe9\x66\xf5\xcd\xf2\xe3\xfb\x75\xd7\xe0\xd3\xd2\x96\x27\x85\xc9\xe4\xfd\x54\x21\x30\xa1\x59\x25\xd3\xc6\x9e\xd6\xa7\x07\x2c\xa8\x1b\x1b\xae\xf8\xe6\x48\x88\xe4\x3c\xc9\xde\x93\xe7\x64\xd8\xb0\x22\xeb\xf9\xe5\xf9\xe5\xf9\x22\x22\x6e\x87\x83\x9f\x3f\xbb\x07\x4d\x66\xd2\xfb\xb2\x96\xd7\xf7\xd3\x3b\xda\x76\x1f\x67\x7d\x58\x39\xc3\x80\x72\xf5\xbe\xf7\x6f\x78\xf6\x5a\x88\x57\x07\x6f\x68\x29\xea\x44\x66\x4b\x54\xd5\x8d\x95\xb0\x4d\xd0\x44\xe8\xf8\xb3\x93\xcb\x68\x11\xfe\xba\xa8\xf0\x89\x61\xfe\xf3\xc3\xfc\xc3\x51\x3d\xae\x18\xb4\x17\x3a\xe3\xd9\x51\x9d\xae\x64\x2e\x70\x44\xab\xdb\x77\xf8\x65\xc5\x9e\x1a\xec\x16\xb0\xd9\xe9\xec\x34\xbc\x54\x78\x7f\xbf\xd1\xf9\x79\xb8\xc0\x9d\xef\x15\xdd\xc5\xc5\xc5\xc3\xb3\x23\x6f\x60\x0d\xe7\x0a\x7b\xdc\x05\xae\xaa\x9f\x3c\xee\x3c\x78\xdc\xe4\x3a\xfd\x8b\xc9\xe3\x4e\x2c\xb3\xd9\xa3\x93\x95\x29\x82\x69\x2c\xfc\x14\x46\x1f\xc5\x25\x4f\x86\xa3\xcd\x9d\xc5\x71\xa7\x70\xa6\xab\x9e\x7f\x28\xda\x7f\x32\x2d\x3e\xa8\xec\x99\x53\xc8\x41\xb3\x3c\x3c\x3b\x82\xe5\x27\x6c\x2d\xb6\x6a\x40\xf0\xc3\xb4\xc8\xe5\x28\xc7\xd5\x70\x50\x60\x44\xf5\x8f\x5e\x11\xcd\x7f\x19\x3c\xae\xd5\xf7\x13\xfc\xfa\xe4\x10\xd5\xf6\xe2\x23\xf8\xba\x1c\x69\x9f\xdd\xab\xec\x6f\x5e\x8b\x10\xe7\xbe\xa0\xc3\x15\xfc\x68\x71\xb9\x78\xb9\x10\x63\xa1\x80\x12\xf6\x15\xc2\x63\xc3\x75\xd3\x4d\x44\x86\x0c\x93\xf1\x1c\x94\x3f\xa8\x03\xdf\x3b\xc3\xd9\xa2\x13\xe5\x30\x95\x9e\x90\x84\x78\xfe\xfc\xf2\x62\x27\x75\x95\x9b\x5d\x8d\xdd\xc5\x6b\xb6\xab\x9e\x1d\x59\xf3\x6f\x76\xf5\xae\x95\xd5\xfa\x3e\x32\x15\xc6\x37\x8b\xb9\x4e\xbc\x2c\xef\x22\x27\x98\xa2\x38\xeb\xc9\xa5\x03\x6b\x97\x7d\x32\x47\x8e\xb2\xe4\xca\x6e\xcb\x1a\x59\x58\xcf\xd4\xa8\x26\x67\xc3\xa8\x08\xd3\xaf\x2c\xcf\xb5\xf5\x7d\xa4\xd4\x17\x97\x2f\xb2\x97\xaf\x1e\x9e\xfd\x63\x00\x43\xfc\x81\x29\x89\x39\x02\x00")
 
 func staticCssAppCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -96,7 +96,7 @@ func staticCssAppCss() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/css/app.css", size: 142269, mode: os.FileMode(511), modTime: time.Unix(1537348383, 0)}
+	info := bindataFileInfo{name: "static/css/app.css", size: 145801, mode: os.FileMode(511), modTime: time.Unix(1792295432, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -201,7 +201,7 @@ func staticFontsFontawesomeWebfontWoff() (*asset, error) {
 	return a, nil
 }
 
-var _staticIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x51\x6f\xdb\x38\x12\x7e\xcf\xaf\x60\xf8\x70\x4f\xc7\x08\x69\x0b\xb4\xd7\x93\x04\x1c\xd2\x1c\x90\xdd\x6e\x9a\x36\xed\x62\x8b\xa2\x30\x68\x71\x6c\x31\x4b\x91\x0c\x39\x72\xe2\x16\xf9\xef\x0b\x4a\xb2\x2c\xc9\x92\xed\xbc\x2c\xb6\x40\x6b\x69\x38\xf3\x71\xe6\x9b\xe1\x0c\xd5\xf8\xf4\xdd\x87\x8b\xcf\x5f\x6f\x2e\x49\x8e\x85\x4a\x4f\xe2\x53\xc6\xbe\xc9\x05\x51\x48\xae\x2e\xc9\xeb\xef\x29\xa9\xfe\xc4\x61\x95\x64\x8a\x7b\x9f\x50\x6d\xd8\x9d\x27\x0a\x99\x84\xff\xd4\x3f\x6f\xea\x9f\xd7\x34\x25\xf1\xe9\x37\xd0\x42\x2e\xbe\x33\xb6\x45\xeb\x42\x1d\x81\xb6\x07\xe6\xcd\x31\x30\x53\xf6\x4b\x6c\x20\x82\x20\x1d\xb1\xaf\x0c\x19\xeb\x1b\xe7\xc0\x45\x7a\x42\x48\x5c\x00\x72\x92\xe5\xdc\x79\xc0\x84\x96\xb8\x60\x6f\xe8\x76\x21\x47\xb4\x0c\xee\x4b\xb9\x4a\xe8\x1f\xec\xcb\xff\xd8\x85\x29\x2c\x47\x39\x57\x40\x49\x66\x34\x82\xc6\x84\x5e\x5d\x26\x20\x96\x50\xdb\xa1\x44\x05\xa9\xbf\x57\x12\xe1\x01\xe6\x71\x54\x0b\x5a\x48\xcd\x0b\x48\xa8\x00\x9f\x39\x69\x51\x1a\xdd\x01\xa2\x43\xb5\x95\x84\x07\x6b\x1c\x76\x74\x1e\xa4\xc0\x3c\x11\xb0\x92\x19\xb0\xea\xe5\xdf\x44\x6a\x89\x92\x2b\xe6\x33\xae\x20\x39\x9f\x08\xe0\xa2\x86\x60\xef\xb9\x5e\x96\x7c\xd9\x8d\x00\x34\x4d\x4f\x82\xd1\x29\x63\xe4\x46\xf1\x0c\xc8\x82\xaf\x64\x66\xf4\x99\xcc\x0c\xe1\x5a\x10\x6e\xad\x02\x86\xa6\xcc\x72\x56\x2d\x58\xbd\x24\x52\x13\xcc\x81\x38\x63\x90\x08\xe9\x20\x43\xe3\xd6\x24\x30\x1c\xc0\x94\xd4\x7f\x12\x07\x2a\xa1\x1e\xd7\x0a\x7c\x0e\x80\x94\xe4\x0e\x16\x09\xfd\xf9\xf3\xcc\x23\x47\x99\x3d\x3d\x65\xde\x47\xdc\xda\xb3\xcc\xfb\xda\xf3\x9a\x9a\x34\x33\xda\x23\xe1\x56\x7e\x0a\xf0\x09\x09\x36\x61\xa7\xa7\x27\x1a\x47\x8d\xce\x49\x1c\xd5\xa9\x8c\xe7\x46\xac\x83\x35\xd9\xa9\xf6\x93\x4d\x69\xd9\x4d\x5d\xcc\x9d\x79\xf0\x90\x73\x6b\xd7\x34\xfd\x6a\x4a\xc2\x1d\x90\xd2\x4b\xbd\x24\x5c\x93\xd8\xa3\x33\x7a\x99\x9a\x12\x05\x47\x10\x71\xd4\x08\x48\x6d\xe7\xce\xc8\x8d\x02\xee\x81\xc4\xbc\x89\x26\xd0\xfc\x36\x8a\x3a\xb8\x67\x99\x29\x22\x9a\x96\x76\xe9\xb8\x00\xb2\x36\xa5\xdb\x98\xc7\x11\x4f\x09\x1a\x22\x0b\xeb\xcc\xaa\x59\x83\x47\x0b\x4e\x82\xce\xe0\x2c\x8e\x6c\x13\x48\xa7\x62\x03\x2f\x42\xae\x88\x14\x09\x2d\xb8\xd4\xb4\x0e\xab\x95\x69\xbe\x9a\x73\x47\x37\xc1\x56\xf2\x26\x5a\x67\x1e\x5a\x79\x7f\x25\x33\x8a\x3d\x7a\x76\xfe\x82\x84\x27\x5f\xb0\xf3\x17\x1d\x4d\x42\xe2\x72\x7b\x94\xf8\x8a\x75\xf6\x6d\x35\x94\xac\xb6\x47\x3e\x57\x30\xf3\xe8\xca\x0c\x4b\x07\x34\xbd\xdd\x3c\xc6\x91\x92\xfb\x6c\x9a\x1a\xa4\x69\x53\x9e\x87\xf4\xef\x4b\x70\x6b\x9a\xde\x7e\x7c\x4f\x3e\x86\xc7\xa1\x7e\x1c\x95\xaa\x13\x6d\x24\xe4\xaa\x25\xa5\x7e\x39\xd9\x79\xde\x90\xe8\xa5\x80\x29\x16\x91\xcf\x41\x79\xa6\xa4\xc7\x09\x36\x1f\x1c\xb7\x7d\xfa\xba\xe6\xa1\x0b\xd0\xf4\x1d\x47\x3e\x0f\xa5\xf3\x39\x04\xe3\x7b\xee\x6d\x18\x6f\x63\xf5\x34\x3d\x32\x9a\xfe\x5e\x52\x2f\x8c\x2b\x78\xd5\x5c\x9e\xe3\x6a\xd8\x58\xcc\x67\x5d\xf3\x29\xf7\xaf\xb6\x3a\x63\x31\xec\x24\x30\xfd\xbf\x54\x10\x7a\xda\x5b\x12\x7b\xcb\xf5\x66\xaf\x85\x54\x30\x0b\xf2\x10\x6a\x58\x48\x47\xf3\x9f\xde\xca\x1f\x43\x53\x2f\x7f\x1c\xb2\xaa\x49\x1e\xd8\x65\xa6\xd4\x38\xdb\x12\xbc\xc7\xfe\x4a\x0b\x78\x9c\x00\x90\xf5\xda\x24\x42\x3f\x73\x1d\x86\xeb\x32\xde\x43\x72\xe5\xf5\xf3\x19\xfe\x64\x1e\x7a\x9e\x6e\x8e\x57\x70\x36\x34\x9e\x23\x3d\x1d\xab\xb1\x61\xb9\xb5\xb1\xb4\x87\xf7\x64\x27\xcc\x4e\x2f\x98\x38\x12\xfe\x5e\x0d\xbb\x49\xfe\x32\x1c\xec\x38\xca\x5f\x0e\x16\x06\x04\x54\x32\xeb\xa0\xbf\xd3\xac\x42\x8c\x23\xeb\x60\x60\x3e\x24\xb0\xde\xea\xc2\xa8\xb2\xd0\x7e\x7c\xbb\x01\x74\x56\xeb\xd2\x1d\x2f\x2a\x9a\x7b\x84\xd7\x8a\x9d\xbe\xa1\x80\x54\xff\x32\x8f\x4e\x5a\x10\x3b\x20\x01\x66\x73\x23\xd9\x5d\x71\x63\xe2\xca\x24\xbd\xe6\x05\xc4\x11\xe6\xd3\x1a\x9f\xd7\xf6\x80\xc6\x8d\x93\x05\x77\x6b\xf2\x2b\xac\xf7\x2b\x5e\x1b\x24\xd7\xa5\x52\xfb\xb5\xde\xc1\x82\x97\x0a\xa7\x94\xe2\x68\x2c\x9e\xa0\x3d\x1a\x7f\x8c\xd5\x5c\x8f\x23\x6c\xe7\x7b\xdf\x2c\xf0\x7a\x54\xb2\x9b\xb3\x7c\x54\xb2\xdb\xb3\x7d\x28\xd9\x1b\xc5\x7f\x48\xb2\xdb\x82\xde\xa7\xf4\x45\xcb\xfb\xf2\x00\x50\x75\x08\xff\xce\x04\x0e\xe5\x9d\x36\x51\x18\xc1\x15\x59\x70\x01\xb4\x62\xbe\xe2\x3c\x1c\xf5\x59\xb5\x42\x03\xdd\x95\x2c\xa1\xec\x9c\x12\x67\x54\xb8\x5f\x4b\xae\xcc\x92\x12\xee\x24\x67\x2a\x8c\x6e\x05\x62\xbe\x6e\xac\x6f\xef\xd5\xfb\x20\x6b\xd6\x73\x29\x04\xe8\x84\xa2\x2b\x61\x2c\x5d\x43\x67\x58\x83\x3e\x4a\xcf\x8e\xf2\x6e\x8f\xdc\xaf\x1f\x78\x04\x37\xa1\x4e\x48\x3c\x2f\x11\x8d\x26\xb8\xb6\x90\xd0\xfa\xa5\xad\xbf\x4c\x19\x0f\x94\x08\x8e\x9c\x09\xe9\x0b\xd9\xc2\xd2\xb4\x9e\x0c\x23\x01\xff\x0b\x65\x01\xfe\xbf\x9b\xe9\x50\xa9\x6d\x5a\xb4\x63\x46\xab\x35\x4d\x2f\x02\x70\x3b\x3f\xea\x5d\x27\x3d\xcc\x5f\xf5\x23\x6a\xe6\xda\x6f\x55\x22\xab\x97\x38\xca\x5f\x4d\xf0\xb1\x7b\x7a\xa7\xa9\x0a\x25\x35\x4d\x54\x18\x03\x23\xc3\xe0\xe0\x4e\x13\x0b\xa3\xe2\xfe\x25\x6c\x42\x71\x20\x18\xda\xb4\x0d\x48\x6a\x5b\x0e\xeb\x64\x78\x6b\xb3\x23\xa5\xd1\x02\x80\x90\x68\x5c\x18\x81\x63\xae\x76\x90\x78\x16\xee\x15\xbb\x0d\x6e\x5b\x5e\x01\xce\x95\xdb\xca\x9a\xa3\x26\x73\xd4\xcc\xd6\xd3\x82\xa6\x9f\x4a\x3d\x5d\x08\x5d\x14\x78\x0c\x5f\xb0\xb3\xcc\xaf\xba\x60\x34\xbd\xac\xe4\xe4\xe2\xf6\xf7\x67\xe1\xdc\x79\xa3\x47\x81\x7e\xb9\xfd\x70\x3d\x85\x34\x36\x13\x8e\x4c\x8a\x29\x71\x98\x95\xc3\x39\xd9\x19\x14\x0e\x7c\xa9\xf0\xc0\xa0\x18\x19\x67\xe3\x5e\xf6\x1e\xb7\x9e\x77\xaf\x65\x1a\xaa\x14\xcf\xda\x56\xd5\xd7\xac\x3f\x9f\x89\x77\x59\xef\x53\x7c\x05\x5a\x18\x17\xf1\x0c\xc2\xdf\xb3\xbb\xfa\xd2\xd8\x7c\x6a\x4f\x9b\xdd\xf9\xe8\xae\xfa\x2e\x7b\x8e\x85\x32\x82\xfb\xfc\x39\x16\x73\x63\xd0\xa3\xe3\xf6\x39\x46\xdc\x0e\xd5\xe3\xa8\x1e\x45\x71\x54\xff\xf7\xd8\x5f\x01\x00\x00\xff\xff\x63\xd8\x23\x3d\x2f\x13\x00\x00")
+var _staticIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x5f\x6f\xe3\x36\x12\x7f\xf7\xa7\xe0\xf2\xa1\x4f\x2b\x0b\xdb\x16\x68\xaf\x95\x09\xec\x25\x29\x2e\xd7\x6c\x92\xdd\x64\xf7\xae\x28\x0a\x83\x16\xc7\x16\x13\x8a\x54\x48\xca\x89\x5b\xec\x77\x3f\x8c\xfe\x59\x92\x25\x5b\xb9\x87\xc3\xd9\x48\x2c\x0e\xe7\xf7\x23\x67\x48\x0e\x39\x54\xf4\xe6\xfc\xe6\xec\xfe\xb7\xdb\x0b\x92\xf8\x54\xb1\x59\xf4\x26\x08\x7e\x97\x6b\xa2\x3c\xb9\xbc\x20\x3f\xfc\xc1\x48\xf1\x89\xb0\x96\xc4\x8a\x3b\xb7\xa0\xda\x04\x0f\x8e\x28\x1f\x48\xf8\x5b\xf9\xf3\x63\xf9\xf3\x03\x65\x24\x7a\xf3\x3b\x68\x21\xd7\x7f\x04\xc1\x9e\xad\x4d\x35\x81\xed\x08\xcd\x8f\x53\x68\xc6\xf0\x1b\x5f\x51\xa0\x80\x0d\xe0\x0b\x60\x10\x74\xc1\x09\x70\xc1\x66\x84\x44\x29\x78\x4e\xe2\x84\x5b\x07\x7e\x41\x73\xbf\x0e\x7e\xa4\xfb\x8a\xc4\xfb\x2c\x80\xa7\x5c\x6e\x17\xf4\xdf\xc1\xe7\xf7\xc1\x99\x49\x33\xee\xe5\x4a\x01\x25\xb1\xd1\x1e\xb4\x5f\xd0\xcb\x8b\x05\x88\x0d\x94\x38\x2f\xbd\x02\xe6\x9e\x94\xf4\xf0\x0c\xab\x28\x2c\x05\x0d\xa5\xe6\x29\x2c\xa8\x00\x17\x5b\x99\x79\x69\x74\x8b\xa8\xd5\x72\xa9\xb6\x95\xf0\x9c\x19\xeb\x5b\x3a\xcf\x52\xf8\x64\x21\x60\x2b\x63\x08\x8a\xc2\x5b\x22\xb5\xf4\x92\xab\xc0\xc5\x5c\xc1\xe2\xdd\x88\x01\x67\x65\x7f\x83\x2b\xae\x37\x39\xdf\xb4\x2d\x00\x4d\xd9\x0c\x41\x6f\x82\x80\xdc\x2a\x1e\x03\x59\xf3\xad\x8c\x8d\x9e\xcb\xd8\x10\xae\x05\xe1\x59\xa6\x20\xf0\x26\x8f\x93\xa0\xa8\xc8\xf4\x86\x48\x4d\x7c\x02\xc4\x1a\xe3\x89\x90\x16\x62\x6f\xec\x8e\xe0\xf0\x20\x99\x92\xfa\x91\x58\x50\x0b\xea\xfc\x4e\x81\x4b\x00\x3c\x25\x89\x85\xf5\x82\xfe\xf5\xd7\xdc\x79\xee\x65\xfc\xf5\x6b\xec\x5c\xc8\xb3\x6c\x1e\x3b\x57\xf6\xbc\x74\x0d\x8b\x8d\x76\x9e\xf0\x4c\x7e\x42\xfa\x05\x41\x0c\xb6\xf4\xf5\x2b\x8d\xc2\x4a\x67\x16\x85\xe5\x50\x46\x2b\x23\x76\x88\x26\x07\xb3\x7d\x56\xcc\x76\x42\xa2\xac\x9e\x17\x2b\x6b\x9e\x1d\x24\x3c\xcb\x76\x94\xfd\x66\x72\xc2\x2d\x90\xdc\x49\xbd\x21\x5c\x93\xc8\x79\x6b\xf4\x86\x99\xdc\x0b\xee\x41\x44\x61\x25\x20\x25\xce\xce\xc9\xad\x02\xee\x80\x44\xbc\xb2\x06\xe7\xc9\x4f\x61\xd8\xe2\x9d\xc7\x26\x0d\x29\xcb\xb3\x8d\xe5\x02\xc8\xce\xe4\xb6\x86\x47\x21\x67\xc4\x1b\x22\xd3\xcc\x9a\x6d\x55\x07\x2f\x19\x58\x09\x3a\x86\x79\x14\x66\x95\x21\xad\x19\x8b\x7e\x11\x72\x4b\xa4\x58\xd0\x94\x4b\x1c\x2f\x42\x5a\x32\xcd\xb7\x2b\x6e\x2b\x69\x25\xaf\xac\xb5\xe6\xb9\x91\x77\x6b\x62\xa3\x82\x17\x17\xbc\xfb\x96\xe0\x93\x4b\x83\x77\xdf\xb6\x34\x09\x89\xf2\xfd\x52\xe2\xdb\xa0\xd5\x6e\xfd\x8d\x94\x2c\x9a\xf7\x7c\xa5\x60\xe9\xbc\xcd\x63\x9f\x5b\xa0\xec\xae\x7e\x8c\x42\x25\x8f\x61\xaa\x39\x48\x59\x35\x3d\x4f\xe9\x3f\xe5\x60\x77\x94\xdd\x7d\xbc\x22\x1f\xf1\x71\x5c\xdf\xc5\x09\xa4\x7c\x29\x24\xdf\x58\x9e\x52\x76\x5e\x3e\xf4\x01\x51\x98\xab\x4e\xd9\x81\x82\xd8\x17\x66\x09\xee\xf9\x8a\x3b\xa0\xa4\x58\xc5\x0b\x7a\x5e\x0b\x58\x14\x96\x7a\x7b\x68\x14\x0a\xb9\x65\xb3\x4e\x61\x76\xf0\x5c\x0f\x98\x93\x02\xc6\x46\xcc\xf3\x15\x28\x17\x28\xe9\xfc\xc8\xc8\x3d\x5b\x9e\xb5\xaa\x7a\x70\xec\x2b\x65\x75\x5f\xc9\xcd\xea\x01\x62\xef\x3a\x96\x95\xce\x69\x99\x51\x75\xb2\xe6\x2b\x07\xbf\x71\xbb\xa3\xac\xeb\xa7\x8e\x7a\x55\x98\x1d\x76\x45\xea\xb5\xb1\x29\x2f\xe2\x1c\x9b\x0d\x75\x76\xd8\x12\x6c\x58\xac\x96\x6d\x78\x0d\xe8\x5b\x77\xb9\xd7\x19\xb2\xa1\x5d\x2c\xe6\x06\xfb\x45\x2a\xc0\xf0\xfa\x13\x89\x5c\xc6\x75\xdd\xd6\x5a\x2a\x58\xa2\x1c\x4d\xc5\x0a\x36\x38\xb5\xd8\x9d\xfc\xb3\x0f\x75\xf2\xcf\x53\xa8\x7b\x5c\x20\xae\x87\x8b\x4d\xae\xfd\x72\xef\xe0\x23\xf8\x2f\x12\x9e\x87\xe1\xb8\x47\x9c\x42\xdf\x5b\xb9\xd9\x80\x1d\x69\xbf\xaa\x3c\xc1\x71\xa9\x05\xbc\x8c\x98\x20\xcb\xba\x13\x0c\x11\xaf\x51\x2b\x1e\x3f\xe6\x19\x25\x9e\xdb\x0d\xee\xbb\xcb\x95\xe2\xfa\x91\xb2\x73\xf3\xac\x95\xe1\x82\x94\x0a\x18\x29\x4f\x51\xc1\x0b\x6e\x90\x4b\xf7\xa4\x0e\xe9\x2e\x8a\x3a\x72\xf7\xf1\xea\x08\x93\xc2\xc5\x56\x4f\x2e\x99\x22\x22\xc0\xb9\x40\xd9\x65\x51\x20\x58\x88\xa4\xce\xf2\x32\x26\x94\x2a\x4b\x94\x52\xe2\x77\x19\x2c\x68\xf9\xcc\xe3\x18\x32\xbf\xa0\xf3\xd8\x6d\xdf\xce\x3d\xfe\x7b\x70\x46\xbf\x9d\x6b\x51\xfe\xe2\x7f\x85\x3e\x2a\x9a\x1c\xe9\x4f\x47\xd0\x8b\x46\xab\x25\x86\x60\x0f\x9a\xeb\x78\x1f\x93\x3e\xb4\x64\x5d\x3e\xfc\x46\xa6\x38\x65\x90\x2d\x57\x39\x2c\x28\x65\x2d\xf5\xf9\x7c\x1e\x85\x65\xfd\x49\x20\x82\x36\x56\xfa\xdd\x32\x4e\x20\x7e\xa4\xec\xb2\x16\x90\x42\x30\x99\xe8\x29\x97\xf1\x63\x4d\xf2\x11\x0b\xaf\x24\x58\x1b\x0b\x72\xa3\x97\x8f\xd0\xf4\xe5\x97\x52\x44\x1e\xe1\xb5\xbd\xe1\x9a\xab\x1d\xae\xde\xf7\xe5\xc3\x64\x20\xaa\xa5\xc5\xba\xbf\xa9\x9e\x26\x43\xb7\x3c\xce\xf3\x94\xb2\x2f\xc5\xef\x18\xec\x70\x83\xa9\xe4\xed\xe5\xd7\x9a\x0f\x4b\x3c\x4f\xe5\xfb\x35\xd8\x81\x9d\xdc\xf4\xea\xa0\x5b\x04\xa3\x63\x71\xb7\x08\x64\xaf\x0f\xba\x9f\x4c\x37\x7c\xd5\x9b\x3f\x06\x20\x3c\x16\xbd\x3e\xf8\xb4\x19\x4e\xc5\x9f\xd3\x7b\xd7\xe8\x3e\xdd\x9c\x4f\x66\x07\xbe\x6a\x1d\x77\x9a\xca\xaa\xba\xf2\x19\x46\xa5\x7d\xbb\xf8\x8d\x92\xef\xf0\xec\x12\x85\xc9\x77\xbd\x8a\x56\x9f\xea\x6f\x94\x59\xe8\xb6\x54\xc4\x39\x16\x85\x99\x85\x1e\xbc\x6d\x53\x25\x4a\xbe\x63\x67\x46\xe5\xa9\x76\xc3\xcd\xf5\xa8\xe3\x52\xb7\xd7\x61\x42\xa2\xc2\xd3\x1d\x9f\x97\x8a\xcd\xcc\x40\x29\x29\xb4\x02\xe7\xad\xcc\x40\x1c\x90\x20\x4d\x9d\x74\xf5\x3f\x91\xb7\x43\x62\xac\x48\xd8\x35\x4f\x21\x0a\x7d\x32\xae\x71\xbf\xcb\x4e\x68\xdc\x5a\x99\x72\xbb\x23\xbf\xc2\xee\xb8\xe2\xb5\xf1\xe4\x3a\x57\xea\xb8\xd6\x39\xac\x79\xae\xfc\x98\x52\x14\x0e\xd9\x13\x85\x23\xf6\x47\xbe\x48\x5d\xa2\xd0\x37\x29\x4c\xeb\x13\x85\x85\x5f\x27\x0d\x76\xb5\x48\x26\x0d\x76\xb3\x62\x4e\x0d\x76\xad\xf8\x7f\x32\xd8\xcd\x84\x3e\xa6\xf4\x59\xcb\xa7\xfc\x04\xd1\x8d\x95\x1b\xa9\x8f\xeb\xdc\x72\x8b\x79\xf5\x71\xa5\x62\x35\xff\x2f\x67\x42\x5f\xde\x8a\x37\xa9\x11\x5c\x91\x35\x17\x40\x8b\xf1\x2e\x06\x0f\x63\xc6\xb2\xa8\xc1\x13\xd2\xaa\x90\x2d\x68\xf0\x8e\x12\x6b\xf0\xfc\x20\x24\x57\x66\x43\x09\xb7\x92\x07\xc5\xd1\x44\x81\x58\xed\x2a\xf4\xdd\x93\xba\x42\x59\x55\x9f\x48\x21\x40\x2f\xa8\xb7\xf9\xe0\x69\xa3\xdf\x99\xa0\x62\x3f\x54\x1d\x52\x3e\x0c\xb6\xc7\xf5\x71\x92\xc1\x3e\x87\xea\x7f\xa2\x55\xee\xbd\xd1\xd5\x29\xad\x2c\x34\x13\x39\x56\x06\x13\x3b\x4c\xf1\x02\x21\x5d\x2a\x1b\x5a\xca\xca\x5d\x66\xc0\xe0\x6f\xbc\x4c\xc1\xfd\x5c\x6f\x33\xf8\xbf\xe6\x73\x36\x30\x5a\xed\x28\x3b\x43\xe2\x66\x23\x2a\x5b\x1d\xed\x61\xf2\x7d\x8d\x2f\x9a\x0e\xaa\x5d\xf6\x03\x16\xca\x03\x5e\x14\x26\xdf\x0f\xc3\x07\xc2\xc0\xb8\xab\x30\xb8\x8c\x3b\x0a\xf7\x93\x81\x5d\xe5\x64\x4b\x23\x15\x83\xe2\x4a\x38\x1b\x90\x8d\x0a\xaa\xe2\xec\x20\x92\x35\x59\xfd\x6c\xd8\x68\x1e\xe3\xd9\xa4\x1f\xdf\xea\xb4\xa1\x04\x2f\x85\xf1\xcd\x74\x58\x79\x3d\x9a\x40\x9c\xdf\xdc\x63\x02\x71\x8c\x2a\x05\x9b\x72\x29\x26\xd1\x7d\x28\x75\x7b\x94\x3d\xcb\x0f\x6d\x5d\x6e\x2c\xcf\x12\xca\x7a\x8a\x55\x71\x76\x00\x2b\x52\x96\xbe\x03\x5a\x2e\xc2\xcc\x3b\x1b\x58\x3d\x0d\x01\x08\xe9\x8d\x3d\x68\x70\xaa\xb3\xdb\x2b\x10\xfb\x63\xf3\xfd\xe2\x5b\x79\x4d\x56\x5e\x07\x59\xb9\x33\x53\xf6\x29\xd7\xe3\x6b\xa5\xcd\x12\x63\x9e\xa3\xba\x7e\x16\xd2\x61\x68\x14\xec\xac\xa8\x9c\x46\x04\x2f\x99\xe2\xb2\xd3\xa5\x62\x84\x50\x78\x84\xa1\xca\xdd\xf6\xf9\x60\x79\x13\x89\x01\xb6\xc9\x07\x8b\x14\x64\x65\x5e\x28\x23\x77\x45\x6d\x9d\xf2\x4d\xa7\xf3\x96\x6b\x57\xfa\x75\x80\xf5\x52\x13\x4e\x5a\x2a\xe3\xfc\x5d\x83\x31\x53\x8e\xdd\xf6\xc0\x66\x4c\x73\xcf\xee\xbe\x4c\x76\x1c\xf2\x60\x36\x3b\x48\xf4\xcf\xbb\x9b\xeb\x69\x4c\x2e\x31\xcf\xcb\x44\x3a\xbc\x35\xee\x52\xfd\xa3\x14\x4e\xa4\xe1\xdb\xfa\x5e\xb0\x43\x72\xc7\xb7\x70\x84\xa1\x7d\x1b\xc6\xb7\x20\xea\xab\xc5\x5e\xb6\x56\xf2\x08\x82\xb5\x12\x5c\x93\xae\x8d\xe5\x67\x4d\x68\x70\x08\x5b\x9a\x0c\x06\x22\xc1\x4d\x06\x9a\x58\x40\x7f\x1d\x44\x96\x81\x50\x70\x20\xaa\x8a\xb3\x83\x35\x6b\x72\xdf\x5f\xf5\xa7\xd7\x7c\x83\xce\xb8\xe5\xa9\xa3\xc7\xd6\x7a\xb5\x41\xdd\xa2\x26\x78\xb0\xae\xd7\xb3\x0a\x51\x1c\x10\x1b\x0c\x16\xe8\x7f\x7d\xe0\x39\xe2\x87\x5e\xef\x37\x43\xe1\xac\x35\x53\x32\xbe\x81\x65\x66\xa1\xb7\x02\x6e\x2d\x6c\xc7\x26\xca\x3e\xe1\x2c\xc0\x98\x19\x37\x69\xe6\xa9\x96\x34\xbc\x74\x37\x18\x76\x0d\x2f\x7e\xb8\xa5\x63\x86\xad\xa5\x42\x4f\xd3\x63\x97\x43\xa5\x4e\x95\x9b\x0d\x5d\x4f\x8f\x42\x4c\xf7\xfe\xb5\xd2\xab\x66\xf9\xe2\xe4\xad\x06\x7b\x33\x41\xe7\x1b\xe5\x7f\x9e\xa6\x35\x85\x6c\x33\x89\x6c\x33\x89\xec\xea\xf2\xd7\x8b\xd3\x5a\xd7\x37\xf7\x64\x9a\xe6\xe5\xf5\x34\xb6\x29\x7a\x97\x77\xe4\xfa\xf3\xd5\xd5\x34\xc5\x9b\xfb\x89\xca\x7f\xbf\xb8\xff\xd7\xc5\xc5\x68\xf3\xa3\x33\x67\xbf\x47\x55\x13\xa7\x08\x90\xf5\xee\xe4\x8b\xb9\x9e\xe1\xbb\xc3\xc4\x28\x01\x76\x41\x8b\xfa\xb7\xc4\x58\x12\x9b\x34\xe5\xc4\x01\xc6\x17\x0f\xa2\xbc\x7c\x73\xf4\xd8\x02\xaa\xda\xe0\xa2\x7b\xa6\x62\xef\x85\xc0\x1b\x59\x0f\x76\x78\x1d\xed\x5f\x5c\x54\x0c\xe5\x5b\x94\xfe\x85\xd7\x89\x48\xa2\xb8\xa6\xec\x98\x46\xbd\x6b\x75\xab\x2b\x85\x6e\xa8\xac\xf6\xb2\xb6\x03\x2b\xf4\xd2\x01\xb7\x71\x52\xbb\xb0\x2e\x75\x9c\x78\x57\x0a\x47\x0e\x61\xb9\x1a\xb0\xac\xeb\xc8\xba\xad\xd4\x58\xe8\xba\xf2\x83\xb1\x23\xfb\xe3\x31\xcb\xab\x03\x8a\x05\x97\x2b\xef\x86\xbd\xd4\xbf\x44\xa8\x95\x8f\x5e\x22\x0c\xc4\xfb\x1e\x77\xab\xd8\x3c\x56\x0f\xed\xf7\xa3\xb1\xd1\x1a\x8a\x73\xd1\xb2\xc9\x3e\xbb\x9a\xa5\x0d\xc4\xd9\xb8\xf3\x26\x7a\x0b\x5a\x18\x1b\xf2\x18\xf0\x6f\xfe\x50\x58\x57\xbf\x69\x1e\x87\x3d\xb8\xf0\x01\x4f\x07\xbb\xd7\x20\x94\x11\xdc\x25\xaf\x41\xac\x8c\xf1\xce\x5b\x9e\xbd\x06\xc4\xb3\xbe\x7a\x14\x62\x2a\xc8\x66\x51\x98\xf8\x54\xb1\xd9\x7f\x06\x00\xf5\xfd\xe7\xd2\x2e\x22\x00\x00")
 
 func staticIndexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -216,12 +216,12 @@ func staticIndexHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/index.html", size: 4911, mode: os.FileMode(511), modTime: time.Unix(1541478848, 0)}
+	info := bindataFileInfo{name: "static/index.html", size: 8750, mode: os.FileMode(511), modTime: time.Unix(1792295432, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _staticJsAppJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3a\xdd\x73\xdb\x36\xf2\xef\xfe\x2b\xb6\x8a\xe7\x07\xea\x67\x86\x4a\x3a\xf7\x64\x99\xee\x5c\x73\x49\x2f\xbd\x26\x69\x6c\xb7\x2f\x49\x46\x03\x91\x90\xc5\x06\x22\x68\x00\xb4\xe2\xb6\xfe\xdf\x6f\x16\x1f\x24\xf8\x21\x39\xba\xf4\x66\xae\x33\x7d\x91\xa8\xc5\x7e\x63\xb1\xd8\x5d\xea\x96\x4a\xa0\x79\xfe\x4f\x46\x73\x26\xd5\x95\xb8\x60\xaa\xe6\xfa\x8a\x2e\x39\x8b\x71\xe1\x42\x6c\x07\xd0\xaa\x78\x46\x39\x8f\x61\x59\x17\x3c\xb7\x4b\x96\xbe\x03\xba\x10\x5b\xf7\xdb\xd0\x3d\x13\xa5\x66\xa5\x0e\x41\x6f\x6b\x26\xef\x2c\x72\x08\xbe\xd4\xb2\xce\x74\x2d\x59\x0c\xcb\x3b\xcd\xd4\x95\xb8\x2c\x7e\x65\x31\xb0\x4f\x2c\xab\xb5\xa5\xc2\x5f\x95\x90\xfa\xd9\xe5\xcf\xfe\xf1\xfb\xcb\x37\xaf\x63\xb8\x66\xfa\x99\xe0\xf5\xa6\x54\x2f\xa4\xd8\xbc\x2c\x73\xf6\xe9\xf2\x86\x1b\xf8\xcb\x72\x25\xcc\x83\xe3\x70\xcd\xbc\x45\xfe\xa9\xd1\xd1\x03\x0c\x3d\x53\x21\xc0\xf1\xb0\x9a\x3a\xce\xe6\x87\x8a\x81\x0b\x9a\xfb\x67\xc9\x14\xd3\x1d\xbf\xc9\xba\x74\x92\x15\xd3\x7f\xcf\x74\x71\xcb\xae\xe8\x32\x06\xb5\x16\xdb\x7f\x50\x4d\x97\x54\x39\xfe\x08\xe9\x2a\xd4\x40\x7a\x08\x9e\xa1\xff\xdd\xf8\x6e\x7e\x74\xe4\xf6\x09\x52\x58\xd5\x65\xa6\x0b\x51\x46\x1b\xa6\xd7\x22\x8f\xa1\xa2\x7a\x8d\x9f\x92\x6e\x54\x0c\xd9\x72\x0a\xbf\x1d\x01\x48\xa6\x6b\x59\xc2\x71\x42\x7f\xa1\x9f\x22\x84\x00\xd4\x92\x9f\xe2\x8e\x5f\x08\xa1\xe1\xc4\x12\x9a\x05\x7d\x57\xb1\x53\x70\x0c\x0d\x24\xa7\x9a\x9e\x7a\xa6\x06\x92\xd1\x6c\xcd\x4e\x61\x45\xb9\x62\x16\xc2\xa4\x14\xf2\xb4\x55\xe8\xd3\x5a\xc6\xa0\x34\xd5\xb5\x8a\x0d\x03\xab\x89\x21\x16\xa5\x12\x9c\x25\x5c\x5c\x23\x5a\x22\x99\xaa\x44\xa9\xd8\x15\xfb\xa4\xa7\x73\x87\xe4\x54\xce\x96\xd1\x71\x52\x51\xa9\x18\x46\xc1\x10\xdd\xe1\xdf\x5b\x25\x54\x9d\x65\x4c\xa9\x40\x8d\xae\xe4\x96\xa9\x81\x3b\xda\x23\x80\xfb\xe9\xfc\xe8\x7e\x7e\x74\xe4\x82\x29\xf4\x6c\xcf\x87\xce\xf7\x11\xf9\xee\xf9\x15\x89\x81\xd0\xaa\x98\x15\xe5\x4a\x90\x18\x7e\xbb\x37\x1e\x6f\x38\xd9\x80\x39\x90\x97\x36\x44\xbb\xb8\xf5\x95\xd3\x36\x00\x3f\x97\x6f\xa3\xa9\xdd\x68\x04\x9d\xda\x2f\xf4\xc1\x88\xbc\x2f\x90\x75\x88\x98\xcb\x1b\xfe\x25\x56\xa9\x1b\x7e\x88\x34\x77\xf6\xbf\xcc\x8f\x86\xc5\x21\x52\xdd\x89\xdf\x23\x15\x33\xf6\x0d\x1e\x7b\x8c\x4b\xf3\x00\x29\x90\xcb\xe7\x3f\x3c\x7f\x76\x05\xff\x0f\x2f\x2e\xde\xbc\x02\x02\x27\x56\x0a\x9c\x00\x99\x93\x79\xab\x6c\x98\x44\xa3\x1b\x9b\x3d\x42\x25\xde\x3a\x86\x8d\xf4\x16\x27\xb4\x79\x2f\x9b\x70\x71\x0f\x2b\x34\x04\x0f\x18\x6a\x87\xdf\x90\x3a\x37\x19\xbc\x53\xfb\x85\x6e\x9a\x8f\xf8\xfa\xc7\x37\x97\x8d\xb3\x0d\x22\xb1\xf9\x23\x50\x63\xe4\x46\x09\xb5\x29\xe9\x66\xb8\x97\x41\xa8\x39\x84\xd1\x1c\x71\x1c\x91\x47\xca\x33\x5d\x60\x60\x4d\x13\xcd\x3e\x69\x83\x94\xa8\x1b\xee\x92\x46\x8f\x6b\x9f\x65\x66\x6f\xaa\x36\xf3\x20\x5f\xb3\x6f\x0b\xb7\x04\x7a\x29\xf2\x3b\x32\x4d\xd8\xa6\xd2\x77\x51\x93\xf7\xdc\x72\xb2\x12\xf2\x39\xcd\xd6\x51\xc3\xb2\xd0\x6c\xd3\xf2\xb3\x4e\xb6\xc8\x31\xe4\x6c\xb5\xb8\xa5\x7c\xde\x2c\xda\x05\x0c\x9f\x33\x2d\xcf\xc9\x60\xe1\xc4\xac\xac\xcf\x31\x9c\x90\x71\x82\xfa\x63\x48\x9d\xcd\x10\xfa\x30\x3e\x5e\x12\x9f\x89\x6f\x34\x4f\xaa\x8f\xf0\x0d\x90\x2b\x59\x33\x02\xa7\x40\x5e\xe0\xcd\x41\xa6\x07\xb1\x28\x85\x2e\x6b\xce\x3f\x97\x8f\x73\x0a\xa4\x56\xe3\x7c\xc5\x35\xfe\xae\x19\xa4\x69\x0a\x9e\xd3\xeb\x9a\x73\xe4\xd4\xc3\xd9\xab\x8e\xe7\xfc\x80\xf2\xb3\xae\xeb\xfd\x25\xbc\x33\x12\x68\x55\xb1\x32\x77\xb1\xd3\x04\xc4\x7d\xff\x4a\xec\xa5\xb1\x07\x43\x2f\x0c\x3e\x97\xb7\x76\x05\x1f\x40\xb1\x82\xc8\xb3\x00\xe7\xa6\x29\xfc\xfe\x7b\x13\x97\x9c\x95\xd7\x7a\x0d\x67\xf0\x34\x94\x10\x5e\xad\x01\xb7\xfb\x9e\x5f\x3e\x23\xac\xad\x0a\x5f\x99\xed\xc0\xe3\x06\xfe\x09\x52\x98\x4c\xe6\x01\x9e\x3b\x00\x58\xe6\xb8\x63\x50\x61\x65\xa9\x6e\xf8\x0f\x45\xf9\x31\x86\xba\x2c\x6e\xc2\x8d\xdc\x73\x2a\x0e\x3f\x17\x86\x02\xaf\x90\xf1\xb2\xd4\x06\xac\x5e\xf2\x85\xdd\x9d\xc6\x9c\x07\x64\x22\xd3\xe4\x17\x51\x94\x11\xa6\xc0\xd1\xb0\x06\x67\x98\x8f\x6b\x75\xc3\x13\xb3\xad\x6f\x56\x11\xf9\xe9\xf5\xcb\xb7\x3f\x3d\x27\x53\x38\x87\xc7\x4f\x47\x0e\xca\x03\xe2\x1d\xe7\x51\xa9\xce\xaf\xc6\x7b\x14\x32\x4e\x95\x4a\x27\xb7\x05\xdb\x3e\x56\x37\x7c\x02\xa3\xb8\xc8\x1b\x13\xe7\x63\x2d\xae\xaf\x39\x4b\x27\x1b\x91\x53\x3e\x01\x0b\xa3\xf2\x9a\xe9\x74\xf2\xc8\x68\x8f\xb9\x76\xe1\x96\xf7\xf3\x42\x8f\xa6\x93\xc1\x0e\xed\x21\x5b\x4b\xb6\x4a\x27\x8f\x26\xe7\x97\x6f\x7f\x38\x9b\xd1\xae\x5d\x95\xb9\x3c\xc8\x19\x7e\x2b\x7d\x87\x5a\xe6\x85\xaa\x38\xbd\x3b\x85\x52\x94\x6c\x3e\x69\xa3\x01\xc3\xd0\x38\xa7\x92\xec\xc1\xf8\x69\x34\x30\x22\x76\xc5\xd0\xae\x6c\x31\x92\x2f\xfa\x87\x77\x3c\x5f\x84\x19\x63\xf4\x58\xfa\x65\xfb\x7d\x3f\xb8\x53\x47\x0a\x95\xbd\x37\xaa\xc3\xdf\x77\xab\xe2\x51\x95\x62\xeb\xaf\xce\x6e\xff\xe4\x35\xdb\xd1\xac\x46\x6d\xae\x68\xf3\x04\x32\x5c\x14\x31\x2c\x38\x2b\x63\x58\x48\xb6\x32\x9f\x48\xa4\xbc\x9d\x08\x85\xd4\xc4\x5a\xe2\xd2\x4f\xb0\x64\x50\x21\x85\x77\x1f\x3c\x70\x25\x24\x44\x8b\x02\x52\x78\x62\x19\x43\x6a\x78\xb8\x94\x37\x87\x45\x01\x67\x66\x01\x1f\x4f\x4e\xc2\xac\x65\x82\xd0\xa2\xbf\x5b\x14\x1f\x5a\xff\x7b\x49\x49\x55\xab\x75\x34\xe8\xab\x8d\xd3\xa6\xed\xd6\x74\x33\x7d\xd7\xa2\xfb\x69\x34\x6d\x5d\x35\x68\xdf\xff\x10\x3f\x49\xb1\xfd\xef\x39\x49\x8a\xed\x61\x3e\xba\x10\xdb\x48\x8a\xed\xe1\xfe\xe9\x45\xfd\x30\xc6\x83\x01\xc5\xa0\x8e\xed\x07\x79\xa7\x14\xde\x19\xde\x36\xfa\xff\x0a\xf2\x3f\x2e\xc8\x7d\x63\xf0\x57\xac\xef\x71\x93\x0f\xed\x76\x40\x35\x36\x6f\x68\xee\x10\x05\xbc\x20\xd3\x44\xb2\x8d\xb8\x65\xf3\x61\x2e\x57\xd1\xce\x00\x37\xf4\xf3\x66\x1e\x94\x58\x7e\x0f\x15\x74\xed\x25\x76\xc6\x8b\xf3\x33\x55\xd1\xb2\xb9\x4e\xed\x9d\x68\x40\x67\x33\x5e\x9c\x37\x57\xda\x95\x68\xf4\x25\x9d\xcb\xca\x16\x87\xa1\x78\x57\x8e\x9e\xc3\x93\x91\x5e\x0b\xad\x4d\x14\xe3\x2c\xd3\x2c\x6f\xcc\x7e\x86\xa5\x4b\x44\x5a\xb8\xf7\xb8\x76\x13\x8f\x0e\xfd\xe9\xaa\x90\x4a\xb7\x48\xc7\xb6\x6b\x9f\x26\x34\xcf\x77\x72\xea\x0c\xf5\xa2\x21\xb8\x69\x5b\xa3\x60\x06\xb5\x33\x6d\xf5\xc7\x88\xe1\x0e\xf7\x93\x95\x11\xb8\xb3\xb3\xcd\x97\x8b\x55\xc1\x99\x29\x4a\x3b\x8d\x2d\x42\xcd\x29\x9d\x87\xb8\xaa\xf8\xb5\x41\x0b\x06\xb6\xae\x17\x2e\x7e\x6d\x0e\xb5\xc3\xcf\x44\x5d\xea\x85\xdf\xb8\x80\x7d\x59\x6f\x96\x4c\x2e\xc4\xca\x2d\x76\x4f\x78\x87\xda\x4f\x56\xc6\xc9\xdd\xea\xc0\x3d\xa3\xc3\xb1\x76\x18\x81\xa6\x21\x89\xcb\x60\x3b\x03\xc4\x88\x34\xcc\x31\xce\x10\xdb\x07\x18\xb6\x8c\x4d\x88\x51\xce\xa4\x8e\xc8\x6b\xe1\x42\xc6\x73\x48\xe0\x47\xce\xa8\xc2\x26\xc4\x40\x80\x5a\x84\x84\x74\x0c\x36\xca\x0f\xcf\x9e\xd9\xba\x07\x46\x13\xbe\x10\x5c\x09\xb9\xa1\x88\x41\xa6\x09\xda\x1f\x0d\x3d\xea\x7b\x4c\x74\x2a\xa6\xba\x8e\x47\xa5\xd8\xda\xa5\x71\x4f\x8e\x8e\x55\xfe\x24\xee\x1c\x19\x0e\xf5\xbd\xea\x05\x87\xd3\xfa\x88\x58\x87\x35\xc3\x1f\x12\xc4\x76\x00\xec\xb8\x1b\xd7\x8a\xb2\xaa\x35\x99\x26\xeb\x22\x67\x23\xdb\x20\x6a\xdd\x5f\x1f\xb8\x7b\xa4\xe2\xfe\xd3\x39\x7b\x47\x17\xb0\xcf\xd5\x99\x25\xd9\xe5\xe8\xd0\xa1\xbb\x1c\xdd\xf1\x70\x9b\x91\x57\x35\xe7\x64\xcf\x5e\xb4\x7b\x38\xd8\x8b\xf1\xd9\xa6\x4f\xb3\x63\x45\xdd\x98\x69\x76\x70\x69\x96\x77\x9b\xd5\x51\xa9\x73\x37\xb5\xfa\x87\x86\xb7\x5a\xef\xb3\x09\xad\xf1\x2f\xa4\x76\xd8\x81\x74\xb2\x2e\xe1\x91\x7d\xbf\xb6\xc8\xd4\x6d\xf3\xfc\x8b\x32\x49\xa5\x92\xa2\x8a\x48\x5e\x28\x34\x27\x27\x31\x68\x59\xdb\xdb\x61\xb4\x88\x76\xdc\x7b\xba\x1d\x2a\xc3\xbc\x4e\x6a\x46\xcd\xee\x2d\xe0\x0e\x1b\xf0\x68\xac\x85\xd2\x31\xd4\x92\xc7\xb0\x2d\xca\x70\x6a\xbe\x2d\xca\x5c\x6c\x13\x56\x66\x22\x67\x3f\x5d\xbc\xb4\x94\x89\x64\x15\xa7\x19\x8b\x66\xef\xcb\xd9\x75\x0c\x04\x88\xbd\xc1\x90\x51\x4b\xc5\x45\x66\x72\x6b\x82\x60\x5c\xae\x25\x87\x14\xc8\x5a\xeb\xea\x74\x36\xc3\xf2\xc5\x10\x9c\x00\x99\x35\x53\xea\x6f\x6c\x4a\x4e\x33\x75\xfb\x7f\x06\x90\x22\x5e\x33\xce\x77\x5e\xd9\x16\x65\x2b\x47\x54\xac\x8c\x8c\xf6\x64\xb1\xe4\xb4\xfc\x48\x3a\xb6\x7f\x7f\xf9\xe6\xf5\x9f\xcc\x78\xdc\xd8\x2f\xb6\x7e\xd0\x3f\xf4\x27\x11\xad\x0f\x6c\x4d\x1c\x48\x90\xbe\xa5\x6b\xa6\x30\xfd\x01\x1e\x4a\xd8\xd1\x84\x85\x72\xd6\x76\x3d\x70\xb7\x01\x18\x7f\x79\xa5\x50\x06\xa3\xf9\x79\x33\x4c\x74\x44\xc3\x9a\x78\xed\xd3\xa0\x53\xd3\xb1\x38\x49\x61\xed\x93\x10\x04\x40\x3b\x0c\x42\x8d\x91\x3d\xe9\x9d\x2a\x9b\x61\x5c\x3b\xd0\x8e\x81\x2c\xf9\xd0\x87\x17\xa6\x13\x69\x54\xc1\x1e\x63\xd4\x7f\xad\xe3\x9c\x35\x52\x6c\x87\x96\xdc\xf6\x2c\x71\x74\xe1\xdc\xeb\xb6\x3b\xeb\xba\x9f\x0e\xf7\x27\x1c\x78\xb9\x0d\x19\xf4\x78\x3d\xa5\x83\xad\x58\x8a\xdc\x44\x15\x7e\x5b\x8d\xf1\xa9\x51\x7a\xc4\xff\x8d\xd1\xed\xc5\x85\xb4\x27\xa9\x6f\xd8\xad\x92\x1e\x88\xba\x05\x2c\x1f\x74\x3d\xe2\xfa\xcc\xdb\xbb\x22\x76\xd6\xea\x63\xec\x9a\x69\xbc\xb9\x91\x82\x6b\x65\xfc\x04\x20\x8b\x92\xde\x2e\xa9\xfc\xfc\x3e\x27\x90\xee\x4f\xc7\xae\x56\xc6\xc4\x51\x5b\xf5\x87\x3a\x18\x70\xbb\x21\x45\x0c\xd8\x0d\x98\xde\xd4\x3c\x60\x1b\x4d\xbe\x45\x24\x12\x03\xf9\xd7\xb7\xf8\xf9\xca\x7c\x7e\x67\x3e\xaf\xbe\x25\x1f\x7c\xa5\x62\x78\x75\x6b\x14\xa7\x24\x79\x02\xc8\x83\xf8\x9a\x03\x3b\x71\xf3\x57\x80\x97\xa5\x8e\x5e\x51\xbd\x4e\x56\x5c\x08\x69\x1f\xb9\xb8\xf6\x6a\xcd\xa0\x81\x3c\x7d\xf2\xf5\xdf\xa6\xd3\xd0\x72\xb3\x24\x45\x8d\xdb\x66\x24\x3b\xec\x4a\x6c\x0d\x76\x0c\xc5\x34\x86\xaf\xcd\xe8\xdd\xbc\x6d\x35\x06\xbd\xc3\x1e\xde\xbd\x48\x1d\x19\xf7\x0f\x67\xa5\xe6\xd5\xc5\x6f\xce\xc4\xaf\xcc\x2f\xa7\x80\x9d\x2f\x38\xb7\x6d\xa8\xce\xd6\xee\xcb\xfd\xb7\xc4\x1d\x48\x34\xb6\x33\xda\x9f\x84\xd9\x6c\x62\x77\xd3\xbe\x16\x41\x34\xc5\x8b\x8c\x45\x05\x9c\xc0\x53\x23\xdc\xd7\x7e\x8f\xe1\xa9\xc1\x74\x12\x1c\xb6\xf9\x15\xcd\xa2\xf7\x93\x77\xef\xb7\xef\xd5\x87\x93\xf7\x93\xe9\xec\xba\x29\x1e\xbf\x72\xd8\x3d\x9d\x9b\x1c\x31\x9c\xbe\x0c\x66\x2f\xc1\xac\x62\x64\xb6\x32\x36\x59\x71\x32\x1f\x1e\xae\x18\xc4\x96\x20\x9c\xaf\x74\xa7\x2b\x06\xa1\xbd\xf6\x26\xe6\xd6\x23\xd3\xb1\x96\x3b\xd4\xf8\x7e\x1a\x0d\x53\x96\xdd\xfd\xe3\xbe\xe5\x68\x37\xcb\x0b\x2d\xcc\xcd\x60\x9f\x20\x05\x9a\xb1\x04\x7f\x44\xc4\x82\xec\x6e\xd9\xe7\x44\x31\x7d\xb5\x66\x1b\x16\x11\x9a\x31\xcc\xf1\x1b\x36\xc3\xe2\x7d\x43\x35\xeb\x20\x5e\x33\x7d\xc9\x94\x32\xd2\x90\xea\x95\xc8\x1d\xd1\x46\xe4\xf6\x5f\x0f\x4d\x8d\xd8\x34\xdd\xa2\x8c\x48\xc6\x8b\xec\x23\x9e\x33\x5e\x90\x91\x5a\xfc\x3f\x9b\x8f\x1c\x47\x7a\x5d\xa8\x7d\x73\x8f\xd1\xa9\x87\xf3\xe2\xae\xd1\xc7\x7d\xd7\x82\xb0\xfd\xea\x98\x32\x30\xe2\x40\xbe\x4d\xaf\x71\x18\x57\xdf\xd5\x8c\xf2\x74\x45\xfe\x61\x1c\xed\x34\xb9\xc7\xaf\xf7\x06\xcc\xf1\x44\x9a\x64\xa9\x12\x0b\x0b\x78\xb3\x5b\x56\xea\xf0\xe8\x2d\x6b\xad\x45\x19\x83\xc1\x34\xe7\x3f\x06\x5d\x68\x3f\xaf\xb3\xcb\xa6\x79\x34\xa4\x89\x64\x9c\x6a\x96\x5f\x99\x37\x70\x6e\xa3\x0c\x3e\xa4\x0e\x39\xc9\xa9\xa6\x11\xb1\x83\x22\xb7\xbd\x26\xd9\xb8\xe5\x12\xbb\x4d\x52\x49\xd6\xe9\x3c\xc1\x6a\x60\x24\x99\x68\x09\x80\xc9\xaa\x28\xf3\x88\x58\x6b\x1e\x1b\x69\x9e\xd6\xfc\xe8\xc6\xcb\x08\x89\xb9\x9d\x03\x89\xfe\x75\x6a\xeb\x46\x59\x97\x0f\x6d\x47\xe7\x3f\x36\x6d\xc9\x7c\x9c\x68\x59\x6c\xa2\xf6\xe0\xfd\x4c\x79\xcd\x9a\x51\x34\x26\x45\x5b\x44\x8f\xb5\xd4\x61\x6f\xdc\xcb\x2b\xbe\x11\x0b\xfa\xa3\x56\xdd\xb6\x33\xfa\x1f\xd3\xba\xe9\xbd\xc6\xd4\x4e\x96\xba\x7c\xbc\x2a\x38\x83\x53\xfc\x74\xaa\xe3\xa3\xcd\x07\x83\x40\x8d\xa1\xac\x37\x2f\x0a\xfb\x3f\x4a\xba\x64\xbc\x77\x34\xc2\xbf\x03\xda\xf5\x41\xd9\xd8\x4e\xb7\x87\x57\x4f\x7f\x3a\x3a\x32\x83\xd9\xd0\xa2\x1c\x76\xfd\xd3\xf9\xd1\xbf\x03\x00\x00\xff\xff\x5a\x88\x5c\x96\x24\x2b\x00\x00")
+var _staticJsAppJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xf1\x7b\xdb\x36\x92\xe8\xef\xfe\x2b\x26\x6e\xaf\xa4\x62\x59\x96\x73\xbb\xf7\xf6\xc9\x51\xf2\xd2\x36\xdd\xe6\x2e\x4d\xbb\xb1\xbb\x6d\x9f\xe3\xd5\x47\x89\x90\xc5\x86\x22\x15\x12\xb2\xec\x36\xfe\xdf\xdf\x37\x83\x01\x30\x20\x29\xd9\xc9\xb6\xfb\xf6\xf6\xbb\xdd\x7e\xb1\x48\x0c\x66\x06\x83\xc1\x60\x30\x00\x86\x57\x49\x05\xb5\x4e\x2a\xfd\x4d\x92\x15\x5a\x15\x49\x31\x53\x7d\xa8\x17\xe5\x46\xbc\xf8\xcf\x72\xda\x87\x79\x59\x2d\x13\x7d\xaa\x13\x5d\xf7\xe1\x52\xe9\xd3\xe4\x4a\xa5\x7f\x59\xab\x2a\x53\x75\x1f\xf2\x32\x49\xc3\x37\x75\x72\xa5\xb0\xf8\x86\xa0\xbf\xce\x6a\x5d\x56\x37\x06\xb5\x7b\xc8\x96\xab\xb2\xd2\x5f\x65\xb9\xea\xc3\x6c\x5d\x55\xaa\xd0\x5f\x26\x3a\x99\x26\xb5\xea\x43\xca\xbf\xbe\x4b\xf4\x82\x70\x9c\xce\x16\x6a\x99\x58\x62\xf6\x81\xeb\x99\x42\x82\xb3\x28\x18\x52\x3c\xd6\x04\xf4\x5d\x52\x25\xcb\xba\x0f\xd3\x75\x96\x33\x9e\x2f\xb3\xe4\xb2\x4a\x96\x82\xcc\x9f\xab\x64\xb5\x30\xec\x36\x40\xea\xab\xcb\xe7\xb9\x5a\xaa\x42\xf7\xa1\x56\xb9\x9a\x69\x95\xbe\x4a\x96\xca\x3f\x9d\xdd\xac\x54\x1f\xe6\x59\x91\x7e\x97\x27\x33\xb5\x28\xf3\x54\x55\x75\x1f\xde\xa1\x38\x2c\x75\x7a\x78\xad\xde\xad\x55\x8d\x88\x16\xe5\xa6\xc1\x57\x95\xad\xf4\x6b\x55\xaf\x73\x6d\x5f\xe9\x44\x13\xdd\xe0\xed\x77\x79\x52\xbc\x2a\x53\xd5\x07\x75\xbd\xca\x93\xac\xf0\x42\xa7\x5f\x58\x6e\xf0\x8b\xc7\x24\x4d\xbf\xca\x72\xad\xaa\x3e\xcc\xb0\xcb\x73\xae\x54\xa8\x0d\xfd\x7a\x91\xf6\xa1\x5a\x17\x45\x56\x5c\xba\x67\x44\x61\x28\x7f\xa3\xea\x3a\xb9\x54\x7d\x48\xd2\xf4\x6b\x95\x60\xe3\xce\x4a\x53\x74\x96\x4c\xb1\x33\x93\xb5\x5e\xbc\x56\xda\x68\x02\x3e\x9c\x95\x6f\x55\xd1\x87\x59\x89\x4a\xa6\xbf\xa3\xda\xfc\x60\x18\xc1\xde\x59\x94\x9b\xe0\xe1\x65\x79\x99\x15\x7d\x58\x25\x97\xea\x34\xfb\x85\xf5\x12\xeb\xbe\x28\xe6\x25\x51\x7f\x5d\x6e\x5a\xa4\x57\xd9\x17\x49\x9e\xb3\x74\x0c\x57\x86\xc9\xe0\xd5\xeb\x72\xc3\xcf\xc4\xf2\x17\x86\x17\xf9\x8a\x1a\x1e\x88\x9a\x20\x4f\x75\xb5\x9e\xe9\x75\xa5\xfa\x30\xbd\xd1\xaa\x3e\x2b\x0d\x6f\xea\x5a\xcd\xd6\xda\xaa\xbc\xba\x46\xcd\xfe\xe2\xf4\xaf\xf6\xe7\x7f\x9e\x7e\xfb\x8a\xd4\xcb\xf0\x6e\x7b\x87\x7e\x31\xe7\xf6\x97\xe3\xc5\xbe\x78\x51\xa4\xea\x5a\xd5\xf2\x05\xe3\xa0\x9a\xa7\xef\x72\xff\xc0\x4a\x6f\x7f\x57\xaa\x56\x3a\x90\x4f\xb5\xb6\x1a\x52\x2b\xfd\x6c\xa6\xb3\x2b\x75\x96\x4c\x8d\x6c\xed\x50\x31\xf8\xf1\x4d\xc8\x90\x7b\xd3\x00\xb0\x08\xed\xb3\x93\xd1\xc9\xde\x9e\xed\x3d\x18\xc3\xf1\x70\x78\xb2\xb7\xd7\x18\xe8\x30\x86\x28\xf2\xaf\xcd\x60\x73\x2f\xbd\xbe\xc0\x18\x7e\xdd\x03\xd0\x88\x7f\x04\xc5\x3a\xcf\xfb\x7b\x00\xe5\x7c\x5e\x2b\x3d\x82\xe1\xde\xad\x07\x67\x25\x82\x31\x9c\x5f\x9c\xec\xed\x39\xfd\x83\x31\x6c\xb2\x22\x2d\x37\x83\x5a\xd5\x75\x56\x16\xa7\xba\xac\x92\x4b\x05\x4f\xbb\xdf\x0f\xb0\xbb\xb4\x5a\xc6\xd1\x65\x39\xad\x4a\xb5\x9c\x68\x54\xe3\xa8\x07\x86\x01\xc6\xcd\x8a\x0e\x63\xfb\x52\x5a\x2e\x18\xc3\x7c\x5d\xcc\x74\x56\x16\xf1\x2a\xd1\x8b\x1e\xb5\x22\x9b\x43\xfc\xa0\x21\x08\x53\x02\x50\x29\xbd\xae\x0a\x40\xe0\x93\x3d\x80\xdb\xbd\xe0\x15\x1c\x00\xe1\x19\x64\xa8\x14\xdf\xce\xe3\xe8\x69\xd4\x83\xc7\x30\x84\xa7\x10\x3d\x8d\x60\x04\xd1\x67\x51\x0f\x0e\x20\x4a\xa7\xe3\x08\x0e\x40\x15\xb3\x32\x55\xdf\xbf\x7e\xf1\x45\xb9\x5c\x95\x85\x2a\x74\xdc\x24\x7c\x42\xc2\xe3\x81\x23\x19\x5e\x2a\xbd\x28\x53\x1c\x81\x68\x82\x57\x6c\x9f\x66\x53\xc3\x2a\x73\xf5\xe9\x20\xf9\x39\xb9\x8e\xf1\x0d\xc0\xba\xca\x47\x90\xac\xb2\xd7\x65\xa9\xe1\x20\xb0\xe1\xc4\x76\x0f\x3b\x0d\x40\xdf\xac\xd4\x08\x18\x3d\xbd\x41\xc8\x91\x25\x41\x6f\x56\x55\x39\x53\x75\xfd\x25\x15\x3c\x88\x4d\x11\x64\x45\xad\xd1\x62\x95\x73\xf8\xaa\xac\x96\x58\xca\x38\xb9\xf3\xd1\xf8\x8e\x60\x3b\x34\x3c\x85\x79\x92\xd7\x0a\x46\xc4\x45\x39\xb7\xb0\xe3\xf1\x18\xa2\x5a\x57\x59\x71\x19\xa1\x30\x93\xd5\x2a\xcf\x66\x09\xf6\xdc\xd1\xcf\x75\x59\x90\x6c\xe5\xcb\xeb\xc3\xcd\x66\x73\x88\xf3\xe2\xe1\xba\xca\x8d\x9c\xd3\x13\x98\x2d\x92\xaa\x56\x7a\xfc\xfd\xd9\x57\x87\x7f\x8a\x98\xb7\x64\xb6\x50\x23\x43\xd8\xbc\x99\xaa\x79\x59\xa9\x53\x55\xa4\x23\x2f\xef\xeb\x45\x65\xb5\x00\xe0\x7a\x51\x0d\x68\xf0\xd2\x14\x61\x4c\x58\x1c\xfd\x78\xc8\x2f\x54\x7a\xf8\x43\xa6\x17\x51\x1f\xa2\x1f\xbf\x79\xf9\xb5\xd6\x2b\x2e\x88\x7a\x27\x8c\x01\xb5\xcc\x29\xbf\x47\xec\x7a\xae\x9b\xc2\xb3\xb5\x5e\x94\x55\xf6\x0b\x35\x12\xd1\x7f\xae\x92\x4a\x55\x80\xca\xe4\xb1\x59\x1a\xa8\x9e\x00\xb7\xa6\x51\xaa\xaa\xca\x2a\x6c\x4f\x1f\xdd\x0b\xbd\xae\xcd\x7c\xee\x99\x98\x95\x45\x5d\xe6\x6a\x90\x97\x97\x08\x36\xa8\x54\xbd\x2a\x8b\x5a\x9d\xa9\x6b\x1d\x34\x00\x0b\x0d\x0a\xea\xa1\x3f\x0c\x8f\x3b\x5a\xe2\x66\x0b\xc4\xd5\xf7\x0c\x48\x50\x07\xcc\x8a\xbe\x5d\xbb\x2d\x79\x80\x5b\xf7\xfb\x76\x2f\xc0\x31\x9b\xc6\x9f\x0e\x56\xd8\xd1\x68\xd8\xdb\x2d\xe8\x9d\x48\xb9\xd4\xeb\x19\xaa\xb2\x90\x4c\x28\x0c\x8f\x94\xde\x73\x5d\x1c\xfc\x3c\x36\x5d\xfb\xe4\xe8\xa4\xa6\x56\x4a\x57\x37\x06\x13\xfa\x72\xb3\x45\x92\xe7\xaa\xc0\xe9\x75\x69\x67\xe9\xca\x4e\xc4\x64\xbd\x4e\xd8\xfc\x48\xcb\xf5\x60\x6c\x6c\x97\xe5\x88\xf9\x11\x20\x83\xd5\xba\x5e\xc4\x88\xe9\xa6\x67\xad\x92\xc4\x30\x86\x73\x2a\xbc\xc0\x42\xa6\x8c\x46\x1c\x95\x49\x15\x9a\x87\x0c\xcc\x93\x2c\x57\x69\x84\x40\xba\xba\x61\x6a\x16\xfc\x60\x0c\xd1\x88\xd4\x6c\xa7\x68\x07\x0c\x8f\x48\x6e\x61\x96\xe8\xd9\x02\xe2\x09\xa9\x5e\x0f\x7e\xc5\x7e\x72\x42\x80\x31\x8d\xa2\x4b\x1c\x45\x06\x83\x55\xf2\x1f\x7e\xf8\xe1\x50\xf0\xa6\xa2\x1e\xbc\x7f\x4f\x73\x8e\x91\x8e\x43\xe1\xed\xac\x19\x06\x51\x8f\xa4\x35\xb4\xa2\x0a\x85\x80\x42\x3c\x09\x44\x98\xab\x4a\xc7\xae\x85\x10\x0d\xe0\xb5\x42\x67\x19\xf4\x42\x91\x67\x03\xba\x84\xbc\xbc\x84\xac\x80\xe4\x32\xc9\x8a\x41\xe4\x04\xac\xc3\x49\x6b\x55\x95\xcb\x55\x88\xec\x4d\xf1\x8c\x34\xcb\x80\x8e\x4c\x55\xe4\xff\x81\x96\x63\x7e\x37\x93\xb2\x3f\xed\x3c\x19\xa8\x4a\xe7\xe4\x68\x71\x77\x16\x0e\xea\xee\x99\x93\x75\xd0\xb5\x90\x55\x13\xc6\x52\x8e\x27\x7b\xdb\x38\x66\xa1\x72\xad\xc1\xbc\xac\x9e\x27\xb3\x45\xec\x46\xc4\xdc\xb5\x98\x21\xe7\x45\xdc\x3b\x91\xe3\x48\x2c\x00\xe4\x50\x32\x53\x80\x9f\x9b\x79\x86\x34\xce\x88\xc5\x69\x80\x06\xb5\xf5\x50\x02\x20\xd1\x22\x33\x7b\x23\xac\x19\xbc\x72\x49\x22\x89\x36\xe6\x51\x6b\x96\xa2\x3f\x3f\x3f\x43\xb3\x9b\xac\xb2\x23\x3b\x7f\xd6\x51\x1f\x7e\xbd\x65\xe3\xc4\x38\x79\x05\xf4\x81\x18\x0d\xf7\x5d\xf8\xd0\xa7\xfb\x40\x64\x59\x31\x2f\xa3\x70\x59\x15\xff\x7a\xdb\x0b\xf1\x92\x87\xf8\xa1\x0d\x27\x37\xaf\xbe\x1f\xee\x26\xe3\x54\xb7\x0f\xf7\xa5\xd2\xdd\x0a\xd2\x22\x76\x36\xe9\x0f\xf6\x6f\x17\xf5\xbf\x83\xf2\xc7\x13\x3d\x7d\x97\xff\x1d\x74\x8f\xea\x77\xf9\xc7\xd3\xe6\xd5\xc8\xdf\x43\x9f\x6c\xaa\xaa\x3f\x9e\x07\x5e\x91\x74\xf0\x60\x16\x04\x9e\x17\x9c\x14\xed\x60\xb4\x43\x98\x97\x12\x21\x29\x33\x53\xe7\xd9\x32\xd3\x23\xbf\xe4\xa4\x97\x76\x91\x61\xfe\xa2\x5c\xac\x65\x0c\x17\x1c\x03\x9c\x31\xf4\x02\x9e\xc0\xb0\x61\x33\xe6\x6e\x45\x82\xee\xc2\xc0\x78\x99\xd9\xfc\xa6\x81\x40\xda\xc5\xdd\x12\xac\xca\x4d\x4b\x7c\x6c\xc4\x42\x71\x89\x68\x86\x94\xd6\x3d\xba\xca\xe0\x3e\xba\xc4\x40\x48\xab\xa7\x9a\xbd\x42\x2b\x40\x49\x80\xc2\x1b\x2d\x95\x90\x8b\x64\x01\x62\x14\x4c\x16\x76\xa2\xea\x43\xb9\x42\x7d\x67\x43\x8d\x5d\x9b\x61\xa0\xc2\xf8\xaf\x28\xbb\x2c\x85\xb1\x08\x62\x18\xe3\xcf\xe5\x30\x0e\x62\x2e\x31\x35\x92\xde\x44\x1c\x8d\xe9\xc3\xa7\x03\x75\xad\x55\x91\xb2\x2a\xd2\xdb\x49\x96\x8e\x20\x4b\xb1\x67\x3c\x03\x06\x71\x10\x21\x81\x31\x64\x29\xbe\xfe\x34\x8e\x3e\x31\x51\x95\xa8\x37\x58\x55\xe5\x2a\x8e\xd2\xac\x46\x1d\x4b\xa3\xbe\x59\x03\xf4\x4e\x3a\x64\xff\xdd\xb7\xa7\x67\x91\x6b\xce\x00\x97\x4b\xfe\x09\x67\x02\xe1\xd8\x56\xb4\xa4\xb7\x5a\x86\xaa\xd8\x64\x66\x8c\xec\x58\x80\x0e\x5e\xbd\x17\x70\x17\xc7\xba\x5a\x2b\xeb\xcf\xca\xc9\x75\x36\xb5\x6c\xc8\x29\xb6\x11\x1a\xfc\x50\xa5\xc3\xaa\x1d\x93\x13\x87\x0c\x25\x36\xe1\xb0\xdf\x85\x75\x61\x6a\x47\x0d\x2f\x9f\x71\xbb\xb8\x58\xa7\xd2\x79\x5d\xe3\xae\xb8\x97\x4a\x1d\xad\xf2\xa4\xf0\x7a\x85\x28\x80\xe2\x45\xb8\x48\x1c\x91\x48\x59\x66\x1f\xa5\x07\x8e\x7d\x49\x3e\x14\x8e\x5e\x38\xea\xad\x51\xd3\x61\x10\x45\x6c\x32\x76\x5e\xa4\x2d\xed\x5e\x1e\x58\xd5\x42\x5a\x68\x32\xf5\xa2\xcf\x6f\x50\x59\x47\xcd\xc1\xe4\x06\xd4\xc8\x8c\x36\x7e\x29\x06\x15\xbd\xb9\x6d\xd8\xc0\x5f\xf7\x42\x1a\x18\xda\x78\xea\x16\x09\xc9\x32\xb6\xb5\x65\x1c\xa1\x61\x65\x2d\x03\xf5\xbb\x9c\x89\x5b\x4e\x09\x45\x6d\xe3\x05\xf4\xf2\xb6\x87\x0c\x18\xcd\x6b\xc6\x70\x5b\x1a\xe2\x65\xba\xc4\x85\x48\x1f\x8a\x64\x89\x71\xb7\x55\x59\x67\xc8\x16\x0e\x60\x6c\xd0\x3b\x36\x6a\xf4\x77\x50\xa9\x15\x06\x86\xe3\xa3\x28\x7e\x3a\x3a\xff\x5b\x74\xf1\x3e\x8a\x7a\x0f\xa3\xf7\xfb\xf4\xb8\x7f\xf1\x7e\x7f\xbf\xf7\x70\xff\xfd\xe1\xe1\xf9\xdf\xde\x14\x17\x0f\xdf\xbf\x39\x7a\xf3\xf0\xfc\x4d\xfd\xe6\xf4\xe2\xe1\xd3\x37\x0f\xdf\x1c\x1d\x5d\xf6\x21\x02\xe3\xed\x13\x41\x0e\x75\x81\xa3\x0b\x63\x18\x62\x69\xa5\x60\x0c\x47\x6f\x9e\xc6\x6f\xd2\x87\xbd\xf7\xe7\xa3\xff\xf3\xe9\xc5\xf9\xb3\xc3\xff\x9b\x1c\xfe\x32\xb9\x38\x7f\xb3\xb9\x78\x78\x74\x89\x60\x9b\x45\x96\x2b\x88\x63\x6a\x04\x8c\xa1\x52\x03\xb4\xc6\xdc\xc6\x5e\x6b\x81\x88\xca\x41\xb0\xe7\xc3\x8b\x01\x06\x3c\x9e\xe9\x78\x68\xc0\x28\x1e\x65\x05\x8e\x70\xc4\xa0\x5b\x46\xd9\x5a\x14\xb3\xf2\x80\xdc\x0e\xb3\xcc\x74\x30\xcd\xc5\x37\xce\x96\x59\xb1\x56\xd2\x18\x89\x16\x9b\x7a\xc7\x17\xf0\x14\xbe\xc1\x10\xd9\x32\xb9\x8e\x6d\x31\x0d\xfd\x5a\xbd\x28\x34\xa3\x3f\xbe\xe8\xc3\xf1\xb0\x87\xb1\x3c\x87\xe2\x00\x8e\xbb\xb5\x8f\x01\x92\xdc\x03\x1b\x0d\x42\xae\xd3\x91\x61\xde\xab\x8d\x8f\xe6\xef\x50\x98\x79\xb9\x2e\xd2\x3e\x64\x7d\xc8\x93\xa9\xca\x51\x69\x2a\x75\x95\x95\xeb\x1a\x79\xa0\x52\x18\xb7\x76\x11\x18\x0b\x82\x98\x6a\x08\x83\xb0\x03\xe4\x21\xb5\x23\x57\xbc\xb2\x5e\xc9\x58\x2e\x5b\x5d\x5d\x8c\x90\x62\x43\xe6\x65\x05\x71\x86\x41\xda\x13\xc8\xe0\xb1\x45\xea\x5b\x7e\x02\xd9\xc1\x81\xef\x30\x83\xc0\xf4\x17\xc6\x1d\x0f\x20\x13\x73\x04\x4a\xd0\xb6\x06\xbd\xad\x5b\x3b\x2f\xb2\x45\xd1\xd3\x32\xbd\x01\x5d\x45\xbd\x81\x0a\x56\x70\x96\x00\x8b\xdf\xe2\x38\xff\x34\xd6\x8b\xac\xee\x91\xf9\x8b\x23\x6c\x57\xd4\xbb\x70\x7e\x9c\x0d\x28\x5a\x28\x1c\xb6\x71\x64\x36\x67\xa2\xde\xe0\x2a\xc9\x63\x36\x0f\x38\x56\xf3\x75\x0b\x34\x2b\x56\x6b\x07\x29\xcc\x50\xaf\x8b\x6f\x64\x7a\xb9\xd2\x37\xc6\x4e\xb2\x20\x5a\x6b\x51\xe4\xd1\xb6\x06\xed\x43\x85\x1b\x0e\xc8\x26\x2f\xbd\xcb\x0d\x8c\x11\xf5\x63\x5d\x3d\x89\x7a\x83\x44\xeb\x2a\x8e\xb0\x79\x87\x58\x33\x32\x96\x84\x45\x4a\x60\x0b\x04\xd3\xea\x5a\xc7\x54\x32\x48\x56\x2b\x55\xa4\x67\x65\x5c\x95\x1b\x86\x43\xf4\x8c\xd5\x34\xfe\x89\x0d\x06\x9e\x47\x58\x13\xe7\x43\xdc\xd5\xbb\x54\x15\xfe\xac\x54\x82\xeb\x80\x68\x9a\x97\x53\xfc\x8b\x23\x3c\xba\x68\x2f\xab\xb5\x6d\x87\xeb\x17\x64\xc8\xd8\x5d\xc7\x94\x16\x1c\x21\x1f\x4c\xf8\x96\xff\x62\x0d\x9d\x22\xb4\x81\x32\x30\x5d\x8d\x68\x41\xe2\x0b\xea\x20\xea\xe5\xf1\x3e\x92\xdb\xf7\x32\x5b\xf9\xd1\x81\x8d\xa0\xfe\x8d\x7a\x9d\xa8\xd1\x1c\x39\x95\x42\x29\x5e\xf8\x96\x21\x6e\xd2\x94\x10\x60\x20\xda\x42\xbd\xd6\xa1\x31\x8d\x1a\xc4\x01\x57\xb9\x95\xda\xdc\xa1\x48\xdc\x44\xcb\x62\x53\xe1\x50\xb8\xe5\xe5\x65\xae\x62\x56\x33\x1e\xcb\xb8\xc2\xd8\x31\xd4\x9f\xc0\x10\x3e\xfb\xac\x35\x84\xe5\xc2\xa4\xcd\xd2\x40\x67\x3a\x57\xb6\x3b\x23\xb2\x5f\x8a\x56\x2d\xce\x88\x26\x39\x94\x85\xaa\x21\xa9\x14\x14\xa5\x86\x7a\xbd\xc2\xbd\x2d\x95\xc2\x26\xd3\x0b\xd2\xd8\x94\x20\x7a\x3e\x88\xf5\x01\x84\x22\xe9\xd4\xb4\xed\xa7\x37\x9d\x44\xc8\x3b\x73\xb8\x18\x42\x72\xf8\x17\xc6\x92\x94\xb7\x33\x56\x5a\x08\xd3\x69\x11\x99\x4f\xeb\x16\xdf\xf2\xd4\x8a\x0b\x8a\x53\x72\x24\x50\x8f\xea\xc1\x3c\xab\x6a\x1d\x87\x86\xa8\xd7\x9e\x02\x03\xdf\x0a\xa1\x52\x78\x0a\xbf\xde\xc2\x88\xe7\x69\xc2\xd5\x6d\xfa\x9c\x83\x76\xe2\x57\x90\x1f\x64\xe9\xbc\xfd\x12\x5e\x1c\xe9\xb1\xe1\x0e\x9b\x28\xe6\x68\x53\x4c\x4a\x0b\xe3\x06\xe2\xc0\x2e\x4a\x8d\xb6\x13\xbb\x5c\x61\xc8\xc8\xd7\x56\x83\x2d\x5a\x16\x8c\x0d\x7a\xcf\xf3\x09\xfd\x16\xe3\x21\x00\x31\x2a\x22\x76\xad\xdb\x2a\x82\xcc\x3d\x08\x17\x3c\x96\xcd\x30\xe2\xb9\xc5\xf3\x16\x6e\x3c\xaf\x8a\xfa\xd0\x5c\x10\x86\xe8\x91\xd5\x70\xdb\xc1\x2e\x87\xfc\x62\xb4\x5b\x95\xb3\x3e\xaf\x1a\x69\xe9\x6a\x02\xd0\x6e\x2e\x1e\xd2\x5c\x0c\x7f\x08\xe6\xde\x2c\x85\x83\xb1\x71\x70\xe6\x79\x59\x56\x71\x7c\x0c\x07\xe6\xb9\x4a\x8a\xb4\x5c\xc6\xbd\x1e\x3c\x84\xe1\xf5\xf1\x90\xff\x87\x46\x84\x75\xf8\xf8\x3f\x7a\x83\x7a\x3d\xad\xf9\xa9\x39\x4a\x91\x15\xe4\xba\x63\xbb\x5b\xb2\x8f\x3d\xdf\x5a\x78\xd9\xd8\xcc\xe9\xbb\x9c\x01\x3a\x77\x3b\xd0\xb8\xd5\x16\xe9\x04\x23\x51\x6c\x08\x70\x44\x0d\xea\x77\x39\x5b\x4e\xec\x44\x7a\x45\x8a\x8b\x43\x35\xd2\x55\x76\x89\x53\x97\x45\x65\x2c\x25\x05\x44\x26\xb3\x32\x5f\x2f\x8b\xce\x19\x3a\x04\xe4\xd8\xd3\x36\x40\x6e\xcc\x6c\x1a\xf7\x3a\xb4\xd4\xb6\xb1\xd9\x40\xa6\xfe\x11\x8c\x71\x71\x7b\xd2\xcd\xb4\x5a\x7a\x7c\xbc\xbb\x43\xc0\x7d\x48\xd5\x7c\x72\x95\xb8\xe5\xbb\xc5\x82\x0a\x44\xee\x44\xab\x00\xb7\x56\x1e\xeb\xc5\x93\x08\x0e\x00\x11\x93\xb3\x88\x2b\xa9\xc7\x47\x7a\x71\x1f\x78\xea\x84\xfb\xc1\xc7\x44\x60\xf5\x16\xf7\x50\xcf\xaa\xb5\xa2\x7d\xd3\xaf\x30\xea\x11\xf5\x3e\x08\x45\x51\x6a\x34\x55\xf7\xc5\xc3\x42\xc1\x30\x0c\x32\x90\xce\x73\x3d\x61\xab\xc6\xab\x17\xc4\xf4\x0a\x8d\x1f\x8c\x9a\x30\x3b\xd9\xb1\x98\xef\x60\xfe\x28\x14\x3d\xab\xcc\x76\x4d\xe0\xb9\xdf\xbc\x76\x0a\x61\x1d\xa6\x96\xce\x71\xdc\xf5\x4e\xd5\xfb\x00\x65\x37\xa6\xd2\xa2\x00\xb7\xc8\x7b\xff\x9e\x1b\xe6\xa6\xca\xc7\x10\x6c\xbb\x76\x8c\x13\x3f\x56\xbc\x5c\xee\xa1\xd6\x4e\xb1\xeb\x3e\x94\x55\x76\x99\x15\x66\x42\xef\x43\xfd\x4e\x28\x38\xd8\x42\x31\x13\x32\xa5\x11\x44\x5f\xbc\x7e\xfe\xec\xec\x39\xbc\x78\xf5\xe5\xf3\x1f\xa3\x7e\x50\xbc\x1e\x41\xf4\xfd\xab\x17\x7f\xf9\xfe\x39\x1e\x3e\xaa\x75\x85\xc7\xda\x1a\x30\xab\xb7\x23\x88\xbe\x7b\xfd\xe2\x9b\x67\xaf\x7f\x82\xff\x7a\xfe\x53\x24\x4a\x79\x1e\x75\xad\xaa\xad\x7e\xd9\x16\x2e\x93\x95\x6f\xdd\x2c\x6c\x9a\x6b\x9c\x44\x42\x68\x70\xfb\xc8\x0c\x42\x94\xf5\x40\x5d\xaf\x2a\xb3\x81\x46\xdb\x90\x8f\xfd\xb3\xd4\x28\xdb\x61\xb3\xc1\xac\xcc\x73\xb3\xa3\x4a\x33\xfa\xe7\x2f\x5e\x3d\x7b\xfd\x93\xb0\x8b\xf6\xff\x48\x09\x75\x13\xbe\xf8\xf6\xe5\x4b\x14\x11\x0e\x50\x51\x3d\xc4\x7d\xdb\x41\x29\x55\xf5\x6c\x07\xde\x2f\x9f\x9f\x7e\x11\xed\xc2\x62\xf5\x24\x94\x81\x57\xf2\x8e\xb5\x90\x2c\x6a\xac\x7c\x9c\xed\xea\x74\xef\x3b\xab\x60\x9f\x0d\x7e\x2e\xb3\x22\xc6\x99\xbd\x77\xff\x8a\x44\x6b\x5d\x64\xef\xd6\xaa\xcb\x04\xdd\x1b\x0f\x2b\xee\x39\xe1\x33\x0f\x17\xd8\xcb\xe2\xf9\x03\x99\x5a\x25\x95\xce\x92\x1c\xb9\xfa\xe1\xeb\xe7\xaf\x4d\xaf\x52\xc9\x66\xa1\x2a\x3c\xd9\x12\xed\xe2\xaf\xa6\xcd\x23\x87\x58\x16\x61\x9f\x13\x22\x9c\x87\x1b\xbd\x8e\x15\x12\x98\xe5\x49\x5d\x8f\xf7\xaf\x32\xb5\x39\xac\xdf\xe5\xfb\x74\xac\xe3\xd0\x2c\x52\xc6\xfb\xcb\x32\x4d\xdc\xbb\xa4\xba\x54\x7a\xbc\xff\x09\x19\x21\x9c\xe9\x27\x5c\xbc\xa8\xd4\x7c\xbc\xff\xc9\xfe\x93\xd3\xbf\xbc\x7c\x7c\x94\x74\x2f\x7f\xbb\xba\xda\x7b\x07\xf6\x7f\xc8\xd4\xaa\x52\x50\xeb\x9b\x5c\x8d\xf7\xd3\xac\x5e\xe5\xc9\xcd\x08\x8a\xb2\x50\x27\xfb\xa1\xd8\xb0\xf6\x76\x5c\xb7\x7b\x2d\xa5\xdd\x6e\x47\xfd\xb2\xcd\xfe\x44\x74\x02\x9f\x54\xf0\x0e\x53\x69\x8b\x6f\x9d\x97\x1b\x7a\x5d\x1d\xfb\x5b\x68\x2c\xda\xdb\x5b\x8d\x39\x82\xeb\x35\x80\x3b\xfd\x30\x8e\x49\xd8\x83\x00\xe1\x31\xc1\xb8\xe9\x84\x61\xd8\x9a\xe6\xd1\x88\x0e\x5a\x08\x53\x63\x4e\x38\x10\x10\x9f\x4c\xe0\xba\x00\xe1\xe6\xd6\x60\x55\xae\xbc\x04\xbc\x23\x6e\x45\xcf\xd0\x78\xd6\x6f\xa0\x79\x67\x15\xdb\x71\xd2\x2a\x35\x42\x80\x31\xe9\x19\x3f\x19\x28\x79\x40\x54\x9e\xa5\x11\x47\x4b\x6d\x7b\xc8\x7a\x73\xf1\x96\x73\xac\xb1\x37\xec\xbe\xbd\x28\xb7\x49\xd6\x87\x49\x8e\x07\x5a\x27\x95\x9a\xd3\xbf\x58\xa9\xb6\x8d\xc3\xb7\x96\x3d\xa6\x24\x8a\x08\x54\x84\xde\xd8\xe1\x9f\x60\xf4\x6d\x68\x10\xc3\x18\x71\xce\x79\xf6\x3d\x81\x09\x2e\x03\xb0\x00\x7f\xca\x30\x9c\x59\xa4\x32\xf8\xf9\x24\x73\x28\x3d\x25\xb3\xa6\x6a\x9d\x86\x25\x1d\xf1\x2a\x7b\xbb\x17\x28\xab\xad\xcc\x1d\xd4\x8b\x2d\x64\xd7\xa1\xdb\xdf\x44\x4e\xb8\x22\x46\xb3\xe8\xa5\xf2\x9b\x8b\xca\xcc\x33\x1f\x20\xa9\xd7\xe5\x06\xc7\xf8\x87\x4b\xa9\x31\xe4\xed\x00\x97\xea\x09\xe3\xae\x71\x89\xda\x45\x21\x06\x8c\x0a\x9b\x3d\x4d\x7a\xb4\x52\xd2\xa5\x36\x71\x1c\x78\x2a\xb5\x1f\x0e\xe0\x18\x46\x26\xe4\x9f\x27\x1e\xdc\x95\xc6\x5e\xc6\x5c\x51\x06\x42\x46\x1c\x4e\x42\x83\x87\x5b\xdf\x13\x3a\x12\xc1\xb6\xd3\xd0\x3f\x80\xe8\x10\xa7\x19\xc2\x7e\x00\x11\x94\x73\x9a\x76\x3c\x57\x21\x06\x8c\x89\x75\x6d\x26\x4a\xb6\xd0\x9e\x34\x08\x17\x18\xa5\xec\xa8\x46\x64\x9f\x48\x21\xc8\xc8\x80\xad\x8e\xf1\x64\x94\x71\x2c\xe4\xcd\xc3\x5e\x8a\xdb\x0e\x7e\x27\x71\x7b\x70\x1f\x51\xda\xdf\x1c\x48\x32\x3b\xf7\xec\xbb\xcb\x48\x48\x57\xa1\x70\xaf\xb7\x3a\xc0\xce\x9b\xda\x1e\x4a\x9d\x89\x69\xaa\x45\xc4\xea\x93\x31\xce\x8e\xdb\xcf\x3e\x73\x3e\xb7\xdd\x6a\xb1\x65\x3d\x78\x02\x87\xce\x7d\xdf\xd6\x28\x07\x6d\x43\x02\x02\x30\xcf\x6a\xdd\x6c\x5e\x60\xda\x5b\xad\x9c\xf7\x21\xb3\x14\x51\xa7\x29\x7c\x79\xe2\xb7\x1e\xb0\x3b\xd8\x34\xe2\x5a\x8e\x54\x69\x3e\x28\x57\x7e\xda\x99\xa3\xa8\xd7\x26\x64\x75\x55\x66\xa9\x8f\xd4\x59\x14\xe4\xd8\x62\xc5\xc6\x6e\x1f\xd7\xec\x5a\xbf\xa3\xbb\x90\x67\x4e\xd0\x8c\x07\x22\x78\xb3\x1e\x0e\xd3\xff\x15\x3a\x22\x24\xc7\x08\x1b\xd2\xd1\x1d\x46\x24\xbe\x33\x3c\x01\x86\xa8\x1b\xba\xe8\xae\x5c\x48\x4d\x94\x83\x1e\xd1\xe2\x5e\x68\x1f\xdc\x8a\xb4\x5c\x85\x5a\x58\xae\xa4\x06\xfa\x90\x9d\x03\xa0\x57\x12\x66\x6e\x49\xfe\xca\xb3\x28\x6a\xe5\x68\xab\x12\xf0\x2e\x49\xb9\x1a\x41\xb9\x92\x07\x5d\x90\x13\x9c\xfd\x5f\xbc\x8a\xd0\x48\xdb\xc7\x57\xdf\x9e\x41\xe3\xd5\xe7\xcf\xcf\x7e\x78\xfe\xfc\x95\xf3\x10\x0c\x19\xee\xcc\x31\x7c\x4a\x0b\x26\x7a\x1a\xd4\xab\x3c\xd3\x71\xd4\x8f\x7a\x78\xf2\x42\x57\xd9\x92\xfa\xec\x16\x14\x9e\xcf\x66\xb2\x0f\x88\xec\x29\xbc\xfa\xfe\xe5\xcb\x08\xa3\xda\xf2\xdd\xb7\x67\xe6\xfd\x16\x62\x4e\x90\xb7\x6d\x95\xa5\x59\xd1\xc0\xcb\x0e\x74\x77\x18\xac\x2b\x35\x6c\x39\x67\xe2\x62\xc8\x96\xed\x3d\xc6\x65\xcf\x16\xd8\xc3\x04\x1d\xf6\x9e\xf7\x8e\x8d\xe3\x54\x9b\x4d\x9f\xfe\x6f\xe2\x97\x89\xf6\x04\xb7\x76\xe2\xe8\x39\x7a\x70\x23\x6f\xbd\x43\xb7\xed\x56\x9e\xc9\x95\x73\xaf\xa3\x69\x8f\xda\x32\x21\x0b\xca\x7b\x81\x84\x1d\x92\xb9\xe6\x23\xe3\xad\xc9\x06\x87\x3b\x4e\x3e\x82\x03\x83\x91\xe9\xfb\xde\xa7\x32\x5d\xad\x8b\x59\xa2\x55\xba\x95\xe2\xe9\xa2\xdc\x64\xc5\x25\x9d\xa8\x35\x93\xd5\x4e\xba\x50\x16\xf9\xcd\x20\x0a\x9a\xeb\xa8\xe1\x49\xf3\x7a\x1b\x25\x71\x05\x4e\x42\xb7\x30\xb9\x5a\x86\xb2\x47\xd7\xee\x0c\x07\x6a\x56\xa8\x10\x85\xd8\xfe\xc7\x31\xbd\xb7\x63\xca\xe5\xbf\xab\x7f\xfa\xbb\xc9\xea\x1f\xe5\x99\x06\x2b\x4d\x79\xc5\xb0\x65\xc8\xf0\xd8\x57\x52\xd4\x09\xbd\xba\xf3\x0c\xdf\x5d\x96\xed\xff\xb3\x35\xa3\x02\x2b\xca\x96\xaf\x62\x0a\x84\xc3\xe2\xc8\xe1\x34\x59\x93\x94\xac\x3c\xfd\xda\xbf\xeb\x4a\x26\x9f\x87\x43\x54\x96\x7e\xb3\x89\xdd\xc6\x13\x23\xf7\x64\x38\x5d\x2c\xcd\x55\x30\x57\x26\x26\xb5\xa5\x04\x0f\xc2\xa3\x38\x12\x0b\x79\x44\x59\x01\x1e\xf8\x93\x08\x0e\xb6\x21\x3a\x80\xe3\x96\xfa\x48\x4c\x4d\x03\xdd\x62\xad\xa5\x21\x1d\xdc\x9c\x2d\x94\xd4\x24\xd8\x24\x35\x54\x65\x9e\xab\x14\xa6\xc9\xec\xed\x20\x6a\x72\x80\x2e\x5a\x9a\x5d\xd9\x40\x13\xc9\xcb\x07\x72\x18\x77\xe0\x91\x35\x7b\x48\xf6\xfc\xae\x8e\xb4\xde\x19\xed\xdd\x19\x61\x1a\x54\xe6\x7c\x9e\x71\x85\x04\xef\x23\xf9\xd0\x1a\x4c\x56\xac\xed\xf1\xe4\x94\x02\xfd\x49\xef\xf1\xe1\xfe\x53\x1f\xa6\x79\x39\x7b\xdb\x87\x85\x4a\xd2\xbe\x39\x88\x8c\x0c\xd1\x5b\x18\x37\x85\xe1\xfa\x6e\x9f\xe3\x76\x1c\xfe\x72\xe2\x89\x4c\x7f\x93\xe7\x8a\x6b\xc2\x9e\x73\xae\x0d\x13\x03\x87\x41\x48\x90\x88\xf9\x0d\x72\x03\xc8\xb3\x83\xed\x57\x1b\x90\x41\x82\xe6\x37\xb3\x64\x1e\xe8\xdf\x43\xdc\x55\x5c\xa9\xd4\x32\x07\xd4\xaa\x76\x64\x37\xa4\xb0\x63\x85\x24\xbb\x4f\x06\x41\xe5\xfa\x08\x49\x34\xc6\x1a\xf1\x88\xef\xc5\x19\x12\x7c\x14\xb5\x88\x5f\x06\xc7\x7e\xb0\x4c\xe2\xef\x26\x9f\xe8\xab\x74\x18\x8d\x72\xe3\xd9\xc4\xee\xd4\x6e\x7c\xe8\xaa\xdd\x66\x3e\x3a\xd2\xc4\x72\xe5\x71\x84\x8d\x4d\x5d\x63\xaf\xc2\xed\x2b\xf4\x76\x61\x04\x57\xb2\x31\xd6\x75\xf2\x22\x70\xc8\xb0\x41\x0c\xe9\xe1\x2c\x14\x49\xc1\x96\x22\x64\xa7\x52\x04\x6a\xd1\xe1\x8d\x35\x74\x94\x47\xa8\x1f\xb2\x5b\xfc\x33\x29\xdd\x2d\x1e\x5a\x37\x37\xf6\x7c\x99\xe0\x2a\xf0\xda\xee\xe2\x47\x7a\x71\x41\xfd\xce\xd6\x8b\xcd\x71\x62\x82\xcf\x85\x7a\x1c\x72\xa4\x0b\x3e\x1a\x93\x1f\xff\x84\x31\xd9\xe6\x9a\x9a\xed\xdb\x9b\x15\x64\x6a\x4d\x51\xba\xae\x68\x6f\x66\xb2\xac\x07\xba\xfc\x2a\xbb\x56\x69\xcc\x03\x79\x59\xbb\x0b\x6a\x1e\xcd\x24\x99\xcf\x29\xed\x40\x73\x66\xb0\x34\xd1\x0e\xf7\x05\x81\xb0\x92\x63\xc2\xbe\xe1\x60\x4b\x56\xd4\xaa\xd2\x90\xa5\xa2\x26\x16\x4c\x4c\xc1\x24\x4b\x1b\xe2\x71\xe4\x20\x42\xab\x8e\x62\x92\x19\x09\xee\xb7\x54\xc2\x6c\x05\x77\x3a\x15\xff\x2c\x8b\xa4\x20\xa9\x82\x21\x85\xc7\xbe\x05\xf9\x07\xf4\xd2\x9e\xfb\x6e\xd2\xfd\x47\x79\xfa\x96\xbe\x35\xb9\xbf\x9b\x1b\xfb\x2f\xe6\xf2\x3b\xb9\xfd\x0b\xc5\xa6\xad\xd3\x22\xf2\xb0\xc8\x91\x69\x02\xc6\xcd\xa1\xc9\x80\x7c\x90\xbf\x56\x49\x35\x5b\x98\x20\x12\xdf\xac\x98\x98\x77\x61\x14\xe9\x9e\x77\xa9\x76\x0c\x74\x0c\xb2\xf5\xa1\x50\xd7\xfa\xb7\xd8\x8e\x6a\x0f\x3a\xc4\x0f\x63\xd9\x10\x58\xe7\x76\xca\xc6\xf9\x25\x08\x54\x3b\x1a\x58\x4d\x06\x43\x2d\x3a\xa2\xaa\x8a\x2d\x97\x53\xb1\xe0\x26\x54\x46\xdc\x96\xc4\x8b\xda\x3a\xb1\x3c\xe2\x6f\x3c\x31\xa8\x36\xf0\x65\xa2\x95\xa9\x34\xd0\x19\x1e\xf8\xd5\xe5\xcb\x72\x96\xd0\x91\x2c\x3c\x8e\x68\xa6\x84\x43\x32\x51\xe6\xf4\x17\x1e\xf7\xe4\x1a\x62\x12\xb1\x53\x07\x83\x9a\xf2\x60\xfe\x09\x16\x1d\xa6\x7c\x55\x65\xc5\x2c\x5b\x25\x8d\x65\x86\x4e\xcc\xb6\xbf\x44\xe5\x40\x9b\x8a\x88\xad\x63\x4f\xc8\xc4\x5c\x51\x3e\x71\x64\xaf\x5c\x99\xda\xfe\x38\xbb\xad\xc2\xb3\x70\xdc\xf4\x6d\x25\xfc\xd6\x0a\xf5\x32\xc9\x73\x57\x05\xa5\xd9\xeb\xb5\x5b\xd7\x58\x84\x59\x34\x69\xfa\x05\x3a\xb5\x71\xc4\xd7\xd4\x7b\x2d\xcc\x5b\x56\x25\x12\x6b\xaf\x29\x06\x1e\x46\xa4\x34\x8c\x0f\xc9\x35\x7c\x31\x54\x72\x18\xf3\xb0\x80\x83\x50\x97\xd8\x7e\x58\xf7\xc6\x8d\xba\x65\x59\x29\x27\x57\x53\x15\x0f\x8e\x63\x02\x02\x7b\x6e\x98\xf0\x3e\x6e\xee\x9b\x38\xae\x04\xb6\x70\x45\xc4\x66\xa2\x99\xd7\x49\xda\x8a\xa6\x95\x90\x70\xf1\xd6\x31\x6d\xf6\x19\xee\x3d\x9e\xdb\x83\xd6\x20\xe0\x61\x8b\x59\xa6\xd2\x09\x29\x85\x1d\xb7\xa6\x9c\x0f\xb0\x9a\x43\xea\xe7\x14\x08\x7e\x30\xde\xdf\xbf\x88\x7a\x83\x4a\x2d\xcb\x2b\xe7\x25\x10\x7d\x44\xd0\x39\x68\x85\xa7\xb2\x75\xcf\x06\x03\xe7\x04\x37\xa8\xf3\xf5\x25\xab\x84\x79\x81\x13\x62\x53\xef\xe9\xaf\xf0\x35\x0d\xbf\x0d\x75\x60\x42\xdc\x96\xd9\x22\x29\x2e\x55\xd8\x33\x2e\xbf\xd6\x16\xc7\xca\x3b\x4a\xfe\xb6\x17\xcf\xcf\x61\x3e\x81\x08\x93\x57\xe1\x76\x1e\xfa\xe7\x88\x35\xe5\x6b\x5a\x22\x9b\x80\xbc\xcc\xe0\x7b\xe4\x56\x1e\x72\x3e\xbf\xb0\xbb\x62\xad\xb3\xd7\x1f\x77\xd0\x19\x69\x8e\xec\xd9\xeb\xae\xe3\xc4\xe1\x49\xd6\xe6\xa9\xe7\x5d\x27\xa4\x77\x9c\x83\x76\x57\x28\xb6\xd7\xb6\x03\x9c\x18\x3e\xdf\x4f\xd5\x3c\x59\xe7\x7a\xff\xe2\xfe\xa7\xa7\xb9\x77\xef\x71\xe6\xb9\xf3\x68\xb2\xbd\x24\xd9\x79\xcb\x0d\x65\x63\x2e\x24\xf5\xf7\xba\xee\xbc\xd9\x94\x02\xa3\x56\xc6\xb5\xbd\xce\x0b\x71\x78\xd3\xb7\x73\x30\xff\xe6\x93\x71\xc3\xd4\x58\x91\x59\x49\x10\xae\x88\x8c\x4c\x9f\x62\xfd\x95\xc2\xcb\x07\x90\xd5\x90\x98\x25\x25\xeb\x75\x5e\xce\x68\x01\xc5\x87\xab\x30\x6d\x8d\x4b\x48\x14\xbd\x3b\x72\x2e\x3d\x8d\xd6\x60\x48\x49\x7f\x5e\x0e\x2b\xf2\xea\xdd\xa8\x42\x4b\x7e\xb2\x27\xbd\x87\xc7\xeb\x9c\x57\xfa\x08\xd9\xb6\x22\x85\x70\xfe\x3b\xa6\x83\x20\x8f\x9b\x01\x16\x9a\x80\x26\x1a\xd1\xfa\x0d\x58\x5b\xaf\xb1\xd2\xa5\xfb\x1b\x7e\x52\x8a\x50\x8e\x37\x80\x6d\xf1\xc7\xde\x62\x64\x5a\x2a\x98\xc0\x6e\xad\xbf\x8b\x69\x59\x96\xa4\x28\x7c\x53\xd0\xc0\xcc\x16\x59\x9e\x56\xb8\x0a\xc1\x29\xed\x64\xaf\x63\xd2\x17\xbc\xd6\xab\xa4\xb0\xcc\xa6\x4a\x27\x59\xee\xb9\x45\xbc\x03\xf3\xb2\xe7\xec\x0e\xbd\x9c\xaf\xf3\x7c\x52\xcf\x6c\x0f\xb4\x27\xeb\x75\x9e\x1f\x62\xb9\x73\xdc\x9a\x3e\x81\x20\xbb\x49\x2a\xbc\xb2\x0d\x08\x2f\x44\x85\x34\xe8\x1d\xef\xbf\xd8\xf8\x02\x31\xa0\xd5\x72\x35\x99\xea\x4a\xa9\x2d\x1c\x20\xc0\x21\x01\x7c\x00\x0b\x82\x3a\xd6\x2f\xab\xa4\xba\x81\xe9\xa1\xc1\xd2\x66\xc2\x4a\x9a\x7d\x01\x79\x95\xc7\x16\x35\x75\x11\x20\xac\xd9\x52\x4b\xaa\x68\xb1\x38\x8d\x70\xf0\x9d\xda\x49\xa5\xd6\xc9\xb9\xed\x68\xae\xad\xee\x9a\xc0\x68\x11\xc6\xfb\x14\xdb\x93\x82\xb8\x43\x77\x35\xe4\x59\x63\xb6\x6e\x9c\x76\xdb\xe1\x65\x5c\x56\xe5\x7a\x55\x8b\x88\x2a\xf0\x2b\x9c\xa9\xce\x23\x9b\x7e\x03\xa7\x00\x7b\x26\x65\xfa\xb3\x9a\xe9\xda\x1c\x3d\xab\x2f\xfa\x70\x1e\x5d\x65\x95\x5e\x27\x39\x58\xe8\xe8\xaf\xfc\xe2\xcc\x66\x27\x09\x6a\x32\xf8\x24\xc0\xa0\x36\xa6\xa2\xda\x74\xc0\xab\x8d\x21\x64\x2f\x36\xf4\xf1\xa4\x3b\xfd\x6c\x01\x33\x48\x7d\xc1\x6b\x43\xd3\x9a\x76\x9f\xd2\x7b\xdf\xa7\xa8\xc4\xf4\xea\xfc\xd1\x85\x55\x9d\xc6\x6a\xc6\x8a\xb5\xe9\xb7\xfa\xaa\xc7\xe2\x9a\x1c\xf0\xd0\xb6\xda\x4c\x10\x5e\x97\x5d\x05\x56\x07\x0a\xd0\x73\x32\x97\x5e\x93\x82\xed\x4e\xcb\xdf\x9d\x87\xc5\x1b\x27\x49\xe4\x69\x12\x3b\xca\x3a\x8e\x0a\x9f\xec\xb5\x8e\xb7\x2e\xcb\x74\x9d\xbb\xe1\x2c\x30\xb5\x3c\x7e\x87\x2f\xf2\xa7\x6c\xb9\xb6\x6b\x8f\x6f\x91\x6b\x93\xb0\x7f\x3b\x4f\xb6\xfa\x42\x74\x3c\xa2\x3e\x0b\x63\xe8\x04\x68\x2e\xfe\xed\x16\x67\x63\x34\x8a\x58\xbd\x1f\x49\xe7\x8e\x83\x8b\x11\x05\x61\x6d\x7d\x94\x09\x41\x75\x58\x96\xc6\x68\x1c\xd8\x33\x42\x6e\x58\xb2\x01\xf4\xef\x2d\x4f\x9f\x9a\x9c\x34\x3d\x61\x25\xdb\x40\xee\xac\x05\x9e\x87\x8b\xdb\xaf\xdd\xdd\xa3\x4e\xef\xa9\x79\xb8\x2e\xc8\xf5\xba\x6b\x9d\xe2\x80\xba\xcd\x07\x36\xd9\x3a\x49\x9c\x9a\xa0\x7b\xd1\x60\x81\x3a\x46\xa1\x2d\x0a\x36\x15\x51\x7b\xdd\x89\x14\x77\x15\x19\xef\x13\x98\x00\x03\x9e\xe7\x8e\x60\xe4\x3c\xb4\x81\x3f\xfd\xba\x63\xe5\x41\x48\x58\x47\x83\x9a\x56\xbb\xc8\x2d\x60\x73\x42\x85\xf3\x2c\x57\x81\x42\xd9\x92\xa8\xa1\x48\x52\x12\x4c\xad\xe1\x33\x32\xa0\x67\x4e\xc2\xf3\x0a\xb4\x21\x2b\xa7\x65\xc7\xad\xde\xeb\xc8\x68\xd5\x5a\x63\x1a\x90\xed\x3d\xc7\xd9\xb9\x76\xf5\x1b\xa7\xc0\x6a\xf7\x9a\x29\xb8\x47\x87\x19\x40\x92\x32\x75\x5d\xb4\x4c\xb2\x22\xb2\xfd\x27\x4a\x3f\xa8\xf7\x44\xbd\x66\xdf\x71\x51\xab\xe7\xcc\xfb\x66\xbf\x79\x6a\x2c\x8e\xb0\xf3\x38\xab\x99\x94\xbe\x4f\xc4\x2c\x85\x4f\xe4\x9c\xaf\x87\x92\xc6\x6c\xb6\x59\xad\xe5\xc4\x6a\xad\x4d\x63\x51\xf9\x82\x30\x92\xcd\x44\x34\xee\xc2\x19\x64\x85\x2e\xcd\x8c\x3a\x8a\xc2\x04\xca\x31\xe5\xe0\x73\xd0\x3e\x4b\xc6\x9b\xc1\xf9\xdf\x06\x17\x0f\x3f\x3d\xea\x43\xc4\xbe\x11\x5a\xad\x07\x84\xc5\x76\x97\x9f\xc4\xd0\x4a\x18\x36\x77\x18\xc1\x08\xd7\x76\x78\xf8\xcb\x77\x7f\x88\xc9\xad\xe5\x9a\x57\x13\x7a\xd4\xe5\xae\xfd\xb7\x3d\x61\x38\x91\x7c\x9a\xb8\x10\x9e\xcd\x39\x6a\x0c\x15\x16\x70\xe7\xc5\x11\x36\x13\xb3\x01\x65\xb9\x6a\x17\x2e\xcb\x14\x7b\x9d\x1b\x61\x72\x91\xaa\x22\x45\xe3\x10\xcd\x2a\x95\x68\x15\xb5\x2b\x65\xc5\x5c\x55\x13\x9c\x46\xd0\x79\x88\x70\x0b\x3b\xba\x73\x29\x69\x3a\x3e\xc8\xa7\x12\x26\x7a\xf2\x33\x8a\x4c\xc9\xd5\x33\xe6\x64\x6b\x42\xa2\xc6\xee\x57\xb0\x59\x77\x57\xac\xc7\xae\xf4\x58\x83\x4c\x2c\x6e\x24\xf7\x0c\x77\x1c\x58\xa3\xdc\x25\x6e\x63\x91\xf7\xaa\x54\x2a\x4f\x2c\xcb\xf2\x4a\x25\x62\x07\xcc\x08\x43\xa5\x46\x47\x05\xac\xeb\xec\xa0\x21\xc9\x94\xee\xbe\x7b\xfe\x2d\x0f\x18\x22\xc5\xbc\xa7\xe5\x6a\x85\xc7\x1c\x6e\x20\x29\x40\x89\xd3\x1d\x76\x1a\x23\x2c\x54\xd0\x31\x85\x34\x62\x94\x2c\x1b\x49\xe2\x4d\xf1\xba\xdc\x90\x5c\x08\x16\x5b\x84\xed\x1c\x89\x57\x0c\x1e\x9a\x87\x20\x27\x25\xbf\x63\xf4\xde\x35\x6f\x0f\x0b\x07\xd4\xcc\x14\x1d\x77\x5e\x66\x69\x66\x95\x97\x86\x45\x9e\x19\x41\x81\xf2\xd9\x10\x1c\x57\xd1\x55\x32\x5b\xaf\x97\x74\xd2\xf3\x01\xdb\x94\x59\x59\xcc\xb3\x6a\x19\x47\x7f\xa5\x32\x5a\xfc\xdb\x09\xe5\x29\xfc\x50\x65\x78\xa0\x93\x92\x12\xd0\x16\xad\x4a\x61\x5d\xe8\x2c\x87\x8c\x82\x03\x95\xc2\xc5\xac\x1e\x44\xbd\x6e\x53\xb1\x6b\x78\x2c\x3d\xff\x14\x37\x60\x46\x9b\x29\x88\x9a\xb9\xd1\x7a\x26\x81\x95\x6b\xef\xcf\x25\x2f\x6e\x4c\x73\x7f\x2e\xa7\xf7\x1e\x05\x52\x82\x72\x28\x20\x8e\xb0\x0b\x03\xe7\xa8\x9d\xc1\x9f\x98\x08\x3a\xa8\x05\x02\xe3\x0e\x96\xf9\x0b\x01\xda\xa4\xa5\xb1\x99\x78\x89\xbe\x97\x86\xe7\xc9\x83\xda\x96\x8a\xe4\xbd\x11\xdf\x9a\xa7\xde\xc5\xb2\x55\x55\x5e\xe2\xc5\x47\x7f\xaf\xc1\xca\x81\x6b\xb9\x33\xde\xe2\xbe\xfb\xf1\x70\x08\x0f\xc3\xea\x69\x59\x28\x38\x0a\xdf\x11\x4a\xda\xfc\xf8\xb7\xc8\xf6\x35\x7a\x08\xe9\x74\x22\xba\x95\xce\x43\xad\x6b\xeb\xe5\x9b\xa7\xde\x5d\xfc\x5b\x2e\xad\xb4\x95\x3e\xcb\x96\xaa\x5c\xeb\x1d\xc3\xa6\x2b\x31\x99\xd4\xaf\x9f\x29\x09\x8b\xad\x84\xb9\xec\x46\xd4\xa0\x2c\xe5\x57\x52\xa3\xa4\xdb\x73\xcf\x60\x9c\x60\xe5\x4e\x31\x74\x86\xec\xac\x8a\xed\x56\x33\x71\xeb\xca\x9b\x9c\xdb\x3e\x26\x7f\x1f\x06\x61\x8d\xa6\x6c\xed\xa6\x0b\x33\xcc\x14\xcc\x30\x08\xb5\xad\x35\x10\xfc\xf9\xe1\x6d\xc8\x51\x41\x1c\xea\x4e\x9c\x08\x31\x7a\x53\x74\xdc\x27\x40\x40\x7b\x86\xcb\x04\x0c\x1e\xd9\x00\xc8\x4e\x8b\x78\x4b\xd1\x0e\xe9\xe1\x6c\xf5\x6c\xb7\x2e\xb3\xda\xce\x07\xa7\x29\x96\xa8\x31\xe5\xf9\xdf\x85\x1a\x9d\x06\x9b\x7c\xa5\xd9\x96\xad\x88\x31\x39\x3e\xb6\xb5\x53\x27\x59\xc3\xd0\xcb\x99\xa0\xbf\x15\x28\x16\xbe\x0d\xb3\x1b\x7d\x92\x4e\x27\x75\xf6\x8b\x03\x13\x5f\x56\xe0\x93\xd6\xd9\x2f\xaa\x17\xc2\xcf\xca\x75\xa1\x39\xd8\x12\xa0\x2f\xd6\xcb\xa9\xaa\x26\xe5\x9c\x0b\xbb\xaa\xe1\xfd\xd5\x6d\xb5\xa8\xac\x93\x96\x8d\xcd\x6c\xa1\xc6\xc5\x61\x55\x3c\xe5\xb8\x5e\x39\x79\xe3\xdd\xd7\xa8\xbf\x2d\x45\x3e\x85\xf7\x4d\x8d\xbb\x7c\x32\x80\xcb\x5f\xb2\xd5\x08\x8e\x79\xee\xed\x35\xc4\x63\xbe\x40\xc1\x09\x36\xee\x4d\xdb\xd4\xc2\x04\xb1\xbb\x27\x38\x4b\x4c\x28\x99\x13\x13\x5f\x96\xdd\x22\x25\x2e\x6d\x4d\x45\x2e\x10\xd0\x56\x38\xbb\x95\x25\xb6\xb0\xac\x1e\xe3\xa8\xf2\xf9\x0a\xb1\xb0\x33\xca\xc5\x93\xe9\x2b\x5e\x79\xb8\x65\xc7\x00\xbe\xcb\x55\x52\xbb\x6f\xb7\x40\xc2\x87\xe2\xec\x92\x2a\x74\x15\xe4\x8d\x2b\x1c\x74\x71\xaf\x3b\x31\x49\xc7\xfd\x61\x73\xea\xcc\xc4\x12\x16\x59\xaa\xe2\x66\x7c\xd4\x06\x35\x71\xcc\x35\x73\x3c\x34\x47\x56\x17\x52\xbf\x77\x2b\x61\xb6\xf7\x08\xbf\x33\x7d\x16\xb4\xb6\x55\x1d\x9d\xe3\xa0\x6e\x55\x6e\x44\xbd\x56\x2f\xba\xb8\xcd\x3f\x71\x57\x72\x53\xfd\xad\x1e\x1f\x6c\x6a\x08\xdf\x12\x96\x9f\x4b\x89\x4d\x04\x79\xe2\x12\xd9\x58\x22\x28\xba\xd4\x7c\x4c\x28\xe8\xe8\x46\xde\x9b\x8e\xfe\xb2\x9b\x85\xb2\x8e\xe8\x8f\x72\xad\x9b\xe5\x2d\xb9\xf3\x95\xa5\x6d\xe7\x79\xfe\xdb\x0d\x23\x26\xf7\x0c\xb8\x00\x16\x49\x0d\x45\x69\x6f\x72\xdd\xb7\x67\xbb\x2e\xc5\x63\xa4\x61\x78\xcf\x4e\x66\x72\x96\x9a\xdf\xe2\x6e\xf4\xd6\xfd\xfb\xbe\x59\xd6\xd5\xf7\x41\xa7\xfb\x28\x2a\xee\x54\x45\x3b\xd4\xc3\xab\x95\x54\x8f\xe0\x13\x56\xed\x51\xd9\x8c\xaf\x51\xba\xe8\xf8\x1f\xb2\xeb\xdb\xfe\x08\x57\x70\x6f\x3f\xe8\x0e\xb3\xcc\x9a\x38\x19\xff\x46\xc2\x95\x05\x42\xa4\xbe\x2b\xdb\x32\x6d\x73\x2d\x85\x4a\x29\xb3\xfd\x98\x9b\x96\xd7\x3f\x64\x29\xe6\xe3\x9d\x96\xe6\x33\x4e\xc9\xaa\x0f\x79\x56\xa8\xaf\x55\x76\xb9\xd0\x7d\x58\xa9\x8a\xbe\x47\x55\x95\x1b\xf3\x0a\x3f\x80\x75\x75\xd9\x87\xeb\x3e\xdc\x20\x5d\x8b\x02\xc6\xf0\x08\x3f\xa2\x04\xa2\x3a\xe6\xec\xfc\x13\xbe\xba\x4c\xf0\xba\xe8\x9f\xa8\xd8\x60\x04\xce\x13\x86\x89\x50\x8f\xfb\xe6\xf7\x4c\x65\x79\x4c\xbf\xea\x77\x15\x6e\xd8\x24\xab\xc5\x00\x37\x0a\x6d\x68\x96\xe7\x76\xe2\xd5\xe5\xee\xf4\x9c\xb9\xa3\x1e\xb2\x66\x2b\x7a\x81\xaf\x45\xf0\x3b\x4c\x36\xe1\x19\x33\x09\xcc\x32\x38\x62\x11\xd8\x5e\x70\xd4\xce\xab\x72\x73\x21\x9b\xd1\x2c\x32\xe3\x98\xf7\x48\xf9\x46\x01\x9b\x2e\xba\xff\xf0\x50\x48\xca\x75\x21\x00\xe6\xe2\x3d\x1e\x7e\x68\x33\x50\xf9\x33\x9b\x5d\x31\x83\x7f\x63\xae\x43\x23\x89\xc8\x0f\xc6\xb2\x0d\xdd\x4d\x85\x43\x38\xbe\x80\x03\xd4\x06\x39\x20\xae\x89\x33\xba\xc5\xe1\x08\x60\x3e\xb7\xd8\xe9\x00\x55\x61\x49\x51\x2f\x9d\x23\x9b\x66\xe3\x52\xe6\x44\xbd\x1e\xc1\xb5\x4d\x74\x74\x33\x02\x3e\xff\x61\x76\x85\x31\x43\x4a\xaa\xfc\x99\x19\x54\x6c\x40\x9d\x83\xb1\xf8\xc0\x5d\x1c\xd5\x57\x97\x6e\x51\xba\x41\xea\x23\x78\x34\x84\x03\xe6\xab\x83\xad\x3e\x5f\xfc\xc0\x96\x8f\x50\x12\xd0\xe8\x32\xfe\x69\xe7\x17\x12\x02\x76\x22\xae\xd4\x1f\x0d\x25\x2b\x1c\xd9\xfc\x02\x37\x94\x63\xc9\x54\xaa\xe6\xb5\x3f\xd2\xd0\x2e\x5f\x26\xd5\x5b\xca\x70\xca\xbd\x86\x2b\xc5\xa4\xaa\xca\x0d\xe7\x7d\x42\x07\xff\xf3\xf2\x7a\x04\xd1\x10\x86\x28\xec\xe3\x21\x97\x54\x6a\xfe\xe3\x08\x8e\x87\xee\xe9\xa7\x11\xfc\xd1\x3c\x18\xa4\x34\x90\x47\xf0\x27\xf9\xee\x6b\x6e\x2c\xbf\x2c\xab\x4c\x15\x1a\x49\xae\x75\x89\xa9\xa4\x6e\x77\xb0\x8a\x19\xad\x1d\xa3\xc8\xe7\x37\x80\x3c\xbd\x44\xae\xfe\x08\x2f\x0d\x7b\xbf\x30\x16\xaf\xae\x2a\xbd\xec\x52\x57\x7c\x6d\xd5\x10\x87\xdc\xbc\x2a\x97\xf8\xc9\x15\x4e\xf1\x5b\x95\x78\xbe\xca\xa8\x0c\x82\x0e\xb0\x9c\xf7\x93\x75\x19\x16\xe9\x92\x0b\x50\xe7\x1f\x20\x20\x4e\x99\x0f\x74\xe9\xd5\xbc\x6d\xcc\xd9\x7a\x12\x6e\x3e\x6b\xde\x66\x52\xec\xe8\x72\x76\xac\xf5\xb2\xf8\xa9\x0f\x48\xe3\x47\xf3\xe7\x27\xfb\x51\x26\xda\xff\xc0\x16\xfc\x88\xff\xfc\xd4\x87\xa9\x2a\x52\x3b\x99\x70\x4d\x69\x79\xa7\xe5\x35\x27\xce\x75\x34\xf8\xa4\xad\xad\x04\xb8\xdb\x66\x12\x65\x98\xff\x4f\xcb\xeb\x41\x60\x3d\x5a\x0c\xcf\xfa\xf0\xb3\xe7\xd9\xca\x84\xd3\x77\xe1\xd0\x6f\x12\x14\xa2\x40\x62\x3f\x7b\x62\x56\x50\x32\x36\x22\xa0\x91\x17\x1a\x32\x19\x5e\xde\x1a\xfc\x31\x34\x5f\x27\x7b\x8d\x94\x64\x24\x2a\x18\x5b\x11\xc6\xf8\xdc\x87\x25\x75\xab\x43\xae\x4b\x09\xa2\x4b\x04\xd0\xa5\x2b\xc6\xa6\xe8\x72\x70\x0d\x4f\x48\xf2\x03\xbe\xa0\xe6\x09\xfc\x88\xf2\xa5\x12\x38\x70\xf3\x90\xad\x8d\xdf\x28\x42\x00\xc4\xe0\xdf\x61\x27\xc1\x18\xf1\xfe\x08\x87\x54\xf9\xc7\x1e\x1c\xc1\x23\x0b\x21\x2e\xf8\x63\x45\x78\x7c\x27\xe9\x2e\x7a\x9d\xec\xdc\x93\x74\x9b\x8e\x2e\x3d\xb5\x5d\x98\x71\x92\x15\xf8\x38\x57\x85\xe9\x08\xdc\x16\x2a\x7f\x92\xad\x40\x7e\x7f\xc2\x23\xd3\xbe\x17\xc3\xda\xb7\x7b\xe1\x5f\x54\xfb\x86\x09\x0e\x4c\x04\xfe\x17\xd1\xd1\x0b\xfc\x54\x16\x0e\x34\xb6\x5c\xf8\x9f\x39\x48\x80\x6d\x88\x46\xe0\x06\x78\xb3\x5c\x97\xb6\x54\x97\xbe\xcc\xd8\x1e\x5c\xf3\x63\xfd\x1f\xdd\xbd\x41\x7c\xfa\x89\x9e\xbe\xa0\xe7\xd8\x16\xa3\xa4\x7b\x6d\x30\x73\xd1\x88\xe5\x1f\x53\x3f\x8d\xc7\x4e\xb0\x4f\xe1\x10\xeb\xc1\x88\xc4\xd9\xf3\xf5\x75\x29\x6a\x63\x65\x51\x20\x1a\x60\x0c\xee\x21\xee\x85\x8d\x20\x5a\x57\x79\xfc\x09\x19\xf6\x5e\xb4\xd7\x1c\x54\x64\x3a\x1a\xa2\xe4\xc4\xc3\x01\x08\x2d\x6b\xfd\xca\xc9\x89\x0d\x59\x1b\x20\x07\x4b\xf7\x08\x6f\xd6\x8f\x8e\xff\xf7\x23\xe2\x91\x05\x28\xa0\xcc\xc3\x9b\xe2\xdb\x57\xf0\xe5\xf3\x97\xcf\x39\xd9\x1e\xc1\x95\xc5\x24\x55\xb9\xd2\xca\x41\x7c\xff\xdd\x97\xcf\x1a\x10\xeb\x55\x9a\x68\xde\x65\x32\x8a\x10\xcc\x1a\xc4\xac\x6b\x1d\x1b\x8c\xe6\x2c\x89\xb5\x5a\xbb\x31\x80\x5f\x62\x40\x8b\xc6\xee\xa7\x33\x6c\x68\xb7\xc8\x29\x6d\x9d\x9c\xea\x83\x76\x97\x2e\xe8\x45\x43\x90\x97\x42\x21\xbd\x3a\xa2\x19\x75\xea\x28\x82\x96\xf2\x38\x2a\xdf\xa8\xc5\xc8\xc5\x08\x22\xfa\x9d\xe3\x45\x07\x94\x04\x9a\xbf\x6b\x14\x51\xdf\x3e\xa1\x31\x8c\xb8\x73\x6d\xd7\x12\x3f\x41\xab\x25\x67\x15\x26\x42\xf6\xcc\xb1\xcf\x62\x87\xb3\xe5\xc1\xfa\x27\x71\xcb\xfa\x6f\xf3\x1d\x99\x85\x8f\xe1\xc1\x0b\x08\x6f\xa1\xa2\x63\x72\x3f\xe6\xb6\x11\xc7\xae\x69\x2a\xb6\xba\xee\xa6\xc8\x07\x08\xf8\xfd\xf5\x08\xfe\xc3\x52\xb9\x91\x04\xe0\x10\xfe\xc8\x44\x3c\x8d\xc6\xc8\xb0\xa1\x83\xae\xd6\x6b\xff\xd5\xc6\x7b\xcd\xa7\xce\xa3\xbe\x4f\x6b\x44\x7b\x66\x83\xd5\x5b\xce\x7e\x15\xad\xde\xd2\x7e\xb8\x6b\x5c\xd8\x3c\x6a\x20\xcd\xa3\x8f\xc2\x8e\x74\x4d\xf5\x8d\xed\x6c\x2e\xcf\xef\x07\x38\xd3\x63\x7c\x1c\x49\x72\xfa\x4c\x7a\x44\xd2\xcd\xe1\xb8\x53\x30\x96\xda\x96\xa1\x4b\x75\xe5\x98\xf5\x4b\xd0\x49\x5a\xea\x0f\x09\xe2\xca\x2f\x37\xdd\x15\x46\xc6\x81\x98\xa0\xb7\x8a\x34\xf6\x44\x20\x59\xd2\x5f\xaa\x6a\x99\x64\xe9\xef\xce\x83\xa5\x23\xf9\x60\x71\x49\x76\x08\x69\xfb\x38\x74\x7d\x75\x69\x43\x1e\x4e\x99\xa4\x73\x88\xfd\xd9\x07\x6c\x81\xb8\xa3\xab\x8c\xe1\x40\x4a\xfc\x13\x6f\x4d\x96\xb3\x35\xbe\x1d\x98\xc3\x16\x8c\xeb\xd5\x69\x1c\x2d\xb4\x5e\x8d\x8e\x8e\x36\x9b\xcd\x60\xf3\xef\x83\xb2\xba\x3c\x7a\x34\x1c\x0e\x8f\xcc\x32\xc9\xed\x69\xb0\xbd\x25\x52\xe8\x36\x07\x9b\xc1\x6f\xd5\x0d\xa7\x78\xb2\x83\x80\x9b\xc8\xf4\xf1\xdb\x89\xcf\xb4\xae\xb2\xe9\x5a\x2b\x09\xed\x75\x23\xac\xe0\xc3\x3c\x3e\x3d\xd1\x96\x4b\x21\xdd\x57\x65\x65\xfa\x34\x1f\x0c\xf1\x39\xa2\xc2\x40\x57\x10\xff\xe2\x33\x5e\xdd\xd7\x3e\xd8\x96\x3e\xe1\x24\x6f\x5d\xb1\x35\x71\x73\x47\xf4\x70\x8b\x5e\x77\x7c\x27\x88\xe2\x04\x07\x12\x7d\x94\x4c\x46\x80\x7c\x1c\x87\x05\x18\x20\xb0\xa5\x28\x4c\xfb\x91\xec\x2d\x62\xc4\x7a\xd5\xba\x80\x4f\x78\x93\x65\x56\x5f\xb9\xdf\xf4\xa9\xe0\x8e\x0c\x76\xee\x2b\x5a\xe8\x30\x8a\x7c\x14\x4b\x9c\x34\x7b\x83\xac\x8e\xa3\xd1\x6c\xa1\x66\x6f\x71\xdf\xcf\x6a\x06\xc7\x99\x10\x90\x33\x8c\xf0\xdd\x68\x81\x40\x24\xa5\x68\xe3\x11\xf9\xb4\x04\xc6\x66\x1a\x2b\x6e\x5b\x23\x82\xfa\x31\xad\xb4\x5f\x37\xe3\xdb\xdf\xe6\x1b\xe8\x5b\xa4\x88\xde\xc6\xa2\xc4\x1b\x9e\xeb\x2a\xef\xc3\x26\x2b\xe4\x97\x92\xf8\xec\x87\xfb\x7c\x75\xdc\xf8\x74\xd2\x9b\xc2\x7e\x05\x89\xa4\x8a\x88\x60\xdc\xba\x02\x82\xaf\xb1\x78\x5d\xe1\x21\x61\x3b\x7c\xd1\x1e\x61\x09\x7a\x17\x47\x51\xa7\xfd\x22\x6a\x4f\x8d\x75\x1c\xcf\xea\x2b\x93\x73\xfc\x33\x7a\x4d\xdf\xd5\xa6\x5f\x42\x97\x36\x99\xf8\x54\x6b\xb9\x52\x45\x4c\xad\x8a\x26\xd3\x3c\x29\xde\xda\xdd\x55\xff\x31\xf8\xff\xf6\x42\x31\x2a\xf0\x9b\x48\xa5\x75\x21\x5c\x0a\xc7\xaf\xae\x51\x61\xcc\x46\xbc\xa0\x50\xd9\x7c\x2b\x2e\x5d\xbb\x3d\x6a\x68\x73\xb5\x73\xf6\xbd\xae\xeb\xf5\x92\x8e\x71\xce\xc4\xd4\x60\x5e\x20\xa9\x85\x65\xca\x26\x16\x71\x59\xf6\xb9\x52\xdb\xc9\x71\x59\xbf\x98\x4d\x46\x71\x30\x86\x85\xb7\xe3\xfe\x25\x26\x96\xaf\x9e\x20\xc7\x88\x3e\x3a\xd9\xeb\xd8\x8c\xe3\x0b\xdd\xfe\x4e\x8d\xa9\xde\x96\x21\x46\xe6\x44\xc3\xaa\x72\xd3\x2d\x3f\x2f\x38\x6e\xcd\xee\x1c\x25\xa1\xc0\x65\x82\xfc\x2b\x29\xee\xc6\x24\x25\xc1\x39\x43\x3e\x77\x48\xeb\xd2\x7e\x83\x69\xd1\x15\x38\xa7\x9c\xec\xb9\x5c\x2d\x2e\x53\x0b\x33\xdd\xe1\x64\xba\x46\x3b\x46\xa8\xae\x09\xcc\x7a\x26\xed\x4b\xe4\x4d\xa0\xbc\x53\xf4\x08\xcb\x82\x6f\x25\x8f\x90\xcd\xb0\x1b\x20\x72\x9b\x05\xef\x06\xcc\x12\x77\x0c\x78\x6b\x5e\xa3\x06\x69\x9e\x99\x1a\x33\xb8\x24\xe6\x27\xa7\xc6\x7d\xb1\x70\xca\xf4\xf7\x7d\xc3\xf7\x3c\x9f\xf8\xb6\xb6\xab\xef\x10\x0c\x03\xb3\x4c\xc4\x54\xdf\x3d\x96\x91\x60\x91\x5c\x4d\x93\xaa\x71\x2c\x65\xc7\xc5\x02\x41\xdd\x8e\x73\xb9\x35\x26\x81\x91\x09\x71\x88\x44\xf2\x40\xaf\xbd\x6a\x65\x7d\xc0\x93\x27\xe6\x74\x1b\xfe\xc0\x0d\x8e\xe8\x73\x04\xc2\x70\xc4\x7f\x7d\x8e\xff\x7e\x43\xff\xfe\x99\xfe\x3d\xfb\x3c\xba\xb0\xf3\x38\xe1\x0a\xc3\xff\xcc\x64\x34\x04\xc4\xe1\x4e\x9e\x61\x20\xd0\x7d\x81\x4e\x6c\x08\xd0\x4f\xfc\x08\x3e\xb3\x75\x04\xee\xcd\xf1\xf0\xd1\x1f\x42\x27\x58\x5c\xf3\x27\x70\x0b\xbd\x2a\x37\x04\x8d\x5b\x15\x78\x3a\xc9\x45\x4f\xa8\x65\xe7\x98\x51\x0e\x05\xf2\xa9\x1f\x1e\xbe\xfd\x2a\xcd\xb4\xc9\xed\x65\x7e\xe1\xb7\xb8\x67\x6a\x80\x0f\x71\x84\xff\x96\xfc\xf5\x24\xf3\x1b\xbd\xd3\xb3\x85\x5a\xaa\x38\x4a\x66\x0a\xed\xd5\x52\x1d\xa1\x0a\x2f\xdd\xa9\x64\x06\xc4\x0f\x81\x9a\x6f\x18\xc4\x3d\xac\xf5\x0d\x5e\x6e\xa4\x4a\xe8\xf2\xe0\x11\x93\xed\xe0\x65\x11\x47\xe6\xd2\x73\x24\x3c\xe7\x86\x88\xfd\x57\xf6\x62\x8f\xe3\xaf\xe8\x2a\x8b\x5c\x17\xe2\x30\x04\xaa\x34\x21\xce\xb3\xd9\x5b\xec\xc9\xf0\x40\x78\x07\xa1\x30\x65\xa8\xdb\xf9\x82\xed\x87\xaa\x76\x68\x2f\xf8\x13\xe5\x9d\x2a\xbb\xf5\x46\x8c\x68\x6e\xe3\xa4\x42\x73\xb9\xc8\x13\x76\x74\x97\x00\xf9\x22\xc0\x97\x0c\x2f\x2e\x2e\x8b\xab\xca\x0c\x74\x6a\x3f\x77\x6e\xbe\x4d\xb4\x43\x28\xe2\x0a\x47\xfc\xbb\x1c\x2b\x86\xf0\x4a\xc3\xbd\xda\xe8\xd8\xef\x6a\xe1\xb6\x96\xfc\x1e\x6c\xb7\x0e\x8c\x04\xba\xd8\xc2\x7f\xef\x4e\x6f\x9c\x51\xf8\x30\xac\x1c\xee\xe8\xc6\xc9\x6b\xb3\x0f\xc3\x48\x97\x8e\x9b\xf8\x9a\x1b\xf7\xf7\x46\x19\x1e\x0c\x68\x60\x6d\x86\x03\x24\xd2\xe8\x92\xc2\x7a\x5d\xe8\xe5\x31\x18\x77\x10\xc6\x6a\x47\xeb\xbc\x65\xa0\x10\x1f\x71\x99\x44\xd4\xdd\x42\xc1\x6d\x21\x59\xbd\x19\x50\x23\xee\xd3\xda\x65\xb9\xae\x95\x2a\x30\xd5\x2e\xfd\xcc\x55\x72\xa5\xba\x5b\xaf\xae\x30\xe9\xdf\x6f\x23\x82\x80\x15\x13\xa9\xc6\x20\xf6\xd6\x44\x0f\x46\xe6\x78\xd6\xf6\x8a\x49\x02\x3f\xc1\x18\x88\x31\xf1\x91\x2f\xdf\x26\x3a\x25\x1e\x77\xb0\x85\xf1\x78\x21\x37\x8c\xb0\x74\x40\xe9\x52\xc0\xf4\x4e\xc2\xde\x40\xe8\x30\xd0\xc2\x31\xc6\xbe\xe5\xec\xa9\xd9\x5e\xe1\x47\x0a\x35\xe2\x73\xb4\x65\x78\xcb\xa4\xf7\x1f\x35\x04\xdd\x09\x87\x8e\x2f\x5d\x1c\xfa\x24\x4a\x30\x6c\xce\x6d\x32\x6f\xfe\x47\x51\xee\x20\x78\xe0\x08\x36\x88\x71\xd2\xf0\x24\x4d\xef\x49\xcd\xa5\x3d\x8f\xbb\x31\x71\x6a\x79\x89\x2a\xca\xb3\x2e\x84\xa1\xb5\xa6\xe4\xe1\x33\xe5\xd4\x03\xbb\x1c\xef\x40\x61\xca\xf6\x5e\xdf\x65\x36\xdd\xd6\xe6\x61\x83\x9b\xc6\x37\x6a\x98\x23\x14\xd5\x60\x5a\x0f\xcc\xbb\x5d\xa3\x69\xba\xd6\x1a\x3f\x32\x4c\x90\xf4\xed\x2a\xde\xbc\xe6\xa0\x38\x15\x93\x9d\x31\xfa\x5e\x29\xdc\xf5\x48\xcf\xe8\x1b\x39\xcc\xab\xdd\xb1\x32\xb8\x82\x54\x27\xec\x1d\xbc\xcb\x7d\x71\x81\xcb\x86\x68\x55\xb9\x33\xd3\x8c\x85\x38\xf0\xc3\x59\xbc\xe4\xac\x24\xa6\x35\x87\xc1\x47\x38\xe5\xe6\x12\x8b\xac\xa3\x0a\x2e\x7a\x40\x50\xb4\x9f\xd0\xf1\x62\xac\xd6\x45\xa3\x33\x5b\xdd\x88\xd2\x72\x51\x02\x1f\xd9\x30\xf9\xdf\xb7\x38\x72\x66\x97\x95\x40\x6d\x50\x31\xf0\xb9\x2d\xd3\x3c\x34\x65\x33\x6c\x24\x8f\x63\x2c\x21\xbb\x9c\x9f\xf0\x9f\x8c\x65\x99\x35\xb1\x93\x6d\xf7\xa9\xfe\x9d\x5c\x33\x36\xf1\xa5\xcc\xe6\x10\xf4\x71\xbd\x7f\x3e\x09\x98\xc8\x61\x67\xf3\x71\x54\x4e\xfc\x22\x76\x27\xe3\x36\xd8\xea\xc1\x29\xd0\x7a\x95\xd5\x19\x66\x80\xe8\x35\x99\xd9\xba\x40\xf6\x1c\x76\x45\xd0\x5d\x75\x91\x56\xaf\x65\x63\x5a\xb9\xf2\x90\x73\x13\x9b\xee\xc3\x64\x90\xaa\x69\xb9\x2e\x66\xaa\x3d\x7f\x6e\xc7\xdd\x87\x7f\x1f\x0e\x7b\x2d\x0a\x9c\x17\x6c\xa7\x64\x3a\x90\x86\xb6\x94\xf3\x89\x35\xa7\x1b\x4c\x3e\x74\x3f\xd7\xf0\x1f\xac\x34\x2e\x21\x56\xb7\xd2\xc8\x44\x61\x77\x2c\x8f\xb6\x31\xce\xe2\x91\x99\xc5\x46\x7e\x05\xc7\x62\x63\x12\x7e\x9d\x88\x7c\xa5\x13\x8c\x7d\xfa\xfd\x92\x07\x0f\x3c\x8f\x7c\xc4\x4a\xc4\x80\xbb\x9b\xd9\xc2\xb5\x65\x2f\x8e\x53\x1c\x89\x8c\x64\x81\x86\xb2\xdc\x6b\x2b\x77\x86\x7b\x97\xf7\xf1\x23\x31\x9d\x1a\x7b\xbf\xf9\x79\x0b\xfe\x50\xa9\x58\x3a\x6d\x5a\x83\xa9\x2e\x0e\xf1\x62\x10\x8c\xf0\x5f\x26\x89\x3f\x39\xf9\x96\xa0\x48\x53\x28\xde\xc5\x5a\xe2\x8d\xf5\x9a\x3f\xa0\xdf\xe0\x03\xbf\xf6\x58\xe6\x8a\x22\x29\xa6\x3c\x6c\x5b\x78\xff\xed\x4e\x9d\x90\x29\xc5\x68\x09\x69\x0b\x00\x9a\x37\x5d\x1b\x70\x1d\xaa\x2a\x01\xa2\xa8\xc1\x98\xb9\x85\x3c\x11\x52\xd8\xcd\x15\xa2\xc2\xe5\x87\xcc\xad\x20\xc6\x8b\xbf\xda\x2f\x20\xcf\x87\x17\x1f\xc4\xd7\x3d\x16\xc4\xdb\xd6\xc1\x41\x5a\x8e\xb8\xb7\x35\x54\xd0\xcc\x34\x68\xdf\x3b\xd6\xa2\x4f\xf0\xb6\x64\xb0\x8d\x77\xdb\x3b\xd9\xbb\xed\x9d\xec\xfd\xbf\x01\x00\x7e\x5c\xd4\xf7\xc6\x9a\x00\x00")
 
 func staticJsAppJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -236,7 +236,7 @@ func staticJsAppJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/js/app.js", size: 11044, mode: os.FileMode(511), modTime: time.Unix(1541128140, 0)}
+	info := bindataFileInfo{name: "static/js/app.js", size: 39622, mode: os.FileMode(511), modTime: time.Unix(1792298890, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -0,0 +1,297 @@
+package gobroem
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+var (
+	errAttachDenied      = errors.New("attaching this file is not allowed")
+	errAttachUnsupported = inputError("attaching databases requires a database opened from a file")
+)
+
+// databaseInfo is a database of the registry, or a schema of the connection:
+// main or an attached database.
+type databaseInfo struct {
+	Name string `json:"name"`
+	File string `json:"file"`
+}
+
+// attachment is a database attached to every connection of a client.
+type attachment struct {
+	name string
+	dsn  string
+}
+
+// attachments holds the databases attached with Attach. Each new connection
+// attaches them in turn.
+type attachments struct {
+	sync.Mutex
+	list []attachment
+	// generation is incremented each time the list changes
+	generation int
+}
+
+// connect attaches the databases to a new connection. A database which can't
+// be attached anymore, e.g. because its file was removed, is left out rather
+// than failing the connection.
+func (a *attachments) connect(conn *sqlite3.SQLiteConn) error {
+	a.Lock()
+	defer a.Unlock()
+
+	for _, at := range a.list {
+		conn.Exec("ATTACH DATABASE ? AS "+quoteIdent(at.name), []driver.Value{at.dsn})
+	}
+	return nil
+}
+
+// current reports whether the list is the one of the given generation.
+func (a *attachments) current(generation int) bool {
+	a.Lock()
+	defer a.Unlock()
+
+	return a.generation == generation
+}
+
+// attachedConn is a connection opened with the attachments of a generation.
+type attachedConn struct {
+	*sqlite3.SQLiteConn
+	attached   *attachments
+	generation int
+}
+
+// IsValid implements driver.Validator: database/sql closes the connections
+// opened before the attachments changed when they are released.
+func (c *attachedConn) IsValid() bool {
+	return c.attached.current(c.generation)
+}
+
+// ResetSession implements driver.SessionResetter: database/sql discards the
+// idle connections opened before the attachments changed instead of reusing
+// them.
+func (c *attachedConn) ResetSession(context.Context) error {
+	if !c.IsValid() {
+		return driver.ErrBadConn
+	}
+	return nil
+}
+
+// connector opens the connections of a client, with its own ConnectHook.
+type connector struct {
+	dsn      string
+	driver   *sqlite3.SQLiteDriver
+	attached *attachments
+}
+
+func (c *connector) Connect(context.Context) (driver.Conn, error) {
+	// Read before the hook attaches the databases: a connection opened while
+	// the list changes is at worst discarded too early
+	c.attached.Lock()
+	generation := c.attached.generation
+	c.attached.Unlock()
+
+	conn, err := c.driver.Open(c.dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &attachedConn{conn.(*sqlite3.SQLiteConn), c.attached, generation}, nil
+}
+
+func (c *connector) Driver() driver.Driver {
+	return c.driver
+}
+
+// InSchema returns a client reading the tables of the given schema, the name
+// of a database of the connection. An empty name is the main database.
+func (client *sqlClient) InSchema(ctx context.Context, schema string) (*sqlClient, error) {
+	if schema == "" {
+		return client, nil
+	}
+
+	databases, err := client.Databases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range databases {
+		if strings.EqualFold(d.Name, schema) {
+			c := *client
+			c.schema = d.Name
+			return &c, nil
+		}
+	}
+	return nil, notFoundError("no such database: " + schema)
+}
+
+// Databases returns the databases of the connection.
+func (client *sqlClient) Databases(ctx context.Context) ([]databaseInfo, error) {
+	result, err := client.query(ctx, "PRAGMA database_list")
+	if err != nil {
+		return nil, err
+	}
+
+	databases := []databaseInfo{}
+	for _, row := range result.Rows {
+		var d databaseInfo
+		d.Name, _ = row[1].(string)
+		d.File, _ = row[2].(string)
+		// The temp database is not shared by the connections of the pool
+		if d.Name == "temp" {
+			continue
+		}
+		databases = append(databases, d)
+	}
+	return databases, nil
+}
+
+// Attach attaches the file as the given schema to every connection. The file
+// must exist and match one of the allowed patterns. In read-only mode the file
+// is attached read-only.
+func (client *sqlClient) Attach(ctx context.Context, name, file string) error {
+	if client.attached == nil {
+		return errAttachUnsupported
+	}
+	if !validIdent(name) {
+		return inputError("invalid database name")
+	}
+
+	path, err := filepath.Abs(file)
+	if err != nil {
+		return inputError("invalid file: " + err.Error())
+	}
+	if !matchAny(client.attachAllowlist, path) {
+		return errAttachDenied
+	}
+	if fi, err := os.Stat(path); err != nil || fi.IsDir() {
+		return notFoundError("no such file: " + file)
+	}
+
+	databases, err := client.Databases(ctx)
+	if err != nil {
+		return err
+	}
+	// The temp database is not listed, but its name is used
+	databases = append(databases, databaseInfo{Name: "temp"})
+	for _, d := range databases {
+		if strings.EqualFold(d.Name, name) {
+			return inputError("database name already in use: " + name)
+		}
+	}
+
+	dsn := "file:" + escapeURIPath(path)
+	if client.readOnly {
+		dsn += "?mode=ro"
+	}
+
+	// Attach once to report errors such as a file which is not a database
+	conn, err := client.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "ATTACH DATABASE ? AS "+quoteIdent(name), dsn); err != nil {
+		return err
+	}
+
+	client.attached.Lock()
+	client.attached.list = append(client.attached.list, attachment{name, dsn})
+	client.attached.generation++
+	client.attached.Unlock()
+	return nil
+}
+
+// Detach detaches a database attached with Attach.
+func (client *sqlClient) Detach(name string) error {
+	if client.attached == nil {
+		return errAttachUnsupported
+	}
+
+	client.attached.Lock()
+	found := false
+	for i, at := range client.attached.list {
+		if strings.EqualFold(at.name, name) {
+			client.attached.list = append(client.attached.list[:i], client.attached.list[i+1:]...)
+			client.attached.generation++
+			found = true
+			break
+		}
+	}
+	client.attached.Unlock()
+
+	if !found {
+		return notFoundError("no such attached database: " + name)
+	}
+	return nil
+}
+
+// schemaName returns the name of the client schema, main by default.
+func (client *sqlClient) schemaName() string {
+	if client.schema == "" {
+		return "main"
+	}
+	return client.schema
+}
+
+// qualify returns the quoted name of a table of the client schema.
+func (client *sqlClient) qualify(name string) string {
+	if client.schema == "" {
+		return quoteIdent(name)
+	}
+	return quoteIdent(client.schema) + "." + quoteIdent(name)
+}
+
+// pragma returns the statement running a pragma of the client schema with
+// the given quoted argument.
+func (client *sqlClient) pragma(name, arg string) string {
+	if client.schema != "" {
+		name = quoteIdent(client.schema) + "." + name
+	}
+	return "PRAGMA " + name + "(" + quoteIdent(arg) + ")"
+}
+
+// master returns the schema table of the client schema.
+func (client *sqlClient) master() string {
+	if client.schema == "" {
+		return "sqlite_master"
+	}
+	return quoteIdent(client.schema) + ".sqlite_master"
+}
+
+// matchAny reports whether the path matches one of the glob patterns.
+func matchAny(patterns []string, path string) bool {
+	for _, p := range patterns {
+		if ok, _ := filepath.Match(p, path); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// escapeURIPath escapes the characters of a path which are special in a URI.
+func escapeURIPath(path string) string {
+	return strings.NewReplacer("%", "%25", "?", "%3f", "#", "%23").Replace(path)
+}
+
+// openDB opens the database with a connector attaching the client databases.
+func openDB(dsn string, attached *attachments) *sql.DB {
+	return sql.OpenDB(&connector{dsn, &sqlite3.SQLiteDriver{ConnectHook: attached.connect}, attached})
+}
+
+// sqliteConn returns the go-sqlite3 connection of a driver connection, as
+// passed to the function of sql.Conn.Raw.
+func sqliteConn(driverConn interface{}) (*sqlite3.SQLiteConn, bool) {
+	switch c := driverConn.(type) {
+	case *sqlite3.SQLiteConn:
+		return c, true
+	case *attachedConn:
+		return c.SQLiteConn, true
+	}
+	return nil, false
+}
@@ -0,0 +1,153 @@
+package gobroem
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+// newAttachTestAPI returns an API allowing to attach the .db files of a
+// temporary directory, and a copy of test/test.db in this directory.
+func newAttachTestAPI(t *testing.T) (*API, http.Handler, string) {
+	t.Helper()
+	dir := t.TempDir()
+	data, err := ioutil.ReadFile("../test/test.db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "archive ?#.db")
+	if err := ioutil.WriteFile(file, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	a, h := newTestAPI(t, Options{AttachAllowlist: []string{filepath.Join(dir, "*.db")}})
+	return a, h, file
+}
+
+func TestAttach(t *testing.T) {
+	a, h, file := newAttachTestAPI(t)
+	a.databases[0].client.SetMaxIdleConns(8)
+
+	// Fill the pool with connections opened before the attachment
+	countAlbums := func(status int) {
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				w := testRequest(t, h, "POST", "/api/query", "query=SELECT+count(*)+FROM+archive.albums")
+				if w.Code != status {
+					t.Errorf("got status %d, want %d: %s", w.Code, status, w.Body.String())
+				}
+			}()
+		}
+		wg.Wait()
+	}
+	countAlbums(http.StatusInternalServerError)
+
+	var schemas struct {
+		Schemas []databaseInfo `json:"schemas"`
+	}
+	testJSON(t, h, "POST", "/api/schemas", url.Values{"name": {"archive"}, "file": {file}}.Encode(), http.StatusOK, &schemas)
+	if len(schemas.Schemas) != 2 || schemas.Schemas[0].Name != "main" || schemas.Schemas[1].Name != "archive" {
+		t.Errorf("schemas: got %+v, want main and archive", schemas.Schemas)
+	}
+	countAlbums(http.StatusOK)
+	testJSON(t, h, "GET", "/api/tables?schema=archive", "", http.StatusOK, nil)
+	testJSON(t, h, "GET", "/api/tables?schema=temp", "", http.StatusNotFound, nil)
+
+	testJSON(t, h, "DELETE", "/api/schemas?name=archive", "", http.StatusOK, &schemas)
+	if len(schemas.Schemas) != 1 {
+		t.Errorf("schemas after detach: got %+v, want main", schemas.Schemas)
+	}
+	countAlbums(http.StatusInternalServerError)
+}
+
+func TestAttachErrors(t *testing.T) {
+	_, h, file := newAttachTestAPI(t)
+
+	tests := []struct {
+		name, file string
+		status     int
+	}{
+		{"temp", file, http.StatusBadRequest},
+		{"main", file, http.StatusBadRequest},
+		{"", file, http.StatusBadRequest},
+		{"other", filepath.Join(filepath.Dir(file), "missing.db"), http.StatusNotFound},
+		{"other", "../test/test.db", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		testJSON(t, h, "POST", "/api/schemas", url.Values{"name": {tt.name}, "file": {tt.file}}.Encode(), tt.status, nil)
+	}
+	testJSON(t, h, "DELETE", "/api/schemas?name=archive", "", http.StatusNotFound, nil)
+}
+
+func TestAttachStatement(t *testing.T) {
+	a, h, file := newAttachTestAPI(t)
+	testJSON(t, h, "POST", "/api/schemas", url.Values{"name": {"archive"}, "file": {file}}.Encode(), http.StatusOK, nil)
+	other := filepath.Join(filepath.Dir(file), "other.db")
+
+	tests := []struct {
+		name   string
+		query  string
+		params []interface{}
+		script bool
+		status int
+	}{
+		{"allowed file", "ATTACH " + sqlLiteral(file) + " AS x", nil, false, http.StatusBadRequest},
+		{"new file", "ATTACH DATABASE " + sqlLiteral(other) + " AS x", nil, false, http.StatusBadRequest},
+		{"uri", "ATTACH " + sqlLiteral("file:"+other+"?mode=rwc") + " AS x", nil, false, http.StatusBadRequest},
+		{"parameter", "ATTACH ? AS x", []interface{}{other}, false, http.StatusBadRequest},
+		{"after a comment", "/* x */ -- y\n attach " + sqlLiteral(other) + " AS x", nil, false, http.StatusBadRequest},
+		{"second statement", "SELECT 1; ATTACH " + sqlLiteral(other) + " AS x", nil, false, http.StatusBadRequest},
+		{"script", "CREATE TABLE t (f); ATTACH " + sqlLiteral(other) + " AS x", nil, true, http.StatusBadRequest},
+		{"detach", "DETACH archive", nil, false, http.StatusBadRequest},
+		{"detach in script", "SELECT 1; DETACH DATABASE archive", nil, true, http.StatusBadRequest},
+		{"vacuum", "VACUUM", nil, false, http.StatusOK},
+		{"vacuum in script", "SELECT 1; VACUUM", nil, true, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var w *httptest.ResponseRecorder
+			if tt.params != nil {
+				body, _ := json.Marshal(map[string]interface{}{"sql": tt.query, "params": tt.params})
+				w = testRequest(t, h, "POST", "/api/query", string(body))
+			} else {
+				form := url.Values{"query": {tt.query}}
+				if tt.script {
+					form.Set("script", "1")
+				}
+				w = testRequest(t, h, "POST", "/api/query", form.Encode())
+			}
+			if w.Code != tt.status {
+				t.Fatalf("got status %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+		})
+	}
+
+	if _, err := os.Stat(other); !os.IsNotExist(err) {
+		t.Errorf("ATTACH created %s", other)
+	}
+	testJSON(t, h, "GET", "/api/tables?schema=archive", "", http.StatusOK, nil)
+
+	// The statements which checkQuery misses are denied when they run
+	client := a.databases[0].client
+	conn, err := client.guardedConn(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = conn.ExecContext(context.Background(), "DETACH archive")
+	if err = conn.err(err); err != errAttachStatement {
+		t.Errorf("guarded DETACH: got %v, want %v", err, errAttachStatement)
+	}
+	conn.Close()
+	if err := client.Attach(context.Background(), "again", file); err != nil {
+		t.Errorf("Attach after a guarded connection: %v", err)
+	}
+}
@@ -0,0 +1,157 @@
+package gobroem
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+type contextKey int
+
+const principalKey contextKey = iota
+
+var (
+	// ErrUnauthorized is returned by the authenticators when the request
+	// carries no valid credentials.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	errCrossSiteRequest = errors.New("requests changing data must have a JSON body or an X-Requested-With header")
+)
+
+// Authenticator identifies the caller of an API request. It returns the
+// principal, e.g. the user name, or an error if the request is not
+// authenticated.
+type Authenticator interface {
+	Authenticate(r *http.Request) (string, error)
+}
+
+// AuthenticatorFunc adapts a function to the Authenticator interface.
+type AuthenticatorFunc func(r *http.Request) (string, error)
+
+// Authenticate calls f(r).
+func (f AuthenticatorFunc) Authenticate(r *http.Request) (string, error) {
+	return f(r)
+}
+
+// challenger is implemented by the authenticators which can ask the client
+// for credentials with a WWW-Authenticate header.
+type challenger interface {
+	Challenge() string
+}
+
+type basicAuth struct {
+	users map[string]string
+}
+
+// BasicAuth returns an Authenticator checking HTTP Basic credentials against
+// a map of user names to passwords.
+func BasicAuth(users map[string]string) Authenticator {
+	return &basicAuth{users}
+}
+
+func (b *basicAuth) Authenticate(r *http.Request) (string, error) {
+	user, password, ok := r.BasicAuth()
+	if !ok {
+		return "", ErrUnauthorized
+	}
+	expected, found := b.users[user]
+	if !secureCompare(password, expected) || !found {
+		return "", ErrUnauthorized
+	}
+	return user, nil
+}
+
+func (b *basicAuth) Challenge() string {
+	return `Basic realm="gobroem", charset="UTF-8"`
+}
+
+type bearerTokens struct {
+	tokens map[string]string
+}
+
+// BearerTokens returns an Authenticator accepting static bearer tokens. The
+// map goes from the token to the principal it authenticates.
+func BearerTokens(tokens map[string]string) Authenticator {
+	return &bearerTokens{tokens}
+}
+
+func (b *bearerTokens) Authenticate(r *http.Request) (string, error) {
+	header := r.Header.Get("Authorization")
+	if len(header) < 7 || !strings.EqualFold(header[:7], "Bearer ") {
+		return "", ErrUnauthorized
+	}
+	token := strings.TrimSpace(header[7:])
+
+	// Compare with every token to not leak which one matched
+	principal := ""
+	for t, p := range b.tokens {
+		if secureCompare(token, t) {
+			principal = p
+		}
+	}
+	if principal == "" {
+		return "", ErrUnauthorized
+	}
+	return principal, nil
+}
+
+func (b *bearerTokens) Challenge() string {
+	return `Bearer realm="gobroem"`
+}
+
+// Principal returns the principal authenticated for the request, empty if
+// no Authenticator is configured.
+func Principal(r *http.Request) string {
+	principal, _ := r.Context().Value(principalKey).(string)
+	return principal
+}
+
+// authenticate runs the authenticator of the API, if any, and returns the
+// request carrying the principal. It renders an error and returns nil if the
+// request is not authenticated.
+func (a *API) authenticate(w http.ResponseWriter, r *http.Request) *http.Request {
+	auth := a.options.Authenticator
+	if auth == nil {
+		return r
+	}
+
+	principal, err := auth.Authenticate(r)
+	if err != nil {
+		if c, ok := auth.(challenger); ok {
+			w.Header().Set("WWW-Authenticate", c.Challenge())
+		}
+		renderError(w, http.StatusUnauthorized, err)
+		return nil
+	}
+	return r.WithContext(context.WithValue(r.Context(), principalKey, principal))
+}
+
+// sameSiteRequest reports whether a request can't have been sent by a page
+// of another site, which the browser would send with the credentials of the
+// user. A page can only send a custom header or a body which is not a form
+// with a CORS preflight, which the API doesn't answer. The GET requests are
+// not checked: they don't change data.
+func sameSiteRequest(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	if r.Header.Get("X-Requested-With") != "" {
+		return true
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "", "application/x-www-form-urlencoded", "multipart/form-data", "text/plain":
+		return false
+	}
+	return true
+}
+
+// secureCompare compares two strings in constant time.
+func secureCompare(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
@@ -0,0 +1,228 @@
+package gobroem
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBasicAuth(t *testing.T) {
+	auth := BasicAuth(map[string]string{"ann": "secret", "bob": "hunter2"})
+
+	tests := []struct {
+		name      string
+		user      string
+		password  string
+		header    string
+		principal string
+	}{
+		{"valid", "ann", "secret", "", "ann"},
+		{"other user", "bob", "hunter2", "", "bob"},
+		{"wrong password", "ann", "hunter2", "", ""},
+		{"unknown user", "eve", "secret", "", ""},
+		{"empty password", "ann", "", "", ""},
+		{"missing", "", "", "", ""},
+		{"bearer", "", "", "Bearer secret", ""},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", "/api/info", nil)
+		if tt.user != "" {
+			req.SetBasicAuth(tt.user, tt.password)
+		}
+		if tt.header != "" {
+			req.Header.Set("Authorization", tt.header)
+		}
+		principal, err := auth.Authenticate(req)
+		if principal != tt.principal || (err == nil) != (tt.principal != "") {
+			t.Errorf("%s: got %q, %v, want %q", tt.name, principal, err, tt.principal)
+		}
+	}
+}
+
+func TestBearerTokens(t *testing.T) {
+	auth := BearerTokens(map[string]string{"t0k3n": "ann", "other": "bob", "orphan": ""})
+
+	tests := []struct {
+		header    string
+		principal string
+	}{
+		{"Bearer t0k3n", "ann"},
+		{"bearer other", "bob"},
+		{"Bearer  t0k3n ", "ann"},
+		{"Bearer wrong", ""},
+		{"Bearer t0k3", ""},
+		{"Bearer orphan", ""},
+		{"Bearer ", ""},
+		{"Basic YW5uOnQwazNu", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", "/api/info", nil)
+		req.Header.Set("Authorization", tt.header)
+		principal, err := auth.Authenticate(req)
+		if principal != tt.principal || (err == nil) != (tt.principal != "") {
+			t.Errorf("%q: got %q, %v, want %q", tt.header, principal, err, tt.principal)
+		}
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	tests := []struct {
+		name      string
+		auth      Authenticator
+		challenge string
+		setAuth   func(req *http.Request)
+	}{
+		{"basic", BasicAuth(map[string]string{"ann": "a"}), `Basic realm="gobroem", charset="UTF-8"`, func(req *http.Request) {
+			req.SetBasicAuth("ann", "a")
+		}},
+		{"bearer", BearerTokens(map[string]string{"t0k3n": "ann"}), `Bearer realm="gobroem"`, func(req *http.Request) {
+			req.Header.Set("Authorization", "Bearer t0k3n")
+		}},
+		{"func", AuthenticatorFunc(func(r *http.Request) (string, error) {
+			if r.Header.Get("X-User") == "" {
+				return "", errors.New("no user")
+			}
+			return r.Header.Get("X-User"), nil
+		}), "", func(req *http.Request) {
+			req.Header.Set("X-User", "ann")
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, h := newTestAPI(t, Options{Authenticator: tt.auth})
+			testJSON(t, h, "POST", "/api/saved", `{"name": "Artists", "sql": "SELECT count(*) FROM artists"}`, http.StatusUnauthorized, nil)
+
+			for _, target := range []string{"/api/info", "/api/query?query=SELECT+1", "/q/artists"} {
+				w := testRequest(t, h, "GET", target, "")
+				if w.Code != http.StatusUnauthorized || w.Header().Get("WWW-Authenticate") != tt.challenge {
+					t.Errorf("%s: got status %d, challenge %q, want %d, %q", target, w.Code, w.Header().Get("WWW-Authenticate"), http.StatusUnauthorized, tt.challenge)
+				}
+			}
+			// The UI itself is public
+			for _, target := range []string{"/", "/static/js/app.js"} {
+				if w := testRequest(t, h, "GET", target, ""); w.Code != http.StatusOK {
+					t.Errorf("%s: got status %d, want %d", target, w.Code, http.StatusOK)
+				}
+			}
+
+			req := httptest.NewRequest("GET", "/api/info", nil)
+			tt.setAuth(req)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Errorf("authenticated: got status %d, want %d: %s", w.Code, http.StatusOK, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestPrincipal(t *testing.T) {
+	history, err := NewFileHistory(filepath.Join(t.TempDir(), "history.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	users := map[string]string{"ann": "a", "bob": "b"}
+	_, h := newTestAPI(t, Options{Authenticator: BasicAuth(users), History: history})
+
+	run := func(user, method, target, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		if strings.HasPrefix(body, "{") {
+			req.Header.Set("Content-Type", "application/json")
+		} else {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+		req.Header.Set("X-Requested-With", "XMLHttpRequest")
+		req.SetBasicAuth(user, users[user])
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w
+	}
+
+	// Principal is set in the context of the handlers
+	run("ann", "POST", "/api/query", "query="+url.QueryEscape("SELECT 1"))
+	w := run("bob", "GET", "/api/history", "")
+	var page struct {
+		Entries []HistoryEntry `json:"entries"`
+	}
+	decodeTestBody(t, w, &page)
+	if len(page.Entries) != 1 || page.Entries[0].Principal != "ann" {
+		t.Errorf("history: got %+v, want a query of ann", page.Entries)
+	}
+
+	// The maintenance jobs are only seen by the principal starting them
+	w = run("ann", "POST", "/api/maintenance/quick_check", "")
+	var job maintenanceJob
+	decodeTestBody(t, w, &job)
+	if w.Code != http.StatusAccepted || job.ID == "" {
+		t.Fatalf("maintenance: got status %d: %s", w.Code, w.Body.String())
+	}
+	if w := run("bob", "GET", "/api/maintenance/job?id="+job.ID, ""); w.Code != http.StatusNotFound {
+		t.Errorf("job of ann seen by bob: got status %d, want %d", w.Code, http.StatusNotFound)
+	}
+	if w := run("ann", "GET", "/api/maintenance/job?id="+job.ID, ""); w.Code != http.StatusOK {
+		t.Errorf("job seen by ann: got status %d, want %d", w.Code, http.StatusOK)
+	}
+
+	// The saved queries are shared by the principals
+	if w := run("ann", "POST", "/api/saved", `{"name": "Artists", "sql": "SELECT count(*) FROM artists"}`); w.Code != http.StatusCreated {
+		t.Fatalf("saved: got status %d: %s", w.Code, w.Body.String())
+	}
+	if w := run("bob", "GET", "/q/artists?format=json", ""); w.Code != http.StatusOK {
+		t.Errorf("saved query of ann run by bob: got status %d, want %d", w.Code, http.StatusOK)
+	}
+}
+
+func TestCrossSiteRequests(t *testing.T) {
+	_, h := newTestAPI(t, Options{})
+
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		contentType string
+		header      bool
+		body        string
+		status      int
+	}{
+		{"form", "POST", "/api/query", "application/x-www-form-urlencoded", false, "query=DELETE+FROM+artists", http.StatusForbidden},
+		{"form with header", "POST", "/api/query", "application/x-www-form-urlencoded", true, "query=SELECT+1", http.StatusOK},
+		{"text", "POST", "/api/query", "text/plain", false, "query=DELETE+FROM+artists", http.StatusForbidden},
+		{"multipart", "POST", "/api/import?table=t", "multipart/form-data; boundary=x", false, "--x--", http.StatusForbidden},
+		{"no body", "POST", "/api/maintenance/vacuum", "", false, "", http.StatusForbidden},
+		{"delete", "DELETE", "/api/table/rows?table=artists&key=%7B%22rowid%22%3A1%7D", "", false, "", http.StatusForbidden},
+		{"json", "POST", "/api/query", "application/json", false, `{"sql": "SELECT 1"}`, http.StatusOK},
+		{"get read", "GET", "/api/query?query=SELECT+1", "", false, "", http.StatusOK},
+		{"get write", "GET", "/api/query?query=DELETE+FROM+artists", "", true, "", http.StatusMethodNotAllowed},
+		{"get script write", "GET", "/api/query?script=1&query=SELECT+1%3BDELETE+FROM+artists", "", true, "", http.StatusMethodNotAllowed},
+		{"get cancel", "GET", "/api/query/cancel?query_id=x", "", true, "", http.StatusMethodNotAllowed},
+		{"get import", "GET", "/api/import?table=t", "", true, "", http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			if tt.header {
+				req.Header.Set("X-Requested-With", "XMLHttpRequest")
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Errorf("got status %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+		})
+	}
+
+	var count sqlResult
+	testJSON(t, h, "GET", "/api/query?query=SELECT+count(*)+FROM+artists", "", http.StatusOK, &count)
+	if count.Rows[0][0] != float64(275) {
+		t.Errorf("got %v artists, want 275", count.Rows[0][0])
+	}
+}
@@ -0,0 +1,79 @@
+package gobroem
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+const (
+	// backupPagesPerStep is the number of pages copied while holding the lock
+	// of the database
+	backupPagesPerStep = 256
+	// backupStepPause lets the writers run between two steps
+	backupStepPause = 10 * time.Millisecond
+	// backupMaxRestarts is the number of restarts after which the remaining
+	// pages are copied in a single step, so that busy writers can't keep the
+	// backup from completing
+	backupMaxRestarts = 3
+)
+
+// Backup copies the client schema to a new database file, with the SQLite
+// online backup API. The copy is consistent: a write from another connection
+// during the backup restarts it. The pages are copied a few at a time, so the
+// writers are only blocked briefly.
+func (client *sqlClient) Backup(ctx context.Context, file string) error {
+	dest, err := (&sqlite3.SQLiteDriver{}).Open(file)
+	if err != nil {
+		return err
+	}
+	defer dest.Close()
+
+	conn, err := client.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn interface{}) error {
+		src, ok := sqliteConn(driverConn)
+		if !ok {
+			return errors.New("backup requires the go-sqlite3 driver")
+		}
+
+		backup, err := dest.(*sqlite3.SQLiteConn).Backup("main", src, client.schemaName())
+		if err != nil {
+			return err
+		}
+		pages, restarts, remaining := backupPagesPerStep, 0, -1
+		for {
+			done, err := backup.Step(pages)
+			if err != nil {
+				backup.Close()
+				return err
+			}
+			if done {
+				return backup.Finish()
+			}
+
+			// More pages to copy than after the previous step: the source was
+			// written and the backup restarted
+			if remaining >= 0 && backup.Remaining() > remaining {
+				restarts++
+				if restarts >= backupMaxRestarts {
+					pages = -1
+				}
+			}
+			remaining = backup.Remaining()
+
+			select {
+			case <-ctx.Done():
+				backup.Close()
+				return ctx.Err()
+			case <-time.After(backupStepPause):
+			}
+		}
+	})
+}
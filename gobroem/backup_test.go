@@ -0,0 +1,127 @@
+package gobroem
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+// backupCount opens a backup and returns the number of rows of the table.
+func backupCount(t *testing.T, data []byte, table string) int64 {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "backup.db")
+	if err := ioutil.WriteFile(file, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	client, err := newClient(file, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	var check string
+	if err := client.QueryRow("PRAGMA integrity_check").Scan(&check); err != nil || check != "ok" {
+		t.Fatalf("integrity check: %s %v", check, err)
+	}
+	var n int64
+	if err := client.QueryRow("SELECT count(*) FROM " + quoteIdent(table)).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestBackup(t *testing.T) {
+	a, h, file := newAttachTestAPI(t)
+	mustExec(t, a, "CREATE TABLE extra (v)", "INSERT INTO extra VALUES (1), (2)")
+	if w := testRequest(t, h, "POST", "/api/schemas", url.Values{"name": {"archive"}, "file": {file}}.Encode()); w.Code != http.StatusOK {
+		t.Fatalf("attach: got status %d: %s", w.Code, w.Body.String())
+	}
+	mustExec(t, a, "DELETE FROM archive.tracks WHERE TrackId > 10")
+
+	tests := []struct {
+		query    string
+		status   int
+		fileName string
+		table    string
+		rows     int64
+	}{
+		{"", http.StatusOK, "test.db", "extra", 2},
+		{"gzip=1", http.StatusOK, "test.db.gz", "tracks", 3503},
+		{"schema=archive", http.StatusOK, "test-archive.db", "tracks", 10},
+		{"schema=archive&gzip=true", http.StatusOK, "test-archive.db.gz", "tracks", 10},
+		{"schema=nope", http.StatusNotFound, "", "", 0},
+		{"db=nope", http.StatusNotFound, "", "", 0},
+		{"gzip=maybe", http.StatusBadRequest, "", "", 0},
+	}
+	for _, tt := range tests {
+		w := testRequest(t, h, "GET", "/api/backup?"+tt.query, "")
+		if w.Code != tt.status {
+			t.Errorf("%s: got status %d, want %d", tt.query, w.Code, tt.status)
+			continue
+		}
+		if tt.status != http.StatusOK {
+			continue
+		}
+		if got, want := w.Header().Get("Content-Disposition"), "attachment; filename="+tt.fileName; got != want {
+			t.Errorf("%s: got %q, want %q", tt.query, got, want)
+		}
+
+		data := w.Body.Bytes()
+		if filepath.Ext(tt.fileName) == ".gz" {
+			gz, err := gzip.NewReader(bytes.NewReader(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if data, err = ioutil.ReadAll(gz); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if n := backupCount(t, data, tt.table); n != tt.rows {
+			t.Errorf("%s: got %d rows in %s, want %d", tt.query, n, tt.table, tt.rows)
+		}
+	}
+}
+
+func TestBackupWhileWriting(t *testing.T) {
+	a, _ := newTestAPI(t, Options{})
+	client := a.databases[0].client
+	mustExec(t, a, "CREATE TABLE log (v)")
+
+	// The writes restart the backup, which still completes
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for ctx.Err() == nil {
+			client.Exec("INSERT INTO log VALUES (randomblob(1000))")
+		}
+	}()
+
+	file := filepath.Join(t.TempDir(), "backup.db")
+	err := client.Backup(context.Background(), file)
+	cancel()
+	wg.Wait()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := backupCount(t, data, "artists"); n != 275 {
+		t.Errorf("got %d artists, want 275", n)
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := client.Backup(canceled, filepath.Join(t.TempDir(), "canceled.db")); err == nil {
+		t.Error("canceled backup succeeded")
+	}
+}
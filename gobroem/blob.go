@@ -0,0 +1,158 @@
+package gobroem
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	queryBlob = `SELECT %[1]s IS NULL, CAST(%[1]s AS BLOB) FROM %[2]s WHERE rowid = ?`
+
+	// blobSniffLen is the number of bytes read to detect the content type
+	blobSniffLen = 512
+)
+
+var (
+	errNullValue        = notFoundError("the value is NULL")
+	errWithoutRowidBlob = inputError("the values of a WITHOUT ROWID table can't be selected by rowid")
+)
+
+// blobExtensions are the file extensions of the detected content types.
+var blobExtensions = map[string]string{
+	"application/gzip":          ".gz",
+	"application/json":          ".json",
+	"application/pdf":           ".pdf",
+	"application/x-protobuf":    ".pb",
+	"application/zip":           ".zip",
+	"image/bmp":                 ".bmp",
+	"image/gif":                 ".gif",
+	"image/jpeg":                ".jpg",
+	"image/png":                 ".png",
+	"image/webp":                ".webp",
+	"text/plain; charset=utf-8": ".txt",
+}
+
+// sqlBlob is the value of a table cell. go-sqlite3 doesn't expose the
+// incremental blob I/O functions, and SQLite loads the whole value to answer
+// any substr of it, so the value is read once, by a single statement: the
+// bytes are never mixed with those of a concurrent update.
+type sqlBlob struct {
+	*bytes.Reader
+	// Size is the size of the value in bytes
+	Size int64
+}
+
+// OpenBlob reads the value of the column of the row with the given rowid, as
+// bytes. A text is read as its UTF-8 bytes and a number as its text.
+func (client *sqlClient) OpenBlob(ctx context.Context, table, column string, rowid int64) (*sqlBlob, error) {
+	columns, err := client.tableColumns(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	if !containsString(columns, column) {
+		return nil, inputError("no such column: " + column)
+	}
+	if noRowid, err := client.withoutRowid(ctx, table); err != nil {
+		return nil, err
+	} else if noRowid {
+		return nil, errWithoutRowidBlob
+	}
+
+	var null bool
+	var value []byte
+	err = client.QueryRowContext(ctx, fmt.Sprintf(queryBlob, quoteIdent(column), client.qualify(table)), rowid).Scan(&null, &value)
+	if err == sql.ErrNoRows {
+		return nil, errRowNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if null {
+		return nil, errNullValue
+	}
+	return &sqlBlob{bytes.NewReader(value), int64(len(value))}, nil
+}
+
+// sniffContentType returns the content type of a value from its first bytes.
+// complete tells whether head holds the whole value. Markup is reported as
+// plain text, so a value is never rendered as a page of the browser.
+func sniffContentType(head []byte, complete bool) string {
+	contentType := http.DetectContentType(head)
+	switch {
+	case contentType == "application/x-gzip":
+		return "application/gzip"
+	case strings.HasPrefix(contentType, "text/"):
+		if looksJSON(head, complete) {
+			return "application/json"
+		}
+		if strings.HasPrefix(contentType, "text/plain") {
+			return contentType
+		}
+		return "text/plain; charset=utf-8"
+	case contentType == "application/octet-stream" && looksProtobuf(head, complete):
+		return "application/x-protobuf"
+	}
+	return contentType
+}
+
+// looksJSON tells whether a text is a JSON object or array. Only the first
+// character is checked when head isn't the whole value.
+func looksJSON(head []byte, complete bool) bool {
+	text := bytes.TrimLeft(head, " \t\r\n")
+	if len(text) == 0 || (text[0] != '{' && text[0] != '[') {
+		return false
+	}
+	return !complete || json.Valid(text)
+}
+
+// looksProtobuf tells whether data parses as a sequence of protocol buffers
+// fields. When data is only the head of the value, its last field may be
+// truncated.
+func looksProtobuf(data []byte, complete bool) bool {
+	if len(data) == 0 {
+		return false
+	}
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return n == 0 && !complete
+		}
+		data = data[n:]
+		if key>>3 == 0 {
+			return false
+		}
+
+		var size uint64
+		switch key & 7 {
+		case 0:
+			if _, n = binary.Uvarint(data); n <= 0 {
+				return n == 0 && !complete
+			}
+			size = uint64(n)
+		case 1:
+			size = 8
+		case 2:
+			length, n := binary.Uvarint(data)
+			if n <= 0 {
+				return n == 0 && !complete
+			}
+			data = data[n:]
+			size = length
+		case 5:
+			size = 4
+		default:
+			return false
+		}
+		if uint64(len(data)) < size {
+			return !complete
+		}
+		data = data[size:]
+	}
+	return true
+}
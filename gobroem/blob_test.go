@@ -0,0 +1,133 @@
+package gobroem
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTableBlob(t *testing.T) {
+	a, h := newTestAPI(t, Options{})
+	large := bytes.Repeat([]byte("0123456789abcdef"), 8<<10)
+	png := append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 24)...)
+	mustExec(t, a,
+		"CREATE TABLE files (data, name TEXT)",
+		"INSERT INTO files (rowid, data) VALUES (1, x'"+hex.EncodeToString(large)+"')",
+		"INSERT INTO files (rowid, data) VALUES (2, x'"+hex.EncodeToString(png)+"')",
+		`INSERT INTO files (rowid, data) VALUES (3, '{"a": [1, 2]}')`,
+		"INSERT INTO files (rowid, data) VALUES (4, NULL)",
+		"INSERT INTO files (rowid, data) VALUES (5, '<html><script>x</script>')",
+		"CREATE TABLE keyed (k PRIMARY KEY, data) WITHOUT ROWID",
+		"INSERT INTO keyed VALUES (1, x'00')",
+	)
+
+	tests := []struct {
+		name        string
+		query       string
+		rangeHeader string
+		status      int
+		contentType string
+		body        []byte
+	}{
+		{"large", "table=files&column=data&rowid=1", "", http.StatusOK, "text/plain; charset=utf-8", large},
+		{"range", "table=files&column=data&rowid=1", "bytes=65530-65545", http.StatusPartialContent, "text/plain; charset=utf-8", large[65530:65546]},
+		{"suffix range", "table=files&column=data&rowid=1", "bytes=-4", http.StatusPartialContent, "text/plain; charset=utf-8", large[len(large)-4:]},
+		{"image", "table=files&column=data&rowid=2", "", http.StatusOK, "image/png", png},
+		{"json", "table=files&column=data&rowid=3", "", http.StatusOK, "application/json", []byte(`{"a": [1, 2]}`)},
+		{"markup", "table=files&column=data&rowid=5", "", http.StatusOK, "text/plain; charset=utf-8", []byte("<html><script>x</script>")},
+		{"hex", "table=files&column=data&rowid=2&format=hex", "", http.StatusOK, "image/png", []byte(hex.Dump(png))},
+		{"base64", "table=files&column=data&rowid=2&format=base64", "", http.StatusOK, "image/png", []byte(base64.StdEncoding.EncodeToString(png))},
+		{"null", "table=files&column=data&rowid=4", "", http.StatusNotFound, "", nil},
+		{"missing row", "table=files&column=data&rowid=9", "", http.StatusNotFound, "", nil},
+		{"missing column", "table=files&column=nope&rowid=1", "", http.StatusBadRequest, "", nil},
+		{"missing table", "table=nope&column=data&rowid=1", "", http.StatusNotFound, "", nil},
+		{"invalid rowid", "table=files&column=data&rowid=x", "", http.StatusBadRequest, "", nil},
+		{"invalid format", "table=files&column=data&rowid=1&format=png", "", http.StatusBadRequest, "", nil},
+		{"without rowid", "table=keyed&column=data&rowid=1", "", http.StatusBadRequest, "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/api/table/blob?"+tt.query, nil)
+			if tt.rangeHeader != "" {
+				req.Header.Set("Range", tt.rangeHeader)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("got status %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+			if tt.body == nil {
+				return
+			}
+			if got := w.Header().Get("X-Blob-Content-Type"); got != tt.contentType {
+				t.Errorf("content type: got %q, want %q", got, tt.contentType)
+			}
+			if !bytes.Equal(w.Body.Bytes(), tt.body) {
+				t.Errorf("got %d bytes %.40q, want %d bytes %.40q", w.Body.Len(), w.Body.Bytes(), len(tt.body), tt.body)
+			}
+		})
+	}
+
+	w := testRequest(t, h, "GET", "/api/table/blob?table=files&column=data&rowid=2&download=1", "")
+	if got, want := w.Header().Get("Content-Disposition"), `attachment; filename=files-data-2.png`; got != want {
+		t.Errorf("download: got %q, want %q", got, want)
+	}
+}
+
+func TestTableBlobLarge(t *testing.T) {
+	a, h := newTestAPI(t, Options{})
+	mustExec(t, a,
+		"CREATE TABLE files (data)",
+		"INSERT INTO files (rowid, data) VALUES (1, randomblob(32 << 20))",
+	)
+	var want []byte
+	if err := a.databases[0].client.QueryRow("SELECT data FROM files").Scan(&want); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reading the value a chunk at a time took minutes
+	start := time.Now()
+	w := testRequest(t, h, "GET", "/api/table/blob?table=files&column=data&rowid=1", "")
+	if w.Code != http.StatusOK || !bytes.Equal(w.Body.Bytes(), want) {
+		t.Fatalf("got status %d, %d bytes, want %d bytes", w.Code, w.Body.Len(), len(want))
+	}
+	if d := time.Since(start); d > 10*time.Second {
+		t.Errorf("got the value in %s", d)
+	}
+
+	req := httptest.NewRequest("GET", "/api/table/blob?table=files&column=data&rowid=1", nil)
+	req.Header.Set("Range", "bytes=33554000-")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusPartialContent || !bytes.Equal(w.Body.Bytes(), want[33554000:]) {
+		t.Errorf("range: got status %d, %d bytes", w.Code, w.Body.Len())
+	}
+}
+
+func TestSniffContentType(t *testing.T) {
+	tests := []struct {
+		name     string
+		head     string
+		complete bool
+		want     string
+	}{
+		{"gzip", "\x1f\x8b\x08\x00", true, "application/gzip"},
+		{"json object", ` {"a": 1}`, true, "application/json"},
+		{"invalid json", `{"a": `, true, "text/plain; charset=utf-8"},
+		{"json head", `[1, 2, `, false, "application/json"},
+		{"html", "<!DOCTYPE html><p>x", true, "text/plain; charset=utf-8"},
+		{"protobuf", "\x08\x96\x01\x12\x02hi", true, "application/x-protobuf"},
+		{"protobuf head", "\x08\x96\x01\x12\x09hi", false, "application/x-protobuf"},
+		{"truncated protobuf", "\x08\x96\x01\x12\x09hi", true, "application/octet-stream"},
+		{"binary", "\x00\x07\x00\x00", true, "application/octet-stream"},
+	}
+	for _, tt := range tests {
+		if got := sniffContentType([]byte(tt.head), tt.complete); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
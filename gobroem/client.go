@@ -2,115 +2,454 @@ package gobroem
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
+	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 
-	// include sqlite
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/mattn/go-sqlite3"
 )
 
 const (
-	queryInfo         = `SELECT * FROM (SELECT COUNT (*) AS count FROM sqlite_master WHERE type='table') AS count_tables, (SELECT COUNT (*) AS count FROM sqlite_master WHERE type='index') AS count_indexes;`
-	queryTables       = `SELECT name FROM sqlite_master WHERE type='table';`
-	queryTableSchema  = `PRAGMA table_info(%s);`
+	queryInfo         = `SELECT * FROM (SELECT COUNT (*) AS count FROM %[1]s WHERE type='table' AND sql NOT LIKE 'CREATE VIRTUAL TABLE%%') AS count_tables, (SELECT COUNT (*) AS count FROM %[1]s WHERE type='index') AS count_indexes, (SELECT COUNT (*) AS count FROM %[1]s WHERE type='view') AS count_views, (SELECT COUNT (*) AS count FROM %[1]s WHERE type='trigger') AS count_triggers, (SELECT COUNT (*) AS count FROM %[1]s WHERE type='table' AND sql LIKE 'CREATE VIRTUAL TABLE%%') AS count_virtual_tables;`
+	queryObjects      = `SELECT type, name, tbl_name, sql FROM %s WHERE type IN ('table', 'view', 'trigger', 'index');`
 	queryTableInfo    = `SELECT COUNT(*) FROM %s;`
-	queryTableSQL     = `SELECT sql FROM sqlite_master WHERE type='table' AND name='%s'`
-	queryTableIndexes = `SELECT * FROM sqlite_master WHERE type='index' AND tbl_name='%s'`
+	queryTableSQL     = `SELECT type, sql FROM %s WHERE name=? ORDER BY type='trigger'`
+	queryTableIndexes = `SELECT name, sql FROM %s WHERE type='index' AND tbl_name=?`
+)
+
+// inputError reports an invalid request parameter, such as an unknown column
+// or a malformed filter.
+type inputError string
+
+func (e inputError) Error() string {
+	return string(e)
+}
+
+// notFoundError reports a missing table or row.
+type notFoundError string
+
+func (e notFoundError) Error() string {
+	return string(e)
+}
+
+func errNoSuchTable(table string) error {
+	return notFoundError("no such table: " + table)
+}
+
+var (
+	errReadOnly         = errors.New("database is read-only, only statements reading it are allowed")
+	errAttachStatement  = inputError("ATTACH and DETACH can't run in a query, use POST and DELETE api/schemas")
+	errGuardUnsupported = errors.New("running queries requires the go-sqlite3 driver")
 )
 
 // sqlClient is a wrapper around sql.DB
 type sqlClient struct {
 	*sql.DB
+	readOnly bool
+	// schema is the database holding the tables, main if empty
+	schema string
+	// attached is nil when the DB is not opened by the client
+	attached *attachments
+	// attachAllowlist holds the glob patterns of the files which can be
+	// attached
+	attachAllowlist []string
 }
 
 type sqlRow []interface{}
 
 type sqlResult struct {
 	Columns []string `json:"columns"`
-	Rows    []sqlRow `json:"rows"`
+	// Types holds the declared types of the columns with the typed encoding
+	Types []string `json:"types,omitempty"`
+	Rows  []sqlRow `json:"rows"`
 }
 
-func newClient(file string) (*sqlClient, error) {
-	db, err := sql.Open("sqlite3", file)
-	if err != nil {
-		return nil, err
+// newClient opens the database file. The files matching the attachAllowlist
+// patterns can be attached to it.
+func newClient(file string, readOnly bool, attachAllowlist []string) (*sqlClient, error) {
+	dsn := file
+	if readOnly {
+		dsn = readOnlyDSN(file)
 	}
-	return &sqlClient{db}, nil
+
+	attached := &attachments{}
+	return &sqlClient{DB: openDB(dsn, attached), readOnly: readOnly, attached: attached, attachAllowlist: attachAllowlist}, nil
 }
 
-func newClientFromDB(db *sql.DB) (*sqlClient, error) {
-	return &sqlClient{db}, nil
+func newClientFromDB(db *sql.DB, readOnly bool) (*sqlClient, error) {
+	return &sqlClient{DB: db, readOnly: readOnly}, nil
 }
 
-func (client *sqlClient) Info() (*sqlResult, error) {
-	return client.query(queryInfo)
+// readOnlyDSN returns the URI opening the file in read-only mode, with writes
+// also disabled at the connection level.
+func readOnlyDSN(file string) string {
+	file = strings.TrimPrefix(file, "file:")
+	if i := strings.IndexByte(file, '?'); i >= 0 && strings.Contains(file[i:], "=") {
+		// Keep the parameters of a DSN
+		return "file:" + file + "&mode=ro&_query_only=1"
+	}
+
+	return "file:" + escapeURIPath(file) + "?mode=ro&_query_only=1"
 }
 
-func (client *sqlClient) Tables() ([]string, error) {
-	return client.fetchRows(queryTables)
+func (client *sqlClient) Info(ctx context.Context) (*sqlResult, error) {
+	return client.query(ctx, fmt.Sprintf(queryInfo, client.master()))
 }
 
-func (client *sqlClient) TableInfo(table string) (*sqlResult, error) {
-	return client.query(fmt.Sprintf(queryTableInfo, table))
+func (client *sqlClient) TableInfo(ctx context.Context, table string) (*sqlResult, error) {
+	return client.query(ctx, fmt.Sprintf(queryTableInfo, client.qualify(table)))
 }
 
 // Table returns the table structure.
-func (client *sqlClient) Table(table string) (*sqlResult, error) {
-	return client.query(fmt.Sprintf(queryTableSchema, table))
+func (client *sqlClient) Table(ctx context.Context, table string) (*sqlResult, error) {
+	return client.query(ctx, client.pragma("table_info", table))
 }
 
-// TableSQL returns the SQL used to create the given table.
-func (client *sqlClient) TableSQL(table string) ([]string, error) {
-	return client.fetchRows(fmt.Sprintf(queryTableSQL, table))
+// TableSQL returns the type of the given schema object, e.g. a table or a
+// view, and the SQL used to create it. A table, view or index is preferred
+// over a trigger of the same name.
+func (client *sqlClient) TableSQL(ctx context.Context, name string) (*sqlResult, error) {
+	return client.query(ctx, fmt.Sprintf(queryTableSQL, client.master()), name)
 }
 
-// TableIndexes returns the indexes for the given table.
-func (client *sqlClient) TableIndexes(table string) (*sqlResult, error) {
-	return client.query(fmt.Sprintf(queryTableIndexes, table))
+// QuerySQL runs a user supplied query with its parameters, once checked with
+// checkQuery.
+func (client *sqlClient) QuerySQL(ctx context.Context, query string, args ...interface{}) (*sqlResult, error) {
+	if err := client.checkQuery(ctx, query); err != nil {
+		return nil, err
+	}
+
+	conn, err := client.guardedConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	result, err := queryResult(ctx, conn, query, args...)
+	return result, conn.err(err)
 }
 
-func (client *sqlClient) QuerySQL(query string) (*sqlResult, error) {
-	return client.query(query)
+// StreamSQL runs a user supplied query like QuerySQL, handing the rows to w
+// as they are scanned, encoded with enc. At most maxRows rows are read if
+// maxRows is positive.
+func (client *sqlClient) StreamSQL(ctx context.Context, w rowWriter, maxRows int, enc valueEncoding, query string, args ...interface{}) error {
+	if err := client.checkQuery(ctx, query); err != nil {
+		return err
+	}
+	return client.streamQuery(ctx, w, maxRows, enc, query, args...)
 }
 
-func (client *sqlClient) query(query string, args ...interface{}) (*sqlResult, error) {
-	rows, err := client.Query(query, args...)
+// checkQuery checks the statements of a user supplied query before it runs.
+// In read-only mode every statement must be a read. ATTACH and DETACH are
+// always denied: they would only change the single connection of the pool
+// running them. The statements also run on a guardedConn, which denies them
+// again should SQLite split the query differently.
+func (client *sqlClient) checkQuery(ctx context.Context, query string) error {
+	if client.readOnly {
+		return client.checkReadOnly(ctx, query)
+	}
+
+	conn, err := client.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn interface{}) error {
+		c, ok := sqliteConn(driverConn)
+		if !ok {
+			return errGuardUnsupported
+		}
+
+		denied := false
+		c.RegisterAuthorizer(func(op int, _, _, _ string) int {
+			if isAttachAction(op) {
+				denied = true
+				return sqlite3.SQLITE_DENY
+			}
+			return sqlite3.SQLITE_OK
+		})
+		defer c.RegisterAuthorizer(nil)
+
+		for _, stmt := range splitStatements(query) {
+			s, err := c.Prepare(stmt)
+			if denied {
+				return errAttachStatement
+			}
+			// Other errors are reported when the statement runs: it may use
+			// a table created by a previous one
+			if err == nil {
+				s.Close()
+			}
+		}
+		return nil
+	})
+}
+
+// isAttachAction reports whether an action checked by the SQLite authorizer
+// attaches or detaches a database.
+func isAttachAction(op int) bool {
+	return op == sqlite3.SQLITE_ATTACH || op == sqlite3.SQLITE_DETACH
+}
+
+// guardedConn is a connection of the pool running user supplied statements.
+// Its authorizer denies ATTACH and DETACH while they are prepared, until it
+// is closed.
+type guardedConn struct {
+	*sql.Conn
+	// prepared is set once a user supplied statement is prepared: VACUUM
+	// attaches a temporary database when it runs
+	prepared bool
+	// denied is set once a statement is denied
+	denied bool
+}
+
+// guardedConn returns a connection of the pool denying ATTACH and DETACH.
+func (client *sqlClient) guardedConn(ctx context.Context) (*guardedConn, error) {
+	conn, err := client.Conn(ctx)
 	if err != nil {
 		return nil, err
 	}
+
+	g := &guardedConn{Conn: conn}
+	err = conn.Raw(func(driverConn interface{}) error {
+		c, ok := sqliteConn(driverConn)
+		if !ok {
+			return errGuardUnsupported
+		}
+		c.RegisterAuthorizer(func(op int, _, _, _ string) int {
+			if isAttachAction(op) && !g.prepared {
+				g.denied = true
+				return sqlite3.SQLITE_DENY
+			}
+			return sqlite3.SQLITE_OK
+		})
+		return nil
+	})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return g, nil
+}
+
+// QueryContext runs a user supplied query on the connection.
+func (g *guardedConn) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return g.query(ctx, g.Conn, query, args...)
+}
+
+// query runs a user supplied query with q, the connection or a transaction
+// of it. go-sqlite3 prepares all the statements of the query before stepping
+// the last one, on the first Next.
+func (g *guardedConn) query(ctx context.Context, q queryer, query string, args ...interface{}) (*sql.Rows, error) {
+	g.prepared = false
+	rows, err := q.QueryContext(ctx, query, args...)
+	g.prepared = err == nil
+	return rows, g.err(err)
+}
+
+// err returns errAttachStatement in place of the error of a denied
+// statement.
+func (g *guardedConn) err(err error) error {
+	if err != nil && g.denied {
+		return errAttachStatement
+	}
+	return err
+}
+
+// Close removes the authorizer and returns the connection to the pool.
+func (g *guardedConn) Close() error {
+	g.Raw(func(driverConn interface{}) error {
+		if c, ok := sqliteConn(driverConn); ok {
+			c.RegisterAuthorizer(nil)
+		}
+		return nil
+	})
+	return g.Conn.Close()
+}
+
+// checkReadOnly returns errReadOnly unless all the statements of the query
+// only read the database, as reported by SQLite. ATTACH, DETACH and the
+// pragmas setting a value are reported as reads by SQLite, so they are
+// denied while the statements are prepared; ATTACH and DETACH with
+// errAttachStatement.
+func (client *sqlClient) checkReadOnly(ctx context.Context, query string) error {
+	conn, err := client.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn interface{}) error {
+		c, ok := sqliteConn(driverConn)
+		if !ok {
+			return errGuardUnsupported
+		}
+
+		denied, attach := false, false
+		c.RegisterAuthorizer(func(op int, arg1, arg2, _ string) int {
+			if !readOnlyAction(op, arg1, arg2) {
+				denied = true
+				attach = attach || isAttachAction(op)
+				return sqlite3.SQLITE_DENY
+			}
+			return sqlite3.SQLITE_OK
+		})
+		defer c.RegisterAuthorizer(nil)
+
+		for _, stmt := range splitStatements(query) {
+			s, err := c.Prepare(stmt)
+			if attach {
+				return errAttachStatement
+			}
+			if denied {
+				return errReadOnly
+			}
+			if err != nil {
+				return err
+			}
+			readOnly := s.(*sqlite3.SQLiteStmt).Readonly()
+			s.Close()
+			if !readOnly {
+				return errReadOnly
+			}
+		}
+		return nil
+	})
+}
+
+// readPragmas are the pragmas taking an argument which only read the
+// database.
+var readPragmas = map[string]bool{
+	"foreign_key_check": true,
+	"foreign_key_list":  true,
+	"index_info":        true,
+	"index_list":        true,
+	"index_xinfo":       true,
+	"integrity_check":   true,
+	"quick_check":       true,
+	"table_info":        true,
+	"table_list":        true,
+	"table_xinfo":       true,
+}
+
+// writePragmas are the pragmas which change the database even without an
+// argument.
+var writePragmas = map[string]bool{
+	"incremental_vacuum": true,
+	"optimize":           true,
+	"wal_checkpoint":     true,
+}
+
+// readOnlyAction reports whether an action checked by the SQLite authorizer
+// leaves the databases of the connection as they are. The arguments are the
+// ones of the authorizer: for a pragma, its name and argument.
+func readOnlyAction(op int, arg1, arg2 string) bool {
+	switch op {
+	case sqlite3.SQLITE_ATTACH, sqlite3.SQLITE_DETACH:
+		return false
+	case sqlite3.SQLITE_PRAGMA:
+		name := strings.ToLower(arg1)
+		if writePragmas[name] {
+			return false
+		}
+		return arg2 == "" || readPragmas[name]
+	}
+	return true
+}
+
+// isReadOnlyError reports whether err is SQLite refusing to write.
+func isReadOnlyError(err error) bool {
+	if err == errReadOnly {
+		return true
+	}
+	sqliteErr, ok := err.(sqlite3.Error)
+	return ok && sqliteErr.Code == sqlite3.ErrReadonly
+}
+
+// queryer is implemented by both sql.DB and sql.Tx.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+func (client *sqlClient) query(ctx context.Context, query string, args ...interface{}) (*sqlResult, error) {
+	return queryResult(ctx, client.DB, query, args...)
+}
+
+// queryResult runs the query with q and collects all the rows.
+func queryResult(ctx context.Context, q queryer, query string, args ...interface{}) (*sqlResult, error) {
+	return queryEncoded(ctx, q, valueEncoding{}, query, args...)
+}
+
+// queryEncoded runs the query with q and collects all the rows, encoded with
+// enc.
+func queryEncoded(ctx context.Context, q queryer, enc valueEncoding, query string, args ...interface{}) (*sqlResult, error) {
+	result, _, err := queryEncodedLast(ctx, q, enc, query, args...)
+	return result, err
+}
+
+// queryEncodedLast is queryEncoded also returning the scanned values of the
+// last row, nil if there are no rows.
+func queryEncodedLast(ctx context.Context, q queryer, enc valueEncoding, query string, args ...interface{}) (*sqlResult, []interface{}, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, err
+	}
 	defer rows.Close()
 
 	columns, err := rows.Columns()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	result := &sqlResult{Columns: columns}
+	// Init an empty slice; otherwise JSON marshal will encode it to "null"
+	result := &sqlResult{Columns: columns, Types: enc.declaredTypes(rows), Rows: make([]sqlRow, 0)}
 
+	var last []interface{}
 	for rows.Next() {
 		cols, err := SliceScan(rows)
 		if err != nil {
 			continue
 		}
 
-		for i, item := range cols {
-			if item == nil {
-				cols[i] = nil
-			} else {
-				t := reflect.TypeOf(item).Kind().String()
+		// The plain encoding converts the blobs in place
+		last = append(last[:0], cols...)
+		result.Rows = append(result.Rows, enc.encodeRow(cols))
+	}
+
+	return result, last, rows.Err()
+}
+
+// textValues converts the byte slices of a scanned row to strings.
+func textValues(cols []interface{}) sqlRow {
+	for i, item := range cols {
+		if item == nil {
+			cols[i] = nil
+		} else {
+			t := reflect.TypeOf(item).Kind().String()
 
-				if t == "slice" {
-					cols[i] = string(item.([]byte))
-				}
+			if t == "slice" {
+				cols[i] = string(item.([]byte))
 			}
 		}
-
-		result.Rows = append(result.Rows, cols)
 	}
+	return cols
+}
+
+// quoteIdent quotes an SQL identifier such as a table or column name, so
+// names holding quotes, spaces or keywords are used verbatim. Identifiers
+// can't hold NUL characters, see validIdent.
+func quoteIdent(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
 
-	return result, nil
+// validIdent reports whether name can be quoted as an identifier.
+func validIdent(name string) bool {
+	return name != "" && strings.IndexByte(name, 0) < 0
 }
 
 // SliceScan a row, returning a []interface{} with values similar to MapScan.
@@ -145,8 +484,8 @@ func SliceScan(r *sql.Rows) ([]interface{}, error) {
 
 // fetchRows return a string slice of all rows for the first column in the
 // query result.
-func (client *sqlClient) fetchRows(query string) ([]string, error) {
-	res, err := client.query(query)
+func (client *sqlClient) fetchRows(ctx context.Context, query string, args ...interface{}) ([]string, error) {
+	res, err := client.query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -155,7 +494,8 @@ func (client *sqlClient) fetchRows(query string) ([]string, error) {
 	results := make([]string, 0)
 
 	for _, row := range res.Rows {
-		results = append(results, row[0].(string))
+		value, _ := row[0].(string)
+		results = append(results, value)
 	}
 
 	return results, nil
@@ -187,19 +527,29 @@ func (res *sqlResult) CSV() []byte {
 
 	// Write the values
 	for _, row := range res.Rows {
-		record := make([]string, len(row))
-
-		for i, val := range row {
-			var v string
-			if val != nil {
-				v = fmt.Sprintf("%v", val)
-			} else {
-				v = ""
-			}
-			record[i] = v
-		}
-		writer.Write(record)
+		writer.Write(csvRecord(row))
 	}
 
+	writer.Flush()
 	return buf.Bytes()
 }
+
+// csvRecord formats the values of a row for a CSV file.
+func csvRecord(row sqlRow) []string {
+	record := make([]string, len(row))
+
+	for i, val := range row {
+		if t, ok := val.(encodedValue); ok {
+			val = t.Value
+		}
+
+		var v string
+		if val != nil {
+			v = fmt.Sprintf("%v", val)
+		} else {
+			v = ""
+		}
+		record[i] = v
+	}
+	return record
+}
@@ -0,0 +1,164 @@
+package gobroem
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+func TestReadOnlyStatements(t *testing.T) {
+	_, h := newTestAPI(t, Options{ReadOnly: true})
+	attached := filepath.Join(t.TempDir(), "attached.db")
+
+	tests := []struct {
+		name   string
+		query  string
+		script bool
+		status int
+	}{
+		{"select", "SELECT count(*) FROM artists", false, http.StatusOK},
+		{"pragma read", "PRAGMA query_only", false, http.StatusOK},
+		{"pragma with read argument", "PRAGMA table_info(artists)", false, http.StatusOK},
+		{"pragma function", "SELECT * FROM pragma_table_info('artists')", false, http.StatusOK},
+		{"insert", "INSERT INTO artists (Name) VALUES ('x')", false, http.StatusForbidden},
+		{"second statement", "SELECT 1; DELETE FROM artists", false, http.StatusForbidden},
+		{"pragma assignment", "PRAGMA query_only=0", false, http.StatusForbidden},
+		{"pragma call", "PRAGMA user_version(5)", false, http.StatusForbidden},
+		{"schema pragma", "PRAGMA main.journal_mode=DELETE", false, http.StatusForbidden},
+		{"checkpoint", "PRAGMA wal_checkpoint", false, http.StatusForbidden},
+		{"schema checkpoint", "PRAGMA main.wal_checkpoint", false, http.StatusForbidden},
+		{"optimize", "PRAGMA optimize", false, http.StatusForbidden},
+		{"incremental vacuum", "PRAGMA incremental_vacuum", false, http.StatusForbidden},
+		{"optimize in script", "SELECT 1; PRAGMA optimize", true, http.StatusForbidden},
+		{"attach", "ATTACH '" + attached + "' AS z", false, http.StatusBadRequest},
+		{"attach in script", "SELECT 1; ATTACH '" + attached + "' AS z", true, http.StatusBadRequest},
+		{"detach", "DETACH temp", false, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := url.Values{"query": {tt.query}}
+			if tt.script {
+				form.Set("script", "1")
+			}
+			w := testRequest(t, h, "POST", "/api/query", form.Encode())
+			if w.Code != tt.status {
+				t.Fatalf("got status %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+		})
+	}
+
+	if _, err := os.Stat(attached); !os.IsNotExist(err) {
+		t.Errorf("ATTACH created %s", attached)
+	}
+	var result sqlResult
+	testJSON(t, h, "POST", "/api/query", "query=PRAGMA+query_only", http.StatusOK, &result)
+	if len(result.Rows) != 1 || result.Rows[0][0] != float64(1) {
+		t.Errorf("query_only: got %v, want 1", result.Rows)
+	}
+}
+
+func TestReadOnlyAction(t *testing.T) {
+	tests := []struct {
+		op         int
+		arg1, arg2 string
+		want       bool
+	}{
+		{sqlite3.SQLITE_READ, "artists", "Name", true},
+		{sqlite3.SQLITE_PRAGMA, "query_only", "", true},
+		{sqlite3.SQLITE_PRAGMA, "query_only", "0", false},
+		{sqlite3.SQLITE_PRAGMA, "TABLE_INFO", "artists", true},
+		{sqlite3.SQLITE_PRAGMA, "integrity_check", "10", true},
+		{sqlite3.SQLITE_PRAGMA, "user_version", "5", false},
+		{sqlite3.SQLITE_PRAGMA, "wal_checkpoint", "", false},
+		{sqlite3.SQLITE_PRAGMA, "WAL_CHECKPOINT", "TRUNCATE", false},
+		{sqlite3.SQLITE_PRAGMA, "optimize", "", false},
+		{sqlite3.SQLITE_PRAGMA, "incremental_vacuum", "", false},
+		{sqlite3.SQLITE_ATTACH, "x.db", "", false},
+		{sqlite3.SQLITE_DETACH, "x", "", false},
+	}
+	for _, tt := range tests {
+		if got := readOnlyAction(tt.op, tt.arg1, tt.arg2); got != tt.want {
+			t.Errorf("readOnlyAction(%d, %q, %q) = %v, want %v", tt.op, tt.arg1, tt.arg2, got, tt.want)
+		}
+	}
+}
+
+func TestHostileNames(t *testing.T) {
+	names := []string{`we"ird`, `br]acket`, `semi;colon`, `sp ace`, `ünïcødé`, `order`, `x"; DROP TABLE artists; --`}
+	for _, name := range names {
+		t.Run(name, func(t *testing.T) {
+			a, h := newTestAPI(t, Options{})
+			column, index := name+" col", name+" idx"
+			mustExec(t, a,
+				"CREATE TABLE "+quoteIdent(name)+" (id INTEGER PRIMARY KEY, "+quoteIdent(column)+" TEXT)",
+				"CREATE INDEX "+quoteIdent(index)+" ON "+quoteIdent(name)+" ("+quoteIdent(column)+")")
+			table := url.QueryEscape(name)
+
+			var tables struct {
+				Tables []string `json:"tables"`
+			}
+			testJSON(t, h, "GET", "/api/tables", "", http.StatusOK, &tables)
+			if !containsString(tables.Tables, name) {
+				t.Errorf("tables: %q not in %q", name, tables.Tables)
+			}
+
+			var columns []map[string]interface{}
+			testJSON(t, h, "GET", "/api/table?table="+table, "", http.StatusOK, &columns)
+			if len(columns) != 2 || columns[1]["name"] != column {
+				t.Errorf("columns: got %v, want id and %q", columns, column)
+			}
+
+			var indexes []indexInfo
+			testJSON(t, h, "GET", "/api/table/indexes?table="+table, "", http.StatusOK, &indexes)
+			if len(indexes) != 1 || indexes[0].Name != index || len(indexes[0].Columns) != 1 || indexes[0].Columns[0].Name != column {
+				t.Errorf("indexes: got %+v, want %q on %q", indexes, index, column)
+			}
+
+			body, _ := json.Marshal(map[string]string{column: "a"})
+			testJSON(t, h, "POST", "/api/table/rows?table="+table, string(body), http.StatusCreated, nil)
+			body, _ = json.Marshal(map[string]string{column: "b"})
+			testJSON(t, h, "PATCH", "/api/table/rows?table="+table+"&key="+url.QueryEscape(`{"id":1}`), string(body), http.StatusOK, nil)
+
+			filters, _ := json.Marshal([]sqlFilter{{Column: column, Op: "=", Value: "b"}})
+			var page testPage
+			testJSON(t, h, "GET", "/api/table/rows?table="+table+"&order="+url.QueryEscape(column)+"&filters="+url.QueryEscape(string(filters)), "", http.StatusOK, &page)
+			if len(page.Rows) != 1 || page.Rows[0][1] != "b" {
+				t.Errorf("rows: got %v, want one row with b", page.Rows)
+			}
+			testJSON(t, h, "GET", "/api/table/rows?table="+table+"&cursor=&order="+url.QueryEscape(column), "", http.StatusOK, &page)
+			if len(page.Rows) != 1 {
+				t.Errorf("keyset rows: got %v, want one row", page.Rows)
+			}
+
+			var info map[string]interface{}
+			testJSON(t, h, "GET", "/api/table/info?table="+table, "", http.StatusOK, &info)
+			if info["row_count"] != float64(1) || info["indexes_count"] != float64(1) {
+				t.Errorf("info: got %v, want 1 row and 1 index", info)
+			}
+
+			testJSON(t, h, "DELETE", "/api/table/rows?table="+table+"&key="+url.QueryEscape(`{"id":1}`), "", http.StatusOK, nil)
+			testJSON(t, h, "GET", "/api/table/info?table="+table, "", http.StatusOK, &info)
+			if info["row_count"] != float64(0) {
+				t.Errorf("info after delete: got %v, want 0 rows", info)
+			}
+			testJSON(t, h, "GET", "/api/table/info?table=artists", "", http.StatusOK, nil)
+		})
+	}
+
+	_, h := newTestAPI(t, Options{})
+	for _, endpoint := range []string{"table", "table/info", "table/sql", "table/indexes", "table/rows"} {
+		w := testRequest(t, h, "GET", "/api/"+endpoint+"?table=a%00b", "")
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s with NUL: got status %d, want 400", endpoint, w.Code)
+		}
+	}
+	w := testRequest(t, h, "GET", "/api/table/rows?table=artists&order=a%00b", "")
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("order with NUL: got status %d, want 400", w.Code)
+	}
+}
@@ -0,0 +1,287 @@
+package gobroem
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	queryDumpObjects  = `SELECT type, name, tbl_name, sql FROM %s WHERE sql IS NOT NULL AND name NOT LIKE 'sqlite\_%%' ESCAPE '\' ORDER BY rowid`
+	queryShadowTables = `SELECT name FROM pragma_table_list WHERE schema = ? AND type = 'shadow'`
+	queryReferences   = `SELECT DISTINCT "table" FROM pragma_foreign_key_list(?, ?)`
+	queryDumpColumns  = `SELECT name FROM pragma_table_xinfo(?, ?) WHERE hidden = 0 ORDER BY cid`
+	querySequences    = `SELECT name, seq FROM %s`
+
+	// dumpBatchRows is the number of rows of an INSERT statement of a dump
+	dumpBatchRows = 100
+)
+
+// dumpTable is a table of a dump with the columns of its INSERT statements.
+type dumpTable struct {
+	schemaObject
+	sql     string
+	columns []string
+}
+
+// Dump writes an SQL script recreating the client schema: the tables, in an
+// order where a table follows the tables it references, with their rows, then
+// the indexes, views and triggers. If tables is not empty, only these tables
+// with their indexes and triggers are dumped. The database is read in a
+// single transaction, so the dump is consistent.
+func (client *sqlClient) Dump(ctx context.Context, w io.Writer, tables []string) error {
+	tx, err := client.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	schema := client.schemaName()
+	objects, err := queryResult(ctx, tx, fmt.Sprintf(queryDumpObjects, client.master()))
+	if err != nil {
+		return err
+	}
+	shadows, err := queryResult(ctx, tx, queryShadowTables, schema)
+	if err != nil {
+		return err
+	}
+	isShadow := make(map[string]bool)
+	for _, row := range shadows.Rows {
+		name, _ := row[0].(string)
+		isShadow[name] = true
+	}
+
+	selected := make(map[string]bool)
+	for _, t := range tables {
+		selected[t] = true
+	}
+
+	var dumped []*dumpTable
+	var others []dumpTable
+	for _, row := range objects.Rows {
+		var t dumpTable
+		objectType, _ := row[0].(string)
+		t.Name, _ = row[1].(string)
+		t.Table, _ = row[2].(string)
+		t.sql, _ = row[3].(string)
+
+		switch {
+		case len(selected) > 0 && !selected[t.Table]:
+		case objectType == "table" && !isShadow[t.Name]:
+			dumped = append(dumped, &t)
+		case objectType == "index" || objectType == "trigger":
+			others = append(others, t)
+		// A view may read any table, so it is only dumped with the whole schema
+		case objectType == "view" && len(selected) == 0:
+			others = append(others, t)
+		}
+	}
+	for _, t := range tables {
+		if !containsTable(dumped, t) {
+			return errNoSuchTable(t)
+		}
+	}
+
+	for _, t := range dumped {
+		columns, err := queryResult(ctx, tx, queryDumpColumns, t.Name, schema)
+		if err != nil {
+			return err
+		}
+		for _, row := range columns.Rows {
+			name, _ := row[0].(string)
+			t.columns = append(t.columns, name)
+		}
+	}
+	dumped, err = sortByReferences(ctx, tx, dumped, schema)
+	if err != nil {
+		return err
+	}
+
+	out := bufio.NewWriter(w)
+	out.WriteString("PRAGMA foreign_keys=OFF;\nBEGIN TRANSACTION;\n")
+	for _, t := range dumped {
+		out.WriteString(t.sql + ";\n")
+		if err := client.dumpRows(ctx, tx, out, t); err != nil {
+			return err
+		}
+	}
+	if err := client.dumpSequences(ctx, tx, out, dumped); err != nil {
+		return err
+	}
+	for _, o := range others {
+		out.WriteString(o.sql + ";\n")
+	}
+	out.WriteString("COMMIT;\n")
+
+	return out.Flush()
+}
+
+// dumpRows writes the rows of the table as batched INSERT statements.
+func (client *sqlClient) dumpRows(ctx context.Context, q queryer, w *bufio.Writer, t *dumpTable) error {
+	if len(t.columns) == 0 {
+		return nil
+	}
+
+	// The unary + keeps the declared type from converting the values, e.g.
+	// the dates to time.Time
+	selected := make([]string, len(t.columns))
+	quoted := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		quoted[i] = quoteIdent(c)
+		selected[i] = "+" + quoted[i]
+	}
+	rows, err := q.QueryContext(ctx, "SELECT "+strings.Join(selected, ", ")+" FROM "+client.qualify(t.Name))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	insert := "INSERT INTO " + quoteIdent(t.Name) + " (" + strings.Join(quoted, ", ") + ") VALUES\n"
+	count := 0
+	for rows.Next() {
+		values, err := SliceScan(rows)
+		if err != nil {
+			return err
+		}
+
+		if count%dumpBatchRows == 0 {
+			if count > 0 {
+				w.WriteString(";\n")
+			}
+			w.WriteString(insert)
+		} else {
+			w.WriteString(",\n")
+		}
+		w.WriteByte('(')
+		for i, v := range values {
+			if i > 0 {
+				w.WriteString(", ")
+			}
+			w.WriteString(sqlLiteral(v))
+		}
+		w.WriteByte(')')
+		count++
+	}
+	if count > 0 {
+		w.WriteString(";\n")
+	}
+	return rows.Err()
+}
+
+// dumpSequences writes the AUTOINCREMENT counters of the dumped tables.
+func (client *sqlClient) dumpSequences(ctx context.Context, q queryer, w *bufio.Writer, tables []*dumpTable) error {
+	result, err := queryResult(ctx, q, fmt.Sprintf(querySequences, client.qualify("sqlite_sequence")))
+	if err != nil {
+		// There is no sqlite_sequence table without AUTOINCREMENT
+		return nil
+	}
+
+	for _, row := range result.Rows {
+		name, _ := row[0].(string)
+		if containsTable(tables, name) {
+			fmt.Fprintf(w, "DELETE FROM sqlite_sequence WHERE name=%s;\n", sqlLiteral(name))
+			fmt.Fprintf(w, "INSERT INTO sqlite_sequence (name, seq) VALUES (%s, %s);\n", sqlLiteral(name), sqlLiteral(row[1]))
+		}
+	}
+	return nil
+}
+
+// sortByReferences orders the tables so a table follows the tables its foreign
+// keys reference. The tables of a reference cycle keep their order.
+func sortByReferences(ctx context.Context, q queryer, tables []*dumpTable, schema string) ([]*dumpTable, error) {
+	references := make(map[string][]string)
+	for _, t := range tables {
+		result, err := queryResult(ctx, q, queryReferences, t.Name, schema)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range result.Rows {
+			name, _ := row[0].(string)
+			references[t.Name] = append(references[t.Name], name)
+		}
+	}
+
+	sorted := make([]*dumpTable, 0, len(tables))
+	visited := make(map[string]bool)
+	var visit func(t *dumpTable)
+	visit = func(t *dumpTable) {
+		if visited[t.Name] {
+			return
+		}
+		visited[t.Name] = true
+		for _, name := range references[t.Name] {
+			for _, r := range tables {
+				if strings.EqualFold(r.Name, name) {
+					visit(r)
+				}
+			}
+		}
+		sorted = append(sorted, t)
+	}
+	for _, t := range tables {
+		visit(t)
+	}
+	return sorted, nil
+}
+
+func containsTable(tables []*dumpTable, name string) bool {
+	for _, t := range tables {
+		if t.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// sqlLiteral returns the SQL literal of a scanned value. A real keeps a
+// decimal point so it is read back as a real, and a text holding NUL
+// characters is written as a blob cast to text.
+func sqlLiteral(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "NULL"
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		switch {
+		case math.IsInf(v, 1):
+			return "1e999"
+		case math.IsInf(v, -1):
+			return "-1e999"
+		case math.IsNaN(v):
+			return "NULL"
+		}
+		s := strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".e") {
+			s += ".0"
+		}
+		return s
+	case []byte:
+		return "X'" + strings.ToUpper(hex.EncodeToString(v)) + "'"
+	case string:
+		if strings.IndexByte(v, 0) >= 0 {
+			return "CAST(X'" + strings.ToUpper(hex.EncodeToString([]byte(v))) + "' AS TEXT)"
+		}
+		return "'" + strings.Replace(v, "'", "''", -1) + "'"
+	default:
+		return sqlLiteral(fmt.Sprint(v))
+	}
+}
+
+// DumpFile writes an SQL script recreating the database of a file, opened
+// read-only, as the api/export/sql endpoint does. If tables is not empty,
+// only these tables are dumped.
+func DumpFile(ctx context.Context, file string, w io.Writer, tables []string) error {
+	client, err := newClient(file, true, nil)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return client.Dump(ctx, w, tables)
+}
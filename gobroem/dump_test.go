@@ -0,0 +1,194 @@
+package gobroem
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// tableContents returns the rows of every table of the database, quoted so
+// that the storage classes are compared too, and the SQL of its objects. The
+// internal tables, which a dump leaves out, are skipped.
+func tableContents(t *testing.T, client *sqlClient) map[string][]string {
+	t.Helper()
+	contents := make(map[string][]string)
+	objects, err := client.query(context.Background(), `SELECT type, name, sql FROM sqlite_master WHERE name NOT LIKE 'sqlite\_%' ESCAPE '\' ORDER BY name`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range objects.Rows {
+		name := row[1].(string)
+		contents["sql "+name] = []string{row[2].(string)}
+		if row[0] != "table" {
+			continue
+		}
+
+		columns, err := client.tableColumns(context.Background(), name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		quoted := make([]string, len(columns))
+		order := make([]string, len(columns))
+		for i, c := range columns {
+			quoted[i] = "quote(" + quoteIdent(c) + ")"
+			order[i] = quoteIdent(c)
+		}
+		query := "SELECT " + strings.Join(quoted, " || ',' || ") + " FROM " + quoteIdent(name) + " ORDER BY " + strings.Join(order, ", ")
+		result, err := client.query(context.Background(), query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rows := make([]string, 0, len(result.Rows))
+		for _, r := range result.Rows {
+			rows = append(rows, r[0].(string))
+		}
+		contents[name] = rows
+	}
+	return contents
+}
+
+func TestDumpRestore(t *testing.T) {
+	source, err := newClient(copyTestDB(t), false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer source.Close()
+	for _, stmt := range []string{
+		// The child is created first, but has to be restored after its parent
+		`CREATE TABLE "child ""x""" (id INTEGER PRIMARY KEY, parent INTEGER REFERENCES "parent's" (id))`,
+		`CREATE TABLE "parent's" (id INTEGER PRIMARY KEY AUTOINCREMENT, v)`,
+		`INSERT INTO "parent's" (v) VALUES (1), (2.0), (-0.5), (1e300), (9223372036854775807), ('it''s'), (x'00ff'), (NULL),
+			(CAST(x'610062' AS TEXT)), ('line
+break'), (1e999), (-1e999), ('Ünïcode ✓')`,
+		`DELETE FROM "parent's" WHERE id = 8`,
+		`INSERT INTO "child ""x""" (parent) SELECT id FROM "parent's"`,
+		`CREATE TABLE pairs (a, b, PRIMARY KEY (a, b)) WITHOUT ROWID`,
+		`INSERT INTO pairs VALUES (1, 'x'), (2, 'y')`,
+		`CREATE TABLE big (n)`,
+		`WITH RECURSIVE c(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM c WHERE n < 250) INSERT INTO big SELECT n FROM c`,
+		`CREATE TABLE empty (v)`,
+		`CREATE INDEX parent_v ON "parent's" (v)`,
+		`CREATE VIEW parents AS SELECT * FROM "parent's"`,
+		`CREATE TRIGGER parent_del AFTER DELETE ON "parent's" BEGIN DELETE FROM "child ""x""" WHERE parent = old.id; END`,
+	} {
+		if _, err := source.Exec(stmt); err != nil {
+			t.Fatalf("%s: %v", stmt, err)
+		}
+	}
+
+	var dump bytes.Buffer
+	if err := source.Dump(context.Background(), &dump, nil); err != nil {
+		t.Fatal(err)
+	}
+	script := dump.String()
+	if strings.Index(script, `CREATE TABLE "parent's"`) > strings.Index(script, `CREATE TABLE "child ""x"""`) {
+		t.Error("the child table is created before its parent")
+	}
+	if strings.Count(script, `INSERT INTO "big"`) != 3 {
+		t.Errorf("got %d INSERT statements for 250 rows, want 3", strings.Count(script, `INSERT INTO "big"`))
+	}
+
+	restored, err := newClient(filepath.Join(t.TempDir(), "restored.db"), false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restored.Close()
+	if _, err := restored.Exec("PRAGMA foreign_keys = ON; " + script); err != nil {
+		t.Fatal(err)
+	}
+
+	want, got := tableContents(t, source), tableContents(t, restored)
+	for name, rows := range want {
+		if !reflect.DeepEqual(got[name], rows) {
+			t.Errorf("%s: got %d rows %.200q, want %d rows %.200q", name, len(got[name]), got[name], len(rows), rows)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("got %d objects, want %d", len(got), len(want))
+	}
+
+	// The AUTOINCREMENT counter is restored
+	if _, err := restored.Exec(`INSERT INTO "parent's" (v) VALUES ('next')`); err != nil {
+		t.Fatal(err)
+	}
+	var id int64
+	restored.QueryRow(`SELECT max(id) FROM "parent's"`).Scan(&id)
+	if id != 14 {
+		t.Errorf("got id %d after the restore, want 14", id)
+	}
+}
+
+func TestDumpTables(t *testing.T) {
+	a, h := newTestAPI(t, Options{})
+	client := a.databases[0].client
+
+	var dump bytes.Buffer
+	if err := client.Dump(context.Background(), &dump, []string{"tracks", "albums"}); err != nil {
+		t.Fatal(err)
+	}
+	script := dump.String()
+	for _, s := range []string{`INSERT INTO "albums"`, `INSERT INTO "tracks"`, "CREATE INDEX [IFK_TrackAlbumId]"} {
+		if !strings.Contains(script, s) {
+			t.Errorf("%s not dumped", s)
+		}
+	}
+	if strings.Contains(script, `INSERT INTO "artists"`) || strings.Contains(script, "CREATE VIEW") {
+		t.Error("dumped an object of another table")
+	}
+
+	if err := client.Dump(context.Background(), &dump, []string{"nope"}); err == nil {
+		t.Error("dumped a missing table")
+	}
+
+	tests := []struct {
+		query    string
+		status   int
+		fileName string
+	}{
+		{"", http.StatusOK, "test.sql"},
+		{"table=artists&table=albums", http.StatusOK, "test.sql"},
+		{"table=nope", http.StatusNotFound, ""},
+		{"schema=nope", http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		w := testRequest(t, h, "GET", "/api/export/sql?"+tt.query, "")
+		if w.Code != tt.status {
+			t.Errorf("%s: got status %d, want %d", tt.query, w.Code, tt.status)
+			continue
+		}
+		if tt.status == http.StatusOK && w.Header().Get("Content-Disposition") != "attachment; filename="+tt.fileName {
+			t.Errorf("%s: got %q", tt.query, w.Header().Get("Content-Disposition"))
+		}
+	}
+}
+
+func TestSQLLiteral(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{nil, "NULL"},
+		{int64(-42), "-42"},
+		{2.0, "2.0"},
+		{0.1, "0.1"},
+		{1e300, "1e+300"},
+		{math.Inf(1), "1e999"},
+		{math.Inf(-1), "-1e999"},
+		{math.NaN(), "NULL"},
+		{[]byte{0, 0xab}, "X'00AB'"},
+		{[]byte{}, "X''"},
+		{"it's", "'it''s'"},
+		{"a\x00b", "CAST(X'610062' AS TEXT)"},
+		{true, "'true'"},
+	}
+	for _, tt := range tests {
+		if got := sqlLiteral(tt.value); got != tt.want {
+			t.Errorf("%#v: got %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package gobroem
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// maxSafeInteger is the largest integer a JavaScript number holds exactly.
+const maxSafeInteger = 1<<53 - 1
+
+// Storage classes of the typed encoding
+const (
+	classNull    = "null"
+	classInteger = "integer"
+	classReal    = "real"
+	classText    = "text"
+	classBlob    = "blob"
+)
+
+// valueEncoding tells how the values of a result are encoded. The zero value
+// is the plain encoding, where a blob is a text.
+type valueEncoding struct {
+	// Typed encodes every value with its storage class, and reports the
+	// declared types of the columns
+	Typed bool
+	// Hex encodes the blobs of the typed encoding in hex rather than base64
+	Hex bool
+}
+
+// encodedValue is a value of the typed encoding, as accepted by the typed
+// parameters. A blob is encoded in base64, or in hex with Encoding set to
+// hex, and an integer beyond the JavaScript precision as a string.
+type encodedValue struct {
+	Type     string      `json:"type"`
+	Value    interface{} `json:"value"`
+	Encoding string      `json:"encoding,omitempty"`
+	// plain is the value in the plain encoding
+	plain interface{}
+}
+
+// encodingHex is the Encoding of the blobs encoded in hex.
+const encodingHex = "hex"
+
+// encodeRow encodes the values of a scanned row.
+func (e valueEncoding) encodeRow(cols []interface{}) sqlRow {
+	if !e.Typed {
+		return textValues(cols)
+	}
+
+	row := make(sqlRow, len(cols))
+	for i, v := range cols {
+		row[i] = e.encodeValue(v)
+	}
+	return row
+}
+
+// encodeValue returns the typed value of a scanned value. The driver converts
+// the values of the BOOLEAN columns to bool and the ones of the DATE,
+// DATETIME and TIMESTAMP columns to time.Time; they are reported as integer
+// and text.
+func (e valueEncoding) encodeValue(v interface{}) encodedValue {
+	switch v := v.(type) {
+	case nil:
+		return encodedValue{classNull, nil, "", nil}
+	case int64:
+		if v > maxSafeInteger || v < -maxSafeInteger {
+			return encodedValue{classInteger, strconv.FormatInt(v, 10), "", v}
+		}
+		return encodedValue{classInteger, v, "", v}
+	case bool:
+		i := int64(0)
+		if v {
+			i = 1
+		}
+		return encodedValue{classInteger, i, "", v}
+	case float64:
+		// JSON has no infinity
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return encodedValue{classReal, strconv.FormatFloat(v, 'g', -1, 64), "", v}
+		}
+		return encodedValue{classReal, v, "", v}
+	case string:
+		return encodedValue{classText, v, "", v}
+	case []byte:
+		if e.Hex {
+			return encodedValue{classBlob, hex.EncodeToString(v), encodingHex, string(v)}
+		}
+		return encodedValue{classBlob, base64.StdEncoding.EncodeToString(v), "", string(v)}
+	case time.Time:
+		return encodedValue{classText, v.Format(sqlite3.SQLiteTimestampFormats[0]), "", v}
+	default:
+		return encodedValue{classText, fmt.Sprint(v), "", v}
+	}
+}
+
+// declaredTypes returns the declared types of the columns of rows, empty for
+// the columns which are not table columns. It returns nil with the plain
+// encoding.
+func (e valueEncoding) declaredTypes(rows *sql.Rows) []string {
+	if !e.Typed {
+		return nil
+	}
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil
+	}
+	types := make([]string, len(columnTypes))
+	for i, t := range columnTypes {
+		types[i] = t.DatabaseTypeName()
+	}
+	return types
+}
+
+// plainValue returns a value of a row in the plain encoding.
+func plainValue(v interface{}) interface{} {
+	if t, ok := v.(encodedValue); ok {
+		return t.plain
+	}
+	return v
+}
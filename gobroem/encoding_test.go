@@ -0,0 +1,103 @@
+package gobroem
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEncodeValue(t *testing.T) {
+	date := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		value interface{}
+		hex   bool
+		want  string
+	}{
+		{nil, false, `{"type":"null","value":null}`},
+		{int64(42), false, `{"type":"integer","value":42}`},
+		{int64(maxSafeInteger), false, `{"type":"integer","value":9007199254740991}`},
+		{int64(maxSafeInteger + 1), false, `{"type":"integer","value":"9007199254740992"}`},
+		{int64(-maxSafeInteger - 1), false, `{"type":"integer","value":"-9007199254740992"}`},
+		{true, false, `{"type":"integer","value":1}`},
+		{false, false, `{"type":"integer","value":0}`},
+		{1.5, false, `{"type":"real","value":1.5}`},
+		{math.Inf(-1), false, `{"type":"real","value":"-Inf"}`},
+		{math.NaN(), false, `{"type":"real","value":"NaN"}`},
+		{"text", false, `{"type":"text","value":"text"}`},
+		{[]byte{0, 0xff}, false, `{"type":"blob","value":"AP8="}`},
+		{[]byte{0, 0xff}, true, `{"type":"blob","value":"00ff","encoding":"hex"}`},
+		{date, false, `{"type":"text","value":"2020-01-02 03:04:05+00:00"}`},
+	}
+	for _, tt := range tests {
+		v := valueEncoding{Typed: true, Hex: tt.hex}.encodeValue(tt.value)
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != tt.want {
+			t.Errorf("%#v: got %s, want %s", tt.value, data, tt.want)
+		}
+	}
+
+	// The plain encoding of a blob is a text
+	enc := valueEncoding{Typed: true}
+	plains := []struct {
+		value interface{}
+		want  interface{}
+	}{
+		{int64(maxSafeInteger + 1), int64(maxSafeInteger + 1)},
+		{true, true},
+		{[]byte{0, 0xff}, "\x00\xff"},
+		{nil, nil},
+		{"x", "x"},
+	}
+	for _, tt := range plains {
+		if got := plainValue(enc.encodeValue(tt.value)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%#v: got plain value %#v, want %#v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestEncodingParams(t *testing.T) {
+	a, h := newTestAPI(t, Options{})
+	mustExec(t, a,
+		`CREATE TABLE typed (i INTEGER, r REAL, t TEXT, b BLOB, d DATETIME, n)`,
+		`INSERT INTO typed VALUES (9007199254740993, 0.5, 'x', x'00ff', '2020-01-02 03:04:05', NULL)`,
+	)
+	query := url.QueryEscape("SELECT i, r, t, b, d, n, 1 + 1 AS e FROM typed")
+
+	tests := []struct {
+		target string
+		status int
+		want   string
+	}{
+		{"/api/query?query=" + query,
+			http.StatusOK, `{"columns":["i","r","t","b","d","n","e"],"rows":[[9007199254740993,0.5,"x","\u0000�","2020-01-02T03:04:05Z",null,2]]`},
+		{"/api/query?typed=1&query=" + query,
+			http.StatusOK, `{"columns":["i","r","t","b","d","n","e"],"types":["INTEGER","REAL","TEXT","BLOB","DATETIME","",""],"rows":[[` +
+				`{"type":"integer","value":"9007199254740993"},{"type":"real","value":0.5},{"type":"text","value":"x"},{"type":"blob","value":"AP8="},` +
+				`{"type":"text","value":"2020-01-02 03:04:05+00:00"},{"type":"null","value":null},{"type":"integer","value":2}]]`},
+		{"/api/query?typed=1&blob=hex&query=" + url.QueryEscape("SELECT b FROM typed"),
+			http.StatusOK, `{"columns":["b"],"types":["BLOB"],"rows":[[{"type":"blob","value":"00ff","encoding":"hex"}]]`},
+		{"/api/table/rows?table=typed&typed=1&blob=hex",
+			http.StatusOK, `{"columns":["i","r","t","b","d","n"],"types":["INTEGER","REAL","TEXT","BLOB","DATETIME",""],"rows":[[` +
+				`{"type":"integer","value":"9007199254740993"},{"type":"real","value":0.5},{"type":"text","value":"x"},{"type":"blob","value":"00ff","encoding":"hex"},` +
+				`{"type":"text","value":"2020-01-02 03:04:05+00:00"},{"type":"null","value":null}]]`},
+		{"/api/query?typed=maybe&query=" + query, http.StatusBadRequest, ""},
+		{"/api/query?blob=octal&query=" + query, http.StatusBadRequest, ""},
+	}
+	for _, tt := range tests {
+		w := testRequest(t, h, "GET", tt.target, "")
+		if w.Code != tt.status {
+			t.Errorf("%s: got status %d, want %d", tt.target, w.Code, tt.status)
+			continue
+		}
+		if got := w.Body.String(); len(got) < len(tt.want) || got[:len(tt.want)] != tt.want {
+			t.Errorf("%s: got %s, want %s...", tt.target, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package gobroem
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// sqlFilter is a single condition on a table column.
+type sqlFilter struct {
+	Column string      `json:"column"`
+	Op     string      `json:"op"`
+	Value  interface{} `json:"value"`
+}
+
+// filterOps maps the supported operators to the number of values they take,
+// -1 meaning a non-empty list.
+var filterOps = map[string]int{
+	"=":           1,
+	"!=":          1,
+	"<":           1,
+	"<=":          1,
+	">":           1,
+	">=":          1,
+	"LIKE":        1,
+	"NOT LIKE":    1,
+	"IN":          -1,
+	"NOT IN":      -1,
+	"IS NULL":     0,
+	"IS NOT NULL": 0,
+	"BETWEEN":     2,
+}
+
+// parseFilters decodes a JSON array of filters.
+func parseFilters(data string) ([]sqlFilter, error) {
+	var filters []sqlFilter
+	if err := decodeJSON(strings.NewReader(data), &filters); err != nil {
+		return nil, inputError("invalid filters: " + err.Error())
+	}
+	for i := range filters {
+		value, err := normalizeJSON(filters[i].Value)
+		if err != nil {
+			return nil, inputError("invalid filter value: " + err.Error())
+		}
+		filters[i].Value = value
+	}
+	return filters, nil
+}
+
+// buildFilter compiles the filters into a parameterized WHERE clause for the
+// given table, without the WHERE keyword. The conditions are joined with AND
+// and the columns are checked against the table schema.
+func (client *sqlClient) buildFilter(ctx context.Context, table string, filters []sqlFilter) (string, []interface{}, error) {
+	if len(filters) == 0 {
+		return "", nil, nil
+	}
+
+	columns, err := client.tableColumns(ctx, table)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var conds []string
+	var args []interface{}
+	for _, f := range filters {
+		if !containsString(columns, f.Column) && !strings.EqualFold(f.Column, "rowid") {
+			return "", nil, inputError("no such column: " + f.Column)
+		}
+
+		op := strings.ToUpper(strings.Join(strings.Fields(f.Op), " "))
+		arity, ok := filterOps[op]
+		if !ok {
+			return "", nil, inputError("unsupported operator: " + f.Op)
+		}
+
+		values, err := filterValues(f.Value, arity)
+		if err != nil {
+			return "", nil, inputError(fmt.Sprintf("%s %s: %v", f.Column, op, err))
+		}
+
+		column := quoteIdent(f.Column)
+		switch op {
+		case "IS NULL", "IS NOT NULL":
+			conds = append(conds, column+" "+op)
+		case "BETWEEN":
+			conds = append(conds, column+" BETWEEN ? AND ?")
+		case "IN", "NOT IN":
+			marks := strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", ")
+			conds = append(conds, column+" "+op+" ("+marks+")")
+		default:
+			conds = append(conds, column+" "+op+" ?")
+		}
+		args = append(args, values...)
+	}
+
+	return strings.Join(conds, " AND "), args, nil
+}
+
+// filterValues returns the bind values of a filter, checking them against the
+// arity of its operator.
+func filterValues(value interface{}, arity int) ([]interface{}, error) {
+	if _, ok := value.(map[string]interface{}); ok {
+		return nil, fmt.Errorf("objects are not supported")
+	}
+	list, isList := value.([]interface{})
+
+	switch {
+	case arity == 0:
+		return nil, nil
+	case arity == 1 && !isList:
+		return []interface{}{value}, nil
+	case arity == 1:
+		return nil, fmt.Errorf("expects a single value")
+	case !isList:
+		return nil, fmt.Errorf("expects a list of values")
+	case arity < 0 && len(list) == 0:
+		return nil, fmt.Errorf("expects at least one value")
+	case arity > 0 && len(list) != arity:
+		return nil, fmt.Errorf("expects %d values", arity)
+	}
+
+	for _, v := range list {
+		if _, ok := v.([]interface{}); ok {
+			return nil, fmt.Errorf("nested lists are not supported")
+		}
+		if _, ok := v.(map[string]interface{}); ok {
+			return nil, fmt.Errorf("objects are not supported")
+		}
+	}
+	return list, nil
+}
+
+// normalizeJSON converts the numbers of a value decoded with UseNumber to
+// int64 when they are integers and float64 otherwise, so large integers are
+// kept exact.
+func normalizeJSON(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		return v.Float64()
+	case []interface{}:
+		for i := range v {
+			n, err := normalizeJSON(v[i])
+			if err != nil {
+				return nil, err
+			}
+			v[i] = n
+		}
+	case map[string]interface{}:
+		for k := range v {
+			n, err := normalizeJSON(v[k])
+			if err != nil {
+				return nil, err
+			}
+			v[k] = n
+		}
+	}
+	return value, nil
+}
+
+// decodeJSON decodes a JSON value from r into v, keeping numbers as
+// json.Number.
+func decodeJSON(r io.Reader, v interface{}) error {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
@@ -0,0 +1,102 @@
+package gobroem
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestBuildFilter(t *testing.T) {
+	a, _ := newTestAPI(t, Options{})
+	client := a.databases[0].client
+
+	tests := []struct {
+		filters string
+		where   string
+		args    []interface{}
+		err     bool
+	}{
+		{`[]`, "", nil, false},
+		{`[{"column": "Title", "op": "=", "value": "Facelift"}]`, `"Title" = ?`, []interface{}{"Facelift"}, false},
+		{`[{"column": "AlbumId", "op": ">=", "value": 9007199254740993}]`, `"AlbumId" >= ?`, []interface{}{int64(9007199254740993)}, false},
+		{`[{"column": "AlbumId", "op": "<", "value": 1.5}]`, `"AlbumId" < ?`, []interface{}{1.5}, false},
+		{`[{"column": "Title", "op": " not  like ", "value": "A%"}]`, `"Title" NOT LIKE ?`, []interface{}{"A%"}, false},
+		{`[{"column": "ArtistId", "op": "in", "value": [1, 2, 3]}]`, `"ArtistId" IN (?, ?, ?)`, []interface{}{int64(1), int64(2), int64(3)}, false},
+		{`[{"column": "ArtistId", "op": "NOT IN", "value": ["x"]}]`, `"ArtistId" NOT IN (?)`, []interface{}{"x"}, false},
+		{`[{"column": "Title", "op": "IS NULL"}]`, `"Title" IS NULL`, nil, false},
+		{`[{"column": "Title", "op": "is not null", "value": 1}]`, `"Title" IS NOT NULL`, nil, false},
+		{`[{"column": "AlbumId", "op": "BETWEEN", "value": [1, 10]}]`, `"AlbumId" BETWEEN ? AND ?`, []interface{}{int64(1), int64(10)}, false},
+		{`[{"column": "rowid", "op": "=", "value": 1}, {"column": "Title", "op": "LIKE", "value": "F%"}]`, `"rowid" = ? AND "Title" LIKE ?`, []interface{}{int64(1), "F%"}, false},
+		{`[{"column": "Title", "op": "=", "value": null}]`, `"Title" = ?`, []interface{}{nil}, false},
+		{`[{"column": "Title\" OR 1 --", "op": "=", "value": 1}]`, "", nil, true},
+		{`[{"column": "Nope", "op": "=", "value": 1}]`, "", nil, true},
+		{`[{"column": "Title", "op": "GLOB", "value": "*"}]`, "", nil, true},
+		{`[{"column": "Title", "op": "= 1 OR", "value": 1}]`, "", nil, true},
+		{`[{"column": "Title", "op": "=", "value": [1]}]`, "", nil, true},
+		{`[{"column": "Title", "op": "=", "value": {"a": 1}}]`, "", nil, true},
+		{`[{"column": "Title", "op": "IN", "value": []}]`, "", nil, true},
+		{`[{"column": "Title", "op": "IN", "value": 1}]`, "", nil, true},
+		{`[{"column": "Title", "op": "IN", "value": [[1]]}]`, "", nil, true},
+		{`[{"column": "AlbumId", "op": "BETWEEN", "value": [1]}]`, "", nil, true},
+	}
+	for _, tt := range tests {
+		filters, err := parseFilters(tt.filters)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.filters, err)
+		}
+		where, args, err := client.buildFilter(context.Background(), "albums", filters)
+		if tt.err {
+			if _, ok := err.(inputError); !ok {
+				t.Errorf("%s: got error %v, want an input error", tt.filters, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.filters, err)
+			continue
+		}
+		if where != tt.where || !reflect.DeepEqual(args, tt.args) {
+			t.Errorf("%s: got %q %#v, want %q %#v", tt.filters, where, args, tt.where, tt.args)
+		}
+	}
+}
+
+func TestParseFiltersErrors(t *testing.T) {
+	for _, filters := range []string{``, `{}`, `[{"column": 1}]`, `[{"column": "Title"}`} {
+		if _, err := parseFilters(filters); err == nil {
+			t.Errorf("%q: no error", filters)
+		}
+	}
+}
+
+func TestTableRowsFilters(t *testing.T) {
+	_, h := newTestAPI(t, Options{})
+	tests := []struct {
+		filters string
+		status  int
+		rows    int
+	}{
+		{`[{"column": "ArtistId", "op": "=", "value": 90}]`, http.StatusOK, 21},
+		{`[{"column": "ArtistId", "op": "=", "value": 90}, {"column": "Title", "op": "LIKE", "value": "A %"}]`, http.StatusOK, 3},
+		{`[{"column": "AlbumId", "op": "IN", "value": [1, 2, 1000]}]`, http.StatusOK, 2},
+		{`[{"column": "Title", "op": "IS NULL"}]`, http.StatusOK, 0},
+		{`[{"column": "Nope", "op": "=", "value": 1}]`, http.StatusBadRequest, 0},
+		{`not json`, http.StatusBadRequest, 0},
+	}
+	for _, tt := range tests {
+		target := "/api/table/rows?table=albums&limit=100&filters=" + url.QueryEscape(tt.filters)
+		if tt.status != http.StatusOK {
+			if w := testRequest(t, h, "GET", target, ""); w.Code != tt.status {
+				t.Errorf("%s: got status %d, want %d", tt.filters, w.Code, tt.status)
+			}
+			continue
+		}
+		var page testPage
+		testJSON(t, h, "GET", target, "", tt.status, &page)
+		if len(page.Rows) != tt.rows {
+			t.Errorf("%s: got %d rows, want %d", tt.filters, len(page.Rows), tt.rows)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+package gobroem
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+)
+
+// graphColumn is a column of a table of the schema graph.
+type graphColumn struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	NotNull bool   `json:"notnull"`
+	// PK is the position of the column in the primary key, 0 if the column is
+	// not part of it
+	PK int `json:"pk"`
+}
+
+// graphNode is a table of the schema graph.
+type graphNode struct {
+	Table   string        `json:"table"`
+	Columns []graphColumn `json:"columns"`
+}
+
+// graphMapping maps a column of a foreign key to the referenced column.
+type graphMapping struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// graphEdge is a foreign key, from the referencing table to the referenced
+// one.
+type graphEdge struct {
+	From     string         `json:"from"`
+	To       string         `json:"to"`
+	Columns  []graphMapping `json:"columns"`
+	OnUpdate string         `json:"on_update"`
+	OnDelete string         `json:"on_delete"`
+}
+
+// schemaGraph is the graph of the tables linked by their foreign keys.
+type schemaGraph struct {
+	Nodes []graphNode `json:"nodes"`
+	Edges []graphEdge `json:"edges"`
+}
+
+// SchemaGraph returns the tables of the database with their columns, and
+// their foreign keys. The internal and virtual tables are left out.
+func (client *sqlClient) SchemaGraph(ctx context.Context) (*schemaGraph, error) {
+	objects, err := client.SchemaObjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := &schemaGraph{
+		Nodes: make([]graphNode, 0, len(objects.Tables)),
+		Edges: make([]graphEdge, 0),
+	}
+	nodes := make(map[string]*graphNode)
+	for _, t := range objects.Tables {
+		if strings.HasPrefix(t.Name, "sqlite_") {
+			continue
+		}
+		schema, err := client.tableSchema(ctx, t.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		node := graphNode{Table: t.Name, Columns: make([]graphColumn, 0, len(schema))}
+		for _, c := range schema {
+			node.Columns = append(node.Columns, graphColumn{c.Name, c.Type, c.NotNull, c.PK})
+		}
+		graph.Nodes = append(graph.Nodes, node)
+	}
+	for i := range graph.Nodes {
+		nodes[graph.Nodes[i].Table] = &graph.Nodes[i]
+	}
+
+	for _, node := range graph.Nodes {
+		edges, err := client.foreignKeys(ctx, node.Table)
+		if err != nil {
+			return nil, err
+		}
+		for _, edge := range edges {
+			// A foreign key without columns references the primary key
+			if target, found := nodes[edge.To]; found {
+				pk := primaryKeyNames(target.Columns)
+				for i := range edge.Columns {
+					if edge.Columns[i].To == "" && i < len(pk) {
+						edge.Columns[i].To = pk[i]
+					}
+				}
+			}
+			graph.Edges = append(graph.Edges, edge)
+		}
+	}
+
+	return graph, nil
+}
+
+// foreignKeys returns the foreign keys of the given table.
+func (client *sqlClient) foreignKeys(ctx context.Context, table string) ([]graphEdge, error) {
+	rows, err := client.QueryContext(ctx, client.pragma("foreign_key_list", table))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var edges []graphEdge
+	lastID := -1
+	for rows.Next() {
+		var id, seq int
+		var to *string
+		var edge graphEdge
+		var mapping graphMapping
+		var match string
+		if err := rows.Scan(&id, &seq, &edge.To, &mapping.From, &to, &edge.OnUpdate, &edge.OnDelete, &match); err != nil {
+			return nil, err
+		}
+		if to != nil {
+			mapping.To = *to
+		}
+
+		// The columns of a foreign key are listed in a row each
+		if id != lastID {
+			edge.From = table
+			edges = append(edges, edge)
+			lastID = id
+		}
+		last := &edges[len(edges)-1]
+		last.Columns = append(last.Columns, mapping)
+	}
+	return edges, rows.Err()
+}
+
+// primaryKeyNames returns the primary key columns in key order.
+func primaryKeyNames(columns []graphColumn) []string {
+	var pk []graphColumn
+	for _, c := range columns {
+		if c.PK > 0 {
+			pk = append(pk, c)
+		}
+	}
+	sort.Slice(pk, func(i, j int) bool { return pk[i].PK < pk[j].PK })
+
+	names := make([]string, len(pk))
+	for i, c := range pk {
+		names[i] = c.Name
+	}
+	return names
+}
+
+// isForeignKey reports whether the column of the table is part of a foreign
+// key.
+func (g *schemaGraph) isForeignKey(table, column string) bool {
+	for _, e := range g.Edges {
+		if e.From != table {
+			continue
+		}
+		for _, m := range e.Columns {
+			if m.From == column {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DOT renders the graph in the Graphviz DOT language, a table being a node
+// with a port per column.
+func (g *schemaGraph) DOT() []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteString("digraph schema {\n\trankdir=LR;\n\tnode [shape=plaintext];\n")
+
+	for _, n := range g.Nodes {
+		fmt.Fprintf(buf, "\t%s [label=<<table border=\"0\" cellborder=\"1\" cellspacing=\"0\">", dotID(n.Table))
+		fmt.Fprintf(buf, "<tr><td bgcolor=\"lightgrey\"><b>%s</b></td></tr>", html.EscapeString(n.Table))
+		for _, c := range n.Columns {
+			label := html.EscapeString(strings.TrimSpace(c.Name + " " + c.Type))
+			if c.PK > 0 {
+				label = "<u>" + label + "</u>"
+			}
+			fmt.Fprintf(buf, "<tr><td port=\"%s\" align=\"left\">%s</td></tr>", html.EscapeString(c.Name), label)
+		}
+		buf.WriteString("</table>>];\n")
+	}
+
+	for _, e := range g.Edges {
+		label := fmt.Sprintf("ON DELETE %s\\nON UPDATE %s", e.OnDelete, e.OnUpdate)
+		for _, m := range e.Columns {
+			from, to := dotID(e.From), dotID(e.To)
+			if m.From != "" {
+				from += ":" + dotID(m.From)
+			}
+			if m.To != "" {
+				to += ":" + dotID(m.To)
+			}
+			fmt.Fprintf(buf, "\t%s -> %s [label=%s];\n", from, to, dotID(label))
+		}
+	}
+
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+// Mermaid renders the graph as a Mermaid entity relationship diagram. The
+// names are reduced to the characters Mermaid accepts.
+func (g *schemaGraph) Mermaid() []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteString("erDiagram\n")
+
+	notNull := make(map[string]bool)
+	for _, n := range g.Nodes {
+		fmt.Fprintf(buf, "    %s {\n", mermaidName(n.Table))
+		for _, c := range n.Columns {
+			notNull[n.Table+"\x00"+c.Name] = c.NotNull
+
+			var keys []string
+			if c.PK > 0 {
+				keys = append(keys, "PK")
+			}
+			if g.isForeignKey(n.Table, c.Name) {
+				keys = append(keys, "FK")
+			}
+			typ := strings.Trim(mermaidName(c.Type), "_")
+			if typ == "" {
+				typ = "ANY"
+			}
+			line := typ + " " + mermaidName(c.Name)
+			if len(keys) > 0 {
+				line += " " + strings.Join(keys, ", ")
+			}
+			fmt.Fprintf(buf, "        %s\n", line)
+		}
+		buf.WriteString("    }\n")
+	}
+
+	for _, e := range g.Edges {
+		// The referenced row is optional unless all the columns are NOT NULL
+		parent := "||"
+		columns := make([]string, len(e.Columns))
+		for i, m := range e.Columns {
+			if !notNull[e.From+"\x00"+m.From] {
+				parent = "|o"
+			}
+			columns[i] = m.From
+		}
+		label := strings.Replace(strings.Join(columns, ", "), `"`, "'", -1)
+		fmt.Fprintf(buf, "    %s %s--o{ %s : \"%s\"\n", mermaidName(e.To), parent, mermaidName(e.From), label)
+	}
+
+	return buf.Bytes()
+}
+
+// dotID quotes a DOT identifier.
+func dotID(s string) string {
+	return `"` + strings.Replace(s, `"`, `\"`, -1) + `"`
+}
+
+// mermaidName replaces the characters Mermaid does not accept in a name.
+func mermaidName(s string) string {
+	name := []byte(s)
+	for i, c := range name {
+		if !isWordChar(c) || c >= 0x80 {
+			name[i] = '_'
+		}
+	}
+	return string(name)
+}
@@ -0,0 +1,118 @@
+package gobroem
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSchemaGraph(t *testing.T) {
+	a, h := newTestAPI(t, Options{})
+	mustExec(t, a,
+		`CREATE TABLE owners (a TEXT NOT NULL, b INTEGER, name, PRIMARY KEY (b, a))`,
+		`CREATE TABLE pets (id INTEGER PRIMARY KEY, oa TEXT NOT NULL, ob INTEGER NOT NULL, parent REFERENCES pets ON DELETE CASCADE,
+			FOREIGN KEY (oa, ob) REFERENCES owners (a, b) ON UPDATE SET NULL)`,
+		`CREATE TABLE toys (pet REFERENCES pets (id), owner REFERENCES owners)`,
+	)
+	graph, err := a.databases[0].client.SchemaGraph(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nodes := make(map[string]graphNode)
+	for _, n := range graph.Nodes {
+		nodes[n.Table] = n
+		if strings.HasPrefix(n.Table, "sqlite_") {
+			t.Errorf("got internal table %s", n.Table)
+		}
+	}
+	wantOwners := []graphColumn{{"a", "TEXT", true, 2}, {"b", "INTEGER", false, 1}, {"name", "", false, 0}}
+	if !reflect.DeepEqual(nodes["owners"].Columns, wantOwners) {
+		t.Errorf("got owners %v, want %v", nodes["owners"].Columns, wantOwners)
+	}
+	if len(nodes["tracks"].Columns) != 9 {
+		t.Errorf("got %d tracks columns, want 9", len(nodes["tracks"].Columns))
+	}
+
+	tests := []graphEdge{
+		{"pets", "pets", []graphMapping{{"parent", "id"}}, "NO ACTION", "CASCADE"},
+		{"pets", "owners", []graphMapping{{"oa", "a"}, {"ob", "b"}}, "SET NULL", "NO ACTION"},
+		{"toys", "owners", []graphMapping{{"owner", "b"}}, "NO ACTION", "NO ACTION"},
+		{"toys", "pets", []graphMapping{{"pet", "id"}}, "NO ACTION", "NO ACTION"},
+		{"albums", "artists", []graphMapping{{"ArtistId", "ArtistId"}}, "NO ACTION", "NO ACTION"},
+	}
+	for _, want := range tests {
+		found := false
+		for _, e := range graph.Edges {
+			if reflect.DeepEqual(e, want) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("edge %v not found", want)
+		}
+	}
+
+	for _, tt := range []struct {
+		format      string
+		status      int
+		contentType string
+	}{
+		{"", http.StatusOK, "application/json"},
+		{"dot", http.StatusOK, "text/vnd.graphviz"},
+		{"mermaid", http.StatusOK, "text/plain; charset=UTF-8"},
+		{"svg", http.StatusBadRequest, "application/json"},
+	} {
+		w := testRequest(t, h, "GET", "/api/schema/graph?format="+tt.format, "")
+		if w.Code != tt.status || !strings.HasPrefix(w.Header().Get("Content-Type"), tt.contentType) {
+			t.Errorf("%s: got status %d and %s, want %d and %s", tt.format, w.Code, w.Header().Get("Content-Type"), tt.status, tt.contentType)
+		}
+	}
+}
+
+func TestSchemaGraphRender(t *testing.T) {
+	graph := &schemaGraph{
+		Nodes: []graphNode{
+			{"pa\"rents", []graphColumn{{"id", "INTEGER", true, 1}, {"<name>", "", false, 0}}},
+			{"kids", []graphColumn{{"id", "INTEGER", false, 1}, {"parent id", "VARCHAR(10)", true, 0}}},
+		},
+		Edges: []graphEdge{
+			{"kids", "pa\"rents", []graphMapping{{"parent id", "id"}}, "NO ACTION", "CASCADE"},
+		},
+	}
+
+	dot := `digraph schema {
+	rankdir=LR;
+	node [shape=plaintext];
+	"pa\"rents" [label=<<table border="0" cellborder="1" cellspacing="0"><tr><td bgcolor="lightgrey"><b>pa&#34;rents</b></td></tr><tr><td port="id" align="left"><u>id INTEGER</u></td></tr><tr><td port="&lt;name&gt;" align="left">&lt;name&gt;</td></tr></table>>];
+	"kids" [label=<<table border="0" cellborder="1" cellspacing="0"><tr><td bgcolor="lightgrey"><b>kids</b></td></tr><tr><td port="id" align="left"><u>id INTEGER</u></td></tr><tr><td port="parent id" align="left">parent id VARCHAR(10)</td></tr></table>>];
+	"kids":"parent id" -> "pa\"rents":"id" [label="ON DELETE CASCADE\nON UPDATE NO ACTION"];
+}
+`
+	if got := string(graph.DOT()); got != dot {
+		t.Errorf("got DOT\n%s\nwant\n%s", got, dot)
+	}
+
+	mermaid := `erDiagram
+    pa_rents {
+        INTEGER id PK
+        ANY _name_
+    }
+    kids {
+        INTEGER id PK
+        VARCHAR_10 parent_id FK
+    }
+    pa_rents ||--o{ kids : "parent id"
+`
+	if got := string(graph.Mermaid()); got != mermaid {
+		t.Errorf("got Mermaid\n%s\nwant\n%s", got, mermaid)
+	}
+
+	// A nullable column makes the referenced row optional
+	graph.Nodes[1].Columns[1].NotNull = false
+	if got := string(graph.Mermaid()); !strings.Contains(got, "pa_rents |o--o{ kids") {
+		t.Errorf("got Mermaid\n%s", got)
+	}
+}
@@ -0,0 +1,94 @@
+package gobroem
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newTestAPI returns an API serving a copy of test/test.db, and its handler
+// mounted at the root.
+func newTestAPI(t *testing.T, opts Options) (*API, http.Handler) {
+	t.Helper()
+	file := copyTestDB(t)
+	a, err := NewAPIWithOptions(file, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a, a.Handler("/", "/static/")
+}
+
+// copyTestDB copies test/test.db to a temporary directory and returns the
+// path of the copy, named test.db.
+func copyTestDB(t *testing.T) string {
+	t.Helper()
+	data, err := ioutil.ReadFile("../test/test.db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "test.db")
+	if err := ioutil.WriteFile(file, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+// mustExec runs statements on the first database of the API.
+func mustExec(t *testing.T, a *API, stmts ...string) {
+	t.Helper()
+	for _, stmt := range stmts {
+		if _, err := a.databases[0].client.Exec(stmt); err != nil {
+			t.Fatalf("%s: %v", stmt, err)
+		}
+	}
+}
+
+// testRequest sends a request to h and returns the response. A body starting
+// with { or [ is sent as JSON, any other as a form. The request has the
+// X-Requested-With header of the UI requests.
+func testRequest(t *testing.T, h http.Handler, method, target, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	var r io.Reader
+	if body != "" {
+		r = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, target, r)
+	req.Header.Set("X-Requested-With", "XMLHttpRequest")
+	if strings.HasPrefix(body, "{") || strings.HasPrefix(body, "[") {
+		req.Header.Set("Content-Type", "application/json")
+	} else if body != "" {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+// testJSON sends a request to h, checks the response status and decodes its
+// JSON body into v.
+func testJSON(t *testing.T, h http.Handler, method, target, body string, status int, v interface{}) {
+	t.Helper()
+	w := testRequest(t, h, method, target, body)
+	if w.Code != status {
+		t.Fatalf("%s %s: got status %d, want %d: %s", method, target, w.Code, status, w.Body.String())
+	}
+	if v == nil {
+		return
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), v); err != nil {
+		t.Fatalf("%s %s: %v: %s", method, target, err, w.Body.String())
+	}
+}
+
+// decodeTestBody decodes the JSON body of a response into v.
+func decodeTestBody(t *testing.T, w *httptest.ResponseRecorder, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(w.Body.Bytes(), v); err != nil {
+		t.Fatalf("%v: %s", err, w.Body.String())
+	}
+}
@@ -0,0 +1,171 @@
+package gobroem
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	queryCreateHistory = `
+CREATE TABLE IF NOT EXISTS history (
+	id INTEGER PRIMARY KEY,
+	database TEXT NOT NULL,
+	query TEXT NOT NULL,
+	executed_at TIMESTAMP NOT NULL,
+	duration_ms REAL NOT NULL,
+	rows INTEGER NOT NULL,
+	error TEXT NOT NULL,
+	principal TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS history_database ON history (database, id);`
+	queryAddHistory  = `INSERT INTO history (database, query, executed_at, duration_ms, rows, error, principal) VALUES (?, ?, ?, ?, ?, ?, ?)`
+	queryListHistory = `SELECT id, database, query, executed_at, duration_ms, rows, error, principal FROM history%s ORDER BY id DESC LIMIT ? OFFSET ?`
+)
+
+// HistoryEntry is a query run through the API.
+type HistoryEntry struct {
+	ID       int64     `json:"id"`
+	Database string    `json:"database"`
+	Query    string    `json:"query"`
+	Time     time.Time `json:"time"`
+	// Duration is the run time of the query in milliseconds
+	Duration float64 `json:"duration_ms"`
+	// Rows is the number of rows returned
+	Rows  int64  `json:"rows"`
+	Error string `json:"error,omitempty"`
+	// Principal is the authenticated caller, empty without authentication
+	Principal string `json:"principal,omitempty"`
+}
+
+// HistoryFilter selects the entries of a history.
+type HistoryFilter struct {
+	Database string
+	// Search keeps the queries containing the text, ignoring the case
+	Search string
+	// Principal keeps the queries of a principal if not empty
+	Principal string
+	Limit     int
+	Offset    int
+}
+
+// HistoryStore records the queries run through the API.
+type HistoryStore interface {
+	// Add records a query. The ID of the entry is set by the store.
+	Add(ctx context.Context, entry HistoryEntry) error
+	// List returns a page of the entries matching the filter, the most recent
+	// first, along with the number of matching entries.
+	List(ctx context.Context, filter HistoryFilter) ([]HistoryEntry, int64, error)
+}
+
+// fileHistory stores the history in a SQLite file.
+type fileHistory struct {
+	db *sql.DB
+}
+
+// NewFileHistory returns a HistoryStore keeping the queries in a SQLite file,
+// created if missing. The file is meant to sit next to the databases, not in
+// one of them.
+func NewFileHistory(file string) (HistoryStore, error) {
+	db, err := sql.Open("sqlite3", "file:"+escapeURIPath(file)+"?_busy_timeout=5000")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(queryCreateHistory); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &fileHistory{db}, nil
+}
+
+func (h *fileHistory) Add(ctx context.Context, entry HistoryEntry) error {
+	_, err := h.db.ExecContext(ctx, queryAddHistory, entry.Database, entry.Query, entry.Time.UTC(),
+		entry.Duration, entry.Rows, entry.Error, entry.Principal)
+	return err
+}
+
+func (h *fileHistory) List(ctx context.Context, filter HistoryFilter) ([]HistoryEntry, int64, error) {
+	var conds []string
+	var args []interface{}
+	if filter.Database != "" {
+		conds = append(conds, "database = ?")
+		args = append(args, filter.Database)
+	}
+	if filter.Principal != "" {
+		conds = append(conds, "principal = ?")
+		args = append(args, filter.Principal)
+	}
+	if filter.Search != "" {
+		// instr needs no escaping, unlike LIKE
+		conds = append(conds, "instr(lower(query), lower(?)) > 0")
+		args = append(args, filter.Search)
+	}
+	where := whereClause(conds)
+
+	var total int64
+	if err := h.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM history"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := h.db.QueryContext(ctx, fmt.Sprintf(queryListHistory, where), append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var entries []HistoryEntry
+	for rows.Next() {
+		var e HistoryEntry
+		if err := rows.Scan(&e.ID, &e.Database, &e.Query, &e.Time, &e.Duration, &e.Rows, &e.Error, &e.Principal); err != nil {
+			return nil, 0, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, total, rows.Err()
+}
+
+// countingWriter counts the rows handed to a rowWriter and keeps the error
+// which stopped them, for the history.
+type countingWriter struct {
+	rowWriter
+	rows int64
+	err  error
+}
+
+func (c *countingWriter) Row(row sqlRow) error {
+	c.rows++
+	return c.rowWriter.Row(row)
+}
+
+func (c *countingWriter) Stats(stats *queryStats) {
+	writeStats(c.rowWriter, stats)
+}
+
+func (c *countingWriter) End(truncated bool, err error) error {
+	c.err = err
+	return c.rowWriter.End(truncated, err)
+}
+
+// recordQuery adds a query started at start to the history, if enabled.
+func (a *API) recordQuery(req *http.Request, db *database, query string, start time.Time, rows int64, err error) {
+	if a.options.History == nil {
+		return
+	}
+
+	entry := HistoryEntry{
+		Database:  db.name,
+		Query:     query,
+		Time:      start,
+		Duration:  milliseconds(time.Since(start)),
+		Rows:      rows,
+		Principal: Principal(req),
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	// The query is recorded even if the client is gone, and a failure to
+	// record it doesn't fail the query
+	a.options.History.Add(context.Background(), entry)
+}
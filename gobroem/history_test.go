@@ -0,0 +1,153 @@
+package gobroem
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFileHistory(t *testing.T) {
+	store, err := NewFileHistory(filepath.Join(t.TempDir(), "history.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2024, 5, 6, 7, 8, 9, 0, time.FixedZone("CET", 3600))
+	entries := []HistoryEntry{
+		{Database: "main", Query: "SELECT * FROM Artists", Time: start, Duration: 1.5, Rows: 275, Principal: "ann"},
+		{Database: "main", Query: "SELECT nope", Time: start.Add(time.Second), Error: "no such column: nope", Principal: "bob"},
+		{Database: "other", Query: "select 1", Time: start.Add(2 * time.Second), Rows: 1, Principal: "ann"},
+		{Database: "main", Query: "DELETE FROM artists WHERE name LIKE '50%'", Time: start.Add(3 * time.Second), Principal: "ann"},
+	}
+	for _, e := range entries {
+		if err := store.Add(context.Background(), e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		filter HistoryFilter
+		ids    []int64
+		total  int64
+	}{
+		{HistoryFilter{Limit: 10}, []int64{4, 3, 2, 1}, 4},
+		{HistoryFilter{Database: "main", Limit: 10}, []int64{4, 2, 1}, 3},
+		{HistoryFilter{Database: "main", Limit: 2, Offset: 1}, []int64{2, 1}, 3},
+		{HistoryFilter{Database: "main", Limit: 10, Offset: 5}, nil, 3},
+		{HistoryFilter{Search: "artists", Limit: 10}, []int64{4, 1}, 2},
+		{HistoryFilter{Search: "50%", Limit: 10}, []int64{4}, 1},
+		{HistoryFilter{Search: "_", Limit: 10}, nil, 0},
+		{HistoryFilter{Principal: "ann", Database: "main", Limit: 10}, []int64{4, 1}, 2},
+		{HistoryFilter{Principal: "carl", Limit: 10}, nil, 0},
+	}
+	for _, tt := range tests {
+		got, total, err := store.List(context.Background(), tt.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []int64
+		for _, e := range got {
+			ids = append(ids, e.ID)
+		}
+		if total != tt.total || len(ids) != len(tt.ids) || len(ids) > 0 && !reflect.DeepEqual(ids, tt.ids) {
+			t.Errorf("%+v: got %v of %d, want %v of %d", tt.filter, ids, total, tt.ids, tt.total)
+		}
+	}
+
+	got, _, err := store.List(context.Background(), HistoryFilter{Search: "nope", Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := entries[1]
+	want.ID = 2
+	if len(got) != 1 || !got[0].Time.Equal(want.Time) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	got[0].Time = want.Time
+	if got[0] != want {
+		t.Errorf("got %+v, want %+v", got[0], want)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	store, err := NewFileHistory(filepath.Join(t.TempDir(), "history.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, h := newTestAPI(t, Options{History: store, Authenticator: BasicAuth(map[string]string{"ann": "a", "bob": "b"})})
+	run := func(user, method, target, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("X-Requested-With", "XMLHttpRequest")
+		req.SetBasicAuth(user, user[:1])
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w
+	}
+
+	run("ann", "GET", "/api/query?query="+url.QueryEscape("SELECT * FROM artists"), "")
+	run("bob", "GET", "/api/query?format=csv&query="+url.QueryEscape("SELECT nope"), "")
+	run("ann", "POST", "/api/query?script=1", "query="+url.QueryEscape("SELECT 1; SELECT * FROM genres; SELECT nope"))
+	run("ann", "GET", "/api/table/rows?table=artists", "")
+
+	var page struct {
+		Entries []HistoryEntry `json:"entries"`
+		Total   int64          `json:"total"`
+	}
+	w := run("ann", "GET", "/api/history", "")
+	decodeTestBody(t, w, &page)
+	if w.Code != http.StatusOK || page.Total != 3 || len(page.Entries) != 3 {
+		t.Fatalf("got status %d and %+v", w.Code, page)
+	}
+	tests := []struct {
+		query     string
+		rows      int64
+		err       bool
+		principal string
+	}{
+		{"SELECT 1; SELECT * FROM genres; SELECT nope", 26, true, "ann"},
+		{"SELECT nope", 0, true, "bob"},
+		{"SELECT * FROM artists", 275, false, "ann"},
+	}
+	for i, tt := range tests {
+		e := page.Entries[i]
+		if e.Query != tt.query || e.Rows != tt.rows || (e.Error != "") != tt.err || e.Principal != tt.principal || e.Database != "test" {
+			t.Errorf("got entry %+v, want %+v", e, tt)
+		}
+	}
+
+	for _, tt := range []struct {
+		query  string
+		status int
+		total  int64
+	}{
+		{"user=bob", http.StatusOK, 1},
+		{"search=ARTISTS", http.StatusOK, 1},
+		{"limit=1&offset=2", http.StatusOK, 3},
+		{"limit=0", http.StatusBadRequest, 0},
+		{"offset=-1", http.StatusBadRequest, 0},
+		{"db=nope", http.StatusNotFound, 0},
+	} {
+		w := run("bob", "GET", "/api/history?"+tt.query, "")
+		if w.Code != tt.status {
+			t.Errorf("%s: got status %d, want %d", tt.query, w.Code, tt.status)
+			continue
+		}
+		if tt.status == http.StatusOK {
+			decodeTestBody(t, w, &page)
+			if page.Total != tt.total {
+				t.Errorf("%s: got total %d, want %d", tt.query, page.Total, tt.total)
+			}
+		}
+	}
+
+	_, h = newTestAPI(t, Options{})
+	if w := testRequest(t, h, "GET", "/api/history", ""); w.Code != http.StatusNotFound {
+		t.Errorf("got status %d without history, want %d", w.Code, http.StatusNotFound)
+	}
+}
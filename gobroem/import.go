@@ -0,0 +1,686 @@
+package gobroem
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// importBatchRows is the default number of rows inserted per transaction
+	// with the ignore and replace policies
+	importBatchRows = 1000
+	// maxImportErrors caps the row errors listed in an import result
+	maxImportErrors = 100
+	// maxImportLine is the maximum length of an NDJSON line
+	maxImportLine = 64 << 20
+	// maxImportMemory is the part of an upload kept in memory, the rest being
+	// stored in temporary files
+	maxImportMemory = 32 << 20
+
+	typeInteger = "INTEGER"
+	typeReal    = "REAL"
+	typeText    = "TEXT"
+)
+
+var realPattern = regexp.MustCompile(`^[-+]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][-+]?[0-9]+)?$`)
+
+// importOptions configures an import.
+type importOptions struct {
+	// Format is csv, json, for an array of objects, or ndjson
+	Format string
+	// Create creates the table, which must not exist. The rows are appended
+	// to an existing table otherwise.
+	Create bool
+	// Header tells whether the first CSV record holds the field names, nil to
+	// detect it. Without header, the fields are named column1, column2...
+	Header    *bool
+	Delimiter rune
+	// InferTypes types the columns of a created table as INTEGER, REAL or
+	// TEXT from their values, and converts the CSV values accordingly
+	InferTypes bool
+	// Columns maps the source fields to the table columns, leaving the other
+	// fields out. All the fields are imported under their name when empty.
+	Columns map[string]string
+	// OnConflict is abort, ignore or replace
+	OnConflict string
+	// BatchSize is the number of rows inserted per transaction, except with
+	// the abort policy which inserts all the rows in one transaction
+	BatchSize int
+}
+
+// importError is the error of a row which could not be imported.
+type importError struct {
+	// Row is the row number in the source, starting at 1 after the header
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
+
+// importResult reports an import.
+type importResult struct {
+	Table        string `json:"table"`
+	Created      bool   `json:"created"`
+	RowsRead     int    `json:"rows_read"`
+	RowsInserted int64  `json:"rows_inserted"`
+	// RowsIgnored counts the rows skipped on conflict with the ignore policy
+	RowsIgnored int `json:"rows_ignored"`
+	RowsFailed  int `json:"rows_failed"`
+	// Aborted reports an import stopped by a row error with the abort
+	// policy, all its rows being rolled back
+	Aborted bool          `json:"aborted"`
+	Errors  []importError `json:"errors"`
+}
+
+func (r *importResult) fail(row int, err error) {
+	r.RowsFailed++
+	if len(r.Errors) < maxImportErrors {
+		r.Errors = append(r.Errors, importError{row, err.Error()})
+	}
+}
+
+// importRecord is a source record, with its values keyed by field name.
+type importRecord struct {
+	row    int
+	values map[string]interface{}
+	// err is set for a record which can't be imported, e.g. a JSON value
+	// which is not an object
+	err error
+}
+
+// recordReader returns the next record of a source, io.EOF after the last
+// one.
+type recordReader func() (importRecord, error)
+
+// Import inserts the records read from r into the table, creating it if
+// asked. The source is read twice: first to check it and collect its fields,
+// then to insert its records one at a time.
+// With the abort policy, a row error stops the import and no row is
+// imported. The failing rows are reported and skipped otherwise, and the rows
+// are committed every opts.BatchSize rows.
+func (client *sqlClient) Import(ctx context.Context, table string, r io.ReadSeeker, opts importOptions) (*importResult, error) {
+	if client.readOnly {
+		return nil, errReadOnly
+	}
+
+	fields, rows, types, err := scanImport(r, &opts)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	// Map the source fields to the table columns
+	var schema []columnInfo
+	if !opts.Create {
+		if schema, err = client.tableSchema(ctx, table); err != nil {
+			return nil, err
+		}
+	}
+	columns, err := importColumns(fields, schema, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var create string
+	if opts.Create {
+		if _, err := client.tableSchema(ctx, table); err == nil {
+			return nil, inputError("table already exists: " + table)
+		} else if _, ok := err.(notFoundError); !ok {
+			return nil, err
+		}
+
+		var defs []string
+		for _, f := range fields {
+			if c, ok := columns[f]; ok {
+				defs = append(defs, strings.TrimSpace(quoteIdent(c)+" "+types[f]))
+			}
+		}
+		create = "CREATE TABLE " + client.qualify(table) + " (" + strings.Join(defs, ", ") + ")"
+	}
+
+	insert := "INSERT"
+	switch opts.OnConflict {
+	case "ignore":
+		insert = "INSERT OR IGNORE"
+	case "replace":
+		insert = "INSERT OR REPLACE"
+	}
+	insert += " INTO " + client.qualify(table)
+
+	result := &importResult{Table: table, RowsRead: rows, Errors: make([]importError, 0)}
+	abort := opts.OnConflict == "abort" || opts.OnConflict == ""
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = importBatchRows
+	}
+
+	// The rows of the current transaction
+	var tx *sql.Tx
+	var inserted int64
+	ignored, pending := 0, 0
+	defer func() {
+		if tx != nil {
+			tx.Rollback()
+		}
+	}()
+
+	begin := func() error {
+		var err error
+		if tx, err = client.BeginTx(ctx, nil); err != nil {
+			return err
+		}
+		// A created table is committed with the first batch, even without
+		// rows
+		if create != "" {
+			_, err = tx.ExecContext(ctx, create)
+			create = ""
+		}
+		return err
+	}
+	commit := func() error {
+		err := tx.Commit()
+		tx = nil
+		if err != nil {
+			return err
+		}
+		result.RowsInserted += inserted
+		result.RowsIgnored += ignored
+		result.Created = opts.Create
+		inserted, ignored, pending = 0, 0, 0
+		return nil
+	}
+
+	read, err := readImport(r, &opts, &fields)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		record, err := read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil {
+			if err := begin(); err != nil {
+				return nil, err
+			}
+		}
+		if opts.InferTypes && opts.Format == "csv" {
+			convertValues(record, types)
+		}
+
+		err = record.err
+		if err == nil {
+			var n int64
+			n, err = importRow(ctx, tx, insert, fields, columns, record)
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			inserted += n
+			if err == nil && n == 0 {
+				ignored++
+			}
+		}
+		if err != nil {
+			result.fail(record.row, err)
+			if abort {
+				result.Aborted = true
+				return result, nil
+			}
+		}
+
+		// The abort policy imports all the rows or none
+		pending++
+		if !abort && pending == batchSize {
+			if err := commit(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if tx == nil && create != "" {
+		if err := begin(); err != nil {
+			return nil, err
+		}
+	}
+	if tx != nil {
+		if err := commit(); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// importRow inserts a record, with the values of the fields it holds, and
+// returns the number of inserted rows.
+func importRow(ctx context.Context, tx execer, insert string, fields []string, columns map[string]string, record importRecord) (int64, error) {
+	var names []string
+	var args []interface{}
+	for _, f := range fields {
+		c, ok := columns[f]
+		if !ok {
+			continue
+		}
+		v, ok := record.values[f]
+		if !ok {
+			continue
+		}
+		names = append(names, quoteIdent(c))
+		args = append(args, v)
+	}
+
+	query := insert + " DEFAULT VALUES"
+	if len(names) > 0 {
+		marks := strings.TrimSuffix(strings.Repeat("?, ", len(names)), ", ")
+		query = insert + " (" + strings.Join(names, ", ") + ") VALUES (" + marks + ")"
+	}
+
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// importColumns returns the table column of each imported field. Without
+// header nor mapping, the CSV fields of an existing table are its columns in
+// order.
+func importColumns(fields []string, schema []columnInfo, opts importOptions) (map[string]string, error) {
+	columns := make(map[string]string)
+	switch {
+	case len(opts.Columns) > 0:
+		for f, c := range opts.Columns {
+			if !containsString(fields, f) {
+				return nil, inputError("no such field: " + f)
+			}
+			columns[f] = c
+		}
+	case opts.Format == "csv" && opts.Header != nil && !*opts.Header && schema != nil:
+		if len(fields) > len(schema) {
+			return nil, inputError("more fields than table columns")
+		}
+		for i, f := range fields {
+			columns[f] = schema[i].Name
+		}
+	default:
+		for _, f := range fields {
+			columns[f] = f
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, c := range columns {
+		if !validIdent(c) {
+			return nil, inputError("invalid column name")
+		}
+		if seen[strings.ToLower(c)] {
+			return nil, inputError("duplicate column: " + c)
+		}
+		seen[strings.ToLower(c)] = true
+
+		if schema != nil && !hasColumn(schema, c) {
+			return nil, inputError("no such column: " + c)
+		}
+	}
+	if opts.Create && len(columns) == 0 {
+		return nil, inputError("no fields to import")
+	}
+	return columns, nil
+}
+
+func hasColumn(schema []columnInfo, name string) bool {
+	for _, c := range schema {
+		if strings.EqualFold(c.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// scanImport reads the records of r once, so that an invalid source is
+// reported before anything is written. It returns the fields, the number of
+// records and, if opts.InferTypes is set, the types inferred for the fields.
+func scanImport(r io.Reader, opts *importOptions) ([]string, int, map[string]string, error) {
+	var fields []string
+	read, err := readImport(r, opts, &fields)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	inferred := make(map[string]*typeInference)
+	rows := 0
+	for {
+		record, err := read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		rows++
+
+		if !opts.InferTypes {
+			continue
+		}
+		for f, v := range record.values {
+			t, ok := inferred[f]
+			if !ok {
+				t = &typeInference{csv: opts.Format == "csv"}
+				inferred[f] = t
+			}
+			t.add(v)
+		}
+	}
+
+	types := make(map[string]string)
+	for f, t := range inferred {
+		if typ := t.result(); typ != "" {
+			types[f] = typ
+		}
+	}
+	return fields, rows, types, nil
+}
+
+// readImport starts reading the records of r. The CSV fields are set once it
+// returns, the JSON ones are added as the records are read, in their order of
+// first appearance. The detected CSV header is set in opts.
+func readImport(r io.Reader, opts *importOptions, fields *[]string) (recordReader, error) {
+	switch opts.Format {
+	case "json":
+		return readJSONRecords(r, fields)
+	case "ndjson":
+		return readNDJSONRecords(r, fields), nil
+	default:
+		return readCSVRecords(r, opts, fields)
+	}
+}
+
+func readCSVRecords(r io.Reader, opts *importOptions, fields *[]string) (recordReader, error) {
+	reader := csv.NewReader(r)
+	if opts.Delimiter != 0 {
+		reader.Comma = opts.Delimiter
+	}
+	reader.FieldsPerRecord = -1
+
+	first, err := reader.Read()
+	if err == io.EOF {
+		return nil, inputError("no records to import")
+	}
+	if err != nil {
+		return nil, inputError("invalid CSV: " + err.Error())
+	}
+	first[0] = strings.TrimPrefix(first[0], "\ufeff")
+
+	if opts.Header == nil {
+		header := isCSVHeader(first)
+		opts.Header = &header
+	}
+
+	// pending is the first record, when it is not a header
+	pending := first
+	*fields = nil
+	if *opts.Header {
+		*fields = first
+		pending = nil
+	} else {
+		for i := range first {
+			*fields = append(*fields, "column"+strconv.Itoa(i+1))
+		}
+	}
+	for i, f := range *fields {
+		if containsString((*fields)[:i], f) {
+			return nil, inputError("duplicate field: " + f)
+		}
+	}
+
+	row := 0
+	return func() (importRecord, error) {
+		line := pending
+		if line != nil {
+			pending = nil
+		} else if line, err = reader.Read(); err == io.EOF {
+			return importRecord{}, io.EOF
+		} else if err != nil {
+			return importRecord{}, inputError("invalid CSV: " + err.Error())
+		}
+
+		row++
+		record := importRecord{row: row}
+		if len(line) != len(*fields) {
+			record.err = errors.New("wrong number of fields: " + strconv.Itoa(len(line)))
+			return record, nil
+		}
+		record.values = make(map[string]interface{}, len(line))
+		for j, v := range line {
+			record.values[(*fields)[j]] = v
+		}
+		return record, nil
+	}, nil
+}
+
+// isCSVHeader reports whether the first record of a CSV file looks like a
+// header: distinct, non-empty names which are not numbers.
+func isCSVHeader(record []string) bool {
+	for i, v := range record {
+		if v == "" || realPattern.MatchString(v) || containsString(record[:i], v) {
+			return false
+		}
+	}
+	return true
+}
+
+// readJSONRecords reads the objects of a JSON array one at a time.
+func readJSONRecords(r io.Reader, fields *[]string) (recordReader, error) {
+	decoder := json.NewDecoder(r)
+	if t, err := decoder.Token(); err != nil {
+		return nil, inputError("invalid JSON array: " + err.Error())
+	} else if t != json.Delim('[') {
+		return nil, inputError("invalid JSON array: not an array")
+	}
+
+	row := 0
+	done := false
+	return func() (importRecord, error) {
+		if done {
+			return importRecord{}, io.EOF
+		}
+		if !decoder.More() {
+			// The closing bracket
+			if _, err := decoder.Token(); err != nil {
+				return importRecord{}, inputError("invalid JSON array: " + err.Error())
+			}
+			done = true
+			return importRecord{}, io.EOF
+		}
+
+		var v json.RawMessage
+		if err := decoder.Decode(&v); err != nil {
+			return importRecord{}, inputError("invalid JSON array: " + err.Error())
+		}
+		row++
+		return jsonRecord(row, v, fields), nil
+	}, nil
+}
+
+func readNDJSONRecords(r io.Reader, fields *[]string) recordReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxImportLine)
+
+	row := 0
+	return func() (importRecord, error) {
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" {
+				continue
+			}
+			row++
+			return jsonRecord(row, json.RawMessage(line), fields), nil
+		}
+		if err := scanner.Err(); err != nil {
+			return importRecord{}, inputError("invalid NDJSON: " + err.Error())
+		}
+		return importRecord{}, io.EOF
+	}
+}
+
+// jsonRecord returns the record of a JSON object, adding its new keys to the
+// fields in order. Nested arrays and objects are imported as JSON text.
+func jsonRecord(row int, data json.RawMessage, fields *[]string) importRecord {
+	var v interface{}
+	if err := decodeJSON(bytes.NewReader(data), &v); err != nil {
+		return importRecord{row: row, err: errors.New("invalid JSON: " + err.Error())}
+	}
+	object, ok := v.(map[string]interface{})
+	if !ok {
+		return importRecord{row: row, err: errors.New("not a JSON object")}
+	}
+
+	record := importRecord{row: row, values: make(map[string]interface{}, len(object))}
+	for _, k := range objectKeys(data) {
+		if !containsString(*fields, k) {
+			*fields = append(*fields, k)
+		}
+		value, err := normalizeJSON(object[k])
+		if err != nil {
+			return importRecord{row: row, err: err}
+		}
+		switch value.(type) {
+		case []interface{}, map[string]interface{}:
+			data, _ := json.Marshal(object[k])
+			value = string(data)
+		}
+		record.values[k] = value
+	}
+	return record
+}
+
+// objectKeys returns the keys of a JSON object in order.
+func objectKeys(data json.RawMessage) []string {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if t, err := decoder.Token(); err != nil || t != json.Delim('{') {
+		return nil
+	}
+
+	var keys []string
+	for decoder.More() {
+		t, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		key, _ := t.(string)
+		if !containsString(keys, key) {
+			keys = append(keys, key)
+		}
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			break
+		}
+	}
+	return keys
+}
+
+// typeInference infers the type of the values of a field, added one at a
+// time: INTEGER, REAL or TEXT, or none if the field holds no values or values
+// of different types. The CSV values are texts typed by their content, an
+// empty text being null.
+type typeInference struct {
+	csv   bool
+	typ   string
+	mixed bool
+}
+
+func (ti *typeInference) add(v interface{}) {
+	if ti.mixed || v == nil {
+		return
+	}
+
+	var t string
+	switch v := v.(type) {
+	case int64, bool:
+		t = typeInteger
+	case float64:
+		t = typeReal
+	case string:
+		switch {
+		case !ti.csv:
+			t = typeText
+		case v == "":
+			return
+		case isIntegerText(v):
+			t = typeInteger
+		case isRealText(v):
+			t = typeReal
+		default:
+			t = typeText
+		}
+	default:
+		ti.mixed = true
+		return
+	}
+
+	switch {
+	case ti.typ == "" || ti.typ == t:
+		ti.typ = t
+	case ti.typ != typeText && t != typeText:
+		ti.typ = typeReal
+	case ti.csv:
+		ti.typ = typeText
+	default:
+		ti.mixed = true
+	}
+}
+
+// result returns the inferred type.
+func (ti *typeInference) result() string {
+	if ti.mixed {
+		return ""
+	}
+	return ti.typ
+}
+
+// isIntegerText reports whether s is an integer, written without leading
+// zeros so that codes such as "007" stay texts.
+func isIntegerText(s string) bool {
+	_, err := strconv.ParseInt(s, 10, 64)
+	return err == nil && !hasLeadingZero(s)
+}
+
+// isRealText reports whether s is a decimal number, written without leading
+// zeros.
+func isRealText(s string) bool {
+	return realPattern.MatchString(s) && !hasLeadingZero(s)
+}
+
+func hasLeadingZero(s string) bool {
+	digits := strings.TrimLeft(s, "+-")
+	return len(digits) > 1 && digits[0] == '0' && digits[1] >= '0' && digits[1] <= '9'
+}
+
+// convertValues converts the CSV values of the typed fields of a record, an
+// empty value being null.
+func convertValues(record importRecord, types map[string]string) {
+	for f, v := range record.values {
+		s, _ := v.(string)
+		switch types[f] {
+		case typeInteger, typeReal:
+			if s == "" {
+				record.values[f] = nil
+			} else if i, err := strconv.ParseInt(s, 10, 64); err == nil && types[f] == typeInteger {
+				record.values[f] = i
+			} else if x, err := strconv.ParseFloat(s, 64); err == nil {
+				record.values[f] = x
+			}
+		}
+	}
+}
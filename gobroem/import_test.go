@@ -0,0 +1,239 @@
+package gobroem
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// importRequest uploads content as the file of an import into the table.
+func importRequest(t *testing.T, h http.Handler, table, filename, content string, fields map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	for k, v := range fields {
+		mw.WriteField(k, v)
+	}
+	fw, err := mw.CreateFormFile("file", filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fw.Write([]byte(content))
+	mw.Close()
+
+	req := httptest.NewRequest("POST", "/api/import?table="+table, &buf)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.Header.Set("X-Requested-With", "XMLHttpRequest")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestTypeInference(t *testing.T) {
+	tests := []struct {
+		name   string
+		csv    bool
+		values []interface{}
+		want   string
+	}{
+		{"csv integers", true, []interface{}{"1", "-2", "", "+3"}, typeInteger},
+		{"csv reals", true, []interface{}{"1", "2.5", "1e3", ".5"}, typeReal},
+		{"csv leading zero", true, []interface{}{"1", "007"}, typeText},
+		{"csv zero", true, []interface{}{"0", "0.5"}, typeReal},
+		{"csv texts", true, []interface{}{"1", "x", "2.5"}, typeText},
+		{"csv empty", true, []interface{}{"", nil}, ""},
+		{"json integers", false, []interface{}{int64(1), true, nil}, typeInteger},
+		{"json numbers", false, []interface{}{int64(1), 2.5}, typeReal},
+		{"json texts", false, []interface{}{"1", "x"}, typeText},
+		{"json mixed", false, []interface{}{int64(1), "x", int64(2)}, ""},
+		{"json numeric text", false, []interface{}{"1"}, typeText},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := &typeInference{csv: tt.csv}
+			for _, v := range tt.values {
+				ti.add(v)
+			}
+			if got := ti.result(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImport(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		fields   map[string]string
+		status   int
+		// result fields checked when status is 200
+		inserted, failed int
+		aborted          bool
+		// schema is the SQL of the table once imported, empty if missing
+		schema string
+		// rows are the imported rows, in rowid order
+		rows string
+	}{
+		{
+			name:     "csv create with inferred types",
+			filename: "people.csv",
+			content:  "\ufeffid;name;zip;score\n1;Ann;007;1.5\n2;Bob;123;2\n3;Cy;;\n",
+			fields:   map[string]string{"mode": "create", "delimiter": ";", "infer_types": "1"},
+			status:   http.StatusOK, inserted: 3,
+			schema: `CREATE TABLE "people" ("id" INTEGER, "name" TEXT, "zip" TEXT, "score" REAL)`,
+			rows:   "[[1,\"Ann\",\"007\",1.5],[2,\"Bob\",\"123\",2],[3,\"Cy\",\"\",null]]",
+		},
+		{
+			name:     "csv without header",
+			filename: "people.csv",
+			content:  "1,Ann\n2,Bob\n",
+			fields:   map[string]string{"mode": "create"},
+			status:   http.StatusOK, inserted: 2,
+			schema: `CREATE TABLE "people" ("column1", "column2")`,
+			rows:   `[["1","Ann"],["2","Bob"]]`,
+		},
+		{
+			name:     "abort rolls back every batch",
+			filename: "people.csv",
+			content:  "id,name\n1,Ann\n2,Bob\n3,Cy\n4\n5,Dee\n",
+			fields:   map[string]string{"mode": "create", "batch_size": "2"},
+			status:   http.StatusOK, failed: 1, aborted: true,
+		},
+		{
+			name:     "ignore commits the other rows",
+			filename: "people.csv",
+			content:  "id,name\n1,Ann\n2,Bob\n3,Cy\n4\n5,Dee\n",
+			fields:   map[string]string{"mode": "create", "batch_size": "2", "on_conflict": "ignore"},
+			status:   http.StatusOK, inserted: 4, failed: 1,
+			schema: `CREATE TABLE "people" ("id", "name")`,
+			rows:   `[["1","Ann"],["2","Bob"],["3","Cy"],["5","Dee"]]`,
+		},
+		{
+			name:     "json array",
+			filename: "people.json",
+			content:  `[{"id":1,"name":"Ann"},{"id":2,"tags":["a","b"]},5,{"name":"Cy","id":3.5}]`,
+			fields:   map[string]string{"mode": "create", "infer_types": "1", "on_conflict": "ignore"},
+			status:   http.StatusOK, inserted: 3, failed: 1,
+			schema: `CREATE TABLE "people" ("id" REAL, "name" TEXT, "tags" TEXT)`,
+			rows:   `[[1,"Ann",null],[2,null,"[\"a\",\"b\"]"],[3.5,"Cy",null]]`,
+		},
+		{
+			name:     "ndjson",
+			filename: "people.ndjson",
+			content:  "{\"id\":1}\n\n{\"id\":2,\"name\":\"Bob\"}\n",
+			fields:   map[string]string{"mode": "create"},
+			status:   http.StatusOK, inserted: 2,
+			schema: `CREATE TABLE "people" ("id", "name")`,
+			rows:   `[[1,null],[2,"Bob"]]`,
+		},
+		{
+			name:     "empty json array",
+			filename: "people.json",
+			content:  `[]`,
+			fields:   map[string]string{"mode": "create", "columns": `{}`},
+			status:   http.StatusBadRequest,
+		},
+		{
+			name:     "truncated json array",
+			filename: "people.json",
+			content:  `[{"id":1},{"id":2}`,
+			fields:   map[string]string{"mode": "create"},
+			status:   http.StatusBadRequest,
+		},
+		{
+			name:     "json object",
+			filename: "people.json",
+			content:  `{"id":1}`,
+			fields:   map[string]string{"mode": "create"},
+			status:   http.StatusBadRequest,
+		},
+		{
+			name:     "invalid csv",
+			filename: "people.csv",
+			content:  "id,name\n1,Ann\n2,\"Bob\n",
+			fields:   map[string]string{"mode": "create"},
+			status:   http.StatusBadRequest,
+		},
+		{
+			name:     "empty csv",
+			filename: "people.csv",
+			content:  "",
+			fields:   map[string]string{"mode": "create"},
+			status:   http.StatusBadRequest,
+		},
+		{
+			name:     "duplicate fields",
+			filename: "people.csv",
+			content:  "id,id\n1,2\n",
+			fields:   map[string]string{"mode": "create", "header": "1"},
+			status:   http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, h := newTestAPI(t, Options{})
+			w := importRequest(t, h, "people", tt.filename, tt.content, tt.fields)
+			if w.Code != tt.status {
+				t.Fatalf("got status %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+			if w.Code == http.StatusOK {
+				var result importResult
+				decodeTestBody(t, w, &result)
+				if result.RowsInserted != int64(tt.inserted) || result.RowsFailed != tt.failed || result.Aborted != tt.aborted {
+					t.Errorf("got %+v, want %d inserted, %d failed, aborted %v", result, tt.inserted, tt.failed, tt.aborted)
+				}
+			}
+
+			var schema string
+			a.databases[0].client.QueryRow("SELECT coalesce(max(sql), '') FROM sqlite_master WHERE name = 'people'").Scan(&schema)
+			if schema != tt.schema {
+				t.Errorf("schema: got %q, want %q", schema, tt.schema)
+			}
+			if tt.rows != "" {
+				w := testRequest(t, h, "POST", "/api/query", "query=SELECT+*+FROM+people+ORDER+BY+rowid")
+				if got := w.Body.String(); !strings.Contains(got, `"rows":`+tt.rows) {
+					t.Errorf("rows: got %s, want %s", got, tt.rows)
+				}
+			}
+		})
+	}
+}
+
+func TestImportAppend(t *testing.T) {
+	a, h := newTestAPI(t, Options{})
+	mustExec(t, a, "CREATE TABLE kv (k TEXT PRIMARY KEY, v NOT NULL)", "INSERT INTO kv VALUES ('a', 0)")
+
+	w := importRequest(t, h, "kv", "kv.json", `[{"key":"a","val":1,"junk":2},{"key":"b","val":2},{"key":"c"}]`,
+		map[string]string{"columns": `{"key":"k","val":"v"}`, "on_conflict": "replace"})
+	var result importResult
+	decodeTestBody(t, w, &result)
+	if result.RowsRead != 3 || result.RowsInserted != 2 || result.RowsFailed != 1 || result.Errors[0].Row != 3 {
+		t.Errorf("got %+v, want 2 rows inserted and row 3 failed", result)
+	}
+
+	// Without header, the fields are the table columns in order
+	w = importRequest(t, h, "kv", "kv.csv", "d,4\n", map[string]string{"header": "0"})
+	decodeTestBody(t, w, &result)
+	if result.RowsInserted != 1 {
+		t.Errorf("got %+v, want 1 row inserted", result)
+	}
+
+	w = testRequest(t, h, "POST", "/api/query", "query=SELECT+*+FROM+kv+ORDER+BY+k")
+	if got, want := w.Body.String(), `"rows":[["a",1],["b",2],["d","4"]]`; !strings.Contains(got, want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	w = importRequest(t, h, "kv", "kv.csv", "k,nope\na,1\n", nil)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("unknown column: got status %d, want 400", w.Code)
+	}
+	w = importRequest(t, h, "missing", "kv.csv", "k\na\n", nil)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("missing table: got status %d, want 404", w.Code)
+	}
+}
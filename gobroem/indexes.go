@@ -0,0 +1,221 @@
+package gobroem
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	// cid of the index columns which are not table columns
+	cidRowid      = -1
+	cidExpression = -2
+)
+
+// indexColumn is a key column of an index.
+type indexColumn struct {
+	// Name is the table column, empty for an expression
+	Name string `json:"name"`
+	// Expression is the indexed expression, as written in the index SQL
+	Expression string `json:"expression,omitempty"`
+	Desc       bool   `json:"desc"`
+	Collation  string `json:"collation"`
+}
+
+// indexInfo describes an index of a table.
+type indexInfo struct {
+	Name  string `json:"name"`
+	Table string `json:"tbl_name"`
+	// Origin is "c" for CREATE INDEX, "u" for a UNIQUE constraint and "pk" for
+	// a PRIMARY KEY constraint
+	Origin  string        `json:"origin"`
+	Unique  bool          `json:"unique"`
+	Partial bool          `json:"partial"`
+	Where   string        `json:"where,omitempty"`
+	Columns []indexColumn `json:"columns"`
+	// SQL is null for the indexes created by a constraint
+	SQL *string `json:"sql"`
+}
+
+// TableIndexes returns the indexes for the given table.
+func (client *sqlClient) TableIndexes(ctx context.Context, table string) ([]indexInfo, error) {
+	result, err := client.query(ctx, fmt.Sprintf(queryTableIndexes, client.master()), table)
+	if err != nil {
+		return nil, err
+	}
+	statements := make(map[string]string)
+	for _, row := range result.Rows {
+		name, _ := row[0].(string)
+		if sql, ok := row[1].(string); ok {
+			statements[name] = sql
+		}
+	}
+
+	list, err := client.query(ctx, client.pragma("index_list", table))
+	if err != nil {
+		return nil, err
+	}
+
+	// Init empty slice; otherwise JSON marshal will encode it to "null"
+	indexes := make([]indexInfo, 0, len(list.Rows))
+	for _, row := range list.Format() {
+		index := indexInfo{Table: table}
+		index.Name, _ = row["name"].(string)
+		index.Origin, _ = row["origin"].(string)
+		index.Unique = row["unique"] == int64(1)
+		index.Partial = row["partial"] == int64(1)
+
+		var terms []string
+		if sql, found := statements[index.Name]; found {
+			index.SQL = &sql
+			terms, index.Where = parseIndexSQL(sql)
+		}
+
+		index.Columns, err = client.indexColumns(ctx, index.Name, terms)
+		if err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, index)
+	}
+
+	return indexes, nil
+}
+
+// indexColumns returns the key columns of the given index. terms are the
+// indexed terms of the index SQL, used to tell the expressions.
+func (client *sqlClient) indexColumns(ctx context.Context, index string, terms []string) ([]indexColumn, error) {
+	rows, err := client.QueryContext(ctx, client.pragma("index_xinfo", index))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns := make([]indexColumn, 0)
+	for rows.Next() {
+		var seqno, cid int
+		var name *string
+		var desc, key bool
+		var c indexColumn
+		if err := rows.Scan(&seqno, &cid, &name, &desc, &c.Collation, &key); err != nil {
+			return nil, err
+		}
+		// The other columns are the rowid or primary key stored with each entry
+		if !key {
+			continue
+		}
+
+		c.Desc = desc
+		switch {
+		case name != nil:
+			c.Name = *name
+		case cid == cidRowid:
+			c.Name = "rowid"
+		case cid == cidExpression && seqno < len(terms):
+			c.Expression = terms[seqno]
+		}
+		columns = append(columns, c)
+	}
+	return columns, rows.Err()
+}
+
+// parseIndexSQL returns the indexed terms of a CREATE INDEX statement, without
+// their COLLATE and sort order, and the WHERE clause of a partial index.
+func parseIndexSQL(sql string) ([]string, string) {
+	var terms []string
+	depth, start := 0, -1
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			i = skipQuoted(sql, i, c)
+		case c == '[':
+			if j := strings.IndexByte(sql[i:], ']'); j >= 0 {
+				i += j
+			}
+		case c == '(':
+			depth++
+			if depth == 1 && start < 0 {
+				start = i + 1
+			}
+		case c == ')' && depth > 0:
+			depth--
+			if depth > 0 || start < 0 {
+				break
+			}
+			terms = append(terms, indexExpression(sql[start:i]))
+
+			// What follows the indexed terms is the WHERE clause, if any
+			rest := strings.TrimSpace(sql[i+1:])
+			if len(rest) > 5 && strings.EqualFold(rest[:5], "WHERE") && !isWordChar(rest[5]) {
+				return terms, strings.TrimSpace(rest[5:])
+			}
+			return terms, ""
+		case c == ',' && depth == 1:
+			terms = append(terms, indexExpression(sql[start:i]))
+			start = i + 1
+		}
+	}
+	return terms, ""
+}
+
+// indexExpression returns an indexed term without its trailing ASC or DESC
+// and COLLATE clause, which index_xinfo reports in desc and coll.
+func indexExpression(term string) string {
+	term = strings.TrimSpace(term)
+	for _, order := range []string{"ASC", "DESC"} {
+		if n := len(term) - len(order); n > 0 && strings.EqualFold(term[n:], order) && !isWordChar(term[n-1]) {
+			term = strings.TrimSpace(term[:n])
+			break
+		}
+	}
+
+	// The last COLLATE keyword outside parentheses and quotes
+	collate, depth := -1, 0
+	for i := 0; i < len(term); i++ {
+		c := term[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			i = skipQuoted(term, i, c)
+		case c == '[':
+			if j := strings.IndexByte(term[i:], ']'); j >= 0 {
+				i += j
+			}
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case depth == 0 && isWordChar(c):
+			j := i
+			for j < len(term) && isWordChar(term[j]) {
+				j++
+			}
+			if strings.EqualFold(term[i:j], "COLLATE") {
+				collate = i
+			}
+			i = j - 1
+		}
+	}
+	if collate > 0 && isSingleName(strings.TrimSpace(term[collate+len("COLLATE"):])) {
+		term = strings.TrimSpace(term[:collate])
+	}
+	return term
+}
+
+// isSingleName reports whether s is a single name, bare or quoted.
+func isSingleName(s string) bool {
+	if s == "" {
+		return false
+	}
+	switch c := s[0]; c {
+	case '\'', '"', '`':
+		return skipQuoted(s, 0, c) == len(s)-1
+	case '[':
+		return strings.IndexByte(s, ']') == len(s)-1
+	}
+	for i := 0; i < len(s); i++ {
+		if !isWordChar(s[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+package gobroem
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseIndexSQL(t *testing.T) {
+	tests := []struct {
+		sql   string
+		terms []string
+		where string
+	}{
+		{`CREATE INDEX i ON t (a)`, []string{"a"}, ""},
+		{`CREATE UNIQUE INDEX "i(x" ON "t(" (a DESC, b COLLATE NOCASE)`, []string{"a", "b"}, ""},
+		{`CREATE INDEX i ON t (lower(a), (b + 1) DESC, substr(c, 1, 2))`, []string{"lower(a)", "(b + 1)", "substr(c, 1, 2)"}, ""},
+		{`CREATE INDEX i ON t (lower(a) collate "no case" asc, a || b COLLATE [x], c COLLATE NOCASE || d)`, []string{"lower(a)", "a || b", "c COLLATE NOCASE || d"}, ""},
+		{`CREATE INDEX i ON t (desc, "a DESC", x'00' || c DESC, f(b COLLATE NOCASE))`, []string{"desc", `"a DESC"`, "x'00' || c", "f(b COLLATE NOCASE)"}, ""},
+		{`CREATE INDEX i ON t (a, 'x,)' || b) WHERE a > 0 AND (b IS NOT NULL)`, []string{"a", "'x,)' || b"}, "a > 0 AND (b IS NOT NULL)"},
+		{`CREATE INDEX i ON [t)] (a) where a`, []string{"a"}, "a"},
+		{`CREATE INDEX i ON t (a) WHEREVER`, []string{"a"}, ""},
+		{`CREATE INDEX i ON t (a`, nil, ""},
+	}
+	for _, tt := range tests {
+		terms, where := parseIndexSQL(tt.sql)
+		if !reflect.DeepEqual(terms, tt.terms) || where != tt.where {
+			t.Errorf("%s: got %q %q, want %q %q", tt.sql, terms, where, tt.terms, tt.where)
+		}
+	}
+}
+
+func TestTableIndexes(t *testing.T) {
+	a, h := newTestAPI(t, Options{})
+	mustExec(t, a,
+		`CREATE TABLE t (id TEXT PRIMARY KEY, a, b TEXT UNIQUE, c)`,
+		`CREATE INDEX t_expr ON t (lower(a) DESC, upper(c) COLLATE NOCASE)`,
+		`CREATE INDEX t_partial ON t (c) WHERE c IS NOT NULL`,
+	)
+	sql := func(s string) *string { return &s }
+	// test.db has the schema format 1, which ignores DESC in indexes
+
+	var indexes []indexInfo
+	testJSON(t, h, "GET", "/api/table/indexes?table=t", "", http.StatusOK, &indexes)
+	want := map[string]indexInfo{
+		"sqlite_autoindex_t_1": {"sqlite_autoindex_t_1", "t", "pk", true, false, "",
+			[]indexColumn{{"id", "", false, "BINARY"}}, nil},
+		"sqlite_autoindex_t_2": {"sqlite_autoindex_t_2", "t", "u", true, false, "",
+			[]indexColumn{{"b", "", false, "BINARY"}}, nil},
+		"t_expr": {"t_expr", "t", "c", false, false, "",
+			[]indexColumn{{"", "lower(a)", false, "BINARY"}, {"", "upper(c)", false, "NOCASE"}},
+			sql("CREATE INDEX t_expr ON t (lower(a) DESC, upper(c) COLLATE NOCASE)")},
+		"t_partial": {"t_partial", "t", "c", false, true, "c IS NOT NULL",
+			[]indexColumn{{"c", "", false, "BINARY"}},
+			sql("CREATE INDEX t_partial ON t (c) WHERE c IS NOT NULL")},
+	}
+	if len(indexes) != len(want) {
+		t.Fatalf("got %d indexes, want %d", len(indexes), len(want))
+	}
+	for _, index := range indexes {
+		if !reflect.DeepEqual(index, want[index.Name]) {
+			t.Errorf("got %+v, want %+v", index, want[index.Name])
+		}
+	}
+
+	testJSON(t, h, "GET", "/api/table/indexes?table=artists", "", http.StatusOK, &indexes)
+	if len(indexes) != 0 {
+		t.Errorf("got %d indexes for artists, want none", len(indexes))
+	}
+}
+
+func TestIndexColumnsDesc(t *testing.T) {
+	client, err := newClient(filepath.Join(t.TempDir(), "new.db"), false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	for _, stmt := range []string{`CREATE TABLE t (a, b)`, `CREATE INDEX t_ab ON t (a DESC, (b + 1) COLLATE NOCASE DESC)`} {
+		if _, err := client.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	indexes, err := client.TableIndexes(context.Background(), "t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []indexColumn{{"a", "", true, "BINARY"}, {"", "(b + 1)", true, "NOCASE"}}
+	if len(indexes) != 1 || !reflect.DeepEqual(indexes[0].Columns, want) {
+		t.Errorf("got %+v, want the columns %+v", indexes, want)
+	}
+}
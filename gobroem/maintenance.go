@@ -0,0 +1,438 @@
+package gobroem
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// maintenanceMaxErrors caps the number of errors reported by a check
+	maintenanceMaxErrors = 100
+	// maxFinishedJobs is the number of finished maintenance jobs kept for
+	// their results
+	maxFinishedJobs = 50
+	// vacuumPollInterval is the interval between two reads of the size of a
+	// file written by VACUUM INTO
+	vacuumPollInterval = 200 * time.Millisecond
+
+	jobRunning  = "running"
+	jobDone     = "done"
+	jobFailed   = "failed"
+	jobCanceled = "canceled"
+)
+
+var (
+	errJobRunning         = errors.New("a maintenance job is already running on the database")
+	errVacuumIntoDisabled = errors.New("VACUUM INTO is disabled")
+)
+
+// progressFunc reports the progress of a maintenance action: done out of
+// total units, the tables or the bytes written, and the current step.
+type progressFunc func(done, total int64, step string)
+
+// integrityResult is the result of an integrity or quick check.
+type integrityResult struct {
+	OK     bool     `json:"ok"`
+	Errors []string `json:"errors"`
+}
+
+// IntegrityCheck runs PRAGMA integrity_check, or the faster quick_check which
+// doesn't compare the indexes with their tables.
+func (client *sqlClient) IntegrityCheck(ctx context.Context, quick bool) (*integrityResult, error) {
+	pragma := "integrity_check"
+	if quick {
+		pragma = "quick_check"
+	}
+	errs, err := client.fetchRows(ctx, "PRAGMA "+quoteIdent(client.schemaName())+"."+pragma+"("+strconv.Itoa(maintenanceMaxErrors)+")")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(errs) == 1 && errs[0] == "ok" {
+		return &integrityResult{OK: true, Errors: make([]string, 0)}, nil
+	}
+	return &integrityResult{Errors: errs}, nil
+}
+
+// foreignKeyViolation is a row referencing a missing parent row.
+type foreignKeyViolation struct {
+	Table string `json:"table"`
+	// RowID is null for a WITHOUT ROWID table
+	RowID  *int64 `json:"rowid"`
+	Parent string `json:"parent"`
+	// ForeignKey is the id of the foreign key in PRAGMA foreign_key_list
+	ForeignKey int64 `json:"fkid"`
+}
+
+type foreignKeyResult struct {
+	OK         bool                  `json:"ok"`
+	Violations []foreignKeyViolation `json:"violations"`
+	Truncated  bool                  `json:"truncated"`
+}
+
+// ForeignKeyCheck runs PRAGMA foreign_key_check on the tables one by one.
+func (client *sqlClient) ForeignKeyCheck(ctx context.Context, progress progressFunc) (*foreignKeyResult, error) {
+	tables, err := client.maintenanceTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &foreignKeyResult{Violations: make([]foreignKeyViolation, 0)}
+	for i, table := range tables {
+		progress(int64(i), int64(len(tables)), table)
+		rows, err := client.QueryContext(ctx, "PRAGMA "+quoteIdent(client.schemaName())+".foreign_key_check("+quoteIdent(table)+")")
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			if len(result.Violations) >= maintenanceMaxErrors {
+				result.Truncated = true
+				break
+			}
+			var v foreignKeyViolation
+			var rowID sql.NullInt64
+			if err := rows.Scan(&v.Table, &rowID, &v.Parent, &v.ForeignKey); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			if rowID.Valid {
+				v.RowID = &rowID.Int64
+			}
+			result.Violations = append(result.Violations, v)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		if result.Truncated {
+			break
+		}
+	}
+	progress(int64(len(tables)), int64(len(tables)), "")
+
+	result.OK = len(result.Violations) == 0
+	return result, nil
+}
+
+type analyzeResult struct {
+	Tables []string `json:"tables"`
+}
+
+// Analyze gathers the statistics of the query planner on the tables one by
+// one.
+func (client *sqlClient) Analyze(ctx context.Context, progress progressFunc) (*analyzeResult, error) {
+	if client.readOnly {
+		return nil, errReadOnly
+	}
+	tables, err := client.maintenanceTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, table := range tables {
+		progress(int64(i), int64(len(tables)), table)
+		if _, err := client.ExecContext(ctx, "ANALYZE "+client.qualify(table)); err != nil {
+			return nil, err
+		}
+	}
+	progress(int64(len(tables)), int64(len(tables)), "")
+	return &analyzeResult{Tables: tables}, nil
+}
+
+type optimizeResult struct {
+	// Statements are the ANALYZE statements run by the optimization
+	Statements []string `json:"statements"`
+}
+
+// Optimize runs PRAGMA optimize, after listing what it will do in debug
+// mode.
+func (client *sqlClient) Optimize(ctx context.Context) (*optimizeResult, error) {
+	if client.readOnly {
+		return nil, errReadOnly
+	}
+
+	conn, err := client.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// PRAGMA optimize considers the queries run on its connection, so both
+	// pragmas run on the same one. 0x03 lists the ANALYZE statements.
+	pragma := "PRAGMA " + quoteIdent(client.schemaName()) + ".optimize"
+	rows, err := conn.QueryContext(ctx, pragma+"(0x03)")
+	if err != nil {
+		return nil, err
+	}
+	result := &optimizeResult{Statements: make([]string, 0)}
+	for rows.Next() {
+		var stmt string
+		if err := rows.Scan(&stmt); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		result.Statements = append(result.Statements, stmt)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := conn.ExecContext(ctx, pragma); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+type vacuumResult struct {
+	// SizeBefore and SizeAfter are the sizes of the database in bytes, or
+	// of the new file with VACUUM INTO
+	SizeBefore int64  `json:"size_before"`
+	SizeAfter  int64  `json:"size_after"`
+	File       string `json:"file,omitempty"`
+}
+
+// Vacuum rebuilds the database, reclaiming its free pages.
+func (client *sqlClient) Vacuum(ctx context.Context) (*vacuumResult, error) {
+	if client.readOnly {
+		return nil, errReadOnly
+	}
+
+	result := &vacuumResult{}
+	var err error
+	if result.SizeBefore, _, err = client.databaseSize(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := client.ExecContext(ctx, "VACUUM "+quoteIdent(client.schemaName())); err != nil {
+		return nil, err
+	}
+	if result.SizeAfter, _, err = client.databaseSize(ctx); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// VacuumInto writes a vacuumed copy of the database to a new file, which
+// doesn't change the database. The progress is the size of the file out of
+// the size of the used pages.
+func (client *sqlClient) VacuumInto(ctx context.Context, file string, progress progressFunc) (*vacuumResult, error) {
+	size, free, err := client.databaseSize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	used := size - free
+
+	// SQLite accepts an empty file: create it so that an existing file is
+	// never overwritten, nor removed on failure
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		if os.IsExist(err) {
+			return nil, inputError("the file already exists")
+		}
+		return nil, err
+	}
+	f.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(vacuumPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if written, err := fileSize(file); err == nil && written < used {
+					progress(written, used, file)
+				}
+			}
+		}
+	}()
+
+	if _, err := client.ExecContext(ctx, "VACUUM "+quoteIdent(client.schemaName())+" INTO ?", file); err != nil {
+		// Don't leave a partial copy behind
+		os.Remove(file)
+		return nil, err
+	}
+
+	result := &vacuumResult{SizeBefore: size, File: file}
+	if result.SizeAfter, err = fileSize(file); err != nil {
+		return nil, err
+	}
+	progress(used, used, "")
+	return result, nil
+}
+
+// databaseSize returns the size of the client schema and of its free pages,
+// in bytes.
+func (client *sqlClient) databaseSize(ctx context.Context) (int64, int64, error) {
+	schema := quoteIdent(client.schemaName())
+	var pages, free, pageSize int64
+	for _, p := range []struct {
+		pragma string
+		value  *int64
+	}{{"page_count", &pages}, {"freelist_count", &free}, {"page_size", &pageSize}} {
+		if err := client.QueryRowContext(ctx, "PRAGMA "+schema+"."+p.pragma).Scan(p.value); err != nil {
+			return 0, 0, err
+		}
+	}
+	return pages * pageSize, free * pageSize, nil
+}
+
+// maintenanceTables returns the names of the tables of the client schema,
+// the virtual and internal ones excepted.
+func (client *sqlClient) maintenanceTables(ctx context.Context) ([]string, error) {
+	objects, err := client.SchemaObjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables := make([]string, 0, len(objects.Tables))
+	for _, t := range objects.Tables {
+		if !strings.HasPrefix(t.Name, "sqlite_") {
+			tables = append(tables, t.Name)
+		}
+	}
+	return tables, nil
+}
+
+// maintenanceJob is a maintenance action run in the background.
+type maintenanceJob struct {
+	ID       string      `json:"id"`
+	Action   string      `json:"action"`
+	Database string      `json:"database"`
+	Schema   string      `json:"schema,omitempty"`
+	Status   string      `json:"status"`
+	Progress jobProgress `json:"progress"`
+	Started  time.Time   `json:"started_at"`
+	Finished *time.Time  `json:"finished_at,omitempty"`
+	Result   interface{} `json:"result,omitempty"`
+	Error    string      `json:"error,omitempty"`
+
+	principal string
+	cancel    context.CancelFunc
+}
+
+// jobProgress is the progress of a job: done out of total units.
+type jobProgress struct {
+	Done  int64  `json:"done"`
+	Total int64  `json:"total"`
+	Step  string `json:"step,omitempty"`
+}
+
+// maintenanceJobs tracks the maintenance jobs, running or finished.
+type maintenanceJobs struct {
+	sync.Mutex
+	// jobs holds the jobs in the order they started
+	jobs []*maintenanceJob
+}
+
+func newMaintenanceJobs() *maintenanceJobs {
+	return &maintenanceJobs{}
+}
+
+// start registers a job. It returns false if another job is running on the
+// same database.
+func (m *maintenanceJobs) start(job *maintenanceJob) bool {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, j := range m.jobs {
+		if j.Database == job.Database && j.Status == jobRunning {
+			return false
+		}
+	}
+	job.Status = jobRunning
+	m.jobs = append(m.jobs, job)
+	return true
+}
+
+// progress updates the progress of a running job.
+func (m *maintenanceJobs) progress(job *maintenanceJob, done, total int64, step string) {
+	m.Lock()
+	defer m.Unlock()
+
+	job.Progress = jobProgress{done, total, step}
+}
+
+// finish records the result of a job, and drops the oldest finished jobs.
+func (m *maintenanceJobs) finish(job *maintenanceJob, result interface{}, err error) {
+	m.Lock()
+	defer m.Unlock()
+
+	now := time.Now()
+	job.Finished = &now
+	switch {
+	case err == context.Canceled:
+		job.Status = jobCanceled
+	case err != nil:
+		job.Status = jobFailed
+		if isReadOnlyError(err) {
+			err = errReadOnly
+		}
+		job.Error = err.Error()
+	default:
+		job.Status = jobDone
+		job.Result = result
+		job.Progress = jobProgress{Done: job.Progress.Total, Total: job.Progress.Total}
+	}
+
+	finished := 0
+	for i := len(m.jobs) - 1; i >= 0; i-- {
+		if m.jobs[i].Status == jobRunning {
+			continue
+		}
+		if finished++; finished > maxFinishedJobs {
+			m.jobs = append(m.jobs[:i], m.jobs[i+1:]...)
+		}
+	}
+}
+
+// get returns a copy of a job started by the principal.
+func (m *maintenanceJobs) get(id, principal string) (maintenanceJob, bool) {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, j := range m.jobs {
+		if j.ID == id && j.principal == principal {
+			return *j, true
+		}
+	}
+	return maintenanceJob{}, false
+}
+
+// list returns copies of the jobs started by the principal on a database,
+// the most recent first.
+func (m *maintenanceJobs) list(database, principal string) []maintenanceJob {
+	m.Lock()
+	defer m.Unlock()
+
+	jobs := make([]maintenanceJob, 0)
+	for i := len(m.jobs) - 1; i >= 0; i-- {
+		if m.jobs[i].Database == database && m.jobs[i].principal == principal {
+			jobs = append(jobs, *m.jobs[i])
+		}
+	}
+	return jobs
+}
+
+// cancel cancels a running job started by the principal. It returns false if
+// there is no such job.
+func (m *maintenanceJobs) cancel(id, principal string) bool {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, j := range m.jobs {
+		if j.ID == id && j.principal == principal && j.Status == jobRunning {
+			j.cancel()
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+package gobroem
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// waitMaintenanceJob polls a maintenance job until it is finished.
+func waitMaintenanceJob(t *testing.T, h http.Handler, id string) maintenanceJob {
+	t.Helper()
+	for i := 0; i < 500; i++ {
+		var job maintenanceJob
+		testJSON(t, h, "GET", "/api/maintenance/job?id="+id, "", http.StatusOK, &job)
+		if job.Status != jobRunning {
+			return job
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("job %s still running", id)
+	return maintenanceJob{}
+}
+
+func TestMaintenance(t *testing.T) {
+	dir := t.TempDir()
+	a, h := newTestAPI(t, Options{VacuumDir: dir})
+	mustExec(t, a,
+		"PRAGMA foreign_keys = OFF",
+		"INSERT INTO albums (Title, ArtistId) VALUES ('Orphan', 9999)",
+		"CREATE TABLE scratch (v)",
+		"INSERT INTO scratch SELECT randomblob(10000) FROM tracks LIMIT 200",
+		"DELETE FROM scratch",
+	)
+	if err := ioutil.WriteFile(filepath.Join(dir, "taken.db"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		action string
+		body   string
+		result string
+	}{
+		{"integrity_check", "", `{"errors":[],"ok":true}`},
+		{"quick_check", "", `{"errors":[],"ok":true}`},
+		{"foreign_key_check", "", `{"ok":false,"truncated":false,"violations":[{"fkid":0,"parent":"artists","rowid":348,"table":"albums"}]}`},
+		{"analyze", "", `"tables":["albums","artists","customers",`},
+		{"optimize", "", `{"statements":[`},
+		{"vacuum", "into=copy.db", `"file":"copy.db"`},
+		{"vacuum", "", `"size_before":`},
+	}
+	for _, tt := range tests {
+		var started maintenanceJob
+		testJSON(t, h, "POST", "/api/maintenance/"+tt.action, tt.body, http.StatusAccepted, &started)
+		if started.ID == "" || started.Action != tt.action || started.Database != "test" {
+			t.Errorf("%s: got job %+v", tt.action, started)
+		}
+		job := waitMaintenanceJob(t, h, started.ID)
+		raw, _ := json.Marshal(job.Result)
+		result := string(raw)
+		if job.Status != jobDone || job.Finished == nil || !strings.Contains(result, tt.result) {
+			t.Errorf("%s %s: got %s %s %s, want %s", tt.action, tt.body, job.Status, job.Error, result, tt.result)
+		}
+	}
+
+	// The vacuum reclaimed the free pages, the copy was written in VacuumDir
+	var list struct {
+		Jobs []maintenanceJob `json:"jobs"`
+	}
+	testJSON(t, h, "GET", "/api/maintenance", "", http.StatusOK, &list)
+	if len(list.Jobs) != len(tests) || list.Jobs[0].Action != "vacuum" {
+		t.Fatalf("got %d jobs, want %d", len(list.Jobs), len(tests))
+	}
+	vacuum := list.Jobs[0].Result.(map[string]interface{})
+	if vacuum["size_after"].(float64) >= vacuum["size_before"].(float64) {
+		t.Errorf("vacuum: got %v", vacuum)
+	}
+	if fi, err := os.Stat(filepath.Join(dir, "copy.db")); err != nil || fi.Size() == 0 {
+		t.Errorf("vacuum into: got %v, %v", fi, err)
+	}
+
+	errorTests := []struct {
+		method string
+		target string
+		body   string
+		status int
+	}{
+		{"GET", "/api/maintenance/analyze", "", http.StatusMethodNotAllowed},
+		{"POST", "/api/maintenance/reindex", "", http.StatusNotFound},
+		{"POST", "/api/maintenance/vacuum", "into=taken.db", http.StatusBadRequest},
+		{"POST", "/api/maintenance/vacuum", "into=../copy.db", http.StatusBadRequest},
+		{"POST", "/api/maintenance/vacuum", "into=..", http.StatusBadRequest},
+		{"POST", "/api/maintenance/analyze?db=nope", "", http.StatusNotFound},
+		{"GET", "/api/maintenance/job?id=nope", "", http.StatusNotFound},
+		{"DELETE", "/api/maintenance/job?id=nope", "", http.StatusNotFound},
+	}
+	for _, tt := range errorTests {
+		if w := testRequest(t, h, tt.method, tt.target, tt.body); w.Code != tt.status {
+			t.Errorf("%s %s %s: got status %d, want %d", tt.method, tt.target, tt.body, w.Code, tt.status)
+		}
+	}
+
+	_, h = newTestAPI(t, Options{ReadOnly: true})
+	for target, status := range map[string]int{
+		"/api/maintenance/analyze":               http.StatusForbidden,
+		"/api/maintenance/optimize":              http.StatusForbidden,
+		"/api/maintenance/vacuum":                http.StatusForbidden,
+		"/api/maintenance/integrity_check":       http.StatusAccepted,
+		"/api/maintenance/vacuum?into=x.db&db=x": http.StatusNotFound,
+	} {
+		if w := testRequest(t, h, "POST", target, ""); w.Code != status {
+			t.Errorf("read-only %s: got status %d, want %d", target, w.Code, status)
+		}
+	}
+	_, h = newTestAPI(t, Options{})
+	if w := testRequest(t, h, "POST", "/api/maintenance/vacuum", "into=copy.db"); w.Code != http.StatusForbidden {
+		t.Errorf("vacuum into without VacuumDir: got status %d, want %d", w.Code, http.StatusForbidden)
+	}
+}
+
+func TestMaintenanceJobs(t *testing.T) {
+	m := newMaintenanceJobs()
+	canceled := false
+	first := &maintenanceJob{ID: "1", Database: "a", principal: "ann", cancel: func() { canceled = true }}
+	if !m.start(first) {
+		t.Fatal("first job not started")
+	}
+	if m.start(&maintenanceJob{ID: "2", Database: "a", principal: "bob"}) {
+		t.Error("second job started on the same database")
+	}
+	if !m.start(&maintenanceJob{ID: "3", Database: "b", principal: "ann", cancel: func() {}}) {
+		t.Error("job not started on another database")
+	}
+
+	m.progress(first, 2, 5, "albums")
+	if job, found := m.get("1", "ann"); !found || job.Progress != (jobProgress{2, 5, "albums"}) {
+		t.Errorf("got job %+v", job)
+	}
+	if _, found := m.get("1", "bob"); found {
+		t.Error("got the job of another principal")
+	}
+	if m.cancel("1", "bob") || canceled {
+		t.Error("canceled the job of another principal")
+	}
+	if !m.cancel("1", "ann") || !canceled {
+		t.Error("job not canceled")
+	}
+
+	tests := []struct {
+		err    error
+		status string
+		errMsg string
+	}{
+		{context.Canceled, jobCanceled, ""},
+		{errors.New("disk I/O error"), jobFailed, "disk I/O error"},
+		{nil, jobDone, ""},
+	}
+	for _, tt := range tests {
+		job := &maintenanceJob{ID: "x", Database: "c", Progress: jobProgress{Done: 1, Total: 4}}
+		m.start(job)
+		m.finish(job, "result", tt.err)
+		if job.Status != tt.status || job.Error != tt.errMsg || job.Finished == nil {
+			t.Errorf("%v: got job %+v", tt.err, job)
+		}
+		if tt.err == nil && (job.Result != "result" || job.Progress.Done != 4) {
+			t.Errorf("got job %+v", job)
+		}
+	}
+	if m.cancel("x", "") {
+		t.Error("canceled a finished job")
+	}
+
+	for i := 0; i < maxFinishedJobs+10; i++ {
+		job := &maintenanceJob{Database: "d"}
+		m.start(job)
+		m.finish(job, nil, nil)
+	}
+	if n := len(m.list("d", "")); n != maxFinishedJobs {
+		t.Errorf("got %d finished jobs, want %d", n, maxFinishedJobs)
+	}
+	if jobs := m.list("b", "ann"); len(jobs) != 1 || jobs[0].Status != jobRunning {
+		t.Errorf("running job dropped: %+v", jobs)
+	}
+}
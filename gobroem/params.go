@@ -0,0 +1,176 @@
+package gobroem
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// queryBody is the JSON body of a parameterized query. Params is either an
+// array of positional parameters or an object of named ones, the names
+// with or without their ":", "@" or "$" prefix.
+type queryBody struct {
+	SQL    string      `json:"sql"`
+	Params interface{} `json:"params"`
+}
+
+// parseQueryBody decodes a parameterized query and returns its SQL and
+// arguments.
+func parseQueryBody(r io.Reader) (string, []interface{}, error) {
+	var body queryBody
+	if err := decodeJSON(r, &body); err != nil {
+		return "", nil, inputError(fmt.Sprintf("invalid query body: %v", err))
+	}
+
+	var args []interface{}
+	switch params := body.Params.(type) {
+	case nil:
+	case []interface{}:
+		for i, p := range params {
+			v, err := paramValue(p)
+			if err != nil {
+				return "", nil, inputError(fmt.Sprintf("parameter %d: %v", i+1, err))
+			}
+			args = append(args, v)
+		}
+	case map[string]interface{}:
+		// Sort the names so errors are reported consistently
+		names := make([]string, 0, len(params))
+		for name := range params {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			v, err := paramValue(params[name])
+			if err != nil {
+				return "", nil, inputError(fmt.Sprintf("parameter %s: %v", name, err))
+			}
+			bare := strings.TrimLeft(name, ":@$")
+			if !validParamName(bare) {
+				return "", nil, inputError("invalid parameter name: " + name)
+			}
+			args = append(args, sql.Named(bare, v))
+		}
+	default:
+		return "", nil, inputError("params must be an array or an object")
+	}
+
+	return strings.TrimSpace(body.SQL), args, nil
+}
+
+// paramValue converts a parameter to the value bound to the statement. A
+// parameter is either a JSON scalar or an object giving its type, one of
+// integer, real, text, blob or null, and its value. A blob is encoded in
+// base64, or in hex with "encoding": "hex", as the typed encoding returns it.
+func paramValue(p interface{}) (interface{}, error) {
+	switch v := p.(type) {
+	case nil, string:
+		return v, nil
+	case bool:
+		if v {
+			return int64(1), nil
+		}
+		return int64(0), nil
+	case json.Number:
+		return normalizeJSON(v)
+	case map[string]interface{}:
+		t, _ := v["type"].(string)
+		t = strings.ToLower(t)
+		if enc, _ := v["encoding"].(string); enc != "" {
+			return encodedBlob(t, strings.ToLower(enc), v["value"])
+		}
+		return typedValue(t, v["value"])
+	}
+	return nil, fmt.Errorf("unsupported value %v", p)
+}
+
+// typedValue converts the value of a typed parameter, given as a JSON number
+// or a string.
+func typedValue(t string, value interface{}) (interface{}, error) {
+	var s string
+	switch v := value.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	case nil:
+		if t == "null" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("missing %s value", t)
+	default:
+		return nil, fmt.Errorf("invalid %s value %v", t, value)
+	}
+
+	switch t {
+	case "integer":
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %q", s)
+		}
+		return i, nil
+	case "real":
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid real %q", s)
+		}
+		return f, nil
+	case "text":
+		return s, nil
+	case "blob":
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base64 blob: %v", err)
+		}
+		return b, nil
+	case "null":
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unknown type %q", t)
+}
+
+// encodedBlob converts the value of a typed blob parameter given with its
+// encoding, base64 or hex.
+func encodedBlob(t, enc string, value interface{}) (interface{}, error) {
+	s, ok := value.(string)
+	switch {
+	case t != "blob":
+		return nil, fmt.Errorf("encoding %q of a %s value", enc, t)
+	case !ok:
+		return nil, fmt.Errorf("invalid blob value %v", value)
+	case enc == encodingHex:
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex blob: %v", err)
+		}
+		return b, nil
+	case enc == "base64":
+		return typedValue(t, s)
+	}
+	return nil, fmt.Errorf("unknown blob encoding %q", enc)
+}
+
+// validParamName reports whether name can be bound by name, which requires
+// it to start with a letter.
+func validParamName(name string) bool {
+	if name == "" {
+		return false
+	}
+	c := name[0]
+	if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if !isWordChar(name[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+package gobroem
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseQueryBody(t *testing.T) {
+	tests := []struct {
+		body  string
+		query string
+		args  []interface{}
+		err   string
+	}{
+		{`{"sql": " SELECT 1 "}`, "SELECT 1", nil, ""},
+		{`{"sql": "SELECT ?, ?, ?, ?, ?", "params": [1, 2.5, "a", null, true]}`, "SELECT ?, ?, ?, ?, ?",
+			[]interface{}{int64(1), 2.5, "a", nil, int64(1)}, ""},
+		{`{"sql": "SELECT ?", "params": [9007199254740993]}`, "SELECT ?", []interface{}{int64(9007199254740993)}, ""},
+		{`{"sql": "SELECT ?, ?, ?, ?, ?", "params": [{"type": "integer", "value": "42"}, {"type": "REAL", "value": 1}, {"type": "text", "value": 7}, {"type": "blob", "value": "AP8="}, {"type": "null"}]}`,
+			"SELECT ?, ?, ?, ?, ?", []interface{}{int64(42), 1.0, "7", []byte{0, 0xff}, nil}, ""},
+		{`{"sql": "SELECT :b, @a, $c", "params": {":b": 1, "@a": "x", "c": null}}`, "SELECT :b, @a, $c",
+			[]interface{}{sql.Named("b", int64(1)), sql.Named("a", "x"), sql.Named("c", nil)}, ""},
+		{`{"sql": "SELECT ?", "params": [[1]]}`, "", nil, "parameter 1: unsupported value"},
+		{`{"sql": "SELECT ?", "params": [{"type": "integer", "value": "1.5"}]}`, "", nil, `parameter 1: invalid integer "1.5"`},
+		{`{"sql": "SELECT ?", "params": [{"type": "real", "value": "x"}]}`, "", nil, `parameter 1: invalid real "x"`},
+		{`{"sql": "SELECT ?", "params": [{"type": "blob", "value": "!"}]}`, "", nil, "parameter 1: invalid base64 blob"},
+		{`{"sql": "SELECT ?", "params": [{"type": "date", "value": "x"}]}`, "", nil, `parameter 1: unknown type "date"`},
+		{`{"sql": "SELECT ?", "params": [{"type": "text"}]}`, "", nil, "parameter 1: missing text value"},
+		{`{"sql": "SELECT ?", "params": [{"type": "text", "value": [1]}]}`, "", nil, "parameter 1: invalid text value"},
+		{`{"sql": "SELECT :1", "params": {"1": 1}}`, "", nil, "invalid parameter name: 1"},
+		{`{"sql": "SELECT :a", "params": {":a-b": 1}}`, "", nil, "invalid parameter name: :a-b"},
+		{`{"sql": "SELECT :a", "params": {"": 1}}`, "", nil, "invalid parameter name: "},
+		{`{"sql": "SELECT :a", "params": {"a": {"type": "integer", "value": "x"}}}`, "", nil, `parameter a: invalid integer "x"`},
+		{`{"sql": "SELECT ?", "params": 1}`, "", nil, "params must be an array or an object"},
+		{`{"sql": "SELECT ?"`, "", nil, "invalid query body"},
+	}
+	for _, tt := range tests {
+		query, args, err := parseQueryBody(strings.NewReader(tt.body))
+		if tt.err != "" {
+			if _, ok := err.(inputError); !ok || !strings.HasPrefix(err.Error(), tt.err) {
+				t.Errorf("%s: got error %v, want %q", tt.body, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.body, err)
+			continue
+		}
+		if query != tt.query || !reflect.DeepEqual(args, tt.args) {
+			t.Errorf("%s: got %q %#v, want %q %#v", tt.body, query, args, tt.query, tt.args)
+		}
+	}
+}
+
+func TestQueryParams(t *testing.T) {
+	_, h := newTestAPI(t, Options{})
+	tests := []struct {
+		body   string
+		status int
+		rows   [][]interface{}
+	}{
+		{`{"sql": "SELECT Name FROM artists WHERE ArtistId = ?", "params": [1]}`, http.StatusOK, [][]interface{}{{"AC/DC"}}},
+		{`{"sql": "SELECT Name FROM artists WHERE ArtistId = :id", "params": {"id": 2}}`, http.StatusOK, [][]interface{}{{"Accept"}}},
+		{`{"sql": "SELECT Name FROM artists WHERE ArtistId IN (@a, $b) ORDER BY 1", "params": {"@a": 1, "$b": 2}}`, http.StatusOK, [][]interface{}{{"AC/DC"}, {"Accept"}}},
+		{`{"sql": "SELECT typeof(?), hex(?)", "params": [{"type": "integer", "value": "3"}, {"type": "blob", "value": "AP8="}]}`, http.StatusOK, [][]interface{}{{"integer", "00FF"}}},
+		{`{"sql": "SELECT hex(?), hex(?)", "params": [{"type": "blob", "value": "00ff", "encoding": "hex"}, {"type": "BLOB", "value": "AP8=", "encoding": "base64"}]}`, http.StatusOK, [][]interface{}{{"00FF", "00FF"}}},
+		{`{"sql": "SELECT ?", "params": [{"type": "blob", "value": "0g", "encoding": "hex"}]}`, http.StatusBadRequest, nil},
+		{`{"sql": "SELECT ?", "params": [{"type": "text", "value": "00", "encoding": "hex"}]}`, http.StatusBadRequest, nil},
+		{`{"sql": "SELECT ?", "params": [{"type": "blob", "value": "00", "encoding": "octal"}]}`, http.StatusBadRequest, nil},
+		{`{"sql": "SELECT ? = 'a'", "params": ["a' OR 1 --"]}`, http.StatusOK, [][]interface{}{{0.0}}},
+		{`{"sql": "SELECT Name FROM artists WHERE ArtistId = ?"}`, http.StatusInternalServerError, nil},
+		{`{"sql": "SELECT ?", "params": 1}`, http.StatusBadRequest, nil},
+		{`{"sql": ""}`, http.StatusBadRequest, nil},
+	}
+	for _, tt := range tests {
+		if tt.rows == nil {
+			if w := testRequest(t, h, "POST", "/api/query", tt.body); w.Code != tt.status {
+				t.Errorf("%s: got status %d, want %d", tt.body, w.Code, tt.status)
+			}
+			continue
+		}
+		var result testPage
+		testJSON(t, h, "POST", "/api/query", tt.body, tt.status, &result)
+		if !reflect.DeepEqual(result.Rows, tt.rows) {
+			t.Errorf("%s: got %v, want %v", tt.body, result.Rows, tt.rows)
+		}
+	}
+}
+
+func TestTypedParamsRoundTrip(t *testing.T) {
+	a, h := newTestAPI(t, Options{})
+	mustExec(t, a,
+		`CREATE TABLE typed (i INTEGER, r REAL, t TEXT, b BLOB, n)`,
+		`INSERT INTO typed VALUES (9007199254740993, 0.5, 'x', x'00ff41', NULL)`,
+	)
+
+	// The typed values returned by a query select the same row when sent back
+	for _, blob := range []string{"base64", "hex"} {
+		var result struct {
+			Rows [][]json.RawMessage `json:"rows"`
+		}
+		testJSON(t, h, "GET", "/api/query?typed=1&blob="+blob+"&query="+url.QueryEscape("SELECT i, r, t, b FROM typed"), "", http.StatusOK, &result)
+		if len(result.Rows) != 1 {
+			t.Fatalf("%s: got %d rows, want 1", blob, len(result.Rows))
+		}
+		params, err := json.Marshal(result.Rows[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		body := `{"sql": "SELECT count(*) FROM typed WHERE i = ? AND r = ? AND t = ? AND b = ?", "params": ` + string(params) + `}`
+		var count testPage
+		testJSON(t, h, "POST", "/api/query", body, http.StatusOK, &count)
+		if !reflect.DeepEqual(count.Rows, [][]interface{}{{1.0}}) {
+			t.Errorf("%s: %s: got %v, want 1 row", blob, params, count.Rows)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package gobroem
+
+import (
+	"context"
+	"strings"
+)
+
+// planNode is a step of a query plan.
+type planNode struct {
+	ID     int64  `json:"id"`
+	Parent int64  `json:"parent"`
+	Detail string `json:"detail"`
+	// FullScan marks a step reading a whole table or index
+	FullScan bool `json:"full_scan"`
+	// TempBTree marks a step sorting or grouping in a temporary b-tree
+	TempBTree bool        `json:"temp_btree"`
+	Children  []*planNode `json:"children"`
+}
+
+// queryPlan is the plan of a query as a tree, with its bytecode if asked.
+type queryPlan struct {
+	Plan     []*planNode `json:"plan"`
+	Bytecode *sqlResult  `json:"bytecode,omitempty"`
+}
+
+// QueryPlan explains how SQLite runs the given query, which must hold a
+// single statement. The parameters, if any, are bound as for QuerySQL.
+func (client *sqlClient) QueryPlan(ctx context.Context, query string, bytecode bool, args ...interface{}) (*queryPlan, error) {
+	statements := splitStatements(query)
+	if len(statements) != 1 {
+		return nil, inputError("the query plan needs exactly one statement")
+	}
+	query = statements[0]
+
+	rows, err := client.QueryContext(ctx, "EXPLAIN QUERY PLAN "+query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	nodes := make(map[int64]*planNode)
+	plan := &queryPlan{Plan: make([]*planNode, 0)}
+	for rows.Next() {
+		var unused int64
+		node := &planNode{Children: make([]*planNode, 0)}
+		if err := rows.Scan(&node.ID, &node.Parent, &unused, &node.Detail); err != nil {
+			return nil, err
+		}
+		node.FullScan = isFullScan(node.Detail)
+		node.TempBTree = strings.Contains(node.Detail, "TEMP B-TREE")
+
+		// Parents are listed before their children
+		if parent, found := nodes[node.Parent]; found {
+			parent.Children = append(parent.Children, node)
+		} else {
+			plan.Plan = append(plan.Plan, node)
+		}
+		nodes[node.ID] = node
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if bytecode {
+		plan.Bytecode, err = client.query(ctx, "EXPLAIN "+query, args...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
+
+// isFullScan reports whether a plan step reads a whole table or index.
+func isFullScan(detail string) bool {
+	if !strings.HasPrefix(detail, "SCAN ") {
+		return false
+	}
+	return !strings.HasPrefix(detail, "SCAN CONSTANT ROW") && !strings.HasPrefix(detail, "SCAN SUBQUERY")
+}
@@ -0,0 +1,122 @@
+package gobroem
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// planDetails flattens a plan in depth-first order, indenting the details of
+// the children.
+func planDetails(nodes []*planNode, indent string) []string {
+	var details []string
+	for _, n := range nodes {
+		details = append(details, indent+n.Detail)
+		details = append(details, planDetails(n.Children, indent+"  ")...)
+	}
+	return details
+}
+
+func TestQueryPlan(t *testing.T) {
+	_, h := newTestAPI(t, Options{})
+	tests := []struct {
+		query string
+		// want lists the details found in the plan, in order
+		want      []string
+		fullScan  string
+		tempBTree string
+	}{
+		{"SELECT * FROM artists", []string{"SCAN artists"}, "SCAN artists", ""},
+		{"SELECT * FROM artists WHERE ArtistId = 1", []string{"SEARCH artists USING INTEGER PRIMARY KEY (rowid=?)"}, "", ""},
+		{"SELECT Name FROM artists ORDER BY Name", []string{"SCAN artists", "USE TEMP B-TREE FOR ORDER BY"}, "SCAN artists", "USE TEMP B-TREE FOR ORDER BY"},
+		{"SELECT * FROM artists WHERE ArtistId IN (SELECT ArtistId FROM albums)",
+			[]string{"SEARCH artists USING INTEGER PRIMARY KEY (rowid=?)", "LIST SUBQUERY 1", "  SCAN albums USING COVERING INDEX IFK_AlbumArtistId"},
+			"SCAN albums USING COVERING INDEX IFK_AlbumArtistId", ""},
+		{"SELECT 1", []string{"SCAN CONSTANT ROW"}, "", ""},
+		{"DELETE FROM artists WHERE ArtistId = 1", []string{"SEARCH artists USING INTEGER PRIMARY KEY (rowid=?)"}, "", ""},
+	}
+	for _, tt := range tests {
+		var plan queryPlan
+		testJSON(t, h, "GET", "/api/query/plan?query="+url.QueryEscape(tt.query), "", http.StatusOK, &plan)
+		if plan.Bytecode != nil {
+			t.Errorf("%s: got bytecode without asking", tt.query)
+		}
+
+		details := planDetails(plan.Plan, "")
+		i := 0
+		for _, d := range details {
+			if i < len(tt.want) && d == tt.want[i] {
+				i++
+			}
+		}
+		if i != len(tt.want) {
+			t.Errorf("%s: got plan %q, want %q", tt.query, details, tt.want)
+		}
+
+		var fullScan, tempBTree []string
+		var walk func(nodes []*planNode)
+		walk = func(nodes []*planNode) {
+			for _, n := range nodes {
+				if n.FullScan {
+					fullScan = append(fullScan, n.Detail)
+				}
+				if n.TempBTree {
+					tempBTree = append(tempBTree, n.Detail)
+				}
+				walk(n.Children)
+			}
+		}
+		walk(plan.Plan)
+		if strings.Join(fullScan, ";") != tt.fullScan || strings.Join(tempBTree, ";") != tt.tempBTree {
+			t.Errorf("%s: got full scans %q and temp b-trees %q, want %q and %q", tt.query, fullScan, tempBTree, tt.fullScan, tt.tempBTree)
+		}
+	}
+
+	// Explaining a statement doesn't run it
+	var page testPage
+	testJSON(t, h, "GET", "/api/table/rows?table=artists&total=1", "", http.StatusOK, &page)
+	if page.Total == nil || *page.Total != 275 {
+		t.Errorf("got %v artists, want 275", page.Total)
+	}
+
+	var plan queryPlan
+	testJSON(t, h, "POST", "/api/query/plan?bytecode=1", `{"sql": "SELECT Name FROM artists WHERE ArtistId = ?", "params": [1]}`, http.StatusOK, &plan)
+	if plan.Bytecode == nil || len(plan.Bytecode.Rows) == 0 || plan.Bytecode.Columns[1] != "opcode" {
+		t.Errorf("got bytecode %v", plan.Bytecode)
+	}
+}
+
+func TestQueryPlanErrors(t *testing.T) {
+	_, h := newTestAPI(t, Options{})
+	tests := []struct {
+		target string
+		status int
+	}{
+		{"query=", http.StatusBadRequest},
+		{"query=" + url.QueryEscape("SELECT 1; SELECT 2"), http.StatusBadRequest},
+		{"query=SELECT+1&bytecode=maybe", http.StatusBadRequest},
+		{"query=" + url.QueryEscape("SELECT * FROM nope"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		if w := testRequest(t, h, "GET", "/api/query/plan?"+tt.target, ""); w.Code != tt.status {
+			t.Errorf("%s: got status %d, want %d", tt.target, w.Code, tt.status)
+		}
+	}
+}
+
+func TestIsFullScan(t *testing.T) {
+	tests := map[string]bool{
+		"SCAN artists":                       true,
+		"SCAN a USING COVERING INDEX i":      true,
+		"SCAN CONSTANT ROW":                  false,
+		"SCAN SUBQUERY 1":                    false,
+		"SEARCH artists USING INDEX i (a=?)": false,
+		"USE TEMP B-TREE FOR ORDER BY":       false,
+	}
+	for detail, want := range tests {
+		if got := isFullScan(detail); got != want {
+			t.Errorf("%s: got %v, want %v", detail, got, want)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package gobroem
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+)
+
+// runningQueries tracks the queries which can be canceled through the API.
+type runningQueries struct {
+	sync.Mutex
+	queries map[string]*runningQuery
+}
+
+type runningQuery struct {
+	principal string
+	cancel    context.CancelFunc
+}
+
+func newRunningQueries() *runningQueries {
+	return &runningQueries{queries: make(map[string]*runningQuery)}
+}
+
+// start registers a query. It returns false if the id is already in use.
+func (r *runningQueries) start(id, principal string, cancel context.CancelFunc) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, found := r.queries[id]; found {
+		return false
+	}
+	r.queries[id] = &runningQuery{principal, cancel}
+	return true
+}
+
+// done unregisters a finished query.
+func (r *runningQueries) done(id string) {
+	r.Lock()
+	defer r.Unlock()
+
+	delete(r.queries, id)
+}
+
+// cancel cancels a query started by the same principal. It returns false if
+// there is no such query.
+func (r *runningQueries) cancel(id, principal string) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	q, found := r.queries[id]
+	if !found || q.principal != principal {
+		return false
+	}
+	q.cancel()
+	delete(r.queries, id)
+	return true
+}
+
+// newQueryID returns a random query id.
+func newQueryID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
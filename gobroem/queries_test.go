@@ -0,0 +1,52 @@
+package gobroem
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestRunningQueries(t *testing.T) {
+	r := newRunningQueries()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if !r.start("q1", "alice", cancel) {
+		t.Fatal("start: id refused")
+	}
+	if r.start("q1", "bob", func() {}) {
+		t.Error("start: id in use accepted")
+	}
+	if r.cancel("q1", "bob") {
+		t.Error("cancel: query of another principal canceled")
+	}
+	if !r.cancel("q1", "alice") {
+		t.Fatal("cancel: query not found")
+	}
+	if ctx.Err() == nil {
+		t.Error("cancel: context not canceled")
+	}
+	if r.cancel("q1", "alice") {
+		t.Error("cancel: query canceled twice")
+	}
+}
+
+func TestQueryID(t *testing.T) {
+	_, h := newTestAPI(t, Options{})
+
+	w := testRequest(t, h, "POST", "/api/query", "query=SELECT+1")
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d: %s", w.Code, w.Body.String())
+	}
+	id := w.Header().Get("X-Query-Id")
+	if len(id) != 32 {
+		t.Errorf("generated id: got %q, want 32 hex digits", id)
+	}
+
+	w = testRequest(t, h, "POST", "/api/query", "query=SELECT+1&query_id=mine")
+	if got := w.Header().Get("X-Query-Id"); got != "mine" {
+		t.Errorf("given id: got %q, want mine", got)
+	}
+	testJSON(t, h, "POST", "/api/query/cancel", "query_id=mine", http.StatusNotFound, nil)
+	testJSON(t, h, "POST", "/api/query/cancel", "", http.StatusBadRequest, nil)
+}
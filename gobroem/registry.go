@@ -0,0 +1,97 @@
+package gobroem
+
+import (
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Registry names the databases served by an API controller. The first one
+// added is the default database of the requests without a db parameter.
+type Registry struct {
+	entries []registryEntry
+}
+
+// registryEntry is a database of a registry, either a file or a DB handle.
+type registryEntry struct {
+	name string
+	file string
+	db   *sql.DB
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// AddFile adds a DB file under the given name.
+func (r *Registry) AddFile(name, file string) error {
+	return r.add(registryEntry{name: name, file: file})
+}
+
+// AddGlob adds the DB files matching the pattern, each named after its file
+// name without the extension, e.g. "shard_01" for "data/shard_01.db".
+func (r *Registry) AddGlob(pattern string) error {
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return err
+	}
+
+	added := 0
+	for _, file := range files {
+		if fi, err := os.Stat(file); err != nil || fi.IsDir() {
+			continue
+		}
+		if err := r.AddFile(fileDBName(file), file); err != nil {
+			return err
+		}
+		added++
+	}
+	if added == 0 {
+		return errors.New("no database file matches " + pattern)
+	}
+	return nil
+}
+
+// AddDB adds a DB handle under the given name.
+func (r *Registry) AddDB(name string, db *sql.DB) error {
+	return r.add(registryEntry{name: name, db: db})
+}
+
+// Names returns the names of the databases, in the order they were added.
+func (r *Registry) Names() []string {
+	names := make([]string, len(r.entries))
+	for i, e := range r.entries {
+		names[i] = e.name
+	}
+	return names
+}
+
+func (r *Registry) add(entry registryEntry) error {
+	if entry.name == "" {
+		return errors.New("database name missing")
+	}
+	for _, e := range r.entries {
+		if e.name == entry.name {
+			return errors.New("duplicate database name: " + entry.name)
+		}
+	}
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+// database is a database served by the API controller.
+type database struct {
+	name string
+	// file is empty for a DB handle
+	file   string
+	client *sqlClient
+}
+
+// fileDBName returns the name of a database file in a registry.
+func fileDBName(file string) string {
+	base := filepath.Base(file)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
@@ -0,0 +1,110 @@
+package gobroem
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"shard_01.db", "shard_02.db", "notes.txt"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "shard_03.db"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		add   func(r *Registry) error
+		names []string
+		err   bool
+	}{
+		{"file", func(r *Registry) error { return r.AddFile("main", "main.db") }, []string{"main"}, false},
+		{"glob", func(r *Registry) error { return r.AddGlob(filepath.Join(dir, "shard_*.db")) }, []string{"shard_01", "shard_02"}, false},
+		{"glob without match", func(r *Registry) error { return r.AddGlob(filepath.Join(dir, "*.sqlite")) }, nil, true},
+		{"bad glob", func(r *Registry) error { return r.AddGlob("[") }, nil, true},
+		{"db", func(r *Registry) error { return r.AddDB("mem", &sql.DB{}) }, []string{"mem"}, false},
+		{"empty name", func(r *Registry) error { return r.AddFile("", "main.db") }, nil, true},
+		{"duplicate", func(r *Registry) error {
+			r.AddFile("main", "a.db")
+			return r.AddDB("main", &sql.DB{})
+		}, []string{"main"}, true},
+		{"duplicate in glob", func(r *Registry) error {
+			r.AddFile("shard_02", "a.db")
+			return r.AddGlob(filepath.Join(dir, "shard_*.db"))
+		}, []string{"shard_02", "shard_01"}, true},
+	}
+	for _, tt := range tests {
+		r := NewRegistry()
+		err := tt.add(r)
+		if (err != nil) != tt.err {
+			t.Errorf("%s: got error %v", tt.name, err)
+		}
+		if names := r.Names(); len(names) != len(tt.names) || len(names) > 0 && !reflect.DeepEqual(names, tt.names) {
+			t.Errorf("%s: got names %q, want %q", tt.name, names, tt.names)
+		}
+	}
+
+	for file, want := range map[string]string{"data/shard_01.db": "shard_01", "a.b.sqlite3": "a.b", "plain": "plain"} {
+		if got := fileDBName(file); got != want {
+			t.Errorf("%s: got name %q, want %q", file, got, want)
+		}
+	}
+	if _, err := NewAPIWithRegistry(NewRegistry(), Options{}); err == nil {
+		t.Error("got an API without databases")
+	}
+}
+
+func TestRegistryAPI(t *testing.T) {
+	registry := NewRegistry()
+	registry.AddFile("first", copyTestDB(t))
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "second.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("CREATE TABLE only_second (v)"); err != nil {
+		t.Fatal(err)
+	}
+	registry.AddDB("second", db)
+
+	a, err := NewAPIWithRegistry(registry, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := a.Handler("/", "/static/")
+
+	var list struct {
+		Databases []databaseInfo `json:"databases"`
+	}
+	testJSON(t, h, "GET", "/api/databases", "", http.StatusOK, &list)
+	if len(list.Databases) != 2 || list.Databases[0].Name != "first" || !filepath.IsAbs(list.Databases[0].File) ||
+		list.Databases[1] != (databaseInfo{Name: "second"}) {
+		t.Errorf("got databases %+v", list.Databases)
+	}
+
+	tests := []struct {
+		db     string
+		table  string
+		status int
+	}{
+		{"", "artists", http.StatusOK},
+		{"first", "artists", http.StatusOK},
+		{"second", "only_second", http.StatusOK},
+		{"second", "artists", http.StatusNotFound},
+		{"third", "artists", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		if w := testRequest(t, h, "GET", "/api/table/rows?db="+tt.db+"&table="+tt.table, ""); w.Code != tt.status {
+			t.Errorf("%s %s: got status %d, want %d", tt.db, tt.table, w.Code, tt.status)
+		}
+	}
+}
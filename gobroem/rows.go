@@ -0,0 +1,714 @@
+package gobroem
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+
+	// keyColumn prefixes the aliases of the keys selected next to the table
+	// columns when paginating with a keyset cursor.
+	keyColumn = "__gobroem_key"
+)
+
+var (
+	errInvalidCursor   = inputError("invalid cursor")
+	errRowNotFound     = notFoundError("row not found")
+	errKeysetView      = inputError("a view has no row key, page it with offset")
+	errHiddenRowid     = inputError("the rowid is hidden by the columns named rowid, _rowid_ and oid")
+	errWithoutRowidKey = inputError("a WITHOUT ROWID table has no rowid, identify the row by its primary key")
+)
+
+// pageOptions describes which page of a table to fetch.
+type pageOptions struct {
+	// Limit is the maximum number of rows in the page
+	Limit int
+	// Offset is the number of rows to skip, ignored in keyset mode
+	Offset int
+	// Order is the column used to sort the rows, the rowid if empty or named
+	// after the rowid without a declared column of that name
+	Order string
+	// Desc sorts the rows in descending order
+	Desc bool
+	// Keyset enables cursor based pagination
+	Keyset bool
+	// Cursor is the cursor returned with the previous page, empty for the
+	// first page
+	Cursor string
+	// Filters restricts the rows to the ones matching all the filters
+	Filters []sqlFilter
+	// Encoding is the encoding of the values of the rows
+	Encoding valueEncoding
+	// Count counts the rows matching the filters, which reads all of them
+	Count bool
+}
+
+// sqlPage is a page of rows from a table.
+type sqlPage struct {
+	*sqlResult
+	Total      *int64 `json:"total,omitempty"`
+	Limit      int    `json:"limit"`
+	Offset     int    `json:"offset"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// TableRows returns a page of rows from the given table, along with the total
+// number of rows matching the filters if counted.
+func (client *sqlClient) TableRows(ctx context.Context, table string, opts pageOptions) (*sqlPage, error) {
+	if opts.Limit <= 0 {
+		opts.Limit = defaultPageSize
+	}
+	if opts.Limit > maxPageSize {
+		opts.Limit = maxPageSize
+	}
+	if opts.Offset < 0 || opts.Keyset {
+		opts.Offset = 0
+	}
+
+	columns, err := client.tableColumns(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	rowid := rowidName(columns)
+	if opts.Order != "" && strings.EqualFold(opts.Order, rowid) {
+		opts.Order = ""
+	}
+	if opts.Order != "" && !containsString(columns, opts.Order) {
+		return nil, inputError("no such column: " + opts.Order)
+	}
+
+	filter, args, err := client.buildFilter(ctx, table, opts.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	var conds []string
+	if filter != "" {
+		conds = append(conds, filter)
+	}
+
+	var total *int64
+	if opts.Count {
+		total = new(int64)
+		err = client.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+client.qualify(table)+whereClause(conds), args...).Scan(total)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	order := rowid
+	if opts.Order != "" {
+		order = quoteIdent(opts.Order)
+	}
+	dir := "ASC"
+	if opts.Desc {
+		dir = "DESC"
+	}
+
+	query := "SELECT * FROM " + client.qualify(table)
+	var keys []string
+	if opts.Keyset {
+		if keys, err = client.keyColumns(ctx, table, rowid); err != nil {
+			return nil, err
+		}
+		// The sort key and the row key are selected first, with a unary plus
+		// so that the driver keeps their SQLite values for the cursor
+		var selected []string
+		cursorKeys := keys
+		if opts.Order != "" {
+			cursorKeys = append([]string{order}, keys...)
+		}
+		for i, k := range cursorKeys {
+			selected = append(selected, "+"+k+" AS "+quoteIdent(keyColumn+strconv.Itoa(i)))
+		}
+		query = "SELECT " + strings.Join(selected, ", ") + ", * FROM " + client.qualify(table)
+
+		if opts.Cursor != "" {
+			values, err := decodeCursor(opts.Cursor, len(cursorKeys))
+			if err != nil {
+				return nil, err
+			}
+			keyset, keyArgs := keysetClause(opts.Order != "", order, keys, opts.Desc, values)
+			conds = append(conds, keyset)
+			args = append(args, keyArgs...)
+		}
+
+		order = strings.Join(cursorKeys, " "+dir+", ")
+	}
+	query += whereClause(conds)
+
+	// Without an explicit order the rows come in storage order, which also
+	// works for WITHOUT ROWID tables
+	if opts.Order != "" || opts.Desc || opts.Keyset {
+		if order == "" {
+			return nil, errHiddenRowid
+		}
+		query += fmt.Sprintf(" ORDER BY %s %s", order, dir)
+	}
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", opts.Limit, opts.Offset)
+
+	result, last, err := queryEncodedLast(ctx, client.DB, opts.Encoding, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &sqlPage{
+		sqlResult: result,
+		Total:     total,
+		Limit:     opts.Limit,
+		Offset:    opts.Offset,
+	}
+
+	if opts.Keyset {
+		// Strip the key columns, the last row keeping them for the cursor
+		n := len(keys)
+		if opts.Order != "" {
+			n++
+		}
+		result.Columns = result.Columns[n:]
+		if result.Types != nil {
+			result.Types = result.Types[n:]
+		}
+		for i, row := range result.Rows {
+			result.Rows[i] = row[n:]
+		}
+
+		if last != nil && len(result.Rows) == opts.Limit {
+			page.NextCursor, err = encodeCursor(last[:n])
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return page, nil
+}
+
+// keyColumns returns the quoted columns identifying a row of the table: the
+// rowid, selected by the given name, or the primary key of a WITHOUT ROWID
+// table. A view has no key.
+func (client *sqlClient) keyColumns(ctx context.Context, table, rowid string) ([]string, error) {
+	kind, withoutRowid, err := client.tableType(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	if kind == "view" {
+		return nil, errKeysetView
+	}
+	if !withoutRowid {
+		if rowid == "" {
+			return nil, errHiddenRowid
+		}
+		return []string{rowid}, nil
+	}
+
+	schema, err := client.tableSchema(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, c := range primaryKeyColumns(schema) {
+		keys = append(keys, quoteIdent(c.Name))
+	}
+	return keys, nil
+}
+
+// withoutRowid reports whether the table is a WITHOUT ROWID table.
+func (client *sqlClient) withoutRowid(ctx context.Context, table string) (bool, error) {
+	_, withoutRowid, err := client.tableType(ctx, table)
+	return withoutRowid, err
+}
+
+// tableType returns the type of the table as reported by PRAGMA table_list:
+// table, view, shadow or virtual, and whether it is a WITHOUT ROWID table.
+func (client *sqlClient) tableType(ctx context.Context, table string) (string, bool, error) {
+	rows, err := client.QueryContext(ctx, client.pragma("table_list", table))
+	if err != nil {
+		return "", false, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return "", false, err
+		}
+		return "", false, errNoSuchTable(table)
+	}
+	var schema, name, kind string
+	var columns int
+	var withoutRowid, strict bool
+	if err := rows.Scan(&schema, &name, &kind, &columns, &withoutRowid, &strict); err != nil {
+		return "", false, err
+	}
+	return kind, withoutRowid, nil
+}
+
+// rowidName returns the first name of the rowid, rowid, _rowid_ or oid, not
+// taken by a declared column, empty if all of them are.
+func rowidName(columns []string) string {
+	for _, name := range []string{"rowid", "_rowid_", "oid"} {
+		taken := false
+		for _, c := range columns {
+			if strings.EqualFold(c, name) {
+				taken = true
+				break
+			}
+		}
+		if !taken {
+			return name
+		}
+	}
+	return ""
+}
+
+// columnInfo describes a table column as reported by PRAGMA table_info.
+type columnInfo struct {
+	Name    string
+	Type    string
+	NotNull bool
+	Default interface{}
+	// PK is the position of the column in the primary key, 0 if the column is
+	// not part of it
+	PK int
+}
+
+// tableSchema returns the columns of the given table.
+func (client *sqlClient) tableSchema(ctx context.Context, table string) ([]columnInfo, error) {
+	rows, err := client.QueryContext(ctx, client.pragma("table_info", table))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []columnInfo
+	for rows.Next() {
+		var cid int
+		var c columnInfo
+		if err := rows.Scan(&cid, &c.Name, &c.Type, &c.NotNull, &c.Default, &c.PK); err != nil {
+			return nil, err
+		}
+		columns = append(columns, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return nil, errNoSuchTable(table)
+	}
+	return columns, nil
+}
+
+// tableColumns returns the column names of the given table.
+func (client *sqlClient) tableColumns(ctx context.Context, table string) ([]string, error) {
+	schema, err := client.tableSchema(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]string, 0, len(schema))
+	for _, c := range schema {
+		columns = append(columns, c.Name)
+	}
+	return columns, nil
+}
+
+// InsertRow inserts a row built from the given column values and returns the
+// inserted row.
+func (client *sqlClient) InsertRow(ctx context.Context, table string, values map[string]interface{}) (*sqlResult, error) {
+	if client.readOnly {
+		return nil, errReadOnly
+	}
+
+	schema, err := client.tableSchema(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, args, err := rowValues(schema, values)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "INSERT INTO " + client.qualify(table)
+	if len(columns) == 0 {
+		query += " DEFAULT VALUES"
+	} else {
+		marks := strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")
+		query += " (" + strings.Join(quoteIdents(columns), ", ") + ") VALUES (" + marks + ")"
+	}
+
+	tx, err := client.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the new row by its primary key when given, by rowid otherwise
+	where, keyArgs, ok := primaryKeyClause(schema, values)
+	if !ok {
+		id, err := res.LastInsertId()
+		if err != nil {
+			return nil, err
+		}
+		where, keyArgs = "rowid = ?", []interface{}{id}
+	}
+
+	result, err := queryResult(ctx, tx, "SELECT * FROM "+client.qualify(table)+" WHERE "+where, keyArgs...)
+	if err != nil {
+		return nil, err
+	}
+	return result, tx.Commit()
+}
+
+// UpdateRow updates the row identified by key with the given column values
+// and returns the updated row.
+func (client *sqlClient) UpdateRow(ctx context.Context, table string, key, values map[string]interface{}) (*sqlResult, error) {
+	if client.readOnly {
+		return nil, errReadOnly
+	}
+
+	schema, err := client.tableSchema(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	withoutRowid, err := client.withoutRowid(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	where, keyArgs, err := keyClause(schema, withoutRowid, key)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, args, err := rowValues(schema, values)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return nil, inputError("no values to update")
+	}
+
+	sets := make([]string, len(columns))
+	for i, c := range columns {
+		sets[i] = quoteIdent(c) + " = ?"
+	}
+	query := "UPDATE " + client.qualify(table) + " SET " + strings.Join(sets, ", ") + " WHERE " + where
+
+	tx, err := client.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, query, append(args, keyArgs...)...)
+	if err != nil {
+		return nil, err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return nil, err
+	} else if n == 0 {
+		return nil, errRowNotFound
+	}
+
+	// The update may have changed the key itself
+	where, keyArgs, err = keyClause(schema, withoutRowid, updatedKey(schema, key, values))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := queryResult(ctx, tx, "SELECT * FROM "+client.qualify(table)+" WHERE "+where, keyArgs...)
+	if err != nil {
+		return nil, err
+	}
+	return result, tx.Commit()
+}
+
+// DeleteRow deletes the row identified by key and returns the deleted row.
+func (client *sqlClient) DeleteRow(ctx context.Context, table string, key map[string]interface{}) (*sqlResult, error) {
+	if client.readOnly {
+		return nil, errReadOnly
+	}
+
+	schema, err := client.tableSchema(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	withoutRowid, err := client.withoutRowid(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	where, keyArgs, err := keyClause(schema, withoutRowid, key)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := client.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	result, err := queryResult(ctx, tx, "SELECT * FROM "+client.qualify(table)+" WHERE "+where, keyArgs...)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Rows) == 0 {
+		return nil, errRowNotFound
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM "+client.qualify(table)+" WHERE "+where, keyArgs...); err != nil {
+		return nil, err
+	}
+	return result, tx.Commit()
+}
+
+// rowValues checks the values against the table columns and returns the
+// column names with their bind values.
+func rowValues(schema []columnInfo, values map[string]interface{}) ([]string, []interface{}, error) {
+	columns := make([]string, 0, len(values))
+	args := make([]interface{}, 0, len(values))
+	for _, c := range schema {
+		v, ok := values[c.Name]
+		if !ok {
+			continue
+		}
+		switch v.(type) {
+		case []interface{}, map[string]interface{}:
+			return nil, nil, inputError("unsupported value for column " + c.Name)
+		}
+		columns = append(columns, c.Name)
+		args = append(args, v)
+	}
+	if len(columns) != len(values) {
+		for name := range values {
+			if !containsString(columns, name) {
+				return nil, nil, inputError("no such column: " + name)
+			}
+		}
+	}
+	return columns, args, nil
+}
+
+// primaryKeyColumns returns the primary key columns in key order.
+func primaryKeyColumns(schema []columnInfo) []columnInfo {
+	var pk []columnInfo
+	for i := 1; ; i++ {
+		found := false
+		for _, c := range schema {
+			if c.PK == i {
+				pk = append(pk, c)
+				found = true
+			}
+		}
+		if !found {
+			return pk
+		}
+	}
+}
+
+// primaryKeyClause builds the WHERE clause matching the primary key values
+// found in values. It returns false if the table has no primary key or a key
+// column is missing.
+func primaryKeyClause(schema []columnInfo, values map[string]interface{}) (string, []interface{}, bool) {
+	pk := primaryKeyColumns(schema)
+	if len(pk) == 0 {
+		return "", nil, false
+	}
+
+	conds := make([]string, len(pk))
+	args := make([]interface{}, len(pk))
+	for i, c := range pk {
+		v, ok := values[c.Name]
+		if !ok || v == nil {
+			return "", nil, false
+		}
+		conds[i] = quoteIdent(c.Name) + " = ?"
+		args[i] = v
+	}
+	return strings.Join(conds, " AND "), args, true
+}
+
+// keyClause builds the WHERE clause matching the single row identified by
+// key, which holds either all the primary key columns or the rowid, unless
+// the table is a WITHOUT ROWID table.
+func keyClause(schema []columnInfo, withoutRowid bool, key map[string]interface{}) (string, []interface{}, error) {
+	if len(key) == 1 {
+		for name, v := range key {
+			if strings.EqualFold(name, "rowid") && !containsColumn(schema, name) {
+				if withoutRowid {
+					return "", nil, errWithoutRowidKey
+				}
+				return "rowid = ?", []interface{}{v}, nil
+			}
+		}
+	}
+
+	pk := primaryKeyColumns(schema)
+	if len(pk) == 0 {
+		return "", nil, inputError("table has no primary key, identify the row by rowid")
+	}
+
+	where, args, ok := primaryKeyClause(schema, key)
+	if !ok || len(key) != len(pk) {
+		names := make([]string, len(pk))
+		for i, c := range pk {
+			names[i] = c.Name
+		}
+		return "", nil, inputError("key must hold the primary key columns: " + strings.Join(names, ", "))
+	}
+	return where, args, nil
+}
+
+// updatedKey returns the key of a row after the given values are applied.
+func updatedKey(schema []columnInfo, key, values map[string]interface{}) map[string]interface{} {
+	pk := primaryKeyColumns(schema)
+	updated := make(map[string]interface{}, len(key))
+	for name, v := range key {
+		updated[name] = v
+		if nv, ok := values[name]; ok {
+			updated[name] = nv
+		}
+	}
+
+	// An INTEGER PRIMARY KEY column is an alias for the rowid
+	if len(pk) == 1 && strings.EqualFold(pk[0].Type, "INTEGER") {
+		if nv, ok := values[pk[0].Name]; ok {
+			for name := range updated {
+				if strings.EqualFold(name, "rowid") {
+					updated[name] = nv
+				}
+			}
+		}
+	}
+	return updated
+}
+
+func containsColumn(schema []columnInfo, name string) bool {
+	for _, c := range schema {
+		if strings.EqualFold(c.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// keysetClause builds the WHERE clause selecting the rows after the given
+// cursor values, which are the last row key, preceded by its sort key when
+// ordered. The key columns are never NULL, but SQLite sorts the NULL sort
+// keys first, so they are handled separately from the plain comparison.
+func keysetClause(ordered bool, order string, keys []string, desc bool, values []interface{}) (string, []interface{}) {
+	cmp := ">"
+	if desc {
+		cmp = "<"
+	}
+	keyArgs := values
+	if ordered {
+		keyArgs = values[1:]
+	}
+	// Row values compare in the order of the ORDER BY
+	keyCmp := keys[0] + " " + cmp + " ?"
+	if len(keys) > 1 {
+		keyCmp = "(" + strings.Join(keys, ", ") + ") " + cmp + " (" + strings.TrimSuffix(strings.Repeat("?, ", len(keys)), ", ") + ")"
+	}
+	if !ordered {
+		return keyCmp, keyArgs
+	}
+
+	key := values[0]
+	switch {
+	case key == nil && !desc:
+		return fmt.Sprintf("((%[1]s IS NULL AND %[2]s) OR %[1]s IS NOT NULL)", order, keyCmp),
+			keyArgs
+	case key == nil && desc:
+		return fmt.Sprintf("(%s IS NULL AND %s)", order, keyCmp),
+			keyArgs
+	case desc:
+		return fmt.Sprintf("(%[1]s < ? OR %[1]s IS NULL OR (%[1]s = ? AND %[2]s))", order, keyCmp),
+			append([]interface{}{key, key}, keyArgs...)
+	default:
+		return fmt.Sprintf("(%[1]s > ? OR (%[1]s = ? AND %[2]s))", order, keyCmp),
+			append([]interface{}{key, key}, keyArgs...)
+	}
+}
+
+// whereClause joins the conditions into a WHERE clause, empty if there are
+// no conditions.
+func whereClause(conds []string) string {
+	if len(conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conds, " AND ")
+}
+
+// encodeCursor encodes the cursor values into an opaque string. The values
+// keep their storage class, so that a blob is compared as a blob.
+func encodeCursor(values []interface{}) (string, error) {
+	typed := make([]encodedValue, len(values))
+	for i, v := range values {
+		typed[i] = valueEncoding{Typed: true}.encodeValue(v)
+	}
+	data, err := json.Marshal(typed)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor decodes a cursor created by encodeCursor, holding n values.
+func decodeCursor(cursor string, n int) ([]interface{}, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	var typed []encodedValue
+	if err := decodeJSON(bytes.NewReader(data), &typed); err != nil {
+		return nil, errInvalidCursor
+	}
+	if len(typed) != n {
+		return nil, errInvalidCursor
+	}
+	values := make([]interface{}, n)
+	for i, v := range typed {
+		if values[i], err = typedValue(v.Type, v.Value); err != nil {
+			return nil, errInvalidCursor
+		}
+	}
+	return values, nil
+}
+
+func quoteIdents(names []string) []string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = quoteIdent(name)
+	}
+	return quoted
+}
+
+func containsString(list []string, s string) bool {
+	return indexOfString(list, s) >= 0
+}
+
+func indexOfString(list []string, s string) int {
+	for i, v := range list {
+		if v == s {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,239 @@
+package gobroem
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+type testPage struct {
+	Columns    []string        `json:"columns"`
+	Rows       [][]interface{} `json:"rows"`
+	Total      *int64          `json:"total"`
+	NextCursor string          `json:"next_cursor"`
+}
+
+// allPages reads a table page by page with keyset pagination, and returns
+// its rows.
+func allPages(t *testing.T, a *API, query string) [][]interface{} {
+	t.Helper()
+	h := a.Handler("/", "/static/")
+	var rows [][]interface{}
+	cursor := ""
+	for i := 0; i < 100; i++ {
+		var page testPage
+		testJSON(t, h, "GET", "/api/table/rows?"+query+"&cursor="+url.QueryEscape(cursor), "", 200, &page)
+		if page.Rows == nil {
+			t.Fatalf("rows are null")
+		}
+		rows = append(rows, page.Rows...)
+		if page.NextCursor == "" {
+			return rows
+		}
+		cursor = page.NextCursor
+	}
+	t.Fatal("too many pages")
+	return nil
+}
+
+func TestTableRowsKeyset(t *testing.T) {
+	a, h := newTestAPI(t, Options{})
+	mustExec(t, a,
+		`CREATE TABLE wr (a TEXT, b INTEGER, v, PRIMARY KEY (a, b)) WITHOUT ROWID`,
+		`INSERT INTO wr VALUES ('x', 2, NULL), ('x', 1, 1), ('y', 1, NULL), ('a', 9, 2), ('a', 3, 1)`,
+		`CREATE TABLE blobs (k BLOB, n INTEGER)`,
+		`INSERT INTO blobs VALUES (x'0062', 1), (x'0061', 2), (x'0062', 3), (x'7a', 4), (NULL, 5)`,
+	)
+
+	tests := []struct {
+		name  string
+		query string
+		want  [][]interface{}
+	}{
+		{"without rowid", "table=wr&limit=2",
+			[][]interface{}{{"a", 3.0, 1.0}, {"a", 9.0, 2.0}, {"x", 1.0, 1.0}, {"x", 2.0, nil}, {"y", 1.0, nil}}},
+		{"without rowid desc", "table=wr&limit=2&dir=desc",
+			[][]interface{}{{"y", 1.0, nil}, {"x", 2.0, nil}, {"x", 1.0, 1.0}, {"a", 9.0, 2.0}, {"a", 3.0, 1.0}}},
+		{"without rowid by column", "table=wr&limit=2&order=v",
+			[][]interface{}{{"x", 2.0, nil}, {"y", 1.0, nil}, {"a", 3.0, 1.0}, {"x", 1.0, 1.0}, {"a", 9.0, 2.0}}},
+		{"without rowid by column desc", "table=wr&limit=2&order=v&dir=desc",
+			[][]interface{}{{"a", 9.0, 2.0}, {"x", 1.0, 1.0}, {"a", 3.0, 1.0}, {"y", 1.0, nil}, {"x", 2.0, nil}}},
+		{"blob sort key", "table=blobs&limit=1&order=k",
+			[][]interface{}{{nil, 5.0}, {"\x00a", 2.0}, {"\x00b", 1.0}, {"\x00b", 3.0}, {"z", 4.0}}},
+		{"blob sort key desc", "table=blobs&limit=2&order=k&dir=desc",
+			[][]interface{}{{"z", 4.0}, {"\x00b", 3.0}, {"\x00b", 1.0}, {"\x00a", 2.0}, {nil, 5.0}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := allPages(t, a, tt.query)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// A date sort key is compared as stored, not as reformatted by the driver
+	rows := allPages(t, a, "table=invoices&limit=7&order=InvoiceDate")
+	if len(rows) != 412 {
+		t.Errorf("got %d invoices, want 412", len(rows))
+	}
+
+	var page testPage
+	testJSON(t, h, "GET", "/api/table/rows?table=wr&cursor=", "", 200, &page)
+	if page.Total == nil || *page.Total != 5 {
+		t.Errorf("got total %v, want 5", page.Total)
+	}
+	page = testPage{}
+	testJSON(t, h, "GET", "/api/table/rows?table=wr&cursor=&total=0", "", 200, &page)
+	if page.Total != nil {
+		t.Errorf("total=0 counted the rows")
+	}
+	testJSON(t, h, "GET", "/api/table/rows?table=wr&offset=10", "", 200, &page)
+	if page.Rows == nil || len(page.Rows) != 0 || page.Total == nil || *page.Total != 5 {
+		t.Errorf("got %v rows and total %v, want an empty page of 5 rows", page.Rows, page.Total)
+	}
+}
+
+func TestTableRowsRowid(t *testing.T) {
+	a, h := newTestAPI(t, Options{})
+	mustExec(t, a,
+		`CREATE TABLE declared (rowid INTEGER, v TEXT)`,
+		`INSERT INTO declared (_rowid_, rowid, v) VALUES (1, 30, 'a'), (2, 10, 'b'), (3, 20, 'c')`,
+		`CREATE TABLE hidden (rowid, _rowid_, oid)`,
+		`INSERT INTO hidden VALUES (1, 2, 3)`,
+		`CREATE VIEW recent AS SELECT * FROM albums WHERE AlbumId > 340`,
+	)
+
+	// A declared column named rowid is ordered by its values
+	var page testPage
+	testJSON(t, h, "GET", "/api/table/rows?table=declared&order=rowid", "", http.StatusOK, &page)
+	if want := [][]interface{}{{10.0, "b"}, {20.0, "c"}, {30.0, "a"}}; !reflect.DeepEqual(page.Rows, want) {
+		t.Errorf("order=rowid: got %v, want %v", page.Rows, want)
+	}
+	rows := allPages(t, a, "table=declared&limit=2&dir=desc")
+	if want := [][]interface{}{{20.0, "c"}, {10.0, "b"}, {30.0, "a"}}; !reflect.DeepEqual(rows, want) {
+		t.Errorf("keyset by the real rowid: got %v, want %v", rows, want)
+	}
+
+	tests := []struct {
+		query  string
+		status int
+	}{
+		{"table=recent&cursor=", http.StatusBadRequest},
+		{"table=recent&cursor=&order=AlbumId", http.StatusBadRequest},
+		{"table=recent&order=AlbumId&limit=3", http.StatusOK},
+		{"table=hidden&cursor=", http.StatusBadRequest},
+		{"table=hidden&dir=desc", http.StatusBadRequest},
+		{"table=hidden", http.StatusOK},
+	}
+	for _, tt := range tests {
+		if w := testRequest(t, h, "GET", "/api/table/rows?"+tt.query, ""); w.Code != tt.status {
+			t.Errorf("%s: got status %d, want %d: %s", tt.query, w.Code, tt.status, w.Body.String())
+		}
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := [][]interface{}{
+		{int64(1)},
+		{int64(1<<62 + 1), int64(-3)},
+		{"text", int64(7)},
+		{[]byte{0, 0xff, 'a'}, int64(7)},
+		{nil, int64(2)},
+		{3.5, "a", int64(1)},
+	}
+	for _, values := range tests {
+		cursor, err := encodeCursor(values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := decodeCursor(cursor, len(values))
+		if err != nil {
+			t.Fatalf("%v: %v", values, err)
+		}
+		if !reflect.DeepEqual(got, values) {
+			t.Errorf("got %#v, want %#v", got, values)
+		}
+	}
+
+	for _, cursor := range []string{"", "not base64!", "W10", "WzFd"} {
+		if _, err := decodeCursor(cursor, 1); err != errInvalidCursor {
+			t.Errorf("%q: got %v, want errInvalidCursor", cursor, err)
+		}
+	}
+	cursor, _ := encodeCursor([]interface{}{int64(1)})
+	if _, err := decodeCursor(cursor, 2); err != errInvalidCursor {
+		t.Errorf("got %v for a cursor of another order, want errInvalidCursor", err)
+	}
+}
+
+func TestRowChanges(t *testing.T) {
+	a, h := newTestAPI(t, Options{})
+	mustExec(t, a,
+		`CREATE TABLE pets (id INTEGER PRIMARY KEY, name TEXT NOT NULL, tag)`,
+		`CREATE TABLE pairs (a TEXT, b INTEGER, v, PRIMARY KEY (a, b))`,
+		`CREATE TABLE notes (v DEFAULT 'none')`,
+		`CREATE TABLE keyed (k TEXT PRIMARY KEY, v) WITHOUT ROWID`,
+		`INSERT INTO keyed VALUES ('a', 1)`,
+	)
+	key := func(s string) string { return "&key=" + url.QueryEscape(s) }
+
+	tests := []struct {
+		method string
+		target string
+		body   string
+		status int
+		want   map[string]interface{}
+	}{
+		{"POST", "table=pets", `{"name": "rex", "tag": 1.5}`, http.StatusCreated, map[string]interface{}{"id": 1.0, "name": "rex", "tag": 1.5}},
+		{"POST", "table=pets", `{"id": 7, "name": "tom", "tag": null}`, http.StatusCreated, map[string]interface{}{"id": 7.0, "name": "tom", "tag": nil}},
+		{"POST", "table=pets", `{"name": "rex", "nope": 1}`, http.StatusBadRequest, nil},
+		{"POST", "table=pets", `{"name": [1]}`, http.StatusBadRequest, nil},
+		{"POST", "table=nope", `{}`, http.StatusNotFound, nil},
+		{"POST", "table=notes", `{}`, http.StatusCreated, map[string]interface{}{"v": "none"}},
+		{"POST", "table=pairs", `{"a": "x", "b": 2, "v": "first"}`, http.StatusCreated, map[string]interface{}{"a": "x", "b": 2.0, "v": "first"}},
+		{"PATCH", "table=pets" + key(`{"id": 1}`), `{"tag": "big"}`, http.StatusOK, map[string]interface{}{"id": 1.0, "name": "rex", "tag": "big"}},
+		{"PATCH", "table=pets" + key(`{"rowid": 1}`), `{"id": 2}`, http.StatusOK, map[string]interface{}{"id": 2.0, "name": "rex", "tag": "big"}},
+		{"PATCH", "table=pets" + key(`{"id": 1}`), `{"tag": 1}`, http.StatusNotFound, nil},
+		{"PATCH", "table=pets" + key(`{"id": 2}`), `{}`, http.StatusBadRequest, nil},
+		{"PATCH", "table=pets" + key(`{"name": "rex"}`), `{"tag": 1}`, http.StatusBadRequest, nil},
+		{"PATCH", "table=pets&key=nope", `{"tag": 1}`, http.StatusBadRequest, nil},
+		{"PATCH", "table=pairs" + key(`{"a": "x", "b": 2}`), `{"b": 3}`, http.StatusOK, map[string]interface{}{"a": "x", "b": 3.0, "v": "first"}},
+		{"PATCH", "table=pairs" + key(`{"a": "x"}`), `{"v": 1}`, http.StatusBadRequest, nil},
+		{"PATCH", "table=notes" + key(`{"rowid": 1}`), `{"v": "some"}`, http.StatusOK, map[string]interface{}{"v": "some"}},
+		{"PATCH", "table=notes" + key(`{"v": "some"}`), `{"v": 1}`, http.StatusBadRequest, nil},
+		{"DELETE", "table=pets" + key(`{"id": 7}`), ``, http.StatusOK, map[string]interface{}{"id": 7.0, "name": "tom", "tag": nil}},
+		{"DELETE", "table=pets" + key(`{"id": 7}`), ``, http.StatusNotFound, nil},
+		{"DELETE", "table=pairs" + key(`{"a": "x", "b": 3}`), ``, http.StatusOK, map[string]interface{}{"a": "x", "b": 3.0, "v": "first"}},
+		{"DELETE", "table=notes" + key(`{"rowid": 1}`), ``, http.StatusOK, map[string]interface{}{"v": "some"}},
+		{"PATCH", "table=keyed" + key(`{"rowid": 1}`), `{"v": 2}`, http.StatusBadRequest, nil},
+		{"DELETE", "table=keyed" + key(`{"ROWID": 1}`), ``, http.StatusBadRequest, nil},
+		{"PATCH", "table=keyed" + key(`{"k": "a"}`), `{"v": 2}`, http.StatusOK, map[string]interface{}{"k": "a", "v": 2.0}},
+	}
+	for _, tt := range tests {
+		target := "/api/table/rows?" + tt.target
+		if tt.want == nil {
+			if w := testRequest(t, h, tt.method, target, tt.body); w.Code != tt.status {
+				t.Errorf("%s %s %s: got status %d, want %d: %s", tt.method, tt.target, tt.body, w.Code, tt.status, w.Body.String())
+			}
+			continue
+		}
+		var got map[string]interface{}
+		testJSON(t, h, tt.method, target, tt.body, tt.status, &got)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s %s %s: got %v, want %v", tt.method, tt.target, tt.body, got, tt.want)
+		}
+	}
+
+	var page testPage
+	testJSON(t, h, "GET", "/api/table/rows?table=pets", "", http.StatusOK, &page)
+	if want := [][]interface{}{{2.0, "rex", "big"}}; !reflect.DeepEqual(page.Rows, want) {
+		t.Errorf("got pets %v, want %v", page.Rows, want)
+	}
+
+	_, roh := newTestAPI(t, Options{ReadOnly: true})
+	if w := testRequest(t, roh, "POST", "/api/table/rows?table=artists", `{"Name": "x"}`); w.Code != http.StatusForbidden {
+		t.Errorf("read-only insert: got status %d, want %d", w.Code, http.StatusForbidden)
+	}
+}
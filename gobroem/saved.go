@@ -0,0 +1,558 @@
+package gobroem
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	queryCreateSaved = `
+CREATE TABLE IF NOT EXISTS saved_queries (
+	slug TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	description TEXT NOT NULL,
+	database TEXT NOT NULL,
+	sql TEXT NOT NULL,
+	params TEXT NOT NULL,
+	format TEXT NOT NULL
+)`
+	queryListSaved   = `SELECT slug, name, description, database, sql, params, format FROM saved_queries`
+	queryGetSaved    = queryListSaved + ` WHERE slug = ?`
+	queryPutSaved    = `INSERT OR REPLACE INTO saved_queries (slug, name, description, database, sql, params, format) VALUES (?, ?, ?, ?, ?, ?, ?)`
+	queryDeleteSaved = `DELETE FROM saved_queries WHERE slug = ?`
+
+	maxSlugLength = 64
+)
+
+var (
+	errSavedQueryNotFound = notFoundError("no such saved query")
+	errSavedQueryExists   = errors.New("a saved query with this slug already exists")
+	errSavedQueryBuiltin  = errors.New("the saved query is defined by the application")
+	errSavedQueryWrites   = inputError("a saved query must only read the database")
+)
+
+// SavedQuery is a named query, run by the q/<slug> page of the API handler.
+type SavedQuery struct {
+	// Slug identifies the query in its URL, with lowercase letters, digits,
+	// "-" and "_". It is derived from the name when empty.
+	Slug        string `json:"slug"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Database is the name of the database the query runs on, the one of the
+	// request if empty
+	Database string       `json:"database,omitempty"`
+	SQL      string       `json:"sql"`
+	Params   []SavedParam `json:"params,omitempty"`
+	// Format is the default output of the page: html, csv or json, html if
+	// empty
+	Format string `json:"format,omitempty"`
+	// Builtin is set for the queries of Options.SavedQueries, which can't be
+	// changed through the API
+	Builtin bool `json:"builtin,omitempty"`
+}
+
+// SavedParam is a named parameter of a saved query, e.g. :since, given as a
+// URL parameter of the page.
+type SavedParam struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Type is the type of the value: integer, real, text or blob (base64
+	// encoded), text if empty
+	Type string `json:"type,omitempty"`
+	// Default is the value of a missing parameter, NULL if empty
+	Default  string `json:"default,omitempty"`
+	Required bool   `json:"required,omitempty"`
+}
+
+// normalize checks a saved query, deriving its slug from its name if missing.
+func (q *SavedQuery) normalize() error {
+	q.Name = strings.TrimSpace(q.Name)
+	q.SQL = strings.TrimSpace(q.SQL)
+	q.Builtin = false
+	if q.Slug == "" {
+		q.Slug = slugify(q.Name)
+	}
+
+	switch {
+	case q.Name == "":
+		return inputError("name missing")
+	case q.SQL == "":
+		return inputError("sql missing")
+	case !validSlug(q.Slug):
+		return inputError("invalid slug: " + q.Slug)
+	}
+	switch q.Format {
+	case "", "html", "csv", "json":
+	default:
+		return inputError("invalid format: " + q.Format)
+	}
+
+	seen := make(map[string]bool)
+	for _, p := range q.Params {
+		if !validParamName(p.Name) {
+			return inputError("invalid parameter name: " + p.Name)
+		}
+		if seen[p.Name] {
+			return inputError("duplicate parameter: " + p.Name)
+		}
+		seen[p.Name] = true
+		if _, err := p.value(p.Default); err != nil {
+			return inputError(fmt.Sprintf("parameter %s: %v", p.Name, err))
+		}
+	}
+	return nil
+}
+
+// args returns the arguments of the query, read from the URL parameters. An
+// empty value is a missing one.
+func (q *SavedQuery) args(values url.Values) ([]interface{}, error) {
+	args := make([]interface{}, 0, len(q.Params))
+	for _, p := range q.Params {
+		s := values.Get(p.Name)
+		if s == "" {
+			if p.Required {
+				return nil, inputError("missing parameter: " + p.Name)
+			}
+			s = p.Default
+		}
+		v, err := p.value(s)
+		if err != nil {
+			return nil, inputError(fmt.Sprintf("parameter %s: %v", p.Name, err))
+		}
+		args = append(args, sql.Named(p.Name, v))
+	}
+	return args, nil
+}
+
+// value converts a value of the parameter given as text, NULL if empty.
+func (p SavedParam) value(s string) (interface{}, error) {
+	t := p.Type
+	switch t {
+	case "":
+		t = "text"
+	case "null":
+		return nil, fmt.Errorf("unknown type %q", t)
+	}
+	if s == "" {
+		return nil, nil
+	}
+	return typedValue(t, s)
+}
+
+// slugify returns the slug of a name, with its letters and digits.
+func slugify(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9') {
+			dash = b.Len() > 0
+			continue
+		}
+		if dash {
+			b.WriteByte('-')
+			dash = false
+		}
+		b.WriteRune(r)
+	}
+
+	slug := b.String()
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+	return slug
+}
+
+func validSlug(slug string) bool {
+	if slug == "" || len(slug) > maxSlugLength {
+		return false
+	}
+	for i, r := range slug {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+		case (r == '-' || r == '_') && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// SavedQueryStore keeps the queries saved through the API.
+type SavedQueryStore interface {
+	// List returns all the saved queries.
+	List(ctx context.Context) ([]SavedQuery, error)
+	// Get returns the saved query with the given slug, nil if there is none.
+	Get(ctx context.Context, slug string) (*SavedQuery, error)
+	// Put creates or replaces the saved query with the slug of q.
+	Put(ctx context.Context, q SavedQuery) error
+	// Delete deletes a saved query. It returns false if there is none.
+	Delete(ctx context.Context, slug string) (bool, error)
+}
+
+// memorySavedQueries keeps the saved queries until the process exits.
+type memorySavedQueries struct {
+	sync.Mutex
+	queries map[string]SavedQuery
+}
+
+func newMemorySavedQueries() *memorySavedQueries {
+	return &memorySavedQueries{queries: make(map[string]SavedQuery)}
+}
+
+func (m *memorySavedQueries) List(ctx context.Context) ([]SavedQuery, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	queries := make([]SavedQuery, 0, len(m.queries))
+	for _, q := range m.queries {
+		queries = append(queries, q)
+	}
+	return queries, nil
+}
+
+func (m *memorySavedQueries) Get(ctx context.Context, slug string) (*SavedQuery, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	q, found := m.queries[slug]
+	if !found {
+		return nil, nil
+	}
+	return &q, nil
+}
+
+func (m *memorySavedQueries) Put(ctx context.Context, q SavedQuery) error {
+	m.Lock()
+	defer m.Unlock()
+
+	m.queries[q.Slug] = q
+	return nil
+}
+
+func (m *memorySavedQueries) Delete(ctx context.Context, slug string) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	_, found := m.queries[slug]
+	delete(m.queries, slug)
+	return found, nil
+}
+
+// fileSavedQueries keeps the saved queries in a SQLite file.
+type fileSavedQueries struct {
+	db *sql.DB
+}
+
+// NewFileSavedQueries returns a SavedQueryStore keeping the queries in a
+// SQLite file, created if missing. It may be the file of the history.
+func NewFileSavedQueries(file string) (SavedQueryStore, error) {
+	db, err := sql.Open("sqlite3", "file:"+escapeURIPath(file)+"?_busy_timeout=5000")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(queryCreateSaved); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &fileSavedQueries{db}, nil
+}
+
+func (f *fileSavedQueries) List(ctx context.Context) ([]SavedQuery, error) {
+	rows, err := f.db.QueryContext(ctx, queryListSaved)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var queries []SavedQuery
+	for rows.Next() {
+		q, err := scanSavedQuery(rows)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, *q)
+	}
+	return queries, rows.Err()
+}
+
+func (f *fileSavedQueries) Get(ctx context.Context, slug string) (*SavedQuery, error) {
+	q, err := scanSavedQuery(f.db.QueryRowContext(ctx, queryGetSaved, slug))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return q, err
+}
+
+func (f *fileSavedQueries) Put(ctx context.Context, q SavedQuery) error {
+	params, err := json.Marshal(q.Params)
+	if err != nil {
+		return err
+	}
+	_, err = f.db.ExecContext(ctx, queryPutSaved, q.Slug, q.Name, q.Description, q.Database, q.SQL, string(params), q.Format)
+	return err
+}
+
+func (f *fileSavedQueries) Delete(ctx context.Context, slug string) (bool, error) {
+	res, err := f.db.ExecContext(ctx, queryDeleteSaved, slug)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// scanSavedQuery scans a row of the saved_queries table.
+func scanSavedQuery(row interface{ Scan(...interface{}) error }) (*SavedQuery, error) {
+	var q SavedQuery
+	var params string
+	if err := row.Scan(&q.Slug, &q.Name, &q.Description, &q.Database, &q.SQL, &params, &q.Format); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(params), &q.Params); err != nil {
+		return nil, err
+	}
+	return &q, nil
+}
+
+// savedQueries holds the saved queries of the API: the builtin ones, from
+// the options, and the ones of the store.
+type savedQueries struct {
+	builtin []SavedQuery
+	store   SavedQueryStore
+}
+
+func newSavedQueries(builtin []SavedQuery, store SavedQueryStore) (*savedQueries, error) {
+	if store == nil {
+		store = newMemorySavedQueries()
+	}
+
+	s := &savedQueries{store: store}
+	for _, q := range builtin {
+		if err := q.normalize(); err != nil {
+			return nil, fmt.Errorf("saved query %s: %v", q.Name, err)
+		}
+		if s.builtinQuery(q.Slug) != nil {
+			return nil, fmt.Errorf("duplicate saved query: %s", q.Slug)
+		}
+		q.Builtin = true
+		s.builtin = append(s.builtin, q)
+	}
+	return s, nil
+}
+
+func (s *savedQueries) builtinQuery(slug string) *SavedQuery {
+	for i := range s.builtin {
+		if s.builtin[i].Slug == slug {
+			return &s.builtin[i]
+		}
+	}
+	return nil
+}
+
+// list returns the saved queries sorted by name.
+func (s *savedQueries) list(ctx context.Context) ([]SavedQuery, error) {
+	stored, err := s.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := append([]SavedQuery{}, s.builtin...)
+	for _, q := range stored {
+		if s.builtinQuery(q.Slug) == nil {
+			queries = append(queries, q)
+		}
+	}
+	sort.SliceStable(queries, func(i, j int) bool {
+		return queries[i].Name < queries[j].Name
+	})
+	return queries, nil
+}
+
+func (s *savedQueries) get(ctx context.Context, slug string) (*SavedQuery, error) {
+	if q := s.builtinQuery(slug); q != nil {
+		return q, nil
+	}
+	q, err := s.store.Get(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if q == nil {
+		return nil, errSavedQueryNotFound
+	}
+	return q, nil
+}
+
+// create saves a new query, which must have been normalized.
+func (s *savedQueries) create(ctx context.Context, q SavedQuery) error {
+	if _, err := s.get(ctx, q.Slug); err == nil {
+		return errSavedQueryExists
+	} else if err != errSavedQueryNotFound {
+		return err
+	}
+	return s.store.Put(ctx, q)
+}
+
+// update replaces a saved query, which must have been normalized.
+func (s *savedQueries) update(ctx context.Context, q SavedQuery) error {
+	if s.builtinQuery(q.Slug) != nil {
+		return errSavedQueryBuiltin
+	}
+	if _, err := s.get(ctx, q.Slug); err != nil {
+		return err
+	}
+	return s.store.Put(ctx, q)
+}
+
+// delete deletes a saved query and returns it.
+func (s *savedQueries) delete(ctx context.Context, slug string) (*SavedQuery, error) {
+	if s.builtinQuery(slug) != nil {
+		return nil, errSavedQueryBuiltin
+	}
+	q, err := s.get(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.store.Delete(ctx, slug); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// savedPage is the HTML page of a saved query.
+type savedPage struct {
+	Query *SavedQuery
+	// Database is the db parameter of the request, kept by the form
+	Database  string
+	Fields    []savedField
+	CSVLink   string
+	JSONLink  string
+	Columns   []string
+	Truncated bool
+	Error     string
+	Stats     *queryStats
+}
+
+// savedField is a field of the parameters form.
+type savedField struct {
+	SavedParam
+	Value string
+}
+
+func newSavedPage(q *SavedQuery, values url.Values) *savedPage {
+	page := &savedPage{Query: q}
+	if q.Database == "" {
+		page.Database = values.Get("db")
+	}
+	for _, p := range q.Params {
+		value := values.Get(p.Name)
+		if value == "" {
+			value = p.Default
+		}
+		page.Fields = append(page.Fields, savedField{p, value})
+	}
+
+	link := url.Values{}
+	for name, v := range values {
+		link[name] = v
+	}
+	link.Set("format", "csv")
+	page.CSVLink = "?" + link.Encode()
+	link.Set("format", "json")
+	page.JSONLink = "?" + link.Encode()
+	return page
+}
+
+var savedPageTemplate = template.Must(template.New("page").Parse(`
+{{define "head"}}<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Query.Name}}</title>
+<style>
+body{margin:20px;font-family:"Helvetica Neue",Helvetica,Arial,sans-serif;font-size:13px;color:#333}
+h1{font-size:20px}
+a{color:#19aa8d}
+label{margin-right:10px;font-weight:400}
+table{margin-top:10px;border-collapse:collapse}
+th,td{padding:4px 8px;border:1px solid #e6e9ed;text-align:left;font-family:Menlo,Monaco,Consolas,monospace;font-size:12px}
+th{background:#f5f7fa}
+.error{color:#da4453}
+.stats{color:#888}
+</style>
+</head>
+<body>
+<h1>{{.Query.Name}}</h1>
+{{with .Query.Description}}<p>{{.}}</p>{{end}}
+{{if .Fields}}<form method="get">
+{{with .Database}}<input type="hidden" name="db" value="{{.}}">{{end}}
+{{range .Fields}}<label title="{{.Description}}">{{.Name}} <input name="{{.Name}}" value="{{.Value}}"{{if .Required}} required{{end}}></label>
+{{end}}<button type="submit">Run</button>
+</form>{{end}}
+<p><a href="{{.CSVLink}}">Download CSV</a> <a href="{{.JSONLink}}">JSON</a></p>
+{{with .Error}}<p class="error">{{.}}</p>{{end}}
+{{if .Columns}}<table>
+<thead><tr>{{range .Columns}}<th>{{.}}</th>{{end}}</tr></thead>
+<tbody>
+{{end}}{{end}}
+{{define "row"}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{end}}
+{{define "foot"}}{{if .Columns}}</tbody>
+</table>
+{{if .Truncated}}<p>The result is truncated.</p>{{end}}
+{{with .Stats}}<p class="stats">{{.Rows}} rows in {{printf "%.1f" .Duration}} ms</p>{{end}}
+{{with .Error}}<p class="error">{{.}}</p>{{end}}{{end}}
+</body>
+</html>
+{{end}}`))
+
+// renderSavedPage renders the page of a saved query which could not run.
+func renderSavedPage(w http.ResponseWriter, status int, page *savedPage) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	savedPageTemplate.ExecuteTemplate(w, "head", page)
+	savedPageTemplate.ExecuteTemplate(w, "foot", page)
+}
+
+// htmlWriter streams the result of a saved query in its HTML page.
+type htmlWriter struct {
+	streamWriter
+	page *savedPage
+}
+
+func newHTMLWriter(w http.ResponseWriter, page *savedPage) *htmlWriter {
+	return &htmlWriter{streamWriter: streamWriter{w: w}, page: page}
+}
+
+func (h *htmlWriter) Begin(columns, types []string) error {
+	h.page.Columns = columns
+	h.begin("text/html; charset=utf-8", false)
+	return savedPageTemplate.ExecuteTemplate(h.w, "head", h.page)
+}
+
+func (h *htmlWriter) Row(row sqlRow) error {
+	if err := savedPageTemplate.ExecuteTemplate(h.w, "row", csvRecord(row)); err != nil {
+		return err
+	}
+	h.rowDone()
+	return nil
+}
+
+func (h *htmlWriter) End(truncated bool, err error) error {
+	h.page.Truncated = truncated
+	h.page.Stats = h.stats
+	if err != nil {
+		h.page.Error = err.Error()
+	}
+	savedPageTemplate.ExecuteTemplate(h.w, "foot", h.page)
+	h.flush()
+	return nil
+}
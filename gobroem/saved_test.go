@@ -0,0 +1,183 @@
+package gobroem
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := map[string]string{
+		"Top Artists":              "top-artists",
+		"  Sales -- 2024 (EUR)!  ": "sales-2024-eur",
+		"Ünïcode":                  "n-code",
+		"***":                      "",
+		strings.Repeat("ab ", 30):  strings.TrimRight(strings.Repeat("ab-", 22)[:64], "-"),
+	}
+	for name, want := range tests {
+		if got := slugify(name); got != want {
+			t.Errorf("%q: got %q, want %q", name, got, want)
+		}
+	}
+
+	for slug, want := range map[string]bool{
+		"top-artists":           true,
+		"a_1":                   true,
+		"-a":                    false,
+		"A":                     false,
+		"a b":                   false,
+		"":                      false,
+		strings.Repeat("a", 65): false,
+	} {
+		if got := validSlug(slug); got != want {
+			t.Errorf("%q: got %v, want %v", slug, got, want)
+		}
+	}
+}
+
+func TestSavedQueryNormalize(t *testing.T) {
+	tests := []struct {
+		query SavedQuery
+		slug  string
+		err   string
+	}{
+		{SavedQuery{Name: " Top Artists ", SQL: " SELECT 1 "}, "top-artists", ""},
+		{SavedQuery{Slug: "top", Name: "Top", SQL: "SELECT 1", Format: "csv", Builtin: true}, "top", ""},
+		{SavedQuery{Name: "Since", SQL: "SELECT :since", Params: []SavedParam{{Name: "since", Type: "integer", Default: "3"}}}, "since", ""},
+		{SavedQuery{Name: "", SQL: "SELECT 1"}, "", "name missing"},
+		{SavedQuery{Name: "Top", SQL: " "}, "", "sql missing"},
+		{SavedQuery{Name: "***", SQL: "SELECT 1"}, "", "invalid slug: "},
+		{SavedQuery{Slug: "Top", Name: "Top", SQL: "SELECT 1"}, "", "invalid slug: Top"},
+		{SavedQuery{Name: "Top", SQL: "SELECT 1", Format: "xml"}, "", "invalid format: xml"},
+		{SavedQuery{Name: "Top", SQL: "SELECT 1", Params: []SavedParam{{Name: "1a"}}}, "", "invalid parameter name: 1a"},
+		{SavedQuery{Name: "Top", SQL: "SELECT 1", Params: []SavedParam{{Name: "a"}, {Name: "a"}}}, "", "duplicate parameter: a"},
+		{SavedQuery{Name: "Top", SQL: "SELECT 1", Params: []SavedParam{{Name: "a", Type: "null"}}}, "", `parameter a: unknown type "null"`},
+		{SavedQuery{Name: "Top", SQL: "SELECT 1", Params: []SavedParam{{Name: "a", Type: "integer", Default: "x"}}}, "", `parameter a: invalid integer "x"`},
+	}
+	for _, tt := range tests {
+		q := tt.query
+		err := q.normalize()
+		if tt.err != "" {
+			if _, ok := err.(inputError); !ok || err.Error() != tt.err {
+				t.Errorf("%+v: got error %v, want %q", tt.query, err, tt.err)
+			}
+			continue
+		}
+		if err != nil || q.Slug != tt.slug || q.Builtin || q.Name != strings.TrimSpace(q.Name) || q.SQL != strings.TrimSpace(q.SQL) {
+			t.Errorf("%+v: got %+v, %v", tt.query, q, err)
+		}
+	}
+}
+
+func TestSavedQueryStores(t *testing.T) {
+	file, err := NewFileSavedQueries(filepath.Join(t.TempDir(), "saved.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stores := map[string]SavedQueryStore{"memory": newMemorySavedQueries(), "file": file}
+	for name, store := range stores {
+		ctx := context.Background()
+		q := SavedQuery{Slug: "top", Name: "Top", Description: "d", Database: "db", SQL: "SELECT :n",
+			Params: []SavedParam{{Name: "n", Type: "integer", Default: "1", Required: true}}, Format: "json"}
+		if err := store.Put(ctx, q); err != nil {
+			t.Fatal(err)
+		}
+		got, err := store.Get(ctx, "top")
+		if err != nil || got == nil || !reflect.DeepEqual(*got, q) {
+			t.Errorf("%s: got %+v, %v, want %+v", name, got, err, q)
+		}
+		q.Name = "Top 2"
+		store.Put(ctx, q)
+		if list, err := store.List(ctx); err != nil || len(list) != 1 || list[0].Name != "Top 2" {
+			t.Errorf("%s: got list %+v, %v", name, list, err)
+		}
+		if got, err := store.Get(ctx, "nope"); got != nil || err != nil {
+			t.Errorf("%s: got %+v, %v for a missing query", name, got, err)
+		}
+		if found, err := store.Delete(ctx, "top"); !found || err != nil {
+			t.Errorf("%s: delete: got %v, %v", name, found, err)
+		}
+		if found, err := store.Delete(ctx, "top"); found || err != nil {
+			t.Errorf("%s: second delete: got %v, %v", name, found, err)
+		}
+	}
+}
+
+func TestSavedQueries(t *testing.T) {
+	builtin := []SavedQuery{{Name: "Artist albums", SQL: "SELECT Title FROM albums WHERE ArtistId = :artist ORDER BY Title",
+		Params: []SavedParam{{Name: "artist", Type: "integer", Required: true}}}}
+	_, h := newTestAPI(t, Options{SavedQueries: builtin, MaxRows: 100})
+
+	steps := []struct {
+		method string
+		target string
+		body   string
+		status int
+	}{
+		{"POST", "/api/saved", `{"name": "Genres", "sql": "SELECT Name FROM genres WHERE GenreId <= :max ORDER BY GenreId", "params": [{"name": "max", "type": "integer", "default": "2"}]}`, http.StatusCreated},
+		{"POST", "/api/saved", `{"name": "Genres", "sql": "SELECT 1"}`, http.StatusConflict},
+		{"POST", "/api/saved", `{"slug": "artist-albums", "name": "Other", "sql": "SELECT 1"}`, http.StatusConflict},
+		{"POST", "/api/saved", `{"name": "Wipe", "sql": "DELETE FROM artists"}`, http.StatusBadRequest},
+		{"POST", "/api/saved", `{"name": "Broken", "sql": "SELECT nope FROM artists"}`, http.StatusBadRequest},
+		{"POST", "/api/saved", `{"name": "Elsewhere", "database": "nope", "sql": "SELECT 1"}`, http.StatusBadRequest},
+		{"POST", "/api/saved", `not json`, http.StatusBadRequest},
+		{"PUT", "/api/saved?slug=artist-albums", `{"name": "Mine", "sql": "SELECT 1"}`, http.StatusForbidden},
+		{"PUT", "/api/saved?slug=genres", `{"slug": "other", "name": "Genres", "sql": "SELECT 1"}`, http.StatusBadRequest},
+		{"PUT", "/api/saved?slug=nope", `{"name": "Nope", "sql": "SELECT 1"}`, http.StatusNotFound},
+		{"DELETE", "/api/saved?slug=artist-albums", ``, http.StatusForbidden},
+		{"GET", "/api/saved?slug=genres", ``, http.StatusOK},
+		{"GET", "/api/saved?slug=nope", ``, http.StatusNotFound},
+	}
+	for _, tt := range steps {
+		if w := testRequest(t, h, tt.method, tt.target, tt.body); w.Code != tt.status {
+			t.Errorf("%s %s %s: got status %d, want %d: %s", tt.method, tt.target, tt.body, w.Code, tt.status, w.Body.String())
+		}
+	}
+
+	var list struct {
+		Queries []SavedQuery `json:"queries"`
+	}
+	testJSON(t, h, "GET", "/api/saved", "", http.StatusOK, &list)
+	if len(list.Queries) != 2 || list.Queries[0].Slug != "artist-albums" || !list.Queries[0].Builtin || list.Queries[1].Slug != "genres" {
+		t.Errorf("got saved queries %+v", list.Queries)
+	}
+
+	pages := []struct {
+		target      string
+		status      int
+		contentType string
+		contains    []string
+	}{
+		{"/q/genres", http.StatusOK, "text/html", []string{"<h1>Genres</h1>", "<td>Rock</td>", "<td>Jazz</td>", `name="max" value="2"`, "2 rows in"}},
+		{"/q/genres?max=1&format=csv", http.StatusOK, "text/csv", []string{"Name\nRock\n"}},
+		{"/q/genres?max=3&format=json", http.StatusOK, "application/json", []string{`[{"Name":"Rock"},{"Name":"Jazz"},{"Name":"Metal"}]`}},
+		{"/q/genres?max=x", http.StatusBadRequest, "text/html", []string{`parameter max: invalid integer &#34;x&#34;`}},
+		{"/q/artist-albums?artist=1", http.StatusOK, "text/html", []string{"<td>For Those About To Rock We Salute You</td>", "<td>Let There Be Rock</td>"}},
+		{"/q/artist-albums", http.StatusBadRequest, "text/html", []string{"missing parameter: artist"}},
+		{"/q/artist-albums?format=csv", http.StatusBadRequest, "application/json", []string{"missing parameter: artist"}},
+		{"/q/genres?db=nope", http.StatusNotFound, "text/html", []string{"No such database: nope"}},
+		{"/q/genres?format=xml", http.StatusBadRequest, "text/html", []string{"invalid format: xml"}},
+		{"/q/nope", http.StatusNotFound, "application/json", nil},
+	}
+	for _, tt := range pages {
+		w := testRequest(t, h, "GET", tt.target, "")
+		if w.Code != tt.status || !strings.HasPrefix(w.Header().Get("Content-Type"), tt.contentType) {
+			t.Errorf("%s: got status %d and %s, want %d and %s", tt.target, w.Code, w.Header().Get("Content-Type"), tt.status, tt.contentType)
+		}
+		for _, s := range tt.contains {
+			if !strings.Contains(w.Body.String(), s) {
+				t.Errorf("%s: %q not found in %s", tt.target, s, w.Body.String())
+			}
+		}
+	}
+
+	if w := testRequest(t, h, "DELETE", "/api/saved?slug=genres", ""); w.Code != http.StatusOK {
+		t.Errorf("delete: got status %d", w.Code)
+	}
+	if w := testRequest(t, h, "GET", "/q/genres", ""); w.Code != http.StatusNotFound {
+		t.Errorf("deleted query: got status %d", w.Code)
+	}
+}
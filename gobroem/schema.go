@@ -0,0 +1,107 @@
+package gobroem
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// schemaObject is an entry of the schema: a table, view, trigger or index.
+type schemaObject struct {
+	Name string `json:"name"`
+	// Table is the table of an index or trigger, the object itself otherwise
+	Table string `json:"tbl_name"`
+	// Module is the module implementing a virtual table, e.g. fts5
+	Module string `json:"module,omitempty"`
+}
+
+// schemaObjects lists the objects of a schema grouped by type. A virtual
+// table is listed apart from the plain tables.
+type schemaObjects struct {
+	Tables        []schemaObject `json:"tables"`
+	VirtualTables []schemaObject `json:"virtual_tables"`
+	Views         []schemaObject `json:"views"`
+	Triggers      []schemaObject `json:"triggers"`
+	Indexes       []schemaObject `json:"indexes"`
+}
+
+// SchemaObjects returns the tables, views, triggers and indexes of the
+// database.
+func (client *sqlClient) SchemaObjects(ctx context.Context) (*schemaObjects, error) {
+	result, err := client.query(ctx, fmt.Sprintf(queryObjects, client.master()))
+	if err != nil {
+		return nil, err
+	}
+
+	// Init empty slices; otherwise JSON marshal will encode them to "null"
+	objects := &schemaObjects{
+		Tables:        make([]schemaObject, 0),
+		VirtualTables: make([]schemaObject, 0),
+		Views:         make([]schemaObject, 0),
+		Triggers:      make([]schemaObject, 0),
+		Indexes:       make([]schemaObject, 0),
+	}
+
+	for _, row := range result.Rows {
+		objectType, _ := row[0].(string)
+		object := schemaObject{}
+		object.Name, _ = row[1].(string)
+		object.Table, _ = row[2].(string)
+		sql, _ := row[3].(string)
+
+		switch objectType {
+		case "table":
+			if module, ok := virtualTableModule(sql); ok {
+				object.Module = module
+				objects.VirtualTables = append(objects.VirtualTables, object)
+			} else {
+				objects.Tables = append(objects.Tables, object)
+			}
+		case "view":
+			objects.Views = append(objects.Views, object)
+		case "trigger":
+			objects.Triggers = append(objects.Triggers, object)
+		case "index":
+			objects.Indexes = append(objects.Indexes, object)
+		}
+	}
+
+	return objects, nil
+}
+
+// virtualTableModule returns the module of a CREATE VIRTUAL TABLE statement,
+// and false if sql creates a plain table.
+func virtualTableModule(sql string) (string, bool) {
+	const prefix = "CREATE VIRTUAL TABLE"
+	if len(sql) < len(prefix) || !strings.EqualFold(sql[:len(prefix)], prefix) {
+		return "", false
+	}
+
+	// The module follows USING, outside of the quoted table name
+	for i := len(prefix); i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			i = skipQuoted(sql, i, c)
+		case c == '[':
+			if j := strings.IndexByte(sql[i:], ']'); j >= 0 {
+				i += j
+			}
+		case isWordChar(c):
+			j := i
+			for j < len(sql) && isWordChar(sql[j]) {
+				j++
+			}
+			if strings.EqualFold(sql[i:j], "USING") {
+				module := strings.TrimSpace(sql[j:])
+				end := 0
+				for end < len(module) && isWordChar(module[end]) {
+					end++
+				}
+				return module[:end], true
+			}
+			i = j - 1
+		}
+	}
+	return "", true
+}
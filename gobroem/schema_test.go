@@ -0,0 +1,98 @@
+package gobroem
+
+import (
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSchemaObjects(t *testing.T) {
+	a, err := NewAPIWithOptions(filepath.Join(t.TempDir(), "schema.db"), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := a.Handler("/", "/static/")
+	mustExec(t, a,
+		`CREATE TABLE "my table" (id INTEGER PRIMARY KEY, v TEXT)`,
+		`CREATE INDEX my_v ON "my table" (v)`,
+		`CREATE VIEW recent AS SELECT * FROM "my table" WHERE id > 10`,
+		`CREATE TRIGGER stamp AFTER INSERT ON "my table" BEGIN SELECT 1; END`,
+		`CREATE VIRTUAL TABLE docs USING fts4(body)`,
+		`CREATE VIRTUAL TABLE "using" USING rtree(id, x0, x1)`,
+	)
+
+	var tables struct {
+		Tables  []string      `json:"tables"`
+		Objects schemaObjects `json:"objects"`
+	}
+	testJSON(t, h, "GET", "/api/tables", "", http.StatusOK, &tables)
+
+	names := func(objects []schemaObject) []string {
+		names := make([]string, 0, len(objects))
+		for _, o := range objects {
+			names = append(names, o.Name)
+		}
+		return names
+	}
+	objects := tables.Objects
+	// The shadow tables of the virtual tables are plain tables
+	wantTables := []string{"my table", "docs_content", "docs_segments", "docs_segdir", "docs_docsize", "docs_stat", "using_rowid", "using_node", "using_parent"}
+	if got := names(objects.Tables); !reflect.DeepEqual(got, wantTables) {
+		t.Errorf("tables: got %q, want %q", got, wantTables)
+	}
+	wantVirtual := []schemaObject{{"docs", "docs", "fts4"}, {"using", "using", "rtree"}}
+	if !reflect.DeepEqual(objects.VirtualTables, wantVirtual) {
+		t.Errorf("virtual tables: got %+v, want %+v", objects.VirtualTables, wantVirtual)
+	}
+	if want := []schemaObject{{"recent", "recent", ""}}; !reflect.DeepEqual(objects.Views, want) {
+		t.Errorf("views: got %+v, want %+v", objects.Views, want)
+	}
+	if want := []schemaObject{{"stamp", "my table", ""}}; !reflect.DeepEqual(objects.Triggers, want) {
+		t.Errorf("triggers: got %+v, want %+v", objects.Triggers, want)
+	}
+	if got := objects.Indexes; len(got) == 0 || got[0] != (schemaObject{"my_v", "my table", ""}) {
+		t.Errorf("indexes: got %+v, want my_v first", got)
+	}
+	if want := append(wantTables, "docs", "using"); !reflect.DeepEqual(tables.Tables, want) {
+		t.Errorf("table names: got %q, want %q", tables.Tables, want)
+	}
+
+	// The counts of api/info match the lists
+	var info map[string]interface{}
+	testJSON(t, h, "GET", "/api/info", "", http.StatusOK, &info)
+	counts := map[string]int{
+		"number_of_tables":         len(objects.Tables),
+		"number_of_virtual_tables": len(objects.VirtualTables),
+		"number_of_views":          len(objects.Views),
+		"number_of_triggers":       len(objects.Triggers),
+		"number_of_indexes":        len(objects.Indexes),
+	}
+	for key, want := range counts {
+		if got, _ := info[key].(float64); int(got) != want {
+			t.Errorf("%s: got %v, want %d", key, info[key], want)
+		}
+	}
+}
+
+func TestVirtualTableModule(t *testing.T) {
+	tests := []struct {
+		sql     string
+		module  string
+		virtual bool
+	}{
+		{"CREATE TABLE t (a)", "", false},
+		{"CREATE VIRTUAL TABLE t USING fts5(body)", "fts5", true},
+		{"create virtual table t using rtree(id, x0, x1)", "rtree", true},
+		{`CREATE VIRTUAL TABLE "using x" USING fts4 (body)`, "fts4", true},
+		{"CREATE VIRTUAL TABLE [a using b] USING csv(filename='x.csv')", "csv", true},
+		{"CREATE VIRTUAL TABLE 'using' USING dbstat", "dbstat", true},
+		{"CREATE VIRTUAL", "", false},
+	}
+	for _, tt := range tests {
+		module, virtual := virtualTableModule(tt.sql)
+		if module != tt.module || virtual != tt.virtual {
+			t.Errorf("%s: got %q, %v, want %q, %v", tt.sql, module, virtual, tt.module, tt.virtual)
+		}
+	}
+}
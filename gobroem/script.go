@@ -0,0 +1,147 @@
+package gobroem
+
+import (
+	"context"
+	"database/sql"
+)
+
+// statementResult is the result of a statement of a script: the columns and
+// rows of a query. The rows affected by a write are in its stats, as for a
+// single query.
+type statementResult struct {
+	Statement string      `json:"statement"`
+	Columns   []string    `json:"columns,omitempty"`
+	Types     []string    `json:"types,omitempty"`
+	Rows      []sqlRow    `json:"rows,omitempty"`
+	Truncated bool        `json:"truncated,omitempty"`
+	Stats     *queryStats `json:"stats,omitempty"`
+}
+
+// scriptResult holds the results of the statements run by a script. When a
+// statement fails, the script stops and FailedStatement is its index.
+type scriptResult struct {
+	Results         []*statementResult `json:"results"`
+	Transaction     bool               `json:"transaction"`
+	Error           string             `json:"error,omitempty"`
+	FailedStatement *int               `json:"failed_statement,omitempty"`
+}
+
+// execer is implemented by both sql.Conn and sql.Tx.
+type execer interface {
+	queryer
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// RunScript splits a user supplied script into statements and runs them one
+// after the other on the same connection, in a single transaction if asked.
+// At most maxRows rows are returned per statement if maxRows is positive,
+// encoded with enc.
+// The error of a failed statement is reported in the result; the returned
+// error is set only if the script could not start.
+func (client *sqlClient) RunScript(ctx context.Context, script string, transaction bool, maxRows int, enc valueEncoding) (*scriptResult, error) {
+	statements := splitStatements(script)
+	if len(statements) == 0 {
+		return nil, inputError("the script holds no statement")
+	}
+	if err := client.checkQuery(ctx, script); err != nil {
+		return nil, err
+	}
+
+	conn, err := client.guardedConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	var q statsConn = conn
+	var tx *sql.Tx
+	if transaction {
+		if tx, err = conn.BeginTx(ctx, nil); err != nil {
+			return nil, err
+		}
+		defer tx.Rollback()
+		q = tx
+	}
+
+	result := &scriptResult{
+		Results:     make([]*statementResult, 0, len(statements)),
+		Transaction: transaction,
+	}
+	for i, stmt := range statements {
+		res, err := runStatement(ctx, conn, q, stmt, maxRows, enc)
+		if err != nil {
+			failed := i
+			result.Error = err.Error()
+			result.FailedStatement = &failed
+			return result, nil
+		}
+		result.Results = append(result.Results, res)
+	}
+
+	if tx != nil {
+		if err := tx.Commit(); err != nil {
+			// Not the fault of a single statement, e.g. a deferred foreign key
+			result.Error = err.Error()
+		}
+	}
+	return result, nil
+}
+
+// runStatement runs a single statement with q, the guarded connection or a
+// transaction of it, collecting its rows if it returns any.
+func runStatement(ctx context.Context, conn *guardedConn, q statsConn, stmt string, maxRows int, enc valueEncoding) (*statementResult, error) {
+	res := &statementResult{Statement: stmt}
+
+	recorder, err := startStats(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := conn.query(ctx, q, stmt)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := rows.Columns()
+	if err != nil {
+		rows.Close()
+		return nil, err
+	}
+
+	if len(columns) == 0 {
+		// go-sqlite3 only steps a statement on the first Next, so nothing has
+		// run yet
+		rows.Next()
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		// The changes of the statement are read from total_changes, which
+		// the statements not writing, such as DDL, leave as is
+		res.Stats = recorder.finish(0, false)
+		return res, nil
+	}
+	defer rows.Close()
+
+	res.Columns = columns
+	res.Types = enc.declaredTypes(rows)
+	res.Rows = make([]sqlRow, 0)
+	for rows.Next() {
+		if maxRows > 0 && len(res.Rows) >= maxRows {
+			res.Truncated = true
+			break
+		}
+		cols, err := SliceScan(rows)
+		if err != nil {
+			return nil, err
+		}
+		res.Rows = append(res.Rows, enc.encodeRow(cols))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// The changes are read once the statement is reset. They are set for a
+	// write with a RETURNING clause.
+	rows.Close()
+	res.Stats = recorder.finish(int64(len(res.Rows)), res.Truncated)
+	return res, nil
+}
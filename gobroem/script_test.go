@@ -0,0 +1,125 @@
+package gobroem
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+// runTestScript runs a script through the API and returns its result.
+func runTestScript(t *testing.T, h http.Handler, script string, transaction bool) *scriptResult {
+	t.Helper()
+	form := url.Values{"query": {script}, "script": {"1"}}
+	if transaction {
+		form.Set("transaction", "1")
+	}
+	var result scriptResult
+	testJSON(t, h, "POST", "/api/query", form.Encode(), http.StatusOK, &result)
+	return &result
+}
+
+func TestRunScriptChanges(t *testing.T) {
+	_, h := newTestAPI(t, Options{})
+	result := runTestScript(t, h, `CREATE TABLE t (id INTEGER PRIMARY KEY, v TEXT);
+INSERT INTO t (v) VALUES ('a;b'), ('c');
+CREATE INDEX t_v ON t (v);
+PRAGMA user_version = 3;
+UPDATE t SET v = v || '!';
+DELETE FROM t WHERE id > 10;
+INSERT INTO t (v) VALUES ('d') RETURNING id, v;
+SELECT count(*) FROM t`, false)
+	if result.Error != "" {
+		t.Fatal(result.Error)
+	}
+
+	tests := []struct {
+		rows         int
+		rowsAffected *int64
+		lastInsertID *int64
+	}{
+		{0, nil, nil},
+		{0, int64p(2), int64p(2)},
+		{0, nil, nil},
+		{0, nil, nil},
+		{0, int64p(2), int64p(2)},
+		{0, nil, nil},
+		{1, int64p(1), int64p(3)},
+		{1, nil, nil},
+	}
+	if len(result.Results) != len(tests) {
+		t.Fatalf("got %d results, want %d", len(result.Results), len(tests))
+	}
+	for i, tt := range tests {
+		res := result.Results[i]
+		if len(res.Rows) != tt.rows || res.Stats == nil || res.Stats.Rows != int64(tt.rows) {
+			t.Fatalf("%s: got %d rows, stats %+v, want %d rows", res.Statement, len(res.Rows), res.Stats, tt.rows)
+		}
+		if !equalInt64p(res.Stats.RowsAffected, tt.rowsAffected) {
+			t.Errorf("%s: rows_affected %v, want %v", res.Statement, fmtInt64p(res.Stats.RowsAffected), fmtInt64p(tt.rowsAffected))
+		}
+		if !equalInt64p(res.Stats.LastInsertID, tt.lastInsertID) {
+			t.Errorf("%s: last_insert_id %v, want %v", res.Statement, fmtInt64p(res.Stats.LastInsertID), fmtInt64p(tt.lastInsertID))
+		}
+	}
+}
+
+func TestRunScriptStats(t *testing.T) {
+	_, h := newTestAPI(t, Options{})
+	insert := "INSERT INTO artists (Name) VALUES ('x'), ('y')"
+
+	// A statement has the same stats whether it is run alone or in a script
+	var single struct {
+		Stats *queryStats `json:"stats"`
+	}
+	testJSON(t, h, "POST", "/api/query", url.Values{"query": {insert}}.Encode(), http.StatusOK, &single)
+	script := runTestScript(t, h, insert, false)
+	for _, stats := range []*queryStats{single.Stats, script.Results[0].Stats} {
+		if stats == nil || !equalInt64p(stats.RowsAffected, int64p(2)) || stats.LastInsertID == nil || stats.Status != stmtStatusUnavailable {
+			t.Errorf("got stats %+v", stats)
+		}
+	}
+}
+
+func TestRunScriptFailure(t *testing.T) {
+	for _, transaction := range []bool{false, true} {
+		_, h := newTestAPI(t, Options{})
+		result := runTestScript(t, h, "INSERT INTO artists (Name) VALUES ('x'); SELECT nope FROM artists; INSERT INTO artists (Name) VALUES ('y')", transaction)
+		if result.FailedStatement == nil || *result.FailedStatement != 1 || result.Error == "" {
+			t.Fatalf("transaction %v: got failed statement %v, error %q, want 1", transaction, result.FailedStatement, result.Error)
+		}
+		if len(result.Results) != 1 {
+			t.Errorf("transaction %v: got %d results, want 1", transaction, len(result.Results))
+		}
+
+		var count sqlResult
+		testJSON(t, h, "POST", "/api/query", "query=SELECT+count(*)+FROM+artists+WHERE+Name+IN+('x','y')", http.StatusOK, &count)
+		want := float64(1)
+		if transaction {
+			want = 0
+		}
+		if count.Rows[0][0] != want {
+			t.Errorf("transaction %v: got %v rows inserted, want %v", transaction, count.Rows[0][0], want)
+		}
+	}
+}
+
+func TestRunScriptErrors(t *testing.T) {
+	_, h := newTestAPI(t, Options{})
+	testJSON(t, h, "POST", "/api/query", "script=1&query=%3B+--+nothing", http.StatusBadRequest, nil)
+	testJSON(t, h, "POST", "/api/query", "transaction=1&query=SELECT+1", http.StatusBadRequest, nil)
+}
+
+func int64p(v int64) *int64 {
+	return &v
+}
+
+func equalInt64p(a, b *int64) bool {
+	return a == nil && b == nil || a != nil && b != nil && *a == *b
+}
+
+func fmtInt64p(v *int64) interface{} {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
@@ -0,0 +1,141 @@
+package gobroem
+
+import (
+	"strings"
+)
+
+// splitStatements splits an SQL script into its statements. Semicolons in
+// string literals, quoted identifiers, comments and trigger bodies do not end
+// a statement. Empty statements are dropped and the returned statements have
+// no trailing semicolon.
+func splitStatements(script string) []string {
+	var statements []string
+
+	start := 0
+	words := 0         // number of keywords seen in the current statement
+	trigger := false   // the statement is a CREATE TRIGGER
+	inBody := false    // inside the BEGIN ... END of a trigger
+	caseDepth := 0     // nested CASE expressions in a trigger body
+	sawCreate := false // the statement starts with CREATE
+	prev := ""         // the previous keyword
+
+	flush := func(end int) {
+		if stmt := strings.TrimSpace(script[start:end]); stmt != "" && !isComment(stmt) {
+			statements = append(statements, stmt)
+		}
+		start = end + 1
+		words, trigger, inBody, caseDepth, sawCreate, prev = 0, false, false, 0, false, ""
+	}
+
+	for i := 0; i < len(script); i++ {
+		c := script[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			i = skipQuoted(script, i, c)
+		case c == '[':
+			if j := strings.IndexByte(script[i:], ']'); j >= 0 {
+				i += j
+			} else {
+				i = len(script)
+			}
+		case c == '-' && i+1 < len(script) && script[i+1] == '-':
+			if j := strings.IndexByte(script[i:], '\n'); j >= 0 {
+				i += j
+			} else {
+				i = len(script)
+			}
+		case c == '/' && i+1 < len(script) && script[i+1] == '*':
+			if j := strings.Index(script[i+2:], "*/"); j >= 0 {
+				i += j + 3
+			} else {
+				i = len(script)
+			}
+		case c == ';':
+			if !inBody {
+				flush(i)
+			}
+		case isWordChar(c):
+			j := i
+			for j < len(script) && isWordChar(script[j]) {
+				j++
+			}
+			word := strings.ToUpper(script[i:j])
+			i = j - 1
+
+			words++
+			temp := prev == "TEMP" || prev == "TEMPORARY"
+			prev = word
+			switch {
+			case words == 1:
+				sawCreate = word == "CREATE"
+			case sawCreate && word == "TRIGGER" && (words == 2 || words == 3 && temp):
+				trigger = true
+			case trigger && !inBody && word == "BEGIN":
+				inBody = true
+			case inBody && word == "CASE":
+				caseDepth++
+			case inBody && word == "END":
+				if caseDepth > 0 {
+					caseDepth--
+				} else {
+					inBody = false
+				}
+			}
+		}
+	}
+	if start < len(script) {
+		flush(len(script))
+	}
+
+	return statements
+}
+
+// skipQuoted returns the index of the quote closing the literal opened at i,
+// a doubled quote being an escaped one.
+func skipQuoted(script string, i int, quote byte) int {
+	for j := i + 1; j < len(script); j++ {
+		if script[j] == quote {
+			if j+1 < len(script) && script[j+1] == quote {
+				j++
+				continue
+			}
+			return j
+		}
+	}
+	return len(script)
+}
+
+// isComment reports whether the statement only holds comments.
+func isComment(stmt string) bool {
+	return trimComments(stmt) == ""
+}
+
+// trimComments returns the statement without its leading spaces and
+// comments.
+func trimComments(stmt string) string {
+	stmt = strings.TrimSpace(stmt)
+	for stmt != "" {
+		switch {
+		case strings.HasPrefix(stmt, "--"):
+			if j := strings.IndexByte(stmt, '\n'); j >= 0 {
+				stmt = stmt[j+1:]
+			} else {
+				stmt = ""
+			}
+		case strings.HasPrefix(stmt, "/*"):
+			if j := strings.Index(stmt, "*/"); j >= 0 {
+				stmt = stmt[j+2:]
+			} else {
+				stmt = ""
+			}
+		default:
+			return stmt
+		}
+		stmt = strings.TrimSpace(stmt)
+	}
+	return ""
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80
+}
@@ -0,0 +1,44 @@
+package gobroem
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitStatements(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   []string
+	}{
+		{"empty", " ; ;\n", nil},
+		{"single", "SELECT 1", []string{"SELECT 1"}},
+		{"trailing semicolon", "SELECT 1;\n", []string{"SELECT 1"}},
+		{"several", "SELECT 1; SELECT 2;SELECT 3", []string{"SELECT 1", "SELECT 2", "SELECT 3"}},
+		{"string", "SELECT 'a;b'; SELECT 'it''s;'", []string{"SELECT 'a;b'", "SELECT 'it''s;'"}},
+		{"quoted identifiers", "SELECT \"a;\"\"b\", [c;d], `e;f` FROM t; SELECT 2", []string{"SELECT \"a;\"\"b\", [c;d], `e;f` FROM t", "SELECT 2"}},
+		{"line comment", "SELECT 1; -- a; b\nSELECT 2", []string{"SELECT 1", "-- a; b\nSELECT 2"}},
+		{"block comment", "SELECT /* ; */ 1; /* only; a comment */", []string{"SELECT /* ; */ 1"}},
+		{"comment only", "-- nothing; here", nil},
+		{"unterminated string", "SELECT 'a; SELECT 2", []string{"SELECT 'a; SELECT 2"}},
+		{
+			"trigger",
+			"CREATE TRIGGER tr AFTER INSERT ON t BEGIN UPDATE t SET v = 1; DELETE FROM u; END; SELECT 1",
+			[]string{"CREATE TRIGGER tr AFTER INSERT ON t BEGIN UPDATE t SET v = 1; DELETE FROM u; END", "SELECT 1"},
+		},
+		{
+			"temp trigger with case",
+			"CREATE TEMP TRIGGER IF NOT EXISTS tr BEFORE DELETE ON t BEGIN SELECT CASE WHEN old.v THEN RAISE(ABORT, 'no;') END; END; SELECT 2",
+			[]string{"CREATE TEMP TRIGGER IF NOT EXISTS tr BEFORE DELETE ON t BEGIN SELECT CASE WHEN old.v THEN RAISE(ABORT, 'no;') END; END", "SELECT 2"},
+		},
+		{"begin transaction", "BEGIN; INSERT INTO t VALUES (1); END", []string{"BEGIN", "INSERT INTO t VALUES (1)", "END"}},
+		{"trigger as a name", "CREATE TABLE trigger (begin); SELECT 1", []string{"CREATE TABLE trigger (begin)", "SELECT 1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitStatements(tt.script); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package gobroem
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const (
+	queryTotalChanges = `SELECT total_changes()`
+	queryChanges      = `SELECT total_changes(), changes(), last_insert_rowid()`
+
+	// stmtStatusUnavailable is reported in place of the counters of the
+	// statement
+	stmtStatusUnavailable = "unavailable: go-sqlite3 doesn't expose sqlite3_stmt_status"
+)
+
+// queryStats describes the run of a statement.
+type queryStats struct {
+	// Duration is the run time of the statement in milliseconds, including
+	// the time spent sending the rows
+	Duration float64 `json:"duration_ms"`
+	Rows     int64   `json:"rows"`
+	// RowsAffected and LastInsertID are set when the statement changed the
+	// database
+	RowsAffected *int64 `json:"rows_affected,omitempty"`
+	LastInsertID *int64 `json:"last_insert_id,omitempty"`
+	Truncated    bool   `json:"truncated"`
+	// Status stands for the sqlite3_stmt_status counters (full scan steps,
+	// sorts, automatic indexes, VM steps), which can't be read through
+	// database/sql
+	Status string `json:"status"`
+}
+
+// statsConn is implemented by both sql.Conn and sql.Tx, which run all their
+// statements on the same connection.
+type statsConn interface {
+	execer
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// statsRecorder measures a statement run on a connection.
+type statsRecorder struct {
+	q       statsConn
+	start   time.Time
+	changes int64
+}
+
+// startStats starts measuring the next statement run with q.
+func startStats(ctx context.Context, q statsConn) (*statsRecorder, error) {
+	r := &statsRecorder{q: q}
+	if err := q.QueryRowContext(ctx, queryTotalChanges).Scan(&r.changes); err != nil {
+		return nil, err
+	}
+	r.start = time.Now()
+	return r, nil
+}
+
+// finish returns the stats of the statement, once its rows are closed. The
+// stats which can't be read are left empty.
+func (r *statsRecorder) finish(rows int64, truncated bool) *queryStats {
+	stats := &queryStats{
+		Duration:  milliseconds(time.Since(r.start)),
+		Rows:      rows,
+		Truncated: truncated,
+		Status:    stmtStatusUnavailable,
+	}
+
+	// The context may be done already: the stats are read anyway
+	ctx := context.Background()
+	var total, changes, lastID int64
+	err := r.q.QueryRowContext(ctx, queryChanges).Scan(&total, &changes, &lastID)
+	// changes() is left as is by the statements which don't write
+	if err == nil && total != r.changes {
+		stats.RowsAffected, stats.LastInsertID = &changes, &lastID
+	}
+	return stats
+}
+
+// statsWriter is implemented by the rowWriters reporting the stats of the
+// query. Stats is called before End.
+type statsWriter interface {
+	Stats(stats *queryStats)
+}
+
+// milliseconds converts a duration to milliseconds.
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
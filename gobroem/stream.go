@@ -0,0 +1,339 @@
+package gobroem
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// flushRows is the number of rows written between two flushes of the
+	// response.
+	flushRows = 100
+
+	trailerTruncated = "X-Result-Truncated"
+	trailerError     = "X-Result-Error"
+	trailerStats     = "X-Result-Stats"
+)
+
+// rowWriter receives the rows of a query as they are scanned.
+type rowWriter interface {
+	// Begin is called once with the result columns, before any row. types
+	// holds the declared types of the columns with the typed encoding.
+	Begin(columns, types []string) error
+	// Row is called for each row.
+	Row(row sqlRow) error
+	// End is called after the last row. err is the error which stopped the
+	// query early, if any.
+	End(truncated bool, err error) error
+}
+
+// streamQuery runs the query and hands the rows to w as they are scanned,
+// stopping after maxRows rows when maxRows is positive. An error is returned
+// only if it occurs before anything is passed to w; later errors are handed
+// to w.End. The stats of the query are handed to w if it is a statsWriter.
+func (client *sqlClient) streamQuery(ctx context.Context, w rowWriter, maxRows int, enc valueEncoding, query string, args ...interface{}) error {
+	conn, err := client.guardedConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	recorder, err := startStats(ctx, conn)
+	if err != nil {
+		return err
+	}
+	rows, err := conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	// Step once before writing anything so most errors get a proper response
+	next := rows.Next()
+	if !next && rows.Err() != nil {
+		return conn.err(rows.Err())
+	}
+
+	if err := w.Begin(columns, enc.declaredTypes(rows)); err != nil {
+		// The client is gone
+		return nil
+	}
+
+	count := 0
+	truncated := false
+	for ; next; next = rows.Next() {
+		if maxRows > 0 && count >= maxRows {
+			truncated = true
+			break
+		}
+
+		cols, err := SliceScan(rows)
+		if err != nil {
+			return w.End(false, err)
+		}
+		if err := w.Row(enc.encodeRow(cols)); err != nil {
+			// The client is gone
+			return nil
+		}
+		count++
+	}
+
+	err = rows.Err()
+	// The changes are read once the statement is reset
+	rows.Close()
+	if sw, ok := w.(statsWriter); ok {
+		sw.Stats(recorder.finish(int64(count), truncated))
+	}
+	return w.End(truncated, err)
+}
+
+// writeStats hands the stats to w if it is a statsWriter.
+func writeStats(w rowWriter, stats *queryStats) {
+	if sw, ok := w.(statsWriter); ok {
+		sw.Stats(stats)
+	}
+}
+
+// streamWriter holds the response shared by the row writers.
+type streamWriter struct {
+	w     http.ResponseWriter
+	count int
+	stats *queryStats
+}
+
+// begin writes the response header.
+func (s *streamWriter) begin(contentType string, trailers bool) {
+	s.w.Header().Set("Content-Type", contentType)
+	if trailers {
+		s.w.Header().Set("Trailer", trailerTruncated+", "+trailerError+", "+trailerStats)
+	}
+	s.w.WriteHeader(http.StatusOK)
+}
+
+// rowDone flushes the response every flushRows rows.
+func (s *streamWriter) rowDone() {
+	s.count++
+	if s.count%flushRows == 0 {
+		s.flush()
+	}
+}
+
+func (s *streamWriter) flush() {
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (s *streamWriter) Stats(stats *queryStats) {
+	s.stats = stats
+}
+
+// setTrailers reports the end of the result in the trailers.
+func (s *streamWriter) setTrailers(truncated bool, err error) {
+	s.w.Header().Set(trailerTruncated, strconv.FormatBool(truncated))
+	if err != nil {
+		s.w.Header().Set(trailerError, err.Error())
+	}
+	if s.stats != nil {
+		data, _ := json.Marshal(s.stats)
+		s.w.Header().Set(trailerStats, string(data))
+	}
+}
+
+// resultWriter streams the sqlResult format, with the columns and the rows
+// as arrays.
+type resultWriter struct {
+	streamWriter
+	sep []byte
+}
+
+func newResultWriter(w http.ResponseWriter) *resultWriter {
+	return &resultWriter{streamWriter: streamWriter{w: w}}
+}
+
+func (r *resultWriter) Begin(columns, types []string) error {
+	data, err := json.Marshal(columns)
+	if err != nil {
+		return err
+	}
+
+	r.begin("application/json; charset=UTF-8", false)
+	r.w.Write([]byte(`{"columns":`))
+	r.w.Write(data)
+	if types != nil {
+		data, _ = json.Marshal(types)
+		r.w.Write([]byte(`,"types":`))
+		r.w.Write(data)
+	}
+	_, err = r.w.Write([]byte(`,"rows":[`))
+	return err
+}
+
+func (r *resultWriter) Row(row sqlRow) error {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return err
+	}
+
+	r.w.Write(r.sep)
+	if _, err := r.w.Write(data); err != nil {
+		return err
+	}
+	r.sep = []byte(",")
+	r.rowDone()
+	return nil
+}
+
+func (r *resultWriter) End(truncated bool, err error) error {
+	r.w.Write([]byte(`],"truncated":` + strconv.FormatBool(truncated)))
+	if err != nil {
+		data, _ := json.Marshal(err.Error())
+		r.w.Write([]byte(`,"error":`))
+		r.w.Write(data)
+	}
+	if r.stats != nil {
+		data, _ := json.Marshal(r.stats)
+		r.w.Write([]byte(`,"stats":`))
+		r.w.Write(data)
+	}
+	r.w.Write([]byte("}"))
+	r.flush()
+	return nil
+}
+
+// objectsWriter streams a JSON array with an object per row, or a JSON
+// object per line when lines is set.
+type objectsWriter struct {
+	streamWriter
+	columns []string
+	lines   bool
+	sep     []byte
+}
+
+func newObjectsWriter(w http.ResponseWriter, lines bool) *objectsWriter {
+	return &objectsWriter{streamWriter: streamWriter{w: w}, lines: lines}
+}
+
+func (o *objectsWriter) Begin(columns, types []string) error {
+	o.columns = columns
+	if o.lines {
+		o.begin("application/x-ndjson", true)
+		return nil
+	}
+
+	o.begin("application/json; charset=UTF-8", true)
+	_, err := o.w.Write([]byte("["))
+	return err
+}
+
+func (o *objectsWriter) Row(row sqlRow) error {
+	item := make(map[string]interface{}, len(o.columns))
+	for i, c := range o.columns {
+		item[c] = row[i]
+	}
+	data, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	if o.lines {
+		data = append(data, '\n')
+	} else {
+		o.w.Write(o.sep)
+		o.sep = []byte(",")
+	}
+	if _, err := o.w.Write(data); err != nil {
+		return err
+	}
+	o.rowDone()
+	return nil
+}
+
+func (o *objectsWriter) End(truncated bool, err error) error {
+	if !o.lines {
+		o.w.Write([]byte("]"))
+	}
+	o.setTrailers(truncated, err)
+	o.flush()
+	return nil
+}
+
+// csvWriter streams a CSV file with a header line.
+type csvWriter struct {
+	streamWriter
+	csv *csv.Writer
+}
+
+func newCSVWriter(w http.ResponseWriter) *csvWriter {
+	return &csvWriter{streamWriter: streamWriter{w: w}, csv: csv.NewWriter(w)}
+}
+
+func (c *csvWriter) Begin(columns, types []string) error {
+	c.begin("text/csv", true)
+	return c.csv.Write(columns)
+}
+
+func (c *csvWriter) Row(row sqlRow) error {
+	if err := c.csv.Write(csvRecord(row)); err != nil {
+		return err
+	}
+	c.count++
+	if c.count%flushRows == 0 {
+		c.csv.Flush()
+		c.flush()
+	}
+	return c.csv.Error()
+}
+
+func (c *csvWriter) End(truncated bool, err error) error {
+	c.csv.Flush()
+	c.setTrailers(truncated, err)
+	c.flush()
+	return nil
+}
+
+// exportWriter writes a file download, sending the response header with the
+// first write so that an error occurring before can still be rendered. A
+// later error is reported in the X-Result-Error trailer.
+type exportWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	fileName    string
+	started     bool
+}
+
+func (e *exportWriter) Write(p []byte) (int, error) {
+	if !e.started {
+		e.started = true
+		e.w.Header().Set("Content-Type", e.contentType)
+		e.w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": e.fileName}))
+		e.w.Header().Set("Trailer", trailerError)
+		e.w.WriteHeader(http.StatusOK)
+	}
+	return e.w.Write(p)
+}
+
+// attachmentWriter sends the rows of another writer as a file download.
+type attachmentWriter struct {
+	rowWriter
+	w        http.ResponseWriter
+	fileName string
+}
+
+func (a *attachmentWriter) Begin(columns, types []string) error {
+	a.w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": a.fileName}))
+	return a.rowWriter.Begin(columns, types)
+}
+
+func (a *attachmentWriter) Stats(stats *queryStats) {
+	writeStats(a.rowWriter, stats)
+}
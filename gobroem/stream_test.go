@@ -0,0 +1,93 @@
+package gobroem
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestStreamFormats(t *testing.T) {
+	_, h := newTestAPI(t, Options{MaxRows: 3})
+	artists := url.QueryEscape("SELECT ArtistId, Name FROM artists ORDER BY ArtistId")
+	one := url.QueryEscape("SELECT 1 AS n, NULL AS v")
+	// The overflow fails the third row, once the first rows are sent
+	failing := url.QueryEscape("SELECT ArtistId, CASE WHEN ArtistId = 3 THEN abs(-9223372036854775807 - 1) END AS v FROM artists ORDER BY ArtistId")
+
+	tests := []struct {
+		name        string
+		query       string
+		contentType string
+		body        string
+		truncated   string
+		err         bool
+	}{
+		{"result", "query=" + one, "application/json; charset=UTF-8",
+			`{"columns":["n","v"],"rows":[[1,null]],"truncated":false,"stats":`, "", false},
+		{"result truncated", "query=" + artists, "application/json; charset=UTF-8",
+			`{"columns":["ArtistId","Name"],"rows":[[1,"AC/DC"],[2,"Accept"],[3,"Aerosmith"]],"truncated":true,"stats":`, "", false},
+		{"result error", "query=" + failing, "application/json; charset=UTF-8",
+			`{"columns":["ArtistId","v"],"rows":[[1,null],[2,null]],"truncated":false,"error":"integer overflow","stats":`, "", false},
+		{"json", "format=json&query=" + one, "application/json; charset=UTF-8",
+			`[{"n":1,"v":null}]`, "false", false},
+		{"json truncated", "format=json&query=" + artists, "application/json; charset=UTF-8",
+			`[{"ArtistId":1,"Name":"AC/DC"},{"ArtistId":2,"Name":"Accept"},{"ArtistId":3,"Name":"Aerosmith"}]`, "true", false},
+		{"json error", "format=json&query=" + failing, "application/json; charset=UTF-8",
+			`[{"ArtistId":1,"v":null},{"ArtistId":2,"v":null}]`, "false", true},
+		{"ndjson", "format=ndjson&query=" + artists, "application/x-ndjson",
+			"{\"ArtistId\":1,\"Name\":\"AC/DC\"}\n{\"ArtistId\":2,\"Name\":\"Accept\"}\n{\"ArtistId\":3,\"Name\":\"Aerosmith\"}\n", "true", false},
+		{"csv", "format=csv&query=" + one, "text/csv",
+			"n,v\n1,\n", "false", false},
+		{"csv truncated", "format=csv&query=" + artists, "text/csv",
+			"ArtistId,Name\n1,AC/DC\n2,Accept\n3,Aerosmith\n", "true", false},
+		{"csv error", "format=csv&query=" + failing, "text/csv",
+			"ArtistId,v\n1,\n2,\n", "false", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := testRequest(t, h, "GET", "/api/query?"+tt.query, "")
+			res := w.Result()
+			if w.Code != http.StatusOK {
+				t.Fatalf("got status %d: %s", w.Code, w.Body.String())
+			}
+			if got := res.Header.Get("Content-Type"); got != tt.contentType {
+				t.Errorf("got content type %q, want %q", got, tt.contentType)
+			}
+			if got := w.Body.String(); !strings.HasPrefix(got, tt.body) {
+				t.Errorf("got body %q, want %q", got, tt.body)
+			}
+			if got := res.Trailer.Get(trailerTruncated); got != tt.truncated {
+				t.Errorf("got truncated trailer %q, want %q", got, tt.truncated)
+			}
+			if got := res.Trailer.Get(trailerError); (got != "") != tt.err {
+				t.Errorf("got error trailer %q", got)
+			}
+			if tt.truncated != "" && !strings.Contains(res.Trailer.Get(trailerStats), `"duration_ms":`) {
+				t.Errorf("got stats trailer %q", res.Trailer.Get(trailerStats))
+			}
+		})
+	}
+}
+
+func TestStreamErrors(t *testing.T) {
+	_, h := newTestAPI(t, Options{})
+	tests := []struct {
+		query  string
+		status int
+	}{
+		{"SELECT nope FROM artists", http.StatusInternalServerError},
+		{"SELECT * FROM nope", http.StatusInternalServerError},
+		{"", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		for _, format := range []string{"", "json", "ndjson", "csv"} {
+			w := testRequest(t, h, "GET", "/api/query?format="+format+"&query="+url.QueryEscape(tt.query), "")
+			if w.Code != tt.status {
+				t.Errorf("%s %q: got status %d, want %d", format, tt.query, w.Code, tt.status)
+			}
+			if !strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") || w.Result().Trailer.Get(trailerError) != "" {
+				t.Errorf("%s %q: error not rendered as a JSON response", format, tt.query)
+			}
+		}
+	}
+}
@@ -1,11 +1,17 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/bakaoh/sqlite-gobroem/gobroem"
 )
@@ -13,9 +19,19 @@ import (
 const version = "0.1.0"
 
 var options struct {
-	db   string
-	host string
-	port uint
+	db        string
+	host      string
+	port      uint
+	readOnly  bool
+	user      string
+	password  string
+	tokenFile string
+	maxRows   int
+	timeout   time.Duration
+	attach    string
+	history   string
+	saved     string
+	vacuumDir string
 }
 
 // printHeader print the welcome header.
@@ -25,15 +41,144 @@ func printHeader() {
 
 // initConfig parse CLI config
 func initConfig() {
-	options.db = *flag.String("db", "test/test.db", "SQLite database file")
-	options.host = *flag.String("bind", "localhost", "HTTP server host")
-	options.port = *flag.Uint("listen", 8000, "HTTP server listen port")
+	flag.StringVar(&options.db, "db", "test/test.db", "Comma separated SQLite database files or glob patterns, e.g. data/*.db")
+	flag.StringVar(&options.host, "bind", "localhost", "HTTP server host")
+	flag.UintVar(&options.port, "listen", 8000, "HTTP server listen port")
+	flag.BoolVar(&options.readOnly, "readonly", false, "Open the database in read-only mode")
+	flag.StringVar(&options.user, "user", "", "HTTP Basic auth user name")
+	flag.StringVar(&options.password, "password", "", "HTTP Basic auth password")
+	flag.IntVar(&options.maxRows, "max-rows", 0, "Maximum number of rows returned by a query, 0 for no limit")
+	flag.DurationVar(&options.timeout, "timeout", 0, "Query timeout, e.g. 30s, 0 for no limit")
+	flag.StringVar(&options.tokenFile, "token-file", "", "File of accepted bearer tokens, one \"name:token\" per line")
+	flag.StringVar(&options.attach, "attach-allow", "", "Comma separated glob patterns of the files which can be attached, e.g. /data/*.db")
+	flag.StringVar(&options.history, "history", "", "SQLite file recording the executed queries, disabled if empty")
+	flag.StringVar(&options.saved, "saved", "", "SQLite file keeping the saved queries, in memory if empty")
+	flag.StringVar(&options.vacuumDir, "vacuum-dir", "", "Directory of the database copies written by VACUUM INTO, disabled if empty")
 	flag.Parse()
 }
 
+// authenticator returns the authenticator configured from the CLI, nil if
+// there is none.
+func authenticator() (gobroem.Authenticator, error) {
+	switch {
+	case options.tokenFile != "" && options.user != "":
+		return nil, errors.New("-user and -token-file are exclusive")
+	case options.tokenFile != "":
+		tokens, err := readTokens(options.tokenFile)
+		if err != nil {
+			return nil, err
+		}
+		return gobroem.BearerTokens(tokens), nil
+	case options.user != "" && options.password == "":
+		return nil, errors.New("-user requires a -password")
+	case options.user != "":
+		return gobroem.BasicAuth(map[string]string{options.user: options.password}), nil
+	case options.password != "":
+		return nil, errors.New("-password requires a -user")
+	}
+	return nil, nil
+}
+
+// readTokens reads a token file. Each line holds a token, optionally prefixed
+// with the principal name and a colon.
+func readTokens(file string) (map[string]string, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make(map[string]string)
+	for n, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, token := "token", line
+		if i := strings.IndexByte(line, ':'); i >= 0 {
+			name, token = line[:i], line[i+1:]
+		}
+		// An empty principal can't be authenticated
+		if name == "" || token == "" {
+			return nil, fmt.Errorf("%s:%d: empty name or token", file, n+1)
+		}
+		tokens[token] = name
+	}
+	if len(tokens) == 0 {
+		return nil, errors.New("no token in " + file)
+	}
+	return tokens, nil
+}
+
+// databases returns the registry of the databases of the -db flag, each named
+// after its file name.
+func databases() (*gobroem.Registry, error) {
+	registry := gobroem.NewRegistry()
+	for _, db := range strings.Split(options.db, ",") {
+		db = strings.TrimSpace(db)
+		if db == "" {
+			continue
+		}
+
+		var err error
+		if strings.ContainsAny(db, "*?[") {
+			err = registry.AddGlob(db)
+		} else {
+			err = registry.AddFile(strings.TrimSuffix(filepath.Base(db), filepath.Ext(db)), db)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+// attachAllowlist returns the patterns of the -attach-allow flag.
+func attachAllowlist() []string {
+	var patterns []string
+	for _, p := range strings.Split(options.attach, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns
+}
+
 // startServer initialize and start the web server.
 func startServer() {
-	api, err := gobroem.NewAPI(options.db)
+	auth, err := authenticator()
+	if err != nil {
+		log.Fatal("invalid authentication config: ", err)
+	}
+
+	registry, err := databases()
+	if err != nil {
+		log.Fatal("invalid database config: ", err)
+	}
+
+	var history gobroem.HistoryStore
+	if options.history != "" {
+		if history, err = gobroem.NewFileHistory(options.history); err != nil {
+			log.Fatal("can not open history: ", err)
+		}
+	}
+
+	var saved gobroem.SavedQueryStore
+	if options.saved != "" {
+		if saved, err = gobroem.NewFileSavedQueries(options.saved); err != nil {
+			log.Fatal("can not open saved queries: ", err)
+		}
+	}
+
+	api, err := gobroem.NewAPIWithRegistry(registry, gobroem.Options{
+		ReadOnly:        options.readOnly,
+		Authenticator:   auth,
+		MaxRows:         options.maxRows,
+		QueryTimeout:    options.timeout,
+		AttachAllowlist: attachAllowlist(),
+		History:         history,
+		SavedQueryStore: saved,
+		VacuumDir:       options.vacuumDir,
+	})
 	if err != nil {
 		log.Fatal("can not open db", err)
 	}
@@ -44,7 +189,42 @@ func startServer() {
 	)
 }
 
+// dump writes the SQL dump of a database, for the dump subcommand.
+func dump(args []string) {
+	flags := flag.NewFlagSet("dump", flag.ExitOnError)
+	db := flags.String("db", "test/test.db", "SQLite database file")
+	tables := flags.String("tables", "", "Comma separated tables to dump, all if empty")
+	output := flags.String("o", "", "Output file, standard output if empty")
+	flags.Parse(args)
+
+	var names []string
+	for _, t := range strings.Split(*tables, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			names = append(names, t)
+		}
+	}
+
+	out := os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			log.Fatal("can not create output file: ", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if err := gobroem.DumpFile(context.Background(), *db, out, names); err != nil {
+		log.Fatal("can not dump db: ", err)
+	}
+}
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "dump" {
+		dump(os.Args[2:])
+		return
+	}
+
 	printHeader()
 	initConfig()
 	startServer()
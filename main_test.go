@@ -0,0 +1,55 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadTokens(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    map[string]string
+	}{
+		{"named", "ann:t0k3n\n# comment\n\nbob:other\n", map[string]string{"t0k3n": "ann", "other": "bob"}},
+		{"unnamed", "t0k3n\n", map[string]string{"t0k3n": "token"}},
+		{"empty name", "ann:t0k3n\n:orphan\n", nil},
+		{"empty token", "ann:\n", nil},
+		{"no token", "# nothing\n", nil},
+	}
+	for _, tt := range tests {
+		file := filepath.Join(t.TempDir(), "tokens")
+		if err := ioutil.WriteFile(file, []byte(tt.content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		got, err := readTokens(file)
+		if !reflect.DeepEqual(got, tt.want) || (err == nil) != (tt.want != nil) {
+			t.Errorf("%s: got %v, %v, want %v", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestAuthenticator(t *testing.T) {
+	defer func(user, password string) {
+		options.user, options.password = user, password
+	}(options.user, options.password)
+
+	tests := []struct {
+		user, password string
+		auth, err      bool
+	}{
+		{"", "", false, false},
+		{"ann", "secret", true, false},
+		{"ann", "", false, true},
+		{"", "secret", false, true},
+	}
+	for _, tt := range tests {
+		options.user, options.password = tt.user, tt.password
+		auth, err := authenticator()
+		if (auth != nil) != tt.auth || (err != nil) != tt.err {
+			t.Errorf("-user %q -password %q: got %v, %v", tt.user, tt.password, auth, err)
+		}
+	}
+}
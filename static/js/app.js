@@ -1,13 +1,50 @@
-var addHeadersToResultTable, addRowsToResultTable, apiCall, buildResultHeader, buildResultRow, buildTableContent, buildTableQueryResult, buildTableStructure, bytesToSize, executeQuery, exportCSV, exportJSON, getColumnsFromIndexSql, getInfo, getQuery, getTable, getTableContent, getTableIndexes, getTableInfo, getTableSql, getTables, loadTables, resetResultTable, runQuery, setActiveTab, showDatabaseInfo, showTableContent, showTableInfo, showTableQuery, showTableStructure;
+var startMaintenance, showMaintenanceJob, formatStats, getSavedQueries, loadSavedQueries, saveQuery, getHistory, showHistory, importFile, currentDatabase, databasePath, getSchemas, loadSchemas, currentSchema, getDatabases, loadDatabases, schemaParams, buildSchemaDiagram, getSchemaGraph, showSchemaDiagram, svgElement, selectedName, selectedType, findPlaceholders, queryParams, queryRequest, showParams, buildScriptResult, buildStatementResult, buildPlanNode, explainQuery, getQueryPlan, showQueryPlan, addFilter, cancelQuery, newQueryId, runningQueryId, showResultMessage, addHeadersToResultTable, authRetries, authToken, contentPage, contentFilters, showFilters, showLogin, pageSize, showPageInfo, addRowsToResultTable, apiCall, buildResultHeader, buildResultRow, buildTableContent, buildTableQueryResult, buildTableStructure, bytesToSize, executeQuery, exportCSV, exportJSON, getInfo, getQuery, getTable, getTableContent, getTableIndexes, getTableInfo, getTableSql, getTables, loadTables, resetResultTable, runQuery, setActiveTab, showDatabaseInfo, showTableContent, showTableInfo, showTableQuery, showTableStructure;
+
+pageSize = 100;
+
+currentDatabase = '';
+
+currentSchema = '';
+
+contentPage = {
+  table: null,
+  offset: 0
+};
+
+contentFilters = [];
+
+authToken = window.sessionStorage ? window.sessionStorage.getItem('gobroem_token') : null;
+
+authRetries = null;
+
+databasePath = function(path) {
+  if (!currentDatabase) {
+    return path;
+  }
+  return path + (path.indexOf('?') < 0 ? '?' : '&') + 'db=' + encodeURIComponent(currentDatabase);
+};
 
 apiCall = function(method, path, params, cb) {
   return $.ajax({
-    url: apiRoot + path,
+    url: apiRoot + databasePath(path),
     type: method,
     data: params,
+    processData: !(params instanceof FormData),
+    contentType: params instanceof FormData ? false : typeof params === 'string' ? 'application/json' : 'application/x-www-form-urlencoded; charset=UTF-8',
     cache: false,
+    beforeSend: function(xhr) {
+      xhr.setRequestHeader('X-Requested-With', 'XMLHttpRequest');
+      if (authToken) {
+        return xhr.setRequestHeader('Authorization', 'Bearer ' + authToken);
+      }
+    },
     error: function(xhr, status, data) {
       console.log(xhr.responseText);
+      if (xhr.status === 401) {
+        return showLogin(xhr, function() {
+          return apiCall(method, path, params, cb);
+        });
+      }
       return cb($.parseJSON(xhr.responseText));
     },
     success: function(data) {
@@ -16,59 +53,270 @@ apiCall = function(method, path, params, cb) {
   });
 };
 
+showLogin = function(xhr, retry) {
+  var challenge, message, retries, token;
+  if (authRetries !== null) {
+    return authRetries.push(retry);
+  }
+  authRetries = [retry];
+  message = 'Authentication failed';
+  try {
+    message += ': ' + $.parseJSON(xhr.responseText).message;
+  } catch (_error) {}
+  challenge = xhr.getResponseHeader('WWW-Authenticate') || '';
+  if (challenge.indexOf('Bearer') !== 0) {
+    authRetries = null;
+    return alert(message + '. Reload the page to log in again.');
+  }
+  token = window.prompt(message + '.\nAccess token:');
+  if (!token) {
+    authRetries = null;
+    return;
+  }
+  authToken = token;
+  if (window.sessionStorage) {
+    window.sessionStorage.setItem('gobroem_token', token);
+  }
+  retries = authRetries;
+  authRetries = null;
+  return retries.forEach(function(fn) {
+    return fn();
+  });
+};
+
+schemaParams = function(params) {
+  if (currentSchema) {
+    params.schema = currentSchema;
+  }
+  return params;
+};
+
+getDatabases = function(cb) {
+  return apiCall('GET', 'api/databases', {}, cb);
+};
+
+getSchemas = function(cb) {
+  return apiCall('GET', 'api/schemas', {}, cb);
+};
+
 getInfo = function(cb) {
-  return apiCall('GET', 'api/info', {}, cb);
+  return apiCall('GET', 'api/info', schemaParams({}), cb);
 };
 
 getTables = function(cb) {
-  return apiCall('GET', 'api/tables', {}, cb);
+  return apiCall('GET', 'api/tables', schemaParams({}), cb);
 };
 
 getTableInfo = function(table, cb) {
-  return apiCall('GET', 'api/table/info', {
+  return apiCall('GET', 'api/table/info', schemaParams({
     table: table
-  }, cb);
+  }), cb);
 };
 
 getTable = function(table, cb) {
-  return apiCall('GET', 'api/table', {
+  return apiCall('GET', 'api/table', schemaParams({
     table: table
-  }, cb);
+  }), cb);
 };
 
 getTableSql = function(table, cb) {
-  return apiCall('GET', 'api/table/sql', {
+  return apiCall('GET', 'api/table/sql', schemaParams({
     table: table
-  }, cb);
+  }), cb);
 };
 
 getTableIndexes = function(table, cb) {
-  return apiCall('GET', 'api/table/indexes', {
+  return apiCall('GET', 'api/table/indexes', schemaParams({
     table: table
-  }, cb);
+  }), cb);
 };
 
-getTableContent = function(table, cb) {
-  var query;
-  query = 'SELECT * FROM ' + table + ';';
-  return executeQuery(query, cb);
+getTableContent = function(table, offset, cb) {
+  var params;
+  params = {
+    table: table,
+    limit: pageSize,
+    offset: offset
+  };
+  if (contentFilters.length > 0) {
+    params.filters = JSON.stringify(contentFilters);
+  }
+  return apiCall('GET', 'api/table/rows', schemaParams(params), cb);
+};
+
+getSchemaGraph = function(cb) {
+  return apiCall('GET', 'api/schema/graph', schemaParams({}), cb);
 };
 
 getQuery = function(query, cb) {
   return executeQuery(query, cb);
 };
 
-executeQuery = function(query, cb) {
-  var data;
-  data = {
-    query: query
+executeQuery = function(query, cb, options) {
+  var id, request;
+  id = newQueryId();
+  request = queryRequest('api/query', query, $.extend({
+    query_id: id
+  }, options));
+  runningQueryId = id;
+  $('#cancel').prop('disabled', false);
+  return apiCall('POST', request.path, request.data, function(result) {
+    if (runningQueryId === id) {
+      runningQueryId = null;
+      $('#cancel').prop('disabled', true);
+    }
+    return cb(result);
+  });
+};
+
+getSavedQueries = function(cb) {
+  return apiCall('GET', 'api/saved', {}, cb);
+};
+
+getHistory = function(params, cb) {
+  return apiCall('GET', 'api/history', params, cb);
+};
+
+getQueryPlan = function(query, cb) {
+  var request;
+  request = queryRequest('api/query/plan', query, {
+    bytecode: true
+  });
+  return apiCall('POST', request.path, request.data, cb);
+};
+
+queryRequest = function(path, query, options) {
+  var params;
+  params = queryParams();
+  if (params === null) {
+    return {
+      path: path,
+      data: $.extend({
+        query: query
+      }, options)
+    };
+  }
+  return {
+    path: path + '?' + $.param(options),
+    data: JSON.stringify({
+      sql: query,
+      params: params
+    })
   };
-  return apiCall('POST', 'api/query', data, cb);
+};
+
+findPlaceholders = function(query) {
+  var match, names, position, re;
+  query = query.replace(/'(?:[^']|'')*'|"(?:[^"]|"")*"|--[^\n]*|\/\*[\s\S]*?\*\//g, ' ');
+  names = [];
+  position = 0;
+  re = /\?(\d*)|[:@$][A-Za-z_][\w]*/g;
+  while ((match = re.exec(query)) !== null) {
+    if (match[0].charAt(0) !== '?') {
+      if (names.indexOf(match[0]) < 0) {
+        names.push(match[0]);
+      }
+      continue;
+    }
+    position = match[1] ? Math.max(position, parseInt(match[1], 10)) : position + 1;
+  }
+  return {
+    positional: position,
+    named: names
+  };
+};
+
+showParams = function(query) {
+  var found, i, labels, previous;
+  found = findPlaceholders(query);
+  labels = found.named;
+  if (found.named.length === 0) {
+    labels = [];
+    for (i = 1; i <= found.positional; i++) {
+      labels.push('?' + i);
+    }
+  }
+  previous = {};
+  $('#params tbody tr').each(function() {
+    return previous[$(this).data('name')] = {
+      type: $(this).find('select').val(),
+      value: $(this).find('input').val()
+    };
+  });
+  $('#params tbody').empty();
+  labels.forEach(function(name) {
+    var row, type;
+    row = $('<tr>').attr('data-name', name);
+    $('<th>').text(name).appendTo(row);
+    type = $('<select>');
+    ['text', 'integer', 'real', 'blob', 'null'].forEach(function(t) {
+      return $('<option>').text(t).appendTo(type);
+    });
+    $('<td>').append(type).appendTo(row);
+    $('<td>').append($('<input type="text">').attr('placeholder', 'value')).appendTo(row);
+    if (previous[name]) {
+      type.val(previous[name].type);
+      row.find('input').val(previous[name].value);
+    }
+    return $('#params tbody').append(row);
+  });
+  $('#params').toggle(labels.length > 0);
+  if (found.named.length > 0 && found.positional > 0) {
+    return $('#params .title').text('Parameters (positional ones are not supported with named ones)');
+  }
+  return $('#params .title').text('Parameters');
+};
+
+queryParams = function() {
+  var named, params, rows;
+  rows = $('#params tbody tr');
+  if (rows.length === 0) {
+    return null;
+  }
+  named = String(rows.first().data('name')).charAt(0) !== '?';
+  params = named ? {} : [];
+  rows.each(function() {
+    var param;
+    param = {
+      type: $(this).find('select').val()
+    };
+    if (param.type !== 'null') {
+      param.value = $(this).find('input').val();
+    }
+    if (named) {
+      return params[$(this).data('name')] = param;
+    }
+    return params.push(param);
+  });
+  return params;
+};
+
+cancelQuery = function() {
+  if (!runningQueryId) {
+    return;
+  }
+  return apiCall('POST', 'api/query/cancel', {
+    query_id: runningQueryId
+  }, function() {});
+};
+
+newQueryId = function() {
+  var i, id;
+  id = '';
+  for (i = 0; i < 4; i++) {
+    id += Math.floor((1 + Math.random()) * 0x100000000).toString(16).substring(1);
+  }
+  return id;
 };
 
 buildTableStructure = function(name, cb) {
   return getTableSql(name, function(data) {
     $('#structure_sql').text(data.sql);
+    if (data.type === 'trigger') {
+      $('#table_columns tbody').empty();
+      $('#table_indexes tbody').empty();
+      return cb();
+    }
     return getTable(name, function(columns) {
       $('#table_columns tbody').empty();
       columns.forEach(function(item) {
@@ -89,22 +337,35 @@ buildTableStructure = function(name, cb) {
           return cb();
         }
         columns.forEach(function(item) {
-          if (!item.sql) item.sql = "";
-          var cols, column, pre, sqlLink, unique;
-          column = '<tr>';
-          column += '<th>' + item.name + '</th>';
-          cols = getColumnsFromIndexSql(item.tbl_name, item.sql);
-          column += '<th>' + cols.join(', ') + '</th>';
-          unique = item.sql.indexOf('UNIQUE') > -1 ? 'True' : 'False';
-          column += '<th>' + unique + '</th>';
-          sqlLink = '<a class="view-sql" ';
-          sqlLink += 'data-toggle="modal" data-target="#index_sql_modal" ';
-          sqlLink += 'data-name="' + item.name + '" ';
-          sqlLink += 'href="#">SQL</a>';
-          pre = '<pre style="display: none;">' + item.sql + '</pre>';
-          column += '<th>' + sqlLink + pre + '</th>';
-          column += '</tr>';
-          return $('#table_indexes tbody').append(column);
+          var cols, origins, row, sql;
+          origins = {
+            c: 'CREATE INDEX',
+            u: 'UNIQUE constraint',
+            pk: 'PRIMARY KEY'
+          };
+          cols = item.columns.map(function(c) {
+            var col;
+            col = c.name || c.expression || '<expression>';
+            if (c.collation !== 'BINARY') {
+              col += ' COLLATE ' + c.collation;
+            }
+            if (c.desc) {
+              col += ' DESC';
+            }
+            return col;
+          });
+          row = $('<tr>');
+          $('<th>').text(item.name).appendTo(row);
+          $('<th>').text(cols.join(', ')).appendTo(row);
+          $('<th>').text(item.unique ? 'True' : 'False').appendTo(row);
+          $('<th>').text(origins[item.origin] || item.origin).appendTo(row);
+          $('<th>').text(item.partial ? 'WHERE ' + item.where : '').appendTo(row);
+          sql = $('<th>');
+          if (item.sql) {
+            $('<a class="view-sql" data-toggle="modal" data-target="#index_sql_modal" href="#">SQL</a>').attr('data-name', item.name).appendTo(sql);
+            $('<pre style="display: none;">').text(item.sql).appendTo(sql);
+          }
+          return $('#table_indexes tbody').append(row.append(sql));
         });
         return cb();
       });
@@ -112,10 +373,19 @@ buildTableStructure = function(name, cb) {
   });
 };
 
-buildTableContent = function(name, cb) {
-  return getTableContent(name, function(data) {
+buildTableContent = function(name, offset, cb) {
+  return getTableContent(name, offset, function(data) {
     var row;
     resetResultTable();
+    if (data.code === 'error') {
+      alert(data.message);
+      contentFilters.pop();
+      return;
+    }
+    contentPage.table = name;
+    contentPage.offset = data.offset;
+    showPageInfo(data);
+    showFilters(data.columns);
     addHeadersToResultTable((function() {
       var _i, _len, _ref, _results;
       _ref = data.columns;
@@ -128,7 +398,7 @@ buildTableContent = function(name, cb) {
     })());
     addRowsToResultTable((function() {
       var _i, _len, _ref, _results;
-      _ref = data.rows;
+      _ref = data.rows || [];
       _results = [];
       for (_i = 0, _len = _ref.length; _i < _len; _i++) {
         row = _ref[_i];
@@ -140,10 +410,74 @@ buildTableContent = function(name, cb) {
   });
 };
 
+showPageInfo = function(data) {
+  var first, last;
+  first = data.total > 0 ? data.offset + 1 : 0;
+  last = data.offset + (data.rows ? data.rows.length : 0);
+  $('#page_info').text(first + '-' + last + ' of ' + data.total);
+  $('#page_prev').prop('disabled', data.offset === 0);
+  $('#page_next').prop('disabled', last >= data.total);
+  return $('#pager').show();
+};
+
+showFilters = function(columns) {
+  var selected;
+  selected = $('#filter_column').val();
+  $('#filter_column').empty();
+  columns.forEach(function(c) {
+    return $('<option>').text(c).appendTo('#filter_column');
+  });
+  if (selected && columns.indexOf(selected) > -1) {
+    $('#filter_column').val(selected);
+  }
+  $('#filter_list').empty();
+  contentFilters.forEach(function(f, i) {
+    var label;
+    label = f.column + ' ' + f.op;
+    if (f.value !== void 0) {
+      label += ' ' + JSON.stringify(f.value);
+    }
+    return $('<li>').text(label + ' \u00d7').attr('data-index', i).appendTo('#filter_list');
+  });
+  return $('#filters').show();
+};
+
+addFilter = function() {
+  var filter, op, value;
+  op = $('#filter_op').val();
+  value = $('#filter_value').val();
+  filter = {
+    column: $('#filter_column').val(),
+    op: op
+  };
+  if (op === 'IN' || op === 'NOT IN' || op === 'BETWEEN') {
+    filter.value = $.map(value.split(','), $.trim);
+  } else if (op !== 'IS NULL' && op !== 'IS NOT NULL') {
+    filter.value = value;
+  }
+  contentFilters.push(filter);
+  return showTableContent(0);
+};
+
 buildTableQueryResult = function(query) {
   return getQuery(query, function(data) {
-    var name, row;
+    var messages, name, row;
     resetResultTable();
+    if (data.code === 'error') {
+      return showResultMessage('Error: ' + data.message);
+    }
+    messages = [];
+    if (data.error) {
+      messages.push('Error after ' + data.rows.length + ' rows: ' + data.error);
+    } else if (data.truncated) {
+      messages.push('Showing the first ' + data.rows.length + ' rows only.');
+    }
+    if (data.stats) {
+      messages.push(formatStats(data.stats));
+    }
+    if (messages.length) {
+      showResultMessage(messages.join(' '));
+    }
     addHeadersToResultTable((function() {
       var _i, _len, _ref, _results;
       _ref = data.columns;
@@ -167,16 +501,246 @@ buildTableQueryResult = function(query) {
   });
 };
 
+buildScriptResult = function(query, transaction) {
+  return executeQuery(query, function(data) {
+    var message;
+    resetResultTable();
+    if (data.code === 'error') {
+      return showResultMessage('Error: ' + data.message);
+    }
+    data.results.forEach(function(result, i) {
+      return $('#script_results').append(buildStatementResult(result, i));
+    });
+    if (data.error) {
+      message = 'Error';
+      if (data.failed_statement != null) {
+        message += ' in statement #' + (data.failed_statement + 1);
+      }
+      message += ': ' + data.error;
+      if (data.transaction) {
+        message += ' The transaction was rolled back.';
+      }
+      $('<div class="error">').text(message).appendTo('#script_results');
+    }
+    return $('#script_results').show();
+  }, {
+    script: true,
+    transaction: transaction
+  });
+};
+
+buildStatementResult = function(result, index) {
+  var body, block, head, table;
+  block = $('<div class="statement">');
+  $('<pre>').text('#' + (index + 1) + ' ' + result.statement).appendTo(block);
+  if (result.columns) {
+    table = $('<table class="table table-striped">');
+    head = $('<tr>');
+    result.columns.forEach(function(c) {
+      return $('<th>').text(c).appendTo(head);
+    });
+    $('<thead>').append(head).appendTo(table);
+    body = $('<tbody>');
+    result.rows.forEach(function(row) {
+      var tr;
+      tr = $('<tr>');
+      row.forEach(function(v) {
+        return $('<td>').text(v === null ? 'NULL' : v).appendTo(tr);
+      });
+      return body.append(tr);
+    });
+    table.append(body).appendTo(block);
+    if (result.truncated) {
+      $('<div class="message">').text('Showing the first ' + result.rows.length + ' rows only.').appendTo(block);
+    }
+  }
+  if (result.stats) {
+    $('<div class="message">').text(formatStats(result.stats)).appendTo(block);
+  }
+  return block;
+};
+
+formatStats = function(stats) {
+  var message;
+  message = stats.rows + ' rows in ' + stats.duration_ms.toFixed(1) + ' ms';
+  if (stats.rows_affected != null) {
+    message += ', ' + stats.rows_affected + ' rows affected, last insert id ' + stats.last_insert_id;
+  }
+  return message + '.';
+};
+
+explainQuery = function(query) {
+  return getQueryPlan(query, function(data) {
+    var name, row;
+    resetResultTable();
+    if (data.code === 'error') {
+      return showResultMessage('Error: ' + data.message);
+    }
+    showQueryPlan(data.plan);
+    if (!data.bytecode) {
+      return;
+    }
+    addHeadersToResultTable((function() {
+      var _i, _len, _ref, _results;
+      _ref = data.bytecode.columns;
+      _results = [];
+      for (_i = 0, _len = _ref.length; _i < _len; _i++) {
+        name = _ref[_i];
+        _results.push(buildResultHeader(name));
+      }
+      return _results;
+    })());
+    return addRowsToResultTable((function() {
+      var _i, _len, _ref, _results;
+      _ref = data.bytecode.rows || [];
+      _results = [];
+      for (_i = 0, _len = _ref.length; _i < _len; _i++) {
+        row = _ref[_i];
+        _results.push(buildResultRow(row));
+      }
+      return _results;
+    })());
+  });
+};
+
+showHistory = function(offset) {
+  return getHistory({
+    search: $('#history_search').val(),
+    limit: pageSize,
+    offset: offset
+  }, function(data) {
+    var list, next;
+    if (data.code === 'error') {
+      alert(data.message);
+      return;
+    }
+    list = $('#history ul');
+    if (offset === 0) {
+      list.empty();
+    }
+    data.entries.forEach(function(entry) {
+      var item, meta;
+      meta = new Date(entry.time).toLocaleString() + ' - ' + Math.round(entry.duration_ms) + ' ms - ' + entry.rows + ' rows';
+      if (entry.principal) {
+        meta += ' - ' + entry.principal;
+      }
+      item = $('<li>').data('query', entry.query);
+      item.append($('<pre>').text(entry.query));
+      item.append($('<small>').text(meta));
+      if (entry.error) {
+        item.addClass('failed').append($('<small class="error">').text(entry.error));
+      }
+      return list.append(item);
+    });
+    next = offset + data.entries.length;
+    $('#history_more').data('offset', next).toggle(next < data.total);
+    return $('#history').show();
+  });
+};
+
+loadSavedQueries = function() {
+  return getSavedQueries(function(data) {
+    var select;
+    if (data.code === 'error') {
+      return;
+    }
+    select = $('#saved_query');
+    select.find('option[value!=""]').remove();
+    data.queries.forEach(function(query) {
+      return $('<option>').val(query.slug).text(query.name).data('query', query).appendTo(select);
+    });
+    return select.change();
+  });
+};
+
+saveQuery = function(query) {
+  var name, params;
+  name = window.prompt('Name of the saved query:');
+  if (!name) {
+    return;
+  }
+  params = [];
+  $('#params tbody tr').each(function() {
+    var param;
+    param = {
+      name: String($(this).data('name')).substring(1)
+    };
+    if ($(this).find('select').val() !== 'null') {
+      param.type = $(this).find('select').val();
+      param["default"] = $(this).find('input').val();
+    }
+    return params.push(param);
+  });
+  return apiCall('POST', 'api/saved', JSON.stringify({
+    name: name,
+    sql: query,
+    database: currentDatabase,
+    params: params
+  }), function(data) {
+    if (data.code === 'error') {
+      alert(data.message);
+      return;
+    }
+    loadSavedQueries();
+    return alert('Saved, the report is at ' + window.location.origin + apiRoot + 'q/' + data.slug);
+  });
+};
+
+showQueryPlan = function(plan) {
+  var list;
+  list = $('<ul>');
+  plan.forEach(function(node) {
+    return list.append(buildPlanNode(node));
+  });
+  $('#plan').empty().append($('<div class="title">').text('Query Plan')).append(list);
+  return $('#plan').show();
+};
+
+buildPlanNode = function(node) {
+  var children, item;
+  item = $('<li>').append($('<span class="detail">').text(node.detail));
+  if (node.full_scan) {
+    item.addClass('full-scan');
+    item.append($('<span class="warning scan">').text('full scan'));
+  }
+  if (node.temp_btree) {
+    item.addClass('temp-btree');
+    item.append($('<span class="warning">').text('temporary b-tree'));
+  }
+  if (node.children.length > 0) {
+    children = $('<ul>');
+    node.children.forEach(function(child) {
+      return children.append(buildPlanNode(child));
+    });
+    item.append(children);
+  }
+  return item;
+};
+
 loadTables = function(cb) {
-  $('#tables li').remove;
+  $('#tables li').remove();
   return getTables(function(data) {
-    var table;
-    data.tables.forEach(function(item) {
-      return $('<li><span>' + item + '</span></li>').appendTo('#tables');
+    var groups, table;
+    groups = [['table', null, data.objects.tables], ['virtual table', 'Virtual Tables', data.objects.virtual_tables], ['view', 'Views', data.objects.views], ['trigger', 'Triggers', data.objects.triggers]];
+    groups.forEach(function(group) {
+      if (group[2].length === 0) {
+        return;
+      }
+      if (group[1]) {
+        $('<li class="group">').text(group[1]).appendTo('#tables');
+      }
+      return group[2].forEach(function(item) {
+        var label;
+        label = $('<span>').text(item.name);
+        if (item.module) {
+          label.append($('<small>').text(' ' + item.module));
+        }
+        return $('<li>').attr('data-name', item.name).attr('data-type', group[0]).append(label).appendTo('#tables');
+      });
     });
-    if (data.tables.length > 0) {
+    table = $('#tables li[data-name]:first');
+    if (table.length > 0) {
       $('#tables li.selected').removeClass('selected');
-      table = $('#tables li:first');
       $(table).addClass('selected');
       showTableInfo();
       showTableStructure();
@@ -185,52 +749,176 @@ loadTables = function(cb) {
   });
 };
 
+loadDatabases = function() {
+  return getDatabases(function(data) {
+    $('#database option').remove();
+    data.databases.forEach(function(database, i) {
+      var value;
+      value = i === 0 ? '' : database.name;
+      return $('<option>').val(value).text(database.name).attr('title', database.file).appendTo('#database');
+    });
+    $('#database').val(currentDatabase);
+    return $('#database').toggle(data.databases.length > 1);
+  });
+};
+
+loadSchemas = function() {
+  return getSchemas(function(data) {
+    $('#schema option').remove();
+    data.schemas.forEach(function(schema) {
+      var value;
+      value = schema.name === 'main' ? '' : schema.name;
+      return $('<option>').val(value).text(schema.name).attr('title', schema.file).appendTo('#schema');
+    });
+    return $('#schema').val(currentSchema);
+  });
+};
+
+importFile = function(file) {
+  var data, exists, table;
+  table = window.prompt('Import ' + file.name + ' into table:', selectedName() || file.name.replace(/\.[^.]*$/, ''));
+  if (!table) {
+    return;
+  }
+  exists = $('#tables li[data-name]').filter(function() {
+    return $(this).attr('data-name') === table;
+  }).length > 0;
+  data = new FormData();
+  data.append('file', file);
+  data.append('mode', exists ? 'append' : 'create');
+  data.append('infer_types', 'true');
+  return apiCall('POST', 'api/import?' + $.param(schemaParams({
+    table: table
+  })), data, function(result) {
+    var message;
+    if (result.code === 'error') {
+      return alert('Import failed: ' + result.message);
+    }
+    message = result.rows_inserted + ' of ' + result.rows_read + ' rows imported into ' + result.table;
+    if (result.aborted) {
+      message += ', stopped by an error';
+    }
+    result.errors.forEach(function(error) {
+      return message += '\nRow ' + error.row + ': ' + error.message;
+    });
+    alert(message);
+    return loadTables(function() {
+      return showDatabaseInfo();
+    });
+  });
+};
+
+startMaintenance = function(action) {
+  if (action === 'vacuum' && !window.confirm('Vacuum the database? Writers are blocked until it is rebuilt.')) {
+    return;
+  }
+  return apiCall('POST', 'api/maintenance/' + action + '?' + $.param(schemaParams({})), {}, function(job) {
+    if (job.code === 'error') {
+      return alert('Maintenance failed: ' + job.message);
+    }
+    return showMaintenanceJob(job);
+  });
+};
+
+showMaintenanceJob = function(job) {
+  var status;
+  status = job.action + ': ' + job.status;
+  if (job.status === 'running' && job.progress.total > 0) {
+    status += ' ' + Math.floor(100 * job.progress.done / job.progress.total) + '%';
+  }
+  $('#db_maintenance_status').text(status);
+  if (job.status === 'running') {
+    return setTimeout(function() {
+      return apiCall('GET', 'api/maintenance/job', {
+        id: job.id
+      }, function(data) {
+        if (data.code === 'error') {
+          return $('#db_maintenance_status').text(data.message);
+        }
+        return showMaintenanceJob(data);
+      });
+    }, 1000);
+  }
+  if (job.status === 'failed') {
+    return alert(job.action + ' failed: ' + job.error);
+  }
+  if (job.status === 'done') {
+    alert(job.action + ' done:\n' + JSON.stringify(job.result, null, 2));
+    return showDatabaseInfo();
+  }
+};
+
+selectedName = function() {
+  return $('#tables li.selected').attr('data-name') || '';
+};
+
+selectedType = function() {
+  return $('#tables li.selected').attr('data-type');
+};
+
 showDatabaseInfo = function() {
   return getInfo(function(data) {
     $('#db_file_name').text(data.filename);
     $('#db_size').text(bytesToSize(data.size));
     $('#db_count_tables').text(data.number_of_tables);
+    $('#db_count_views').text(data.number_of_views);
+    $('#db_count_triggers').text(data.number_of_triggers);
+    $('#db_backup').attr('href', apiRoot + databasePath('api/backup?' + $.param(schemaParams({
+      gzip: 1
+    }))));
+    $('#db_export_sql').attr('href', apiRoot + databasePath('api/export/sql?' + $.param(schemaParams({}))));
     return $('#db_count_indexes').text(data.number_of_indexes);
   });
 };
 
 showTableInfo = function() {
   var name;
-  name = $('#tables li.selected').text();
+  name = selectedName();
   if (name.length === 0) {
     alert('No table selected. Please, select a table.');
     return;
   }
+  if (selectedType() === 'trigger') {
+    return $('#table_information').hide();
+  }
   return getTableInfo(name, function(data) {
     $('#table_information').show();
+    $('#table_count_indexes').text(data.indexes_count);
     return $('#table_count_rows').text(data.row_count);
   });
 };
 
 showTableStructure = function() {
   var name;
-  name = $('#tables li.selected').text();
+  name = selectedName();
   if (name.length === 0) {
     alert('No table selected. Please, select a table.');
     return;
   }
   return buildTableStructure(name, function() {
     setActiveTab('table_structure');
+    $('#diagram').hide();
     $('#structure').show();
     $('#input').hide();
     return $('#output').hide();
   });
 };
 
-showTableContent = function() {
+showTableContent = function(offset) {
   var name;
-  name = $('#tables li.selected').text();
+  name = selectedName();
   if (name.length === 0) {
     alert('No table selected. Please, select a table.');
     return;
   }
-  return buildTableContent(name, function() {
+  if (selectedType() === 'trigger') {
+    alert('A trigger has no content.');
+    return;
+  }
+  return buildTableContent(name, offset || 0, function() {
     setActiveTab('table_content');
+    $('#params').hide();
+    $('#diagram').hide();
     $('#structure').hide();
     $('#input').hide();
     $('#output').addClass('full');
@@ -238,9 +926,169 @@ showTableContent = function() {
   });
 };
 
+showSchemaDiagram = function() {
+  return getSchemaGraph(function(data) {
+    if (data.code === 'error') {
+      alert(data.message);
+      return;
+    }
+    buildSchemaDiagram(data);
+    setActiveTab('schema_diagram');
+    $('#structure').hide();
+    $('#input').hide();
+    $('#output').hide();
+    return $('#diagram').show();
+  });
+};
+
+buildSchemaDiagram = function(graph) {
+  var boxWidth, boxes, gap, lineHeight, perRow, rowHeights, svg, x, y;
+  boxWidth = 200;
+  lineHeight = 18;
+  gap = 80;
+  perRow = Math.max(1, Math.ceil(Math.sqrt(graph.nodes.length)));
+  boxes = {};
+  rowHeights = [];
+  graph.nodes.forEach(function(node, i) {
+    var row;
+    row = Math.floor(i / perRow);
+    rowHeights[row] = Math.max(rowHeights[row] || 0, (node.columns.length + 1) * lineHeight);
+  });
+  y = 10;
+  graph.nodes.forEach(function(node, i) {
+    if (i > 0 && i % perRow === 0) {
+      y += rowHeights[Math.floor(i / perRow) - 1] + gap;
+    }
+    x = 10 + (i % perRow) * (boxWidth + gap);
+    boxes[node.table] = {
+      x: x,
+      y: y,
+      node: node
+    };
+  });
+  svg = svgElement('svg', {
+    width: 20 + perRow * (boxWidth + gap),
+    height: y + (rowHeights[rowHeights.length - 1] || 0) + 20
+  });
+  svg.appendChild(svgElement('defs')).appendChild(svgElement('marker', {
+    id: 'arrow',
+    viewBox: '0 0 10 10',
+    refX: 10,
+    refY: 5,
+    markerWidth: 8,
+    markerHeight: 8,
+    orient: 'auto'
+  })).appendChild(svgElement('path', {
+    d: 'M 0 0 L 10 5 L 0 10 z'
+  }));
+  graph.edges.forEach(function(edge) {
+    var from, to;
+    from = boxes[edge.from];
+    to = boxes[edge.to];
+    if (!from || !to) {
+      return;
+    }
+    return edge.columns.forEach(function(m) {
+      var columnY, fromX, fromY, path, title, toX, toY, bend;
+      columnY = function(box, name) {
+        var i;
+        i = 0;
+        box.node.columns.forEach(function(c, j) {
+          if (c.name === name) {
+            return i = j;
+          }
+        });
+        return box.y + (i + 1.5) * lineHeight;
+      };
+      fromY = columnY(from, m.from);
+      toY = columnY(to, m.to);
+      if (to.x > from.x) {
+        fromX = from.x + boxWidth;
+        toX = to.x;
+        bend = (toX - fromX) / 2;
+      } else if (to.x < from.x) {
+        fromX = from.x;
+        toX = to.x + boxWidth;
+        bend = (toX - fromX) / 2;
+      } else {
+        fromX = toX = from.x + boxWidth;
+        bend = gap / 2;
+        if (fromY === toY) {
+          toY += lineHeight / 2;
+        }
+      }
+      path = svgElement('path', {
+        'class': 'edge',
+        'data-from': edge.from,
+        'data-to': edge.to,
+        d: 'M ' + fromX + ' ' + fromY + ' C ' + (fromX + bend) + ' ' + fromY + ', ' + (toX - (to.x === from.x ? -bend : bend)) + ' ' + toY + ', ' + toX + ' ' + toY,
+        'marker-end': 'url(#arrow)'
+      });
+      title = svgElement('title');
+      title.textContent = edge.from + '.' + m.from + ' \u2192 ' + edge.to + '.' + m.to + '\nON DELETE ' + edge.on_delete + '\nON UPDATE ' + edge.on_update;
+      path.appendChild(title);
+      return svg.appendChild(path);
+    });
+  });
+  $.each(boxes, function(name, box) {
+    var group, text;
+    group = svgElement('g', {
+      'class': 'node',
+      'data-name': name,
+      transform: 'translate(' + box.x + ',' + box.y + ')'
+    });
+    group.appendChild(svgElement('rect', {
+      width: boxWidth,
+      height: (box.node.columns.length + 1) * lineHeight
+    }));
+    group.appendChild(svgElement('rect', {
+      'class': 'header',
+      width: boxWidth,
+      height: lineHeight
+    }));
+    text = svgElement('text', {
+      'class': 'title',
+      x: 6,
+      y: lineHeight - 5
+    });
+    text.textContent = name;
+    group.appendChild(text);
+    box.node.columns.forEach(function(c, i) {
+      text = svgElement('text', {
+        'class': c.pk > 0 ? 'pk' : '',
+        x: 6,
+        y: (i + 2) * lineHeight - 5
+      });
+      text.textContent = c.name + (c.type ? ' ' + c.type : '');
+      return group.appendChild(text);
+    });
+    return svg.appendChild(group);
+  });
+  $('#diagram_dot').attr('href', apiRoot + databasePath('api/schema/graph?' + $.param(schemaParams({
+    format: 'dot'
+  }))));
+  $('#diagram_mermaid').attr('href', apiRoot + databasePath('api/schema/graph?' + $.param(schemaParams({
+    format: 'mermaid'
+  }))));
+  return $('#diagram_graph').empty().append(svg);
+};
+
+svgElement = function(name, attrs) {
+  var element;
+  element = document.createElementNS('http://www.w3.org/2000/svg', name);
+  $.each(attrs || {}, function(key, value) {
+    return element.setAttribute(key, value);
+  });
+  return element;
+};
+
 showTableQuery = function(query) {
   resetResultTable();
+  $('#pager').hide();
+  $('#filters').hide();
+  $('#params').toggle($('#params tbody tr').length > 0);
   setActiveTab('table_query');
+  $('#diagram').hide();
   $('#structure').hide();
   $('#output').removeClass('full');
   $('#input').show();
@@ -249,7 +1097,11 @@ showTableQuery = function(query) {
 
 runQuery = function(query) {
   $('#run #export_csv #export_json').prop('disabled', true);
-  buildTableQueryResult(query);
+  if ($('#script_mode').is(':checked')) {
+    buildScriptResult(query, $('#script_transaction').is(':checked'));
+  } else {
+    buildTableQueryResult(query);
+  }
   return $('#run #export_csv #export_json').prop('disabled', false);
 };
 
@@ -257,7 +1109,7 @@ exportCSV = function(query) {
   var host, url, win;
   query = window.encodeURI(query.replace(/\n/g, ' '));
   host = window.location.host;
-  url = 'http://' + host + '/api/query?format=csv&query=' + query;
+  url = 'http://' + host + '/' + databasePath('api/query?format=csv') + '&query=' + query;
   return win = window.open(url, '_blank');
 };
 
@@ -265,7 +1117,7 @@ exportJSON = function(query) {
   var host, url, win;
   query = window.encodeURI(query.replace(/\n/g, ' '));
   host = window.location.host;
-  url = 'http://' + host + '/api/query?format=json&query=' + query;
+  url = 'http://' + host + '/' + databasePath('api/query?format=json') + '&query=' + query;
   return win = window.open(url, '_blank');
 };
 
@@ -303,7 +1155,14 @@ addRowsToResultTable = function(rows) {
   return $('#table_results').append(body);
 };
 
+showResultMessage = function(message) {
+  return $('<caption>').text(message).appendTo('#table_results');
+};
+
 resetResultTable = function() {
+  $('#plan').empty().hide();
+  $('#history').hide();
+  $('#script_results').empty().hide();
   return $('#table_results').empty();
 };
 
@@ -322,36 +1181,37 @@ bytesToSize = function(bytes) {
   return Math.round(bytes / Math.pow(1024, i), 2) + ' ' + sizes[i];
 };
 
-getColumnsFromIndexSql = function(name, sql) {
-  if (!sql) return [];
-  var i, match, matches, result;
-  i = sql.indexOf('"' + name + '"');
-  sql = sql.slice(i + 1, sql.length - 1);
-  matches = sql.match(/(\"[\w\s]+\")/g);
-  if (!matches) return [];
-  result = (function() {
-    var _i, _len, _results;
-    _results = [];
-    for (_i = 0, _len = matches.length; _i < _len; _i++) {
-      match = matches[_i];
-      _results.push(match.replace(/"/g, ''));
-    }
-    return _results;
-  })();
-  return result;
-};
-
 $(function() {
   var editor;
   editor = ace.edit('editor');
   editor.setTheme('ace/theme/textmate');
   editor.getSession().setMode('ace/mode/sql');
-  $('#tables').on('click', 'li', function() {
+  editor.getSession().on('change', function() {
+    return showParams(editor.getValue());
+  });
+  $('#tables').on('click', 'li[data-name]', function() {
+    contentFilters = [];
     $('#tables li.selected').removeClass('selected');
     $(this).addClass('selected');
     showTableInfo();
     return showTableStructure();
   });
+  $('#database').on('change', function() {
+    currentDatabase = $(this).val();
+    currentSchema = '';
+    contentFilters = [];
+    loadSchemas();
+    return loadTables(function() {
+      return showDatabaseInfo();
+    });
+  });
+  $('#schema').on('change', function() {
+    currentSchema = $(this).val();
+    contentFilters = [];
+    return loadTables(function() {
+      return showDatabaseInfo();
+    });
+  });
   $('#table_structure').on('click', function() {
     return showTableStructure();
   });
@@ -361,6 +1221,38 @@ $(function() {
   $('#table_query').on('click', function() {
     return showTableQuery();
   });
+  $('#schema_diagram').on('click', function() {
+    return showSchemaDiagram();
+  });
+  $('#diagram_graph').on('click', 'g.node', function() {
+    var name;
+    name = $(this).attr('data-name');
+    return $('#tables li[data-name]').filter(function() {
+      return $(this).attr('data-name') === name;
+    }).click();
+  });
+  $('#diagram_graph').on('mouseenter mouseleave', 'g.node', function(event) {
+    var name;
+    name = $(this).attr('data-name');
+    return $('#diagram_graph path.edge').each(function() {
+      var active;
+      active = event.type === 'mouseenter' && ($(this).attr('data-from') === name || $(this).attr('data-to') === name);
+      return this.setAttribute('class', active ? 'edge active' : 'edge');
+    });
+  });
+  $('#page_prev').on('click', function() {
+    return showTableContent(Math.max(contentPage.offset - pageSize, 0));
+  });
+  $('#page_next').on('click', function() {
+    return showTableContent(contentPage.offset + pageSize);
+  });
+  $('#filter_add').on('click', function() {
+    return addFilter();
+  });
+  $('#filter_list').on('click', 'li', function() {
+    contentFilters.splice($(this).data('index'), 1);
+    return showTableContent(0);
+  });
   $('#index_sql_modal').on('show.bs.modal', function(event) {
     var button, modal, sql, title;
     button = $(event.relatedTarget);
@@ -378,6 +1270,17 @@ $(function() {
     }
     return runQuery(query);
   });
+  $('#explain').on('click', function() {
+    var query;
+    query = $.trim(editor.getValue());
+    if (query.length === 0) {
+      return;
+    }
+    return explainQuery(query);
+  });
+  $('#cancel').on('click', function() {
+    return cancelQuery();
+  });
   $('#export_csv').on('click', function() {
     var query;
     query = $.trim(editor.getValue());
@@ -386,11 +1289,60 @@ $(function() {
     }
     return exportCSV(query);
   });
+  $('#show_history').on('click', function() {
+    if ($('#history').is(':visible')) {
+      return $('#history').hide();
+    }
+    resetResultTable();
+    return showHistory(0);
+  });
+  $('#history_search').on('input', _.debounce(function() {
+    return showHistory(0);
+  }, 300));
+  $('#history_more').on('click', function() {
+    return showHistory($(this).data('offset'));
+  });
+  $('#save_query').on('click', function() {
+    var query;
+    query = $.trim(editor.getValue());
+    if (query.length === 0) {
+      return;
+    }
+    return saveQuery(query);
+  });
+  $('#saved_query').on('change', function() {
+    var query;
+    query = $(this).find('option:selected').data('query');
+    $('#saved_open').toggle(!!query);
+    if (!query) {
+      return;
+    }
+    $('#saved_open').attr('href', apiRoot + 'q/' + query.slug);
+    return editor.setValue(query.sql, -1);
+  });
+  $('#history').on('click', 'li', function() {
+    return editor.setValue($(this).data('query'), -1);
+  });
   $('.btn-file :file').on('fileselect', function(event, numFiles, label) {
     return console.log(label);
   });
+  $('#db_maintenance').on('change', function() {
+    if ($(this).val()) {
+      startMaintenance($(this).val());
+    }
+    return $(this).val('');
+  });
+  $('#import_file').on('change', function() {
+    if (this.files.length > 0) {
+      importFile(this.files[0]);
+    }
+    return $(this).val('');
+  });
   return loadTables(function() {
     showDatabaseInfo();
+    loadDatabases();
+    loadSchemas();
+    loadSavedQueries();
     return $('#main').show();
   });
 });